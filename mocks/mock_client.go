@@ -5,37 +5,149 @@
 package mocks
 
 import (
+	io "io"
+	url "net/url"
+	reflect "reflect"
+
 	gomock "github.com/golang/mock/gomock"
 	serializers "github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
 	model "github.com/mattermost/mattermost-server/v5/model"
-	url "net/url"
-	reflect "reflect"
 )
 
-// MockClient is a mock of Client interface
+// MockClient is a mock of Client interface.
 type MockClient struct {
 	ctrl     *gomock.Controller
 	recorder *MockClientMockRecorder
 }
 
-// MockClientMockRecorder is the mock recorder for MockClient
+// MockClientMockRecorder is the mock recorder for MockClient.
 type MockClientMockRecorder struct {
 	mock *MockClient
 }
 
-// NewMockClient creates a new mock instance
+// NewMockClient creates a new mock instance.
 func NewMockClient(ctrl *gomock.Controller) *MockClient {
 	mock := &MockClient{ctrl: ctrl}
 	mock.recorder = &MockClientMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
-// CreateSubscription mocks base method
+// AddPullRequestThreadComment mocks base method.
+func (m *MockClient) AddPullRequestThreadComment(arg0, arg1, arg2 string, arg3, arg4 int, arg5 *serializers.PullRequestThreadCommentRequest, arg6 string) (*serializers.Comment, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPullRequestThreadComment", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(*serializers.Comment)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddPullRequestThreadComment indicates an expected call of AddPullRequestThreadComment.
+func (mr *MockClientMockRecorder) AddPullRequestThreadComment(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPullRequestThreadComment", reflect.TypeOf((*MockClient)(nil).AddPullRequestThreadComment), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// AddWorkItemComment mocks base method.
+func (m *MockClient) AddWorkItemComment(arg0, arg1, arg2, arg3, arg4 string) (*serializers.WorkItemComment, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkItemComment", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*serializers.WorkItemComment)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddWorkItemComment indicates an expected call of AddWorkItemComment.
+func (mr *MockClientMockRecorder) AddWorkItemComment(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkItemComment", reflect.TypeOf((*MockClient)(nil).AddWorkItemComment), arg0, arg1, arg2, arg3, arg4)
+}
+
+// AddWorkItemHyperlink mocks base method.
+func (m *MockClient) AddWorkItemHyperlink(arg0, arg1, arg2, arg3, arg4, arg5 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkItemHyperlink", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkItemHyperlink indicates an expected call of AddWorkItemHyperlink.
+func (mr *MockClientMockRecorder) AddWorkItemHyperlink(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkItemHyperlink", reflect.TypeOf((*MockClient)(nil).AddWorkItemHyperlink), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// AddWorkItemRelations mocks base method.
+func (m *MockClient) AddWorkItemRelations(arg0, arg1, arg2 string, arg3 []*serializers.WorkItemRelation, arg4 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkItemRelations", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkItemRelations indicates an expected call of AddWorkItemRelations.
+func (mr *MockClientMockRecorder) AddWorkItemRelations(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkItemRelations", reflect.TypeOf((*MockClient)(nil).AddWorkItemRelations), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CancelBuild mocks base method.
+func (m *MockClient) CancelBuild(arg0, arg1 string, arg2 int, arg3 string) (*serializers.BuildDetails, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBuild", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*serializers.BuildDetails)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CancelBuild indicates an expected call of CancelBuild.
+func (mr *MockClientMockRecorder) CancelBuild(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBuild", reflect.TypeOf((*MockClient)(nil).CancelBuild), arg0, arg1, arg2, arg3)
+}
+
+// CreateGitBranch mocks base method.
+func (m *MockClient) CreateGitBranch(arg0, arg1, arg2, arg3, arg4, arg5 string) (*serializers.GitRefUpdateResult, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGitBranch", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*serializers.GitRefUpdateResult)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateGitBranch indicates an expected call of CreateGitBranch.
+func (mr *MockClientMockRecorder) CreateGitBranch(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGitBranch", reflect.TypeOf((*MockClient)(nil).CreateGitBranch), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// CreatePullRequest mocks base method.
+func (m *MockClient) CreatePullRequest(arg0, arg1, arg2 string, arg3 *serializers.CreatePullRequestRequest, arg4 string) (*serializers.PullRequest, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePullRequest", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*serializers.PullRequest)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePullRequest indicates an expected call of CreatePullRequest.
+func (mr *MockClientMockRecorder) CreatePullRequest(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequest", reflect.TypeOf((*MockClient)(nil).CreatePullRequest), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CreateSubscription mocks base method.
 func (m *MockClient) CreateSubscription(arg0 *serializers.CreateSubscriptionRequestPayload, arg1 *serializers.ProjectDetails, arg2, arg3, arg4, arg5 string) (*serializers.SubscriptionValue, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSubscription", arg0, arg1, arg2, arg3, arg4, arg5)
@@ -45,13 +157,13 @@ func (m *MockClient) CreateSubscription(arg0 *serializers.CreateSubscriptionRequ
 	return ret0, ret1, ret2
 }
 
-// CreateSubscription indicates an expected call of CreateSubscription
+// CreateSubscription indicates an expected call of CreateSubscription.
 func (mr *MockClientMockRecorder) CreateSubscription(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockClient)(nil).CreateSubscription), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
-// CreateTask mocks base method
+// CreateTask mocks base method.
 func (m *MockClient) CreateTask(arg0 *serializers.CreateTaskRequestPayload, arg1 string) (*serializers.TaskValue, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateTask", arg0, arg1)
@@ -61,13 +173,13 @@ func (m *MockClient) CreateTask(arg0 *serializers.CreateTaskRequestPayload, arg1
 	return ret0, ret1, ret2
 }
 
-// CreateTask indicates an expected call of CreateTask
+// CreateTask indicates an expected call of CreateTask.
 func (mr *MockClientMockRecorder) CreateTask(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockClient)(nil).CreateTask), arg0, arg1)
 }
 
-// DeleteSubscription mocks base method
+// DeleteSubscription mocks base method.
 func (m *MockClient) DeleteSubscription(arg0, arg1, arg2 string) (int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteSubscription", arg0, arg1, arg2)
@@ -76,13 +188,13 @@ func (m *MockClient) DeleteSubscription(arg0, arg1, arg2 string) (int, error) {
 	return ret0, ret1
 }
 
-// DeleteSubscription indicates an expected call of DeleteSubscription
+// DeleteSubscription indicates an expected call of DeleteSubscription.
 func (mr *MockClientMockRecorder) DeleteSubscription(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockClient)(nil).DeleteSubscription), arg0, arg1, arg2)
 }
 
-// GenerateOAuthToken mocks base method
+// GenerateOAuthToken mocks base method.
 func (m *MockClient) GenerateOAuthToken(arg0 url.Values) (*serializers.OAuthSuccessResponse, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GenerateOAuthToken", arg0)
@@ -92,29 +204,13 @@ func (m *MockClient) GenerateOAuthToken(arg0 url.Values) (*serializers.OAuthSucc
 	return ret0, ret1, ret2
 }
 
-// GenerateOAuthToken indicates an expected call of GenerateOAuthToken
+// GenerateOAuthToken indicates an expected call of GenerateOAuthToken.
 func (mr *MockClientMockRecorder) GenerateOAuthToken(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateOAuthToken", reflect.TypeOf((*MockClient)(nil).GenerateOAuthToken), arg0)
 }
 
-// GetUserProfile mocks base method
-func (m *MockClient) GetUserProfile(arg0, arg1 string) (*serializers.UserProfile, int, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserProfile", arg0, arg1)
-	ret0, _ := ret[0].(*serializers.UserProfile)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
-}
-
-// GetApprovalDetails indicates an expected call of GetApprovalDetails
-func (mr *MockClientMockRecorder) GetUserProfile(arg0, arg1 string) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProfile", reflect.TypeOf((*MockClient)(nil).GetUserProfile), arg0, arg1)
-}
-
-// GetApprovalDetails mocks base method
+// GetApprovalDetails mocks base method.
 func (m *MockClient) GetApprovalDetails(arg0, arg1, arg2 string, arg3 int) (*serializers.PipelineApprovalDetails, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetApprovalDetails", arg0, arg1, arg2, arg3)
@@ -124,13 +220,13 @@ func (m *MockClient) GetApprovalDetails(arg0, arg1, arg2 string, arg3 int) (*ser
 	return ret0, ret1, ret2
 }
 
-// GetApprovalDetails indicates an expected call of GetApprovalDetails
+// GetApprovalDetails indicates an expected call of GetApprovalDetails.
 func (mr *MockClientMockRecorder) GetApprovalDetails(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovalDetails", reflect.TypeOf((*MockClient)(nil).GetApprovalDetails), arg0, arg1, arg2, arg3)
 }
 
-// GetBuildDetails mocks base method
+// GetBuildDetails mocks base method.
 func (m *MockClient) GetBuildDetails(arg0, arg1, arg2, arg3 string) (*serializers.BuildDetails, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBuildDetails", arg0, arg1, arg2, arg3)
@@ -140,13 +236,253 @@ func (m *MockClient) GetBuildDetails(arg0, arg1, arg2, arg3 string) (*serializer
 	return ret0, ret1, ret2
 }
 
-// GetBuildDetails indicates an expected call of GetBuildDetails
+// GetBuildDetails indicates an expected call of GetBuildDetails.
 func (mr *MockClientMockRecorder) GetBuildDetails(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildDetails", reflect.TypeOf((*MockClient)(nil).GetBuildDetails), arg0, arg1, arg2, arg3)
 }
 
-// GetPullRequest mocks base method
+// GetBuildLogLines mocks base method.
+func (m *MockClient) GetBuildLogLines(arg0, arg1 string, arg2, arg3, arg4 int, arg5 string) (*serializers.BuildLogLines, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuildLogLines", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*serializers.BuildLogLines)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBuildLogLines indicates an expected call of GetBuildLogLines.
+func (mr *MockClientMockRecorder) GetBuildLogLines(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildLogLines", reflect.TypeOf((*MockClient)(nil).GetBuildLogLines), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// GetBuildLogs mocks base method.
+func (m *MockClient) GetBuildLogs(arg0, arg1 string, arg2 int, arg3 string) (*serializers.BuildLogList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuildLogs", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*serializers.BuildLogList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBuildLogs indicates an expected call of GetBuildLogs.
+func (mr *MockClientMockRecorder) GetBuildLogs(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildLogs", reflect.TypeOf((*MockClient)(nil).GetBuildLogs), arg0, arg1, arg2, arg3)
+}
+
+// GetBuildTimeline mocks base method.
+func (m *MockClient) GetBuildTimeline(arg0, arg1 string, arg2 int, arg3 string) (*serializers.BuildTimeline, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuildTimeline", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*serializers.BuildTimeline)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBuildTimeline indicates an expected call of GetBuildTimeline.
+func (mr *MockClientMockRecorder) GetBuildTimeline(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildTimeline", reflect.TypeOf((*MockClient)(nil).GetBuildTimeline), arg0, arg1, arg2, arg3)
+}
+
+// GetCheckConfiguration mocks base method.
+func (m *MockClient) GetCheckConfiguration(arg0, arg1 string, arg2 int, arg3 string) (*serializers.CheckConfiguration, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckConfiguration", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*serializers.CheckConfiguration)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCheckConfiguration indicates an expected call of GetCheckConfiguration.
+func (mr *MockClientMockRecorder) GetCheckConfiguration(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckConfiguration", reflect.TypeOf((*MockClient)(nil).GetCheckConfiguration), arg0, arg1, arg2, arg3)
+}
+
+// GetCommitDiffs mocks base method.
+func (m *MockClient) GetCommitDiffs(arg0, arg1, arg2, arg3, arg4, arg5 string) (*serializers.CommitDiffs, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommitDiffs", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*serializers.CommitDiffs)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCommitDiffs indicates an expected call of GetCommitDiffs.
+func (mr *MockClientMockRecorder) GetCommitDiffs(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitDiffs", reflect.TypeOf((*MockClient)(nil).GetCommitDiffs), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// GetCurrentIteration mocks base method.
+func (m *MockClient) GetCurrentIteration(arg0, arg1, arg2, arg3 string) (*serializers.IterationList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentIteration", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*serializers.IterationList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCurrentIteration indicates an expected call of GetCurrentIteration.
+func (mr *MockClientMockRecorder) GetCurrentIteration(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentIteration", reflect.TypeOf((*MockClient)(nil).GetCurrentIteration), arg0, arg1, arg2, arg3)
+}
+
+// GetFailedTestResults mocks base method.
+func (m *MockClient) GetFailedTestResults(arg0, arg1 string, arg2, arg3 int, arg4 string) (*serializers.TestResultList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFailedTestResults", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*serializers.TestResultList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFailedTestResults indicates an expected call of GetFailedTestResults.
+func (mr *MockClientMockRecorder) GetFailedTestResults(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedTestResults", reflect.TypeOf((*MockClient)(nil).GetFailedTestResults), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetGitRepositories mocks base method.
+func (m *MockClient) GetGitRepositories(arg0, arg1, arg2 string) (*serializers.GitRepositoryList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGitRepositories", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*serializers.GitRepositoryList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGitRepositories indicates an expected call of GetGitRepositories.
+func (mr *MockClientMockRecorder) GetGitRepositories(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGitRepositories", reflect.TypeOf((*MockClient)(nil).GetGitRepositories), arg0, arg1, arg2)
+}
+
+// GetGitRepositoryBranches mocks base method.
+func (m *MockClient) GetGitRepositoryBranches(arg0, arg1, arg2, arg3 string) (*serializers.GitRefList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGitRepositoryBranches", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*serializers.GitRefList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGitRepositoryBranches indicates an expected call of GetGitRepositoryBranches.
+func (mr *MockClientMockRecorder) GetGitRepositoryBranches(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGitRepositoryBranches", reflect.TypeOf((*MockClient)(nil).GetGitRepositoryBranches), arg0, arg1, arg2, arg3)
+}
+
+// GetIdentitiesByDescriptors mocks base method.
+func (m *MockClient) GetIdentitiesByDescriptors(arg0 string, arg1 []string, arg2 string) (*serializers.IdentityList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdentitiesByDescriptors", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*serializers.IdentityList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIdentitiesByDescriptors indicates an expected call of GetIdentitiesByDescriptors.
+func (mr *MockClientMockRecorder) GetIdentitiesByDescriptors(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdentitiesByDescriptors", reflect.TypeOf((*MockClient)(nil).GetIdentitiesByDescriptors), arg0, arg1, arg2)
+}
+
+// GetIdentityWithMembers mocks base method.
+func (m *MockClient) GetIdentityWithMembers(arg0, arg1, arg2 string) (*serializers.IdentityList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdentityWithMembers", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*serializers.IdentityList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIdentityWithMembers indicates an expected call of GetIdentityWithMembers.
+func (mr *MockClientMockRecorder) GetIdentityWithMembers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdentityWithMembers", reflect.TypeOf((*MockClient)(nil).GetIdentityWithMembers), arg0, arg1, arg2)
+}
+
+// GetIterationCapacities mocks base method.
+func (m *MockClient) GetIterationCapacities(arg0, arg1, arg2, arg3, arg4 string) (*serializers.IterationCapacity, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIterationCapacities", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*serializers.IterationCapacity)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIterationCapacities indicates an expected call of GetIterationCapacities.
+func (mr *MockClientMockRecorder) GetIterationCapacities(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIterationCapacities", reflect.TypeOf((*MockClient)(nil).GetIterationCapacities), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetIterationWorkItems mocks base method.
+func (m *MockClient) GetIterationWorkItems(arg0, arg1, arg2, arg3, arg4 string) (*serializers.IterationWorkItems, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIterationWorkItems", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*serializers.IterationWorkItems)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIterationWorkItems indicates an expected call of GetIterationWorkItems.
+func (mr *MockClientMockRecorder) GetIterationWorkItems(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIterationWorkItems", reflect.TypeOf((*MockClient)(nil).GetIterationWorkItems), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetPipelines mocks base method.
+func (m *MockClient) GetPipelines(arg0, arg1, arg2 string) (*serializers.PipelineList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelines", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*serializers.PipelineList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPipelines indicates an expected call of GetPipelines.
+func (mr *MockClientMockRecorder) GetPipelines(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelines", reflect.TypeOf((*MockClient)(nil).GetPipelines), arg0, arg1, arg2)
+}
+
+// GetPolicyEvaluations mocks base method.
+func (m *MockClient) GetPolicyEvaluations(arg0, arg1 string, arg2 int, arg3 string) (*serializers.PolicyEvaluationList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyEvaluations", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*serializers.PolicyEvaluationList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPolicyEvaluations indicates an expected call of GetPolicyEvaluations.
+func (mr *MockClientMockRecorder) GetPolicyEvaluations(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyEvaluations", reflect.TypeOf((*MockClient)(nil).GetPolicyEvaluations), arg0, arg1, arg2, arg3)
+}
+
+// GetPullRequest mocks base method.
 func (m *MockClient) GetPullRequest(arg0, arg1, arg2, arg3 string) (*serializers.PullRequest, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetPullRequest", arg0, arg1, arg2, arg3)
@@ -156,13 +492,29 @@ func (m *MockClient) GetPullRequest(arg0, arg1, arg2, arg3 string) (*serializers
 	return ret0, ret1, ret2
 }
 
-// GetPullRequest indicates an expected call of GetPullRequest
+// GetPullRequest indicates an expected call of GetPullRequest.
 func (mr *MockClientMockRecorder) GetPullRequest(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequest", reflect.TypeOf((*MockClient)(nil).GetPullRequest), arg0, arg1, arg2, arg3)
 }
 
-// GetReleaseDetails mocks base method
+// GetPullRequests mocks base method.
+func (m *MockClient) GetPullRequests(arg0, arg1, arg2 string, arg3 *serializers.PullRequestSearchCriteria, arg4 string) (*serializers.PullRequestList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequests", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*serializers.PullRequestList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPullRequests indicates an expected call of GetPullRequests.
+func (mr *MockClientMockRecorder) GetPullRequests(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequests", reflect.TypeOf((*MockClient)(nil).GetPullRequests), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetReleaseDetails mocks base method.
 func (m *MockClient) GetReleaseDetails(arg0, arg1, arg2, arg3 string) (*serializers.ReleaseDetails, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetReleaseDetails", arg0, arg1, arg2, arg3)
@@ -172,13 +524,13 @@ func (m *MockClient) GetReleaseDetails(arg0, arg1, arg2, arg3 string) (*serializ
 	return ret0, ret1, ret2
 }
 
-// GetReleaseDetails indicates an expected call of GetReleaseDetails
+// GetReleaseDetails indicates an expected call of GetReleaseDetails.
 func (mr *MockClientMockRecorder) GetReleaseDetails(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleaseDetails", reflect.TypeOf((*MockClient)(nil).GetReleaseDetails), arg0, arg1, arg2, arg3)
 }
 
-// GetRunApprovalDetails mocks base method
+// GetRunApprovalDetails mocks base method.
 func (m *MockClient) GetRunApprovalDetails(arg0, arg1, arg2, arg3 string) (*serializers.PipelineRunApprovalDetails, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRunApprovalDetails", arg0, arg1, arg2, arg3)
@@ -188,13 +540,13 @@ func (m *MockClient) GetRunApprovalDetails(arg0, arg1, arg2, arg3 string) (*seri
 	return ret0, ret1, ret2
 }
 
-// GetRunApprovalDetails indicates an expected call of GetRunApprovalDetails
+// GetRunApprovalDetails indicates an expected call of GetRunApprovalDetails.
 func (mr *MockClientMockRecorder) GetRunApprovalDetails(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunApprovalDetails", reflect.TypeOf((*MockClient)(nil).GetRunApprovalDetails), arg0, arg1, arg2, arg3)
 }
 
-// GetSubscriptionFilterPossibleValues mocks base method
+// GetSubscriptionFilterPossibleValues mocks base method.
 func (m *MockClient) GetSubscriptionFilterPossibleValues(arg0 *serializers.GetSubscriptionFilterPossibleValuesRequestPayload, arg1 string) (*serializers.SubscriptionFilterPossibleValuesResponseFromClient, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSubscriptionFilterPossibleValues", arg0, arg1)
@@ -204,13 +556,13 @@ func (m *MockClient) GetSubscriptionFilterPossibleValues(arg0 *serializers.GetSu
 	return ret0, ret1, ret2
 }
 
-// GetSubscriptionFilterPossibleValues indicates an expected call of GetSubscriptionFilterPossibleValues
+// GetSubscriptionFilterPossibleValues indicates an expected call of GetSubscriptionFilterPossibleValues.
 func (mr *MockClientMockRecorder) GetSubscriptionFilterPossibleValues(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionFilterPossibleValues", reflect.TypeOf((*MockClient)(nil).GetSubscriptionFilterPossibleValues), arg0, arg1)
 }
 
-// GetTask mocks base method
+// GetTask mocks base method.
 func (m *MockClient) GetTask(arg0, arg1, arg2, arg3 string) (*serializers.TaskValue, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTask", arg0, arg1, arg2, arg3)
@@ -220,13 +572,157 @@ func (m *MockClient) GetTask(arg0, arg1, arg2, arg3 string) (*serializers.TaskVa
 	return ret0, ret1, ret2
 }
 
-// GetTask indicates an expected call of GetTask
+// GetTask indicates an expected call of GetTask.
 func (mr *MockClientMockRecorder) GetTask(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockClient)(nil).GetTask), arg0, arg1, arg2, arg3)
 }
 
-// Link mocks base method
+// GetTasks mocks base method.
+func (m *MockClient) GetTasks(arg0, arg1 string, arg2, arg3 []string, arg4 string) (*serializers.TaskList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTasks", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*serializers.TaskList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTasks indicates an expected call of GetTasks.
+func (mr *MockClientMockRecorder) GetTasks(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasks", reflect.TypeOf((*MockClient)(nil).GetTasks), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetTeamSettings mocks base method.
+func (m *MockClient) GetTeamSettings(arg0, arg1, arg2, arg3 string) (*serializers.TeamSettings, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamSettings", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*serializers.TeamSettings)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTeamSettings indicates an expected call of GetTeamSettings.
+func (mr *MockClientMockRecorder) GetTeamSettings(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamSettings", reflect.TypeOf((*MockClient)(nil).GetTeamSettings), arg0, arg1, arg2, arg3)
+}
+
+// GetTestRuns mocks base method.
+func (m *MockClient) GetTestRuns(arg0, arg1 string, arg2 int, arg3 string) (*serializers.TestRunList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTestRuns", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*serializers.TestRunList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTestRuns indicates an expected call of GetTestRuns.
+func (mr *MockClientMockRecorder) GetTestRuns(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestRuns", reflect.TypeOf((*MockClient)(nil).GetTestRuns), arg0, arg1, arg2, arg3)
+}
+
+// GetUserProfile mocks base method.
+func (m *MockClient) GetUserProfile(arg0, arg1 string) (*serializers.UserProfile, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserProfile", arg0, arg1)
+	ret0, _ := ret[0].(*serializers.UserProfile)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserProfile indicates an expected call of GetUserProfile.
+func (mr *MockClientMockRecorder) GetUserProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProfile", reflect.TypeOf((*MockClient)(nil).GetUserProfile), arg0, arg1)
+}
+
+// GetWorkItemFields mocks base method.
+func (m *MockClient) GetWorkItemFields(arg0, arg1, arg2 string) (*serializers.WorkItemFieldList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkItemFields", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*serializers.WorkItemFieldList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWorkItemFields indicates an expected call of GetWorkItemFields.
+func (mr *MockClientMockRecorder) GetWorkItemFields(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkItemFields", reflect.TypeOf((*MockClient)(nil).GetWorkItemFields), arg0, arg1, arg2)
+}
+
+// GetWorkItemTemplate mocks base method.
+func (m *MockClient) GetWorkItemTemplate(arg0, arg1, arg2, arg3, arg4 string) (*serializers.WorkItemTemplate, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkItemTemplate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*serializers.WorkItemTemplate)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWorkItemTemplate indicates an expected call of GetWorkItemTemplate.
+func (mr *MockClientMockRecorder) GetWorkItemTemplate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkItemTemplate", reflect.TypeOf((*MockClient)(nil).GetWorkItemTemplate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetWorkItemTemplates mocks base method.
+func (m *MockClient) GetWorkItemTemplates(arg0, arg1, arg2, arg3 string) (*serializers.WorkItemTemplateList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkItemTemplates", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*serializers.WorkItemTemplateList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWorkItemTemplates indicates an expected call of GetWorkItemTemplates.
+func (mr *MockClientMockRecorder) GetWorkItemTemplates(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkItemTemplates", reflect.TypeOf((*MockClient)(nil).GetWorkItemTemplates), arg0, arg1, arg2, arg3)
+}
+
+// GetWorkItemTypeFields mocks base method.
+func (m *MockClient) GetWorkItemTypeFields(arg0, arg1, arg2, arg3 string) (*serializers.WorkItemTypeFieldList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkItemTypeFields", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*serializers.WorkItemTypeFieldList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWorkItemTypeFields indicates an expected call of GetWorkItemTypeFields.
+func (mr *MockClientMockRecorder) GetWorkItemTypeFields(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkItemTypeFields", reflect.TypeOf((*MockClient)(nil).GetWorkItemTypeFields), arg0, arg1, arg2, arg3)
+}
+
+// GetWorkItemTypes mocks base method.
+func (m *MockClient) GetWorkItemTypes(arg0, arg1, arg2 string) (*serializers.WorkItemTypeList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkItemTypes", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*serializers.WorkItemTypeList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWorkItemTypes indicates an expected call of GetWorkItemTypes.
+func (mr *MockClientMockRecorder) GetWorkItemTypes(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkItemTypes", reflect.TypeOf((*MockClient)(nil).GetWorkItemTypes), arg0, arg1, arg2)
+}
+
+// Link mocks base method.
 func (m *MockClient) Link(arg0 *serializers.LinkRequestPayload, arg1 string) (*serializers.Project, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Link", arg0, arg1)
@@ -236,13 +732,13 @@ func (m *MockClient) Link(arg0 *serializers.LinkRequestPayload, arg1 string) (*s
 	return ret0, ret1, ret2
 }
 
-// Link indicates an expected call of Link
+// Link indicates an expected call of Link.
 func (mr *MockClientMockRecorder) Link(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Link", reflect.TypeOf((*MockClient)(nil).Link), arg0, arg1)
 }
 
-// OpenDialogRequest mocks base method
+// OpenDialogRequest mocks base method.
 func (m *MockClient) OpenDialogRequest(arg0 *model.OpenDialogRequest, arg1 string) (int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "OpenDialogRequest", arg0, arg1)
@@ -251,13 +747,92 @@ func (m *MockClient) OpenDialogRequest(arg0 *model.OpenDialogRequest, arg1 strin
 	return ret0, ret1
 }
 
-// OpenDialogRequest indicates an expected call of OpenDialogRequest
+// OpenDialogRequest indicates an expected call of OpenDialogRequest.
 func (mr *MockClientMockRecorder) OpenDialogRequest(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDialogRequest", reflect.TypeOf((*MockClient)(nil).OpenDialogRequest), arg0, arg1)
 }
 
-// UpdatePipelineApprovalRequest mocks base method
+// PreviewPipelineRun mocks base method.
+func (m *MockClient) PreviewPipelineRun(arg0, arg1 string, arg2 int, arg3 string) (*serializers.PipelinePreview, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewPipelineRun", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*serializers.PipelinePreview)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PreviewPipelineRun indicates an expected call of PreviewPipelineRun.
+func (mr *MockClientMockRecorder) PreviewPipelineRun(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewPipelineRun", reflect.TypeOf((*MockClient)(nil).PreviewPipelineRun), arg0, arg1, arg2, arg3)
+}
+
+// RetryBuild mocks base method.
+func (m *MockClient) RetryBuild(arg0, arg1 string, arg2 int, arg3 string) (*serializers.BuildDetails, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryBuild", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*serializers.BuildDetails)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RetryBuild indicates an expected call of RetryBuild.
+func (mr *MockClientMockRecorder) RetryBuild(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryBuild", reflect.TypeOf((*MockClient)(nil).RetryBuild), arg0, arg1, arg2, arg3)
+}
+
+// RetryBuildStage mocks base method.
+func (m *MockClient) RetryBuildStage(arg0, arg1 string, arg2 int, arg3, arg4 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryBuildStage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryBuildStage indicates an expected call of RetryBuildStage.
+func (mr *MockClientMockRecorder) RetryBuildStage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryBuildStage", reflect.TypeOf((*MockClient)(nil).RetryBuildStage), arg0, arg1, arg2, arg3, arg4)
+}
+
+// RunPipeline mocks base method.
+func (m *MockClient) RunPipeline(arg0, arg1 string, arg2 int, arg3 *serializers.RunPipelineRequest, arg4 string) (*serializers.PipelineRun, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunPipeline", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*serializers.PipelineRun)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RunPipeline indicates an expected call of RunPipeline.
+func (mr *MockClientMockRecorder) RunPipeline(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPipeline", reflect.TypeOf((*MockClient)(nil).RunPipeline), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SearchIdentities mocks base method.
+func (m *MockClient) SearchIdentities(arg0, arg1, arg2 string) (*serializers.IdentityList, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchIdentities", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*serializers.IdentityList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchIdentities indicates an expected call of SearchIdentities.
+func (mr *MockClientMockRecorder) SearchIdentities(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIdentities", reflect.TypeOf((*MockClient)(nil).SearchIdentities), arg0, arg1, arg2)
+}
+
+// UpdatePipelineApprovalRequest mocks base method.
 func (m *MockClient) UpdatePipelineApprovalRequest(arg0 *serializers.PipelineApproveRequest, arg1, arg2, arg3 string, arg4 int) (int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdatePipelineApprovalRequest", arg0, arg1, arg2, arg3, arg4)
@@ -266,13 +841,13 @@ func (m *MockClient) UpdatePipelineApprovalRequest(arg0 *serializers.PipelineApp
 	return ret0, ret1
 }
 
-// UpdatePipelineApprovalRequest indicates an expected call of UpdatePipelineApprovalRequest
+// UpdatePipelineApprovalRequest indicates an expected call of UpdatePipelineApprovalRequest.
 func (mr *MockClientMockRecorder) UpdatePipelineApprovalRequest(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipelineApprovalRequest", reflect.TypeOf((*MockClient)(nil).UpdatePipelineApprovalRequest), arg0, arg1, arg2, arg3, arg4)
 }
 
-// UpdatePipelineRunApprovalRequest mocks base method
+// UpdatePipelineRunApprovalRequest mocks base method.
 func (m *MockClient) UpdatePipelineRunApprovalRequest(arg0 []*serializers.PipelineApproveRequest, arg1, arg2, arg3 string) (*serializers.PipelineRunApproveResponse, int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdatePipelineRunApprovalRequest", arg0, arg1, arg2, arg3)
@@ -282,8 +857,87 @@ func (m *MockClient) UpdatePipelineRunApprovalRequest(arg0 []*serializers.Pipeli
 	return ret0, ret1, ret2
 }
 
-// UpdatePipelineRunApprovalRequest indicates an expected call of UpdatePipelineRunApprovalRequest
+// UpdatePipelineRunApprovalRequest indicates an expected call of UpdatePipelineRunApprovalRequest.
 func (mr *MockClientMockRecorder) UpdatePipelineRunApprovalRequest(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePipelineRunApprovalRequest", reflect.TypeOf((*MockClient)(nil).UpdatePipelineRunApprovalRequest), arg0, arg1, arg2, arg3)
 }
+
+// UpdatePullRequest mocks base method.
+func (m *MockClient) UpdatePullRequest(arg0, arg1, arg2 string, arg3 int, arg4 *serializers.UpdatePullRequestRequest, arg5 string) (*serializers.PullRequest, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePullRequest", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*serializers.PullRequest)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdatePullRequest indicates an expected call of UpdatePullRequest.
+func (mr *MockClientMockRecorder) UpdatePullRequest(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequest", reflect.TypeOf((*MockClient)(nil).UpdatePullRequest), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// UpdatePullRequestThreadStatus mocks base method.
+func (m *MockClient) UpdatePullRequestThreadStatus(arg0, arg1, arg2 string, arg3, arg4 int, arg5, arg6 string) (*serializers.PullRequestCommentThread, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePullRequestThreadStatus", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(*serializers.PullRequestCommentThread)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdatePullRequestThreadStatus indicates an expected call of UpdatePullRequestThreadStatus.
+func (mr *MockClientMockRecorder) UpdatePullRequestThreadStatus(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequestThreadStatus", reflect.TypeOf((*MockClient)(nil).UpdatePullRequestThreadStatus), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// UpdatePullRequestVote mocks base method.
+func (m *MockClient) UpdatePullRequestVote(arg0, arg1, arg2, arg3 string, arg4, arg5 int, arg6 string) (*serializers.Reviewer, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePullRequestVote", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(*serializers.Reviewer)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdatePullRequestVote indicates an expected call of UpdatePullRequestVote.
+func (mr *MockClientMockRecorder) UpdatePullRequestVote(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequestVote", reflect.TypeOf((*MockClient)(nil).UpdatePullRequestVote), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// UpdateWorkItemState mocks base method.
+func (m *MockClient) UpdateWorkItemState(arg0, arg1, arg2, arg3, arg4 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkItemState", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkItemState indicates an expected call of UpdateWorkItemState.
+func (mr *MockClientMockRecorder) UpdateWorkItemState(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkItemState", reflect.TypeOf((*MockClient)(nil).UpdateWorkItemState), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UploadWorkItemAttachment mocks base method.
+func (m *MockClient) UploadWorkItemAttachment(arg0, arg1, arg2 string, arg3 io.ReaderAt, arg4 int64, arg5 string) (*serializers.WorkItemAttachment, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadWorkItemAttachment", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*serializers.WorkItemAttachment)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UploadWorkItemAttachment indicates an expected call of UploadWorkItemAttachment.
+func (mr *MockClientMockRecorder) UploadWorkItemAttachment(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadWorkItemAttachment", reflect.TypeOf((*MockClient)(nil).UploadWorkItemAttachment), arg0, arg1, arg2, arg3, arg4, arg5)
+}
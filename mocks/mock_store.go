@@ -5,36 +5,110 @@
 package mocks
 
 import (
+	reflect "reflect"
+
 	gomock "github.com/golang/mock/gomock"
 	serializers "github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
 	store "github.com/mattermost/mattermost-plugin-azure-devops/server/store"
-	reflect "reflect"
 )
 
-// MockKVStore is a mock of KVStore interface
+// MockKVStore is a mock of KVStore interface.
 type MockKVStore struct {
 	ctrl     *gomock.Controller
 	recorder *MockKVStoreMockRecorder
 }
 
-// MockKVStoreMockRecorder is the mock recorder for MockKVStore
+// MockKVStoreMockRecorder is the mock recorder for MockKVStore.
 type MockKVStoreMockRecorder struct {
 	mock *MockKVStore
 }
 
-// NewMockKVStore creates a new mock instance
+// NewMockKVStore creates a new mock instance.
 func NewMockKVStore(ctrl *gomock.Controller) *MockKVStore {
 	mock := &MockKVStore{ctrl: ctrl}
 	mock.recorder = &MockKVStoreMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockKVStore) EXPECT() *MockKVStoreMockRecorder {
 	return m.recorder
 }
 
-// DeleteProject mocks base method
+// AddApprovalPost mocks base method.
+func (m *MockKVStore) AddApprovalPost(arg0, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddApprovalPost", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddApprovalPost indicates an expected call of AddApprovalPost.
+func (mr *MockKVStoreMockRecorder) AddApprovalPost(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddApprovalPost", reflect.TypeOf((*MockKVStore)(nil).AddApprovalPost), arg0, arg1, arg2, arg3)
+}
+
+// AddPullRequestReviewers mocks base method.
+func (m *MockKVStore) AddPullRequestReviewers(arg0 string, arg1 int, arg2 []string) ([]string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPullRequestReviewers", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddPullRequestReviewers indicates an expected call of AddPullRequestReviewers.
+func (mr *MockKVStoreMockRecorder) AddPullRequestReviewers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPullRequestReviewers", reflect.TypeOf((*MockKVStore)(nil).AddPullRequestReviewers), arg0, arg1, arg2)
+}
+
+// DeleteIdentityOverride mocks base method.
+func (m *MockKVStore) DeleteIdentityOverride(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIdentityOverride", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIdentityOverride indicates an expected call of DeleteIdentityOverride.
+func (mr *MockKVStoreMockRecorder) DeleteIdentityOverride(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIdentityOverride", reflect.TypeOf((*MockKVStore)(nil).DeleteIdentityOverride), arg0)
+}
+
+// DeletePipelineApproval mocks base method.
+func (m *MockKVStore) DeletePipelineApproval(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePipelineApproval", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePipelineApproval indicates an expected call of DeletePipelineApproval.
+func (mr *MockKVStoreMockRecorder) DeletePipelineApproval(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePipelineApproval", reflect.TypeOf((*MockKVStore)(nil).DeletePipelineApproval), arg0)
+}
+
+// DeletePreset mocks base method.
+func (m *MockKVStore) DeletePreset(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePreset", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePreset indicates an expected call of DeletePreset.
+func (mr *MockKVStoreMockRecorder) DeletePreset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePreset", reflect.TypeOf((*MockKVStore)(nil).DeletePreset), arg0, arg1)
+}
+
+// DeleteProject mocks base method.
 func (m *MockKVStore) DeleteProject(arg0 *serializers.ProjectDetails) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteProject", arg0)
@@ -42,13 +116,28 @@ func (m *MockKVStore) DeleteProject(arg0 *serializers.ProjectDetails) error {
 	return ret0
 }
 
-// DeleteProject indicates an expected call of DeleteProject
+// DeleteProject indicates an expected call of DeleteProject.
 func (mr *MockKVStoreMockRecorder) DeleteProject(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockKVStore)(nil).DeleteProject), arg0)
 }
 
-// DeleteSubscription mocks base method
+// DeleteReviewReminder mocks base method.
+func (m *MockKVStore) DeleteReviewReminder(arg0, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReviewReminder", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReviewReminder indicates an expected call of DeleteReviewReminder.
+func (mr *MockKVStoreMockRecorder) DeleteReviewReminder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReviewReminder", reflect.TypeOf((*MockKVStore)(nil).DeleteReviewReminder), arg0, arg1)
+}
+
+// DeleteSubscription mocks base method.
 func (m *MockKVStore) DeleteSubscription(arg0 *serializers.SubscriptionDetails) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteSubscription", arg0)
@@ -56,13 +145,41 @@ func (m *MockKVStore) DeleteSubscription(arg0 *serializers.SubscriptionDetails)
 	return ret0
 }
 
-// DeleteSubscription indicates an expected call of DeleteSubscription
+// DeleteSubscription indicates an expected call of DeleteSubscription.
 func (mr *MockKVStoreMockRecorder) DeleteSubscription(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockKVStore)(nil).DeleteSubscription), arg0)
 }
 
-// DeleteUser mocks base method
+// DeleteSubscriptionAndChannelIDMap mocks base method.
+func (m *MockKVStore) DeleteSubscriptionAndChannelIDMap(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscriptionAndChannelIDMap", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscriptionAndChannelIDMap indicates an expected call of DeleteSubscriptionAndChannelIDMap.
+func (mr *MockKVStoreMockRecorder) DeleteSubscriptionAndChannelIDMap(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscriptionAndChannelIDMap", reflect.TypeOf((*MockKVStore)(nil).DeleteSubscriptionAndChannelIDMap), arg0)
+}
+
+// DeleteThreadLink mocks base method.
+func (m *MockKVStore) DeleteThreadLink(arg0 *serializers.ThreadLink) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteThreadLink", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteThreadLink indicates an expected call of DeleteThreadLink.
+func (mr *MockKVStoreMockRecorder) DeleteThreadLink(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteThreadLink", reflect.TypeOf((*MockKVStore)(nil).DeleteThreadLink), arg0)
+}
+
+// DeleteUser mocks base method.
 func (m *MockKVStore) DeleteUser(arg0 string) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteUser", arg0)
@@ -71,13 +188,13 @@ func (m *MockKVStore) DeleteUser(arg0 string) (bool, error) {
 	return ret0, ret1
 }
 
-// DeleteUser indicates an expected call of DeleteUser
+// DeleteUser indicates an expected call of DeleteUser.
 func (mr *MockKVStoreMockRecorder) DeleteUser(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockKVStore)(nil).DeleteUser), arg0)
 }
 
-// DeleteUserTokenOnEncryptionSecretChange mocks base method
+// DeleteUserTokenOnEncryptionSecretChange mocks base method.
 func (m *MockKVStore) DeleteUserTokenOnEncryptionSecretChange() error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteUserTokenOnEncryptionSecretChange")
@@ -85,13 +202,28 @@ func (m *MockKVStore) DeleteUserTokenOnEncryptionSecretChange() error {
 	return ret0
 }
 
-// DeleteUserTokenOnEncryptionSecretChange indicates an expected call of DeleteUserTokenOnEncryptionSecretChange
+// DeleteUserTokenOnEncryptionSecretChange indicates an expected call of DeleteUserTokenOnEncryptionSecretChange.
 func (mr *MockKVStoreMockRecorder) DeleteUserTokenOnEncryptionSecretChange() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserTokenOnEncryptionSecretChange", reflect.TypeOf((*MockKVStore)(nil).DeleteUserTokenOnEncryptionSecretChange))
 }
 
-// GetAllProjects mocks base method
+// GetAllPresets mocks base method.
+func (m *MockKVStore) GetAllPresets(arg0 string) ([]*serializers.WorkItemPreset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPresets", arg0)
+	ret0, _ := ret[0].([]*serializers.WorkItemPreset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPresets indicates an expected call of GetAllPresets.
+func (mr *MockKVStoreMockRecorder) GetAllPresets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPresets", reflect.TypeOf((*MockKVStore)(nil).GetAllPresets), arg0)
+}
+
+// GetAllProjects mocks base method.
 func (m *MockKVStore) GetAllProjects(arg0 string) ([]serializers.ProjectDetails, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllProjects", arg0)
@@ -100,13 +232,13 @@ func (m *MockKVStore) GetAllProjects(arg0 string) ([]serializers.ProjectDetails,
 	return ret0, ret1
 }
 
-// GetAllProjects indicates an expected call of GetAllProjects
+// GetAllProjects indicates an expected call of GetAllProjects.
 func (mr *MockKVStoreMockRecorder) GetAllProjects(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProjects", reflect.TypeOf((*MockKVStore)(nil).GetAllProjects), arg0)
 }
 
-// GetAllSubscriptions mocks base method
+// GetAllSubscriptions mocks base method.
 func (m *MockKVStore) GetAllSubscriptions(arg0 string) ([]*serializers.SubscriptionDetails, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllSubscriptions", arg0)
@@ -115,13 +247,88 @@ func (m *MockKVStore) GetAllSubscriptions(arg0 string) ([]*serializers.Subscript
 	return ret0, ret1
 }
 
-// GetAllSubscriptions indicates an expected call of GetAllSubscriptions
+// GetAllSubscriptions indicates an expected call of GetAllSubscriptions.
 func (mr *MockKVStoreMockRecorder) GetAllSubscriptions(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSubscriptions", reflect.TypeOf((*MockKVStore)(nil).GetAllSubscriptions), arg0)
 }
 
-// GetProject mocks base method
+// GetApprovalPosts mocks base method.
+func (m *MockKVStore) GetApprovalPosts(arg0, arg1, arg2 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApprovalPosts", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApprovalPosts indicates an expected call of GetApprovalPosts.
+func (mr *MockKVStoreMockRecorder) GetApprovalPosts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovalPosts", reflect.TypeOf((*MockKVStore)(nil).GetApprovalPosts), arg0, arg1, arg2)
+}
+
+// GetIdentityOverrides mocks base method.
+func (m *MockKVStore) GetIdentityOverrides() (store.IdentityOverrideList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdentityOverrides")
+	ret0, _ := ret[0].(store.IdentityOverrideList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdentityOverrides indicates an expected call of GetIdentityOverrides.
+func (mr *MockKVStoreMockRecorder) GetIdentityOverrides() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdentityOverrides", reflect.TypeOf((*MockKVStore)(nil).GetIdentityOverrides))
+}
+
+// GetPersonalNotificationSettings mocks base method.
+func (m *MockKVStore) GetPersonalNotificationSettings(arg0 string) (*serializers.PersonalNotificationSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalNotificationSettings", arg0)
+	ret0, _ := ret[0].(*serializers.PersonalNotificationSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalNotificationSettings indicates an expected call of GetPersonalNotificationSettings.
+func (mr *MockKVStoreMockRecorder) GetPersonalNotificationSettings(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalNotificationSettings", reflect.TypeOf((*MockKVStore)(nil).GetPersonalNotificationSettings), arg0)
+}
+
+// GetPipelineApprovals mocks base method.
+func (m *MockKVStore) GetPipelineApprovals() ([]*serializers.PipelineApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineApprovals")
+	ret0, _ := ret[0].([]*serializers.PipelineApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineApprovals indicates an expected call of GetPipelineApprovals.
+func (mr *MockKVStoreMockRecorder) GetPipelineApprovals() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineApprovals", reflect.TypeOf((*MockKVStore)(nil).GetPipelineApprovals))
+}
+
+// GetPreset mocks base method.
+func (m *MockKVStore) GetPreset(arg0, arg1 string) (*serializers.WorkItemPreset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreset", arg0, arg1)
+	ret0, _ := ret[0].(*serializers.WorkItemPreset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreset indicates an expected call of GetPreset.
+func (mr *MockKVStoreMockRecorder) GetPreset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreset", reflect.TypeOf((*MockKVStore)(nil).GetPreset), arg0, arg1)
+}
+
+// GetProject mocks base method.
 func (m *MockKVStore) GetProject() (*store.ProjectList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetProject")
@@ -130,13 +337,58 @@ func (m *MockKVStore) GetProject() (*store.ProjectList, error) {
 	return ret0, ret1
 }
 
-// GetProject indicates an expected call of GetProject
+// GetProject indicates an expected call of GetProject.
 func (mr *MockKVStoreMockRecorder) GetProject() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockKVStore)(nil).GetProject))
 }
 
-// GetSubscriptionList mocks base method
+// GetPullRequestThreadPosts mocks base method.
+func (m *MockKVStore) GetPullRequestThreadPosts(arg0 string, arg1, arg2 int) (store.PullRequestThreadPostList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequestThreadPosts", arg0, arg1, arg2)
+	ret0, _ := ret[0].(store.PullRequestThreadPostList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPullRequestThreadPosts indicates an expected call of GetPullRequestThreadPosts.
+func (mr *MockKVStoreMockRecorder) GetPullRequestThreadPosts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestThreadPosts", reflect.TypeOf((*MockKVStore)(nil).GetPullRequestThreadPosts), arg0, arg1, arg2)
+}
+
+// GetReviewReminders mocks base method.
+func (m *MockKVStore) GetReviewReminders() ([]*serializers.ReviewReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewReminders")
+	ret0, _ := ret[0].([]*serializers.ReviewReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewReminders indicates an expected call of GetReviewReminders.
+func (mr *MockKVStoreMockRecorder) GetReviewReminders() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewReminders", reflect.TypeOf((*MockKVStore)(nil).GetReviewReminders))
+}
+
+// GetSubscriptionAndChannelIDMap mocks base method.
+func (m *MockKVStore) GetSubscriptionAndChannelIDMap(arg0 string) (*store.SubscriptionWebhookSecretAndChannelMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionAndChannelIDMap", arg0)
+	ret0, _ := ret[0].(*store.SubscriptionWebhookSecretAndChannelMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionAndChannelIDMap indicates an expected call of GetSubscriptionAndChannelIDMap.
+func (mr *MockKVStoreMockRecorder) GetSubscriptionAndChannelIDMap(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionAndChannelIDMap", reflect.TypeOf((*MockKVStore)(nil).GetSubscriptionAndChannelIDMap), arg0)
+}
+
+// GetSubscriptionList mocks base method.
 func (m *MockKVStore) GetSubscriptionList() (*store.SubscriptionList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSubscriptionList")
@@ -145,28 +397,58 @@ func (m *MockKVStore) GetSubscriptionList() (*store.SubscriptionList, error) {
 	return ret0, ret1
 }
 
-// GetSubscriptionList indicates an expected call of GetSubscriptionList
+// GetSubscriptionList indicates an expected call of GetSubscriptionList.
 func (mr *MockKVStoreMockRecorder) GetSubscriptionList() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionList", reflect.TypeOf((*MockKVStore)(nil).GetSubscriptionList))
 }
 
-// LoadAzureDevopsUserIDFromMattermostUser mocks base method
-func (m *MockKVStore) LoadAzureDevopsUserIDFromMattermostUser(arg0 string) (string, error) {
+// GetThreadLink mocks base method.
+func (m *MockKVStore) GetThreadLink(arg0 string) (*serializers.ThreadLink, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LoadAzureDevopsUserIDFromMattermostUser", arg0)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetThreadLink", arg0)
+	ret0, _ := ret[0].(*serializers.ThreadLink)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// LoadAzureDevopsUserIDFromMattermostUser indicates an expected call of LoadAzureDevopsUserIDFromMattermostUser
-func (mr *MockKVStoreMockRecorder) LoadAzureDevopsUserIDFromMattermostUser(arg0 string) *gomock.Call {
+// GetThreadLink indicates an expected call of GetThreadLink.
+func (mr *MockKVStoreMockRecorder) GetThreadLink(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadAzureDevopsUserIDFromMattermostUser", reflect.TypeOf((*MockKVStore)(nil).LoadAzureDevopsUserIDFromMattermostUser), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThreadLink", reflect.TypeOf((*MockKVStore)(nil).GetThreadLink), arg0)
+}
+
+// GetThreadLinksForWorkItem mocks base method.
+func (m *MockKVStore) GetThreadLinksForWorkItem(arg0, arg1 string) ([]*serializers.ThreadLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThreadLinksForWorkItem", arg0, arg1)
+	ret0, _ := ret[0].([]*serializers.ThreadLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LoadAzureDevopsUserDetails mocks base method
+// GetThreadLinksForWorkItem indicates an expected call of GetThreadLinksForWorkItem.
+func (mr *MockKVStoreMockRecorder) GetThreadLinksForWorkItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThreadLinksForWorkItem", reflect.TypeOf((*MockKVStore)(nil).GetThreadLinksForWorkItem), arg0, arg1)
+}
+
+// IsPullRequestCommentMirrored mocks base method.
+func (m *MockKVStore) IsPullRequestCommentMirrored(arg0 string, arg1, arg2, arg3 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPullRequestCommentMirrored", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPullRequestCommentMirrored indicates an expected call of IsPullRequestCommentMirrored.
+func (mr *MockKVStoreMockRecorder) IsPullRequestCommentMirrored(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPullRequestCommentMirrored", reflect.TypeOf((*MockKVStore)(nil).IsPullRequestCommentMirrored), arg0, arg1, arg2, arg3)
+}
+
+// LoadAzureDevopsUserDetails mocks base method.
 func (m *MockKVStore) LoadAzureDevopsUserDetails(arg0 string) (*serializers.User, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "LoadAzureDevopsUserDetails", arg0)
@@ -175,13 +457,130 @@ func (m *MockKVStore) LoadAzureDevopsUserDetails(arg0 string) (*serializers.User
 	return ret0, ret1
 }
 
-// LoadAzureDevopsUserDetails indicates an expected call of LoadAzureDevopsUserDetails
-func (mr *MockKVStoreMockRecorder) LoadAzureDevopsUserDetails(arg0 string) *gomock.Call {
+// LoadAzureDevopsUserDetails indicates an expected call of LoadAzureDevopsUserDetails.
+func (mr *MockKVStoreMockRecorder) LoadAzureDevopsUserDetails(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadAzureDevopsUserDetails", reflect.TypeOf((*MockKVStore)(nil).LoadAzureDevopsUserDetails), arg0)
 }
 
-// StoreOAuthState mocks base method
+// LoadAzureDevopsUserIDFromEmail mocks base method.
+func (m *MockKVStore) LoadAzureDevopsUserIDFromEmail(arg0 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadAzureDevopsUserIDFromEmail", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadAzureDevopsUserIDFromEmail indicates an expected call of LoadAzureDevopsUserIDFromEmail.
+func (mr *MockKVStoreMockRecorder) LoadAzureDevopsUserIDFromEmail(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadAzureDevopsUserIDFromEmail", reflect.TypeOf((*MockKVStore)(nil).LoadAzureDevopsUserIDFromEmail), arg0)
+}
+
+// LoadAzureDevopsUserIDFromMattermostUser mocks base method.
+func (m *MockKVStore) LoadAzureDevopsUserIDFromMattermostUser(arg0 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadAzureDevopsUserIDFromMattermostUser", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadAzureDevopsUserIDFromMattermostUser indicates an expected call of LoadAzureDevopsUserIDFromMattermostUser.
+func (mr *MockKVStoreMockRecorder) LoadAzureDevopsUserIDFromMattermostUser(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadAzureDevopsUserIDFromMattermostUser", reflect.TypeOf((*MockKVStore)(nil).LoadAzureDevopsUserIDFromMattermostUser), arg0)
+}
+
+// MarkApprovalReminderSent mocks base method.
+func (m *MockKVStore) MarkApprovalReminderSent(arg0 string, arg1 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkApprovalReminderSent", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkApprovalReminderSent indicates an expected call of MarkApprovalReminderSent.
+func (mr *MockKVStoreMockRecorder) MarkApprovalReminderSent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkApprovalReminderSent", reflect.TypeOf((*MockKVStore)(nil).MarkApprovalReminderSent), arg0, arg1)
+}
+
+// MarkPersonalNotificationSent mocks base method.
+func (m *MockKVStore) MarkPersonalNotificationSent(arg0, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPersonalNotificationSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkPersonalNotificationSent indicates an expected call of MarkPersonalNotificationSent.
+func (mr *MockKVStoreMockRecorder) MarkPersonalNotificationSent(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPersonalNotificationSent", reflect.TypeOf((*MockKVStore)(nil).MarkPersonalNotificationSent), arg0, arg1, arg2)
+}
+
+// MarkPullRequestCommentMirrored mocks base method.
+func (m *MockKVStore) MarkPullRequestCommentMirrored(arg0 string, arg1, arg2, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPullRequestCommentMirrored", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPullRequestCommentMirrored indicates an expected call of MarkPullRequestCommentMirrored.
+func (mr *MockKVStoreMockRecorder) MarkPullRequestCommentMirrored(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPullRequestCommentMirrored", reflect.TypeOf((*MockKVStore)(nil).MarkPullRequestCommentMirrored), arg0, arg1, arg2, arg3)
+}
+
+// MarkReviewReminderSent mocks base method.
+func (m *MockKVStore) MarkReviewReminderSent(arg0, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReviewReminderSent", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkReviewReminderSent indicates an expected call of MarkReviewReminderSent.
+func (mr *MockKVStoreMockRecorder) MarkReviewReminderSent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReviewReminderSent", reflect.TypeOf((*MockKVStore)(nil).MarkReviewReminderSent), arg0, arg1)
+}
+
+// StoreAzureDevopsUserDetailsWithMattermostUserID mocks base method.
+func (m *MockKVStore) StoreAzureDevopsUserDetailsWithMattermostUserID(arg0 *serializers.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreAzureDevopsUserDetailsWithMattermostUserID", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreAzureDevopsUserDetailsWithMattermostUserID indicates an expected call of StoreAzureDevopsUserDetailsWithMattermostUserID.
+func (mr *MockKVStoreMockRecorder) StoreAzureDevopsUserDetailsWithMattermostUserID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreAzureDevopsUserDetailsWithMattermostUserID", reflect.TypeOf((*MockKVStore)(nil).StoreAzureDevopsUserDetailsWithMattermostUserID), arg0)
+}
+
+// StoreIdentityOverride mocks base method.
+func (m *MockKVStore) StoreIdentityOverride(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreIdentityOverride", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreIdentityOverride indicates an expected call of StoreIdentityOverride.
+func (mr *MockKVStoreMockRecorder) StoreIdentityOverride(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreIdentityOverride", reflect.TypeOf((*MockKVStore)(nil).StoreIdentityOverride), arg0, arg1)
+}
+
+// StoreOAuthState mocks base method.
 func (m *MockKVStore) StoreOAuthState(arg0, arg1 string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "StoreOAuthState", arg0, arg1)
@@ -189,13 +588,55 @@ func (m *MockKVStore) StoreOAuthState(arg0, arg1 string) error {
 	return ret0
 }
 
-// StoreOAuthState indicates an expected call of StoreOAuthState
+// StoreOAuthState indicates an expected call of StoreOAuthState.
 func (mr *MockKVStoreMockRecorder) StoreOAuthState(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreOAuthState", reflect.TypeOf((*MockKVStore)(nil).StoreOAuthState), arg0, arg1)
 }
 
-// StoreProject mocks base method
+// StorePersonalNotificationSettings mocks base method.
+func (m *MockKVStore) StorePersonalNotificationSettings(arg0 string, arg1 *serializers.PersonalNotificationSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorePersonalNotificationSettings", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StorePersonalNotificationSettings indicates an expected call of StorePersonalNotificationSettings.
+func (mr *MockKVStoreMockRecorder) StorePersonalNotificationSettings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePersonalNotificationSettings", reflect.TypeOf((*MockKVStore)(nil).StorePersonalNotificationSettings), arg0, arg1)
+}
+
+// StorePipelineApproval mocks base method.
+func (m *MockKVStore) StorePipelineApproval(arg0 *serializers.PipelineApproval) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorePipelineApproval", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StorePipelineApproval indicates an expected call of StorePipelineApproval.
+func (mr *MockKVStoreMockRecorder) StorePipelineApproval(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePipelineApproval", reflect.TypeOf((*MockKVStore)(nil).StorePipelineApproval), arg0)
+}
+
+// StorePreset mocks base method.
+func (m *MockKVStore) StorePreset(arg0 *serializers.WorkItemPreset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorePreset", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StorePreset indicates an expected call of StorePreset.
+func (mr *MockKVStoreMockRecorder) StorePreset(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePreset", reflect.TypeOf((*MockKVStore)(nil).StorePreset), arg0)
+}
+
+// StoreProject mocks base method.
 func (m *MockKVStore) StoreProject(arg0 *serializers.ProjectDetails) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "StoreProject", arg0)
@@ -203,55 +644,55 @@ func (m *MockKVStore) StoreProject(arg0 *serializers.ProjectDetails) error {
 	return ret0
 }
 
-// StoreProject indicates an expected call of StoreProject
+// StoreProject indicates an expected call of StoreProject.
 func (mr *MockKVStoreMockRecorder) StoreProject(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreProject", reflect.TypeOf((*MockKVStore)(nil).StoreProject), arg0)
 }
 
-// StoreSubscription mocks base method
-func (m *MockKVStore) StoreSubscription(arg0 *serializers.SubscriptionDetails) error {
+// StorePullRequestThreadPost mocks base method.
+func (m *MockKVStore) StorePullRequestThreadPost(arg0 string, arg1, arg2 int, arg3, arg4 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StoreSubscription", arg0)
+	ret := m.ctrl.Call(m, "StorePullRequestThreadPost", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// StoreSubscription indicates an expected call of StoreSubscription
-func (mr *MockKVStoreMockRecorder) StoreSubscription(arg0 interface{}) *gomock.Call {
+// StorePullRequestThreadPost indicates an expected call of StorePullRequestThreadPost.
+func (mr *MockKVStoreMockRecorder) StorePullRequestThreadPost(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreSubscription", reflect.TypeOf((*MockKVStore)(nil).StoreSubscription), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePullRequestThreadPost", reflect.TypeOf((*MockKVStore)(nil).StorePullRequestThreadPost), arg0, arg1, arg2, arg3, arg4)
 }
 
-// StoreAzureDevopsUserDetailsWithMattermostUserID mocks base method
-func (m *MockKVStore) StoreAzureDevopsUserDetailsWithMattermostUserID(arg0 *serializers.User) error {
+// StoreReviewReminder mocks base method.
+func (m *MockKVStore) StoreReviewReminder(arg0 *serializers.ReviewReminder) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StoreAzureDevopsUserDetailsWithMattermostUserID", arg0)
+	ret := m.ctrl.Call(m, "StoreReviewReminder", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// StoreAzureDevopsUserDetailsWithMattermostUserID indicates an expected call of StoreAzureDevopsUserDetailsWithMattermostUserID
-func (mr *MockKVStoreMockRecorder) StoreAzureDevopsUserDetailsWithMattermostUserID(arg0 interface{}) *gomock.Call {
+// StoreReviewReminder indicates an expected call of StoreReviewReminder.
+func (mr *MockKVStoreMockRecorder) StoreReviewReminder(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreAzureDevopsUserDetailsWithMattermostUserID", reflect.TypeOf((*MockKVStore)(nil).StoreAzureDevopsUserDetailsWithMattermostUserID), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreReviewReminder", reflect.TypeOf((*MockKVStore)(nil).StoreReviewReminder), arg0)
 }
 
-// VerifyOAuthState mocks base method
-func (m *MockKVStore) VerifyOAuthState(arg0, arg1 string) error {
+// StoreSubscription mocks base method.
+func (m *MockKVStore) StoreSubscription(arg0 *serializers.SubscriptionDetails) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VerifyOAuthState", arg0, arg1)
+	ret := m.ctrl.Call(m, "StoreSubscription", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// VerifyOAuthState indicates an expected call of VerifyOAuthState
-func (mr *MockKVStoreMockRecorder) VerifyOAuthState(arg0, arg1 interface{}) *gomock.Call {
+// StoreSubscription indicates an expected call of StoreSubscription.
+func (mr *MockKVStoreMockRecorder) StoreSubscription(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyOAuthState", reflect.TypeOf((*MockKVStore)(nil).VerifyOAuthState), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreSubscription", reflect.TypeOf((*MockKVStore)(nil).StoreSubscription), arg0)
 }
 
-// StoreSubscriptionAndChannelIDMap mocks base method
+// StoreSubscriptionAndChannelIDMap mocks base method.
 func (m *MockKVStore) StoreSubscriptionAndChannelIDMap(arg0, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "StoreSubscriptionAndChannelIDMap", arg0, arg1, arg2)
@@ -259,37 +700,36 @@ func (m *MockKVStore) StoreSubscriptionAndChannelIDMap(arg0, arg1, arg2 string)
 	return ret0
 }
 
-// StoreSubscriptionAndChannelIDMap indicates an expected call of StoreSubscriptionAndChannelIDMap
+// StoreSubscriptionAndChannelIDMap indicates an expected call of StoreSubscriptionAndChannelIDMap.
 func (mr *MockKVStoreMockRecorder) StoreSubscriptionAndChannelIDMap(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreSubscriptionAndChannelIDMap", reflect.TypeOf((*MockKVStore)(nil).StoreSubscriptionAndChannelIDMap), arg0, arg1, arg2)
 }
 
-// GetSubscriptionAndChannelIDMap mocks base method
-func (m *MockKVStore) GetSubscriptionAndChannelIDMap(arg0 string) (*store.SubscriptionWebhookSecretAndChannelMap, error) {
+// StoreThreadLink mocks base method.
+func (m *MockKVStore) StoreThreadLink(arg0 *serializers.ThreadLink) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSubscriptionAndChannelIDMap", arg0)
-	ret0, _ := ret[0].(*store.SubscriptionWebhookSecretAndChannelMap)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "StoreThreadLink", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetSubscriptionAndChannelIDMap indicates an expected call of GetSubscriptionAndChannelIDMap
-func (mr *MockKVStoreMockRecorder) GetSubscriptionAndChannelIDMap(arg0 interface{}) *gomock.Call {
+// StoreThreadLink indicates an expected call of StoreThreadLink.
+func (mr *MockKVStoreMockRecorder) StoreThreadLink(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionAndChannelIDMap", reflect.TypeOf((*MockKVStore)(nil).GetSubscriptionAndChannelIDMap), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreThreadLink", reflect.TypeOf((*MockKVStore)(nil).StoreThreadLink), arg0)
 }
 
-// DeleteSubscriptionAndChannelIDMap mocks base method
-func (m *MockKVStore) DeleteSubscriptionAndChannelIDMap(arg0 string) error {
+// VerifyOAuthState mocks base method.
+func (m *MockKVStore) VerifyOAuthState(arg0, arg1 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSubscriptionAndChannelIDMap", arg0)
+	ret := m.ctrl.Call(m, "VerifyOAuthState", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteSubscriptionAndChannelIDMap indicates an expected call of DeleteSubscriptionAndChannelIDMap
-func (mr *MockKVStoreMockRecorder) DeleteSubscriptionAndChannelIDMap(arg0 interface{}) *gomock.Call {
+// VerifyOAuthState indicates an expected call of VerifyOAuthState.
+func (mr *MockKVStoreMockRecorder) VerifyOAuthState(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscriptionAndChannelIDMap", reflect.TypeOf((*MockKVStore)(nil).DeleteSubscriptionAndChannelIDMap), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyOAuthState", reflect.TypeOf((*MockKVStore)(nil).VerifyOAuthState), arg0, arg1)
 }
@@ -2,7 +2,9 @@ package config
 
 import (
 	"errors"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
 )
@@ -23,6 +25,9 @@ type Configuration struct {
 	AzureDevopsOAuthAppID        string `json:"azureDevopsOAuthAppID"`
 	AzureDevopsOAuthClientSecret string `json:"azureDevopsOAuthClientSecret"`
 	EncryptionSecret             string `json:"EncryptionSecret"`
+	IgnoredWorkItemFields        string `json:"ignoredWorkItemFields"`
+	ApprovalReminderInterval     string `json:"approvalReminderInterval"`
+	ApprovalTimeout              string `json:"approvalTimeout"`
 	MattermostSiteURL            string
 }
 
@@ -39,10 +44,45 @@ func (c *Configuration) ProcessConfiguration() error {
 	c.AzureDevopsOAuthAppID = strings.TrimSpace(c.AzureDevopsOAuthAppID)
 	c.AzureDevopsOAuthClientSecret = strings.TrimSpace(c.AzureDevopsOAuthClientSecret)
 	c.EncryptionSecret = strings.TrimSpace(c.EncryptionSecret)
+	c.IgnoredWorkItemFields = strings.TrimSpace(c.IgnoredWorkItemFields)
+	c.ApprovalReminderInterval = strings.TrimSpace(c.ApprovalReminderInterval)
+	c.ApprovalTimeout = strings.TrimSpace(c.ApprovalTimeout)
 
 	return nil
 }
 
+// GetIgnoredWorkItemFields returns the reference names of the work item fields which are not shown in work item updated notifications.
+func (c *Configuration) GetIgnoredWorkItemFields() map[string]bool {
+	ignoredFields := make(map[string]bool)
+	for _, field := range strings.Split(c.IgnoredWorkItemFields, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			ignoredFields[field] = true
+		}
+	}
+
+	return ignoredFields
+}
+
+// GetApprovalReminderInterval returns the interval in which the pending approvers of pipeline runs are reminded, the reminders are disabled if it is not a positive number of hours.
+func (c *Configuration) GetApprovalReminderInterval() time.Duration {
+	return getHours(c.ApprovalReminderInterval, 0)
+}
+
+// GetApprovalTimeout returns the time after which the approvals of pipeline runs expire if the timeout of their approval check is not known.
+// It defaults to the default timeout of the approval checks of Azure DevOps.
+func (c *Configuration) GetApprovalTimeout() time.Duration {
+	return getHours(c.ApprovalTimeout, constants.DefaultApprovalTimeout)
+}
+
+func getHours(value string, defaultValue time.Duration) time.Duration {
+	hours, err := strconv.Atoi(value)
+	if err != nil || hours <= 0 {
+		return defaultValue
+	}
+
+	return time.Duration(hours) * time.Hour
+}
+
 // Used for config validations.
 func (c *Configuration) IsValid() error {
 	if c.AzureDevopsAPIBaseURL == "" {
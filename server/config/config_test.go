@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -151,3 +152,20 @@ func TestCloneConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestGetApprovalReminderInterval(t *testing.T) {
+	assert.Equal(t, 4*time.Hour, (&Configuration{ApprovalReminderInterval: "4"}).GetApprovalReminderInterval())
+	assert.Equal(t, time.Duration(0), (&Configuration{ApprovalReminderInterval: "-1"}).GetApprovalReminderInterval())
+	assert.Equal(t, time.Duration(0), (&Configuration{}).GetApprovalReminderInterval())
+}
+
+func TestGetApprovalTimeout(t *testing.T) {
+	assert.Equal(t, 48*time.Hour, (&Configuration{ApprovalTimeout: "48"}).GetApprovalTimeout())
+	assert.Equal(t, constants.DefaultApprovalTimeout, (&Configuration{ApprovalTimeout: "2 days"}).GetApprovalTimeout())
+}
+
+func TestGetIgnoredWorkItemFields(t *testing.T) {
+	c := &Configuration{IgnoredWorkItemFields: " System.Tags, ,Microsoft.VSTS.Common.Severity "}
+	assert.Equal(t, map[string]bool{"System.Tags": true, "Microsoft.VSTS.Common.Severity": true}, c.GetIgnoredWorkItemFields())
+	assert.Empty(t, (&Configuration{}).GetIgnoredWorkItemFields())
+}
@@ -17,24 +17,117 @@ const (
 		"* `/azuredevops connect` - Connect your Mattermost account to your Azure DevOps account.\n" +
 		"* `/azuredevops disconnect` - Disconnect your Mattermost account from your Azure DevOps account.\n" +
 		"* `/azuredevops link [projectURL]` - Link your project to a current channel.\n" +
-		"* `/azuredevops boards create [title] [description]` - Create a new task for your project.\n" +
+		"* `/azuredevops boards workitem create [title] [description] [type=type]` - Create a new work item for your project, the required and custom fields of its type are filled in a dialog.\n" +
+		"* `/azuredevops boards workitem create --preset [preset name] [title]` - Create a new work item using a preset of the current channel.\n" +
+		"* `/azuredevops boards workitem breakdown [parent work item ID or link] [title...]` - Create child tasks of a work item in the same area and iteration, one for each title e.g. `breakdown 42 \"Write tests\" \"Update docs\"`.\n" +
+		"* `/azuredevops boards sprint [project] [team] [--stale-days number of days]` - View the work items, remaining work and capacity of the current iteration of a team. Work items not updated in the given number of days (3 by default) are highlighted.\n" +
+		"* `/azuredevops boards preset add [preset name] type=[work item type] [field=value...]` - Add a work item preset to the current channel. Supported fields are `area`, `iteration`, `tags`, `assignee` (an email or a @username), `priority`, `organization`, `project` or any field reference name.\n" +
+		"* `/azuredevops boards preset import [preset name] template=[template name] [team=team name] [organization=organization] [project=project]` - Import an Azure DevOps work item template as a preset of the current channel.\n" +
+		"* `/azuredevops boards preset list` - View the work item presets of the current channel.\n" +
+		"* `/azuredevops boards preset delete [preset name]` - Delete a work item preset from the current channel.\n" +
+		"* `/azuredevops boards thread link [work item link] [--mirror]` - Link the current thread to a work item. With `--mirror`, replies in the thread are added as comments on the work item. Run this command from the reply box of the thread.\n" +
+		"* `/azuredevops boards thread unlink` - Unlink the current thread from its work item. Run this command from the reply box of the thread.\n" +
 		"* `/azuredevops boards/repos/pipelines subscription add` - Add a new Boards/Repos/Pipelines subscription for your linked projects.\n" +
 		"* `/azuredevops boards/repos/pipelines subscription list [me or anyone] [all_channels]` - View Boards/Repos/Pipelines subscriptions.\n" +
-		"* `/azuredevops boards/repos/pipelines subscription delete [subscription id]` - Delete a Boards/Repos/Pipelines subscription"
-	InvalidCommand      = "Invalid command.\n\n"
-	CommandHelp         = "help"
-	CommandConnect      = "connect"
-	CommandDisconnect   = "disconnect"
-	CommandLink         = "link"
-	CommandBoards       = "boards"
-	CommandRepos        = "repos"
-	CommandPipelines    = "pipelines"
-	CommandCreate       = "create"
-	CommandWorkitem     = "workitem"
-	CommandSubscription = "subscription"
-	CommandAdd          = "add"
-	CommandList         = "list"
-	CommandDelete       = "delete"
+		"* `/azuredevops boards/repos/pipelines subscription delete [subscription id]` - Delete a Boards/Repos/Pipelines subscription\n" +
+		"* `/azuredevops boards subscription filter [subscription id] [type=Bug,Incident] [tag=tag] [from=state] [to=state] [priority<=number]` - Only post the notifications of a Boards subscription for work items matching all the given filters. The state filters are only supported for work item updated subscriptions. Use `clear` instead of the filters to remove them.\n" +
+		"* `/azuredevops repos subscription filter [subscription id] [collapse=merges,bots]` - Collapse the merge commits and/or the commits of bots in the notifications of a Code Pushed subscription. Use `clear` instead of the filters to remove them.\n" +
+		"* `/azuredevops repos branch create [work item ID or link] [repo] [base branch] [--activate]` - Create a branch `users/[alias]/[ID]-[title]` to start working on a work item and link it to the work item. The default branch of the repo is used as the base branch if it is not provided. With `--activate`, the work item is moved to the Active state.\n" +
+		"* `/azuredevops repos pr create [repo] [source branch] [target branch] \"[title]\" [organization=organization] [project=project]` - Create a pull request in a repo of your linked project. The description, reviewers, linked work items and draft flag are chosen in a dialog and the created pull request is posted in the channel.\n" +
+		"* `/azuredevops repos pr complete [pull request ID or link] [--merge-strategy noFastForward, squash, rebase or rebaseMerge] [--delete-source-branch] [--transition-work-items]` - Complete a pull request once all its blocking branch policies pass. The policies which are not passing yet are listed otherwise.\n" +
+		"* `/azuredevops repos pr autocomplete [pull request ID or link] [--merge-strategy strategy] [--delete-source-branch] [--transition-work-items]` - Complete a pull request automatically once all its branch policies pass.\n" +
+		"* `/azuredevops repos pr abandon/reactivate [pull request ID or link]` - Abandon an active pull request or reactivate an abandoned one.\n" +
+		"* `/azuredevops repos prs [project] [repo] [mine, review or all]` - View the active pull requests of a project grouped by repo, with their age, reviewers and votes, merge conflicts and policy status. Use `mine` for the pull requests you created and `review` for the ones you are a reviewer on.\n" +
+		"* `/azuredevops repos reminders add [weekdays, daily or mon,wed,fri] [HH:MM] [repo=repo name] [min-age=24h] [organization=organization] [project=project]` - Add a reminder to the current channel which lists the active pull requests waiting for review at the given days and time, in your timezone, and mentions the reviewers who have not voted yet. Only the pull requests older than `min-age` (e.g. `30m`, `24h` or `3d`) are listed.\n" +
+		"* `/azuredevops repos reminders list` - View the pull request review reminders of the current channel.\n" +
+		"* `/azuredevops repos reminders delete [reminder ID]` - Delete a pull request review reminder from the current channel.\n" +
+		"* `/azuredevops pipelines run [pipeline name, ID or link] [branch] [name=value...]` - Run a pipeline of your linked project. The branch, runtime parameters and variables are confirmed in a dialog, values given as `name=value` are used for the runtime parameters of the same name and as variables otherwise. You need the permission to queue builds of the pipeline.\n" +
+		"* `/azuredevops notifications [on or off] [reviews, mentions, assignments or all]` - View or change the direct messages you receive when you are added as a reviewer on a pull request, mentioned in a comment or assigned a work item. They are only sent for the events of the subscriptions added in Mattermost.\n" +
+		"* `/azuredevops identity map/unmap [Azure DevOps email, unique name or ID] [@username]` - Map an Azure DevOps identity to a Mattermost user when it can not be matched by its connected account or email. Only system admins can use this command.\n" +
+		"* `/azuredevops identity list` - View the Azure DevOps identities mapped to Mattermost users. Only system admins can use this command."
+	InvalidCommand       = "Invalid command.\n\n"
+	CommandHelp          = "help"
+	CommandConnect       = "connect"
+	CommandDisconnect    = "disconnect"
+	CommandLink          = "link"
+	CommandBoards        = "boards"
+	CommandRepos         = "repos"
+	CommandPipelines     = "pipelines"
+	CommandCreate        = "create"
+	CommandWorkitem      = "workitem"
+	CommandSubscription  = "subscription"
+	CommandAdd           = "add"
+	CommandList          = "list"
+	CommandDelete        = "delete"
+	CommandPreset        = "preset"
+	CommandImport        = "import"
+	CommandThread        = "thread"
+	CommandUnlink        = "unlink"
+	CommandBreakdown     = "breakdown"
+	CommandSprint        = "sprint"
+	CommandFilter        = "filter"
+	CommandPullRequest   = "pr"
+	CommandComplete      = "complete"
+	CommandAbandon       = "abandon"
+	CommandReactivate    = "reactivate"
+	CommandAutoComplete  = "autocomplete"
+	CommandNotifications = "notifications"
+	CommandOn            = "on"
+	CommandOff           = "off"
+	CommandRun           = "run"
+	CommandIdentity      = "identity"
+	CommandMap           = "map"
+	CommandUnmap         = "unmap"
+	CommandPullRequests  = "prs"
+	CommandReminders     = "reminders"
+	CommandBranch        = "branch"
+
+	// Command flags
+	FlagPreset    = "--preset"
+	FlagMirror    = "--mirror"
+	FlagStaleDays = "--stale-days"
+	FlagActivate  = "--activate"
+
+	// Pull request completion flags
+	FlagMergeStrategy       = "--merge-strategy"
+	FlagDeleteSourceBranch  = "--delete-source-branch"
+	FlagTransitionWorkItems = "--transition-work-items"
+
+	// Keys used in preset arguments e.g. "area=Web\\Checkout"
+	PresetArgumentSeparator    = "="
+	PresetArgumentType         = "type"
+	PresetArgumentArea         = "area"
+	PresetArgumentIteration    = "iteration"
+	PresetArgumentTags         = "tags"
+	PresetArgumentAssignee     = "assignee"
+	PresetArgumentPriority     = "priority"
+	PresetArgumentOrganization = "organization"
+	PresetArgumentProject      = "project"
+	PresetArgumentTeam         = "team"
+	PresetArgumentTemplate     = "template"
+
+	// Separator of the key and value of subscription filter arguments e.g. "type=Bug"
+	FilterArgumentSeparator = "="
+
+	// Work item subscription filter arguments
+	WorkItemFilterArgumentType      = "type"
+	WorkItemFilterArgumentTag       = "tag"
+	WorkItemFilterArgumentStateFrom = "from"
+	WorkItemFilterArgumentStateTo   = "to"
+	WorkItemFilterArgumentPriority  = "priority"
+	WorkItemFilterArgumentClear     = "clear"
+	WorkItemFilterPriorityOperator  = "<="
+	DefaultTeamNameFormat           = "%s Team"
+
+	// Code push subscription filter arguments e.g. "collapse=merges,bots"
+	CodePushFilterArgumentCollapse = "collapse"
+	CodePushFilterCollapseMerges   = "merges"
+	CodePushFilterCollapseBots     = "bots"
+
+	// Pipeline subscription filter arguments e.g. "notify=requester"
+	PipelineFilterArgumentNotify  = "notify"
+	PipelineFilterNotifyRequester = "requester"
+	FailedBuildNotificationKind   = "failedBuild"
 
 	// Regex to verify task link
 	TaskLinkRegex = `http(s)?:\/\/dev.azure.com\/[a-zA-Z0-9!@#$%^&*()_+\-=\[\]{};':"\\|,.<>\/?]*\/[a-zA-Z0-9!@#$%^&*()_+\-=\[\]{};':"\\|,.<>\/?]*\/_workitems\/edit\/[1-9][0-9]*`
@@ -45,11 +138,18 @@ const (
 	// Regex to verify pipeline build details link
 	BuildDetailsLinkRegex = `http(s)?:\/\/dev.azure.com\/[a-zA-Z0-9!@#$%^&*()_+\-=\[\]{};':"\\|,.<>\/?]*\/[a-zA-Z0-9!@#$%^&*()_+\-=\[\]{};':"\\|,.<>\/?]*\/_build\/results\?buildId=[a-zA-Z0-9!@#$%^&*()_+\-=\[\]{};':"\\|,.<>\/?]+`
 
+	// Regex to verify pipeline link
+	PipelineLinkRegex = `http(s)?:\/\/dev.azure.com\/[a-zA-Z0-9!@#$%^&*()_+\-=\[\]{};':"\\|,.<>\/?]*\/[a-zA-Z0-9!@#$%^&*()_+\-=\[\]{};':"\\|,.<>\/?]*\/_build\?definitionId=[1-9][0-9]*`
+
 	// Regex to verify pipeline release details link
 	ReleaseDetailsLinkRegex = `http(s)?:\/\/dev.azure.com\/[a-zA-Z0-9!@#$%^&*()_+\-=\[\]{};':"\\|,.<>\/?]*\/[a-zA-Z0-9!@#$%^&*()_+\-=\[\]{};':"\\|,.<>\/?]*\/_releaseProgress\?_a=release-pipeline-progress&releaseId=[a-zA-Z0-9!@#$%^&*()_+\-=\[\]{};':"\\|,.<>\/?]+`
 
 	WorkItemCommentedOnMarkdownRegex = ` commented on by [a-zA-Z0-9!@#$%^&*()_+\-=\[\]{};':"|,.<>\/? ]*`
 
+	// Regex to find the IDs of the users mentioned in pull request and work item comments
+	PullRequestCommentMentionRegex = `@<([0-9a-fA-F-]{36})>`
+	WorkItemCommentMentionRegex    = `data-vss-mention="version:[0-9.]+,([0-9a-fA-F-]{36})"`
+
 	// Azure API Versions
 	CreateTaskAPIVersion = "7.1-preview.3"
 	TasksIDAPIVersion    = "5.1"
@@ -87,6 +187,7 @@ const (
 	PathParamOrganization = "organization"
 	PathParamProject      = "project"
 	PathParamRepository   = "repository"
+	PathParamWorkItemType = "type"
 
 	// URL query params constants
 	QueryParamProject     = "project"
@@ -96,6 +197,8 @@ const (
 	QueryParamEventType   = "event_type"
 	QueryParamPage        = "page"
 	QueryParamPerPage     = "per_page"
+	QueryParamTeam        = "team"
+	QueryParamStaleDays   = "stale_days"
 
 	// Filters
 	FilterCreatedByMe          = "me"
@@ -156,8 +259,62 @@ const (
 
 	DateTimeFormat = "Mon Jan 2 15:04:05 -0700 MST 2006"
 	DateTimeLayout = "2006-01-02T15:04:05"
+	DateLayout     = "2006-01-02"
 	TimeLayout     = "15:04:05"
 
+	// Work item field reference names
+	FieldReferenceNameTitle         = "System.Title"
+	FieldReferenceNameDescription   = "System.Description"
+	FieldReferenceNameAreaPath      = "System.AreaPath"
+	FieldReferenceNameIteration     = "System.IterationPath"
+	FieldReferenceNameTags          = "System.Tags"
+	FieldReferenceNameAssignedTo    = "System.AssignedTo"
+	FieldReferenceNamePriority      = "Microsoft.VSTS.Common.Priority"
+	FieldReferenceNameProject       = "System.TeamProject"
+	FieldReferenceNameType          = "System.WorkItemType"
+	FieldReferenceNameState         = "System.State"
+	FieldReferenceNameChangedDate   = "System.ChangedDate"
+	FieldReferenceNameRemainingWork = "Microsoft.VSTS.Scheduling.RemainingWork"
+	FieldReferenceNameHistory       = "System.History"
+	FieldTagsSeparator              = "; "
+
+	// Work item relation types
+	RelationTypeHyperlink    = "Hyperlink"
+	RelationTypeParent       = "System.LinkTypes.Hierarchy-Reverse"
+	RelationTypeChild        = "System.LinkTypes.Hierarchy-Forward"
+	RelationTypeAttachedFile = "AttachedFile"
+	RelationTypeArtifactLink = "ArtifactLink"
+
+	// Work item hierarchy
+	DefaultChildWorkItemType = "Task"
+	WorkItemStateDone        = "Done"
+	WorkItemStateClosed      = "Closed"
+	WorkItemStateCompleted   = "Completed"
+	WorkItemStateRemoved     = "Removed"
+	// Maximum number of work items which can be fetched in a single request
+	MaxWorkItemsPerRequest = 200
+
+	// Sprint overview
+	DefaultStaleDays = 3
+	UnassignedMember = "Unassigned"
+
+	// Thread links
+	ThreadLinkRelationComment = "Mattermost thread"
+	MirroredCommentMarker     = "Mirrored from Mattermost"
+	MirroredCommentFormat     = "%s<br><br><em>" + MirroredCommentMarker + "</em>"
+
+	// Work item field types
+	FieldTypeString          = "string"
+	FieldTypeInteger         = "integer"
+	FieldTypeDouble          = "double"
+	FieldTypeBoolean         = "boolean"
+	FieldTypeDateTime        = "dateTime"
+	FieldTypeHTML            = "html"
+	FieldTypeIdentity        = "identity"
+	FieldTypePicklistString  = "picklistString"
+	FieldTypePicklistInteger = "picklistInteger"
+	FieldTypePicklistDouble  = "picklistDouble"
+
 	PipelineRequestIDApproved          = "approved"
 	PipelineRequestIDRejected          = "rejected"
 	PipelineRequestNameRun             = "run"
@@ -169,7 +326,182 @@ const (
 	PipelineRequestContextRequestName  = "requestName"
 	PipelineRequestContextProjectID    = "projectId"
 
-	DialogFieldNameComment = "comment"
+	// Pull request votes
+	PullRequestVoteApproved                = 10
+	PullRequestVoteApprovedWithSuggestions = 5
+	PullRequestVoteWaitingForAuthor        = -5
+	PullRequestVoteRejected                = -10
+	PullRequestStatusActive                = "active"
+	PullRequestStatusCompleted             = "completed"
+	PullRequestStatusAbandoned             = "abandoned"
+	PullRequestContextOrganization         = "organization"
+	PullRequestContextProjectID            = "projectId"
+	PullRequestContextRepositoryID         = "repositoryId"
+	PullRequestContextPullRequestID        = "pullRequestId"
+	PullRequestContextVote                 = "vote"
+	PullRequestContextAction               = "action"
+	PullRequestContextSelectedOption       = "selected_option"
+	PullRequestMergeStrategyNoFastForward  = "noFastForward"
+	PullRequestMergeStrategySquash         = "squash"
+	PullRequestMergeStrategyRebase         = "rebase"
+	PullRequestMergeStrategyRebaseMerge    = "rebaseMerge"
+	PolicyEvaluationStatusApproved         = "approved"
+	PolicyEvaluationStatusNotApplicable    = "notApplicable"
+	PullRequestReviewersFieldTitle         = "Reviewer(s)"
+	PullRequestMergeStatusConflicts        = "conflicts"
+	PullRequestMergeStatusSucceeded        = "succeeded"
+
+	// Pull request filters of the "repos prs" command and the pull request counts API
+	PullRequestFilterMine   = "mine"
+	PullRequestFilterReview = "review"
+	PullRequestFilterAll    = "all"
+	MaxActivePullRequests   = 100
+	// Number of pull requests whose policy evaluations are fetched at the same time
+	MaxConcurrentPolicyEvaluationRequests = 10
+
+	// Code pushes
+	MaxCodePushCommits     = 10
+	ShortCommitIDLength    = 8
+	ZeroObjectID           = "0000000000000000000000000000000000000000"
+	RefNamePrefixBranch    = "refs/heads/"
+	RefNamePrefixTag       = "refs/tags/"
+	CommitWebURL           = "%s/commit/%s"
+	MergeCommitPrefix      = "Merge "
+	MergedPullRequestRegex = `^Merged PR [0-9]+:`
+
+	// Work item branches e.g. "users/jdoe/123-fix-the-login-page", the slug of the title is left out if it is empty
+	WorkItemBranchNameFormat           = "users/%s/%d"
+	WorkItemBranchSlugFormat           = "%s-%s"
+	MaxWorkItemBranchSlugLength        = 50
+	WorkItemStateActive                = "Active"
+	BranchArtifactLinkName             = "Branch"
+	BranchArtifactLinkURL              = "vstfs:///Git/Ref/%s%%2F%s%%2FGB%s"
+	BranchWebURL                       = "%s?version=GB%s"
+	GitRefUpdateStatusStaleOldObjectID = "staleOldObjectId"
+
+	// Pipeline runs e.g. "pipelines run web-ci main environment=staging"
+	PipelineDefinitionIDQueryParam         = "definitionId="
+	PipelineRepositorySelf                 = "self"
+	PipelineParameterTypeBoolean           = "boolean"
+	PipelineParameterTypeNumber            = "number"
+	PipelineParameterTypeString            = "string"
+	DialogFieldNameBranch                  = "branch"
+	DialogFieldNameVariables               = "variables"
+	DialogFieldNamePipelineParameterPrefix = "parameter_"
+
+	// Pipeline run actions on the notifications and previews of builds, runs and stages
+	BuildStatusInProgress          = "inProgress"
+	BuildStatusNotStarted          = "notStarted"
+	BuildStatusPostponed           = "postponed"
+	BuildStatusCancelling          = "cancelling"
+	BuildStatusCompleted           = "completed"
+	BuildResultFailed              = "failed"
+	BuildResultCanceled            = "canceled"
+	BuildResultPartiallySucceeded  = "partiallySucceeded"
+	BuildStageStateRetry           = "retry"
+	TimelineRecordTypeStage        = "Stage"
+	PipelineRunActionCancel        = "cancel"
+	PipelineRunActionRetry         = "retry"
+	PipelineRunActionRerunStage    = "rerunStage"
+	PipelineRunContextOrganization = "organization"
+	PipelineRunContextProject      = "project"
+	PipelineRunContextBuildID      = "buildId"
+	PipelineRunContextAction       = "action"
+	PipelineRunContextStage        = "stage"
+	PipelineRunStatusFieldTitle    = "Status"
+	BuildIDQueryParam              = "buildId"
+	DialogFieldNameStage           = "stage"
+
+	// Failure details of build completed notifications
+	TimelineRecordTypeJob        = "Job"
+	TimelineRecordTypeTask       = "Task"
+	TimelineIssueTypeError       = "error"
+	TestOutcomeFailed            = "Failed"
+	TestResultMetadataFlaky      = "flaky"
+	BuildURI                     = "vstfs:///Build/Build/%d"
+	BuildLogContextLogID         = "logId"
+	BuildLogContextTaskName      = "taskName"
+	BuildFailedTasksFieldTitle   = "Failed tasks"
+	BuildTestsFieldTitle         = "Tests"
+	MaxBuildFailedTasks          = 5
+	MaxBuildFailedTestNames      = 5
+	MaxBuildErrorMessageLength   = 200
+	MaxBuildLogTailLines         = 30
+	MaxBuildLogTailMessageLength = 3000
+
+	// Approvals of run stages, their posts are synced with Azure DevOps and their pending approvers are reminded until they are completed
+	ApprovalStatusPending          = "pending"
+	ApprovalStatusTimedOut         = "timedOut"
+	ApprovalExecutionOrderSequence = "inSequence"
+	ApprovalApproversFieldIndex    = 2
+	ApprovalExpiresFieldTitle      = "Expires"
+
+	// Approval cards sent to the approvers of releases and run stages, the groups of approvers are expanded into their members
+	ApprovalCardNotificationKind = "approvalCard"
+	MaxApprovalGroupMembers      = 100
+
+	// Pull request review reminders e.g. "reminders add weekdays 09:30 repo=web min-age=24h"
+	ReminderArgumentRepository = "repo"
+	ReminderArgumentMinAge     = "min-age"
+	ReminderDaysWeekdays       = "weekdays"
+	ReminderDaysDaily          = "daily"
+	ReminderTimeLayout         = "15:04"
+	ReminderOccurrenceLayout   = "2006-01-02"
+
+	// Personal notifications
+	PersonalNotificationReviews     = "reviews"
+	PersonalNotificationMentions    = "mentions"
+	PersonalNotificationAssignments = "assignments"
+	PersonalNotificationAll         = "all"
+
+	// Pull request comment threads
+	PullRequestThreadStatusActive     = "active"
+	PullRequestThreadStatusFixed      = "fixed"
+	PullRequestThreadStatusWontFix    = "wontFix"
+	PullRequestThreadStatusPending    = "pending"
+	PullRequestCommentTypeText        = 1
+	PullRequestContextThreadID        = "threadId"
+	PullRequestContextThreadStatus    = "threadStatus"
+	PullRequestThreadStatusFieldTitle = "Thread Status"
+	MirroredPullRequestCommentFormat  = "%s\n\n_" + MirroredCommentMarker + "_"
+	// The props of pull request comment notification posts identifying the pull request thread of the comment
+	PostPropPullRequestOrganization = "azure_devops_organization"
+	PostPropPullRequestProjectID    = "azure_devops_project_id"
+	PostPropPullRequestRepositoryID = "azure_devops_repository_id"
+	PostPropPullRequestID           = "azure_devops_pull_request_id"
+	PostPropPullRequestThreadID     = "azure_devops_pull_request_thread_id"
+	PostPropPullRequestCommentID    = "azure_devops_pull_request_comment_id"
+
+	DialogFieldNameComment             = "comment"
+	DialogFieldNameWorkItemLink        = "workItemLink"
+	DialogFieldNameMergeStrategy       = "mergeStrategy"
+	DialogFieldNameDeleteSourceBranch  = "deleteSourceBranch"
+	DialogFieldNameTransitionWorkItems = "transitionWorkItems"
+	DialogFieldNameTitle               = "title"
+	DialogFieldNameDescription         = "description"
+	DialogFieldNameReviewer            = "reviewer%d"
+	DialogFieldNameWorkItems           = "workItems"
+	DialogFieldNameDraft               = "draft"
+	DialogFieldNameMirrorReplies       = "mirrorReplies"
+	// Reviewers of a pull request are picked in separate fields of the dialog as user selects allow a single user
+	MaxPullRequestDialogReviewers = 3
+
+	// Work item field changes
+	WorkItemFieldChangeFormat      = "**%s:** %s → %s"
+	WorkItemFieldBlockChangeFormat = "**%s:**\n%s"
+	WorkItemFieldEmptyValue        = "—"
+	FieldDisplayNameHistory        = "Comment"
+	// Longer values and values spanning multiple lines are quoted below the field name instead of being shown inline
+	MaxInlineWorkItemFieldValueLength = 80
+	MaxWorkItemFieldValueLength       = 500
+
+	// Work item attachments
+	AttachedFileRelationComment = "Attached from Mattermost"
+	AttachmentUploadTypeSimple  = "Simple"
+	AttachmentUploadTypeChunked = "Chunked"
+	// Files larger than the maximum chunk size are uploaded in chunks
+	MaxAttachmentChunkSizeInBytes = 4 * 1024 * 1024
+	MaxAttachmentSizeInBytes      = 60 * 1024 * 1024
 
 	MaxBytesSizeForReadingResponseBody = 1000000
 )
@@ -211,8 +543,65 @@ var (
 		SubscriptionEventRunStateChanged:            true,
 	}
 
+	// Fields updated by Azure DevOps on every revision, which are not shown in work item updated notifications
+	NoiseWorkItemFields = map[string]bool{
+		"System.Rev":                            true,
+		"System.AuthorizedDate":                 true,
+		"System.AuthorizedAs":                   true,
+		"System.RevisedDate":                    true,
+		"System.ChangedDate":                    true,
+		"System.ChangedBy":                      true,
+		"System.Watermark":                      true,
+		"System.PersonId":                       true,
+		"System.AreaId":                         true,
+		"System.IterationId":                    true,
+		"System.NodeName":                       true,
+		"System.CommentCount":                   true,
+		"System.BoardColumnDone":                true,
+		"Microsoft.VSTS.Common.StateChangeDate": true,
+		"Microsoft.VSTS.Common.ActivatedDate":   true,
+		"Microsoft.VSTS.Common.ResolvedDate":    true,
+		"Microsoft.VSTS.Common.ClosedDate":      true,
+		"Microsoft.VSTS.Common.StackRank":       true,
+		"Microsoft.VSTS.Common.BacklogPriority": true,
+	}
+
+	PullRequestVoteEmoji = map[int]string{
+		PullRequestVoteApproved:                "&#9989;",
+		PullRequestVoteApprovedWithSuggestions: "&#9745;",
+		PullRequestVoteWaitingForAuthor:        "&#9203;",
+		PullRequestVoteRejected:                "&#10060;",
+	}
+
+	PullRequestMergeStrategies = map[string]bool{
+		PullRequestMergeStrategyNoFastForward: true,
+		PullRequestMergeStrategySquash:        true,
+		PullRequestMergeStrategyRebase:        true,
+		PullRequestMergeStrategyRebaseMerge:   true,
+	}
+
+	PullRequestVoteNames = map[int]string{
+		PullRequestVoteApproved:                "Approve",
+		PullRequestVoteApprovedWithSuggestions: "Approve with suggestions",
+		PullRequestVoteWaitingForAuthor:        "Wait for author",
+		PullRequestVoteRejected:                "Reject",
+	}
+
+	PullRequestThreadStatusNames = map[string]string{
+		PullRequestThreadStatusActive:  "Active",
+		PullRequestThreadStatusPending: "Pending",
+		PullRequestThreadStatusFixed:   "Resolved",
+		PullRequestThreadStatusWontFix: "Won't fix",
+		"closed":                       "Closed",
+		"byDesign":                     "By design",
+	}
+
 	PipelineRequestUpdateEmoji = map[string]string{
 		PipelineRequestIDApproved: "&#9989;",
 		PipelineRequestIDRejected: "&#10060;",
+		ApprovalStatusTimedOut:    "&#8987;",
 	}
+
+	// Commits whose author name or email contains one of the below are considered to be made by bots
+	BotCommitAuthorMarkers = []string{"[bot]", "Build Service"}
 )
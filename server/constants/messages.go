@@ -2,35 +2,204 @@ package constants
 
 const (
 	// Generic
-	GenericErrorMessage            = "Something went wrong, please try again later"
-	SessionExpiredMessage          = "Session expired. Please connect your Azure DevOps account again"
-	ConnectAccount                 = "[Click here to connect your Azure DevOps account](%s%s)"
-	ConnectAccountFirst            = "Your Azure DevOps account is not connected \n%s"
-	UserConnected                  = "Your Azure DevOps account is successfully connected!"
-	MattermostUserAlreadyConnected = "Your Azure DevOps account is already connected"
-	UserDisconnected               = "Your Azure DevOps account is now disconnected"
-	CreatedTask                    = "Work item [#%d: \"%s\"](%s) of type \"%s\" was successfully created by %s."
-	TaskTitle                      = "[%s #%d: %s](%s)"
-	PullRequestTitle               = "[#%d: %s](%s)"
-	BuildDetailsTitle              = "[#%s](%s): %s"
-	PipelineDetailsTitle           = "[%s](%s): %s"
-	AlreadyLinkedProject           = "This project is already linked."
-	NoProjectLinked                = "No project is linked, please link a project."
-	PipelinesRequestBeingProcessed = "Your approval/rejection request is being processed."
-	PipelinesRequestProcessed      = "Your approval/rejection request is processed."
+	GenericErrorMessage                  = "Something went wrong, please try again later"
+	SessionExpiredMessage                = "Session expired. Please connect your Azure DevOps account again"
+	ConnectAccount                       = "[Click here to connect your Azure DevOps account](%s%s)"
+	ConnectAccountFirst                  = "Your Azure DevOps account is not connected \n%s"
+	UserConnected                        = "Your Azure DevOps account is successfully connected!"
+	MattermostUserAlreadyConnected       = "Your Azure DevOps account is already connected"
+	UserDisconnected                     = "Your Azure DevOps account is now disconnected"
+	CreatedTask                          = "Work item [#%d: \"%s\"](%s) of type \"%s\" was successfully created by %s."
+	TaskTitle                            = "[%s #%d: %s](%s)"
+	PullRequestTitle                     = "[#%d: %s](%s)"
+	BuildDetailsTitle                    = "[#%s](%s): %s"
+	PipelineDetailsTitle                 = "[%s](%s): %s"
+	AlreadyLinkedProject                 = "This project is already linked."
+	NoProjectLinked                      = "No project is linked, please link a project."
+	PipelinesRequestBeingProcessed       = "Your approval/rejection request is being processed."
+	PipelinesRequestProcessed            = "Your approval/rejection request is processed."
+	PresetAdded                          = "Work item preset %q is successfully added to this channel."
+	PresetAlreadyExists                  = "Work item preset %q already exists in this channel. Delete it first to replace it."
+	PresetDeleted                        = "Work item preset %q is successfully deleted from this channel."
+	PresetNotFound                       = "Work item preset %q does not exist in this channel."
+	NoPresetsFound                       = "No work item presets found for this channel."
+	PresetNameRequired                   = "Preset name is not provided"
+	PresetTitleRequired                  = "Work item title is not provided"
+	PresetTemplateRequired               = "Template name is not provided, use `template=[template name]`"
+	PresetTemplateNotFound               = "Work item template %q does not exist for the team %q"
+	PresetProjectRequired                = "Unable to find the project for the preset, use `organization=[organization] project=[project]` to select one of your linked projects"
+	ThreadLinked                         = "This thread is linked to work item [#%s](%s)."
+	ThreadLinkedWithMirroring            = "This thread is linked to work item [#%s](%s). Replies in this thread will be added as comments on the work item."
+	ThreadUnlinked                       = "This thread is no longer linked to work item #%s."
+	ThreadNotLinked                      = "This thread is not linked to any work item."
+	ThreadRequired                       = "Run this command from the reply box of the thread to be linked."
+	WorkItemLinkRequired                 = "Link of the work item is not provided or is invalid"
+	ThreadReplyNotMirrored               = "Your reply was not added as a comment on the linked work item because your Azure DevOps account is not connected."
+	InvalidCommandArguments              = "Invalid command arguments: %s"
+	PresetInvalidArgument                = "Invalid argument %q, arguments must be of the form `field=value`"
+	ParentWorkItemRequired               = "Parent work item is not provided, use the ID or the link of the parent work item"
+	ParentWorkItemProjectRequired        = "Unable to find the project of the parent work item, use the link of the parent work item instead of its ID"
+	ChildWorkItemTitlesRequired          = "Titles of the child work items are not provided e.g. `breakdown 42 \"Write tests\" \"Update docs\"`"
+	ChildWorkItemsCreated                = "Created %d child work item(s) of [%s #%d: %s](%s):\n"
+	ChildWorkItemsPartiallyCreated       = "Created %d of %d child work item(s) of [%s #%d: %s](%s) before an error occurred: %s\n"
+	ChildWorkItem                        = "* [%s #%d: %s](%s)\n"
+	WorkItemChildrenProgress             = "%d/%d done"
+	SprintProjectRequired                = "Unable to find the project, use `/azuredevops boards sprint [project] [team]` with one of your linked projects"
+	NoCurrentIteration                   = "No current iteration is set for the team %q of the project %q."
+	InvalidStaleDays                     = "Number of days must be a positive integer"
+	SprintTitle                          = "###### %s (%s - %s) | %s / %s\n"
+	SprintOverview                       = "**%d** work item(s) | **%s** remaining work | **%s** capacity over the remaining %d working day(s)\n"
+	SprintStaleWorkItemsTitle            = "###### Not updated in the last %d day(s)\n"
+	SprintNoStaleWorkItems               = "All work items were updated in the last %d day(s)."
+	SprintStaleWorkItem                  = "* [%s #%d: %s](%s) | %s | %s | updated %d day(s) ago\n"
+	FilesAttached                        = "Attached %d file(s) to work item [#%s](%s): %s"
+	SubscriptionIDNotProvided            = "Subscription ID is not provided"
+	WorkItemFiltersRequired              = "Filters are not provided, use `type=Bug,Incident tag=[tag] from=[state] to=[state] priority<=[number]` or `clear` to remove the filters"
+	WorkItemFiltersUpdated               = "Notifications of the subscription with ID: %q are only posted for work items matching: %s"
+	WorkItemFiltersCleared               = "Filters of the subscription with ID: %q are removed"
+	PullRequestVoted                     = "Your vote %q is recorded on the pull request #%d."
+	PullRequestVoteNotPermitted          = "Looks like you do not have permission to vote on the pull request #%d."
+	PullRequestRequired                  = "ID or link of the pull request is not provided"
+	PullRequestProjectRequired           = "Unable to find the project of the pull request, use the link of the pull request instead of its ID"
+	PullRequestNotActive                 = "Pull request #%d is not active"
+	PullRequestNotAbandoned              = "Pull request #%d is not abandoned"
+	PullRequestUpdateNotPermitted        = "Looks like you do not have permission to update the pull request #%d."
+	PullRequestCompleted                 = "Pull request %s is completed."
+	PullRequestCompletionQueued          = "Pull request %s is queued for completion."
+	PullRequestAbandoned                 = "Pull request %s is abandoned."
+	PullRequestReactivated               = "Pull request %s is reactivated."
+	PullRequestAutoCompleteSet           = "Pull request %s will be completed automatically once all the policies pass."
+	PullRequestBlockedByPolicies         = "Pull request %s can not be completed until the following policies pass:\n%sUse `/azuredevops repos pr autocomplete %d` to complete it automatically once they pass."
+	PullRequestBlockingPolicy            = "* %s\n"
+	PullRequestThreadReplyNotMirrored    = "Your reply was not added to the pull request thread because your Azure DevOps account is not connected."
+	PullRequestThreadStatusUpdated       = "Status of the comment thread on the pull request #%d is changed to %q."
+	PullRequestThreadUpdateNotPermitted  = "Looks like you do not have permission to update the comment thread on the pull request #%d."
+	PersonalNotificationsStatus          = "##### Personal notifications\n* Added as a reviewer on a pull request: **%s**\n* Mentioned in a pull request or work item comment: **%s**\n* Assigned a work item: **%s**\n\nUse `/azuredevops notifications on/off [reviews, mentions, assignments or all]` to change them. The notifications are sent as direct messages, but only for the events delivered to the subscriptions added to the channels in Mattermost. No notification is sent for the projects, repositories or events without a subscription."
+	PersonalNotificationsUpdated         = "Personal notifications for %s are turned %s."
+	PersonalNotificationReviewRequested  = "You are added as a reviewer on a pull request.\n%s"
+	PersonalNotificationMentioned        = "You are mentioned in a comment.\n%s"
+	PersonalNotificationWorkItemAssigned = "A work item is assigned to you.\n%s"
+	IdentityOverrideNotPermitted         = "Only system admins can manage the mappings of Azure DevOps identities to Mattermost users."
+	IdentityOverrideAdded                = "Azure DevOps identity %q is mapped to @%s."
+	IdentityOverrideDeleted              = "Azure DevOps identity %q is no longer mapped to a Mattermost user."
+	IdentityOverrideNotFound             = "Azure DevOps identity %q is not mapped to a Mattermost user."
+	IdentityOverridesEmpty               = "No Azure DevOps identities are mapped to Mattermost users. Identities are matched to Mattermost users by their connected accounts and emails."
+	IdentityOverridesListHeader          = "##### Azure DevOps identities mapped to Mattermost users\n| Azure DevOps identity | Mattermost user |\n| :-- | :-- |\n"
+	IdentityOverridesListItem            = "| %s | %s |\n"
+	MattermostUserNotFound               = "Mattermost user %q is not found."
+	AssigneeNotFound                     = "Unable to find the Azure DevOps identity of the Mattermost user %q, use the email of the assignee instead."
+	PullRequestsProjectRequired          = "Unable to find the project, use `/azuredevops repos prs [project] [repo] [mine, review or all]` with one of your linked projects"
+	NoActivePullRequests                 = "No active pull requests found for %s."
+	PullRequestsTitle                    = "###### Active pull requests (%s) | %s\n"
+	PullRequestsRepositoryTitle          = "\n##### %s\n| Pull request | Age | Created by | Reviewer(s) | Merge | Policies |\n| :----------- | :-- | :--------- | :---------- | :---- | :------- |\n"
+	PullRequestsItem                     = "| %s | %s | %s | %s | %s | %s |\n"
+	PullRequestsLimitReached             = "\nOnly the latest %d pull requests are shown."
+	ReviewReminderUsage                  = "Days and time of the reminder are not provided e.g. `/azuredevops repos reminders add weekdays 09:30 repo=web min-age=24h`"
+	ReviewReminderProjectRequired        = "Unable to find the project for the reminder, use `organization=[organization] project=[project]` to select one of your linked projects"
+	ReviewReminderAdded                  = "Review reminder with ID: %q is added to this channel. Pull requests of %s waiting for review will be listed %s."
+	ReviewReminderDeleted                = "Review reminder with ID: %q is deleted from this channel."
+	ReviewReminderNotFound               = "Review reminder with ID: %q does not exist in this channel."
+	ReviewReminderIDRequired             = "Reminder ID is not provided"
+	NoReviewReminders                    = "No review reminders found for this channel."
+	ReviewRemindersListHeader            = "###### Review reminder(s)\n| ID | Schedule | Pull requests of | Older than | Created by |\n| :- | :------- | :--------------- | :--------- | :--------- |\n"
+	ReviewRemindersListItem              = "| %s | %s | %s | %s | %s |\n"
+	ReviewReminderTitle                  = "#### %d pull request(s) of %s waiting for review\n"
+	ReviewReminderItem                   = "* %s | opened %s ago | waiting for %s\n"
+	CodePushFiltersRequired              = "Filters are not provided, use `collapse=merges,bots` or `clear` to remove the filters"
+	CodePushFiltersUpdated               = "Notifications of the subscription with ID: %q are posted with: %s"
+	CodePushCommit                       = "%s %s"
+	CodePushCommitAuthor                 = " - %s"
+	CodePushMoreCommits                  = "_and %d more commit(s)_"
+	CodePushCollapsedCommits             = "_%d %s commit(s) collapsed_"
+	CodePushRefCreated                   = "%s `%s` created at %s"
+	CodePushRefDeleted                   = "%s `%s` deleted, it was at `%s`"
+	CodePushForcePush                    = ":warning: **Force push:** `%s` was rewritten from `%s` to `%s`, %d commit(s) were removed"
+	CodePushChanges                      = "%d added, %d edited, %d deleted"
+	PipelineFiltersRequired              = "Filters are not provided, use `notify=requester` or `clear` to remove the filters"
+	PipelineFiltersUpdated               = "Notifications of the subscription with ID: %q are posted with: %s"
+	FailedBuildRequesterMention          = "@%s your build failed."
+	FailedBuildRequesterDM               = "Your build failed: %s"
+	ApprovalExpiresIn                    = "in %s"
+	ApprovalExpired                      = "Expired"
+	ApprovalCardMessage                  = "Your approval is requested."
+	ApprovalReminder                     = "Reminder: the stage %s of the pipeline %s is waiting for your approval and expires in %s. Approve or reject it from [the notification](%s)."
+	WorkItemBranchUsage                  = "Work item is not provided, use `/azuredevops repos branch create [work item ID or link] [repo] [base branch] [--activate]`"
+	WorkItemBranchProjectRequired        = "Unable to find the project of the work item, use the link of the work item instead of its ID"
+	WorkItemBranchCreated                = "Created the branch [%s](%s) from `%s` in the repo %s and linked it to [%s #%d: %s](%s)."
+	WorkItemBranchActivated              = "\nThe work item is moved to %s."
+	WorkItemBranchNotActivated           = "\nUnable to move the work item to %s: %s"
+	PullRequestCreateUsage               = "Repo, branches or title are not provided, use `/azuredevops repos pr create [repo] [source branch] [target branch] \"[title]\" [organization=organization] [project=project]`"
+	PullRequestCreateProjectRequired     = "Unable to find the project of the repo, use `organization=[organization] project=[project]` with one of your linked projects"
+	PullRequestAlreadyExists             = "An active pull request from `%s` into `%s` already exists."
+	PullRequestCreateNotPermitted        = "Looks like you do not have permission to create pull requests in this repo."
+	PullRequestReviewerNotFound          = "@%s is not found in the organization, connect their Azure DevOps account or ask an admin to map their identity"
+	PipelineRunUsage                     = "Pipeline is not provided, use `/azuredevops pipelines run [pipeline name, ID or link] [branch] [name=value...]`"
+	PipelineRunProjectRequired           = "Unable to find the project of the pipeline, use the link of the pipeline instead of its name or ID"
+	PipelineRunQueued                    = "Queued the run [%s](%s) of the pipeline **%s**%s."
+	PipelineRunBranch                    = " on `%s`"
+	PipelineRunCancelRequested           = "Requested to cancel the run [%s](%s)."
+	PipelineRunRetried                   = "Retrying the failed jobs of the run [%s](%s)."
+	PipelineRunStageRerun                = "Rerunning the stage **%s** of the run [%s](%s)."
+	PipelineRunUpdateNotPermitted        = "Looks like you do not have permission to cancel or retry the run %d of this pipeline."
+	NoRerunnableStages                   = "The run %d has no failed or canceled stages to rerun."
+	BuildFailedTask                      = "- **%s**"
+	BuildFailedTaskError                 = ": `%s`"
+	BuildMoreFailedTasks                 = "- and %d more"
+	BuildTestSummary                     = "%d total, %d failed, %d flaky"
+	BuildFailedTest                      = "\n- `%s`"
+	BuildLogTail                         = "Last %d lines of the log of **%s**:\n```\n%s\n```"
+	BuildLogEmpty                        = "The log of **%s** is empty."
+	BuildLogNotPermitted                 = "Looks like you do not have permission to view the logs of this build."
 
 	// Validations Errors
-	OrganizationRequired            = "organization is required"
-	ProjectRequired                 = "project is required"
-	TaskTypeRequired                = "task type is required"
-	TaskTitleRequired               = "task title is required"
-	EventTypeRequired               = "event type is required"
-	ServiceTypeRequired             = "service type is required"
-	ChannelIDRequired               = "channel ID is required"
-	WebhookSecretRequired           = "webhook secret is required"
-	MMUserIDRequired                = "mattermsot user ID is required"
-	EmptyAzureDevopsAPIBaseURLError = "azure devops API base URL should not be empty"
-	EmptyAzureDevopsOAuthAppIDError = "azure devops OAuth app id should not be empty"
+	OrganizationRequired               = "organization is required"
+	ProjectRequired                    = "project is required"
+	TaskTypeRequired                   = "task type is required"
+	TaskTitleRequired                  = "task title is required"
+	PostIDRequired                     = "post ID is required"
+	WorkItemIDRequired                 = "work item ID is required"
+	PostHasNoFiles                     = "post has no files to attach"
+	FileTooLarge                       = "file %q is larger than the maximum size of %d MB"
+	FileNotReadableInRanges            = "file %q can not be read in ranges from the file storage"
+	SubscriptionIDRequired             = "subscription ID is required"
+	InvalidWorkItemFilterArgument      = "invalid filter %q, filters must be of the form `type=Bug,Incident`, `tag=[tag]`, `from=[state]`, `to=[state]` or `priority<=[number]`"
+	InvalidWorkItemFilterPriority      = "invalid priority %q, priority must be a positive integer"
+	WorkItemFiltersNotSupported        = "filters are only supported for work item subscriptions"
+	WorkItemStateFiltersNotSupported   = "state filters are only supported for work item updated subscriptions"
+	MergeStrategyRequired              = "merge strategy is not provided"
+	InvalidMergeStrategy               = "invalid merge strategy %q, merge strategy must be one of noFastForward, squash, rebase or rebaseMerge"
+	InvalidPullRequestCompletionOption = "invalid option %q, options must be `--merge-strategy [strategy]`, `--delete-source-branch` or `--transition-work-items`"
+	InvalidPersonalNotificationKind    = "invalid notification kind %q, it must be one of reviews, mentions, assignments or all"
+	InvalidReminderDays                = "invalid days %q, days must be weekdays, daily or a comma separated list of days e.g. mon,wed,fri"
+	InvalidReminderTime                = "invalid time %q, time must be of the form HH:MM e.g. 09:30"
+	InvalidReminderMinAge              = "invalid minimum age %q, it must be a duration e.g. 30m, 24h or 3d"
+	InvalidReminderArgument            = "invalid argument %q, arguments must be `repo=[repo name]`, `min-age=[duration]`, `organization=[organization]` or `project=[project]`"
+	InvalidCodePushFilterArgument      = "invalid filter %q, filters must be of the form `collapse=merges,bots`"
+	CodePushFiltersNotSupported        = "collapse filters are only supported for code pushed subscriptions"
+	InvalidPipelineFilterArgument      = "invalid filter %q, filters must be of the form `notify=requester`"
+	PipelineFiltersNotSupported        = "notify filters are only supported for build completed and run state changed subscriptions"
+	RepositoryRequired                 = "repo is not provided, use one of the repos of the project: %s"
+	RepositoryNotFound                 = "repo %q does not exist, use one of the repos of the project: %s"
+	NoRepositories                     = "project %q has no repos"
+	RepositoryIsEmpty                  = "repo %q has no branches"
+	BranchNotFound                     = "branch %q does not exist in the repo %q"
+	PullRequestTitleRequired           = "pull request title is required"
+	PipelineNotFound                   = "pipeline %q does not exist in the project %q"
+	InvalidPipelineVariable            = "invalid variable %q, variables must be of the form `name=value`, one per line"
+	PipelineRunNotInProgress           = "run %s is not in progress"
+	PipelineRunNotFailed               = "run %s has not failed or been canceled"
+	StageRequired                      = "stage is required"
+	SameSourceAndTargetBranch          = "source and target branches must be different"
+	InvalidPullRequestWorkItem         = "invalid work item %q, use the IDs or links of the work items separated by commas"
+	BranchAlreadyExists                = "branch %q already exists in the repo %q"
+	PresetTypeRequired                 = "work item type is required"
+	EventTypeRequired                  = "event type is required"
+	ServiceTypeRequired                = "service type is required"
+	ChannelIDRequired                  = "channel ID is required"
+	WebhookSecretRequired              = "webhook secret is required"
+	MMUserIDRequired                   = "mattermsot user ID is required"
+	EmptyAzureDevopsAPIBaseURLError    = "azure devops API base URL should not be empty"
+	EmptyAzureDevopsOAuthAppIDError    = "azure devops OAuth app id should not be empty"
 
 	// #nosec G101 -- This is a false positive. The below line is not a hardcoded credential
 	EmptyAzureDevopsOAuthClientSecretError = "azure devops OAuth client secret should not be empty"
@@ -72,6 +241,7 @@ const (
 	GetChannelError                                = "Error in getting channels for team and user"
 	GetUserError                                   = "Error in getting Mattermost user details"
 	InvalidPaginationQueryParam                    = "Invalid value for query param(s) page or per_page"
+	ErrorQueueAccess                               = "Cannot run the pipeline, looks like you do not have access to queue runs of this pipeline. Please make sure you have the \"Queue builds\" permission for this pipeline"
 	ErrorAdminAccess                               = "Cannot delete the subscription, looks like you do not have access to add/delete a subscription for this project. Please make sure you are a project or team administrator for this project"
 	ErrorFetchSubscriptionList                     = "Error in fetching subscription list"
 	ErrorMessageForAdmin                           = "There is no registered handler for the service hooks event type %s"
@@ -85,4 +255,79 @@ const (
 	ErrorFetchSubscriptionFilterPossibleValues     = "Error in fetching subscription filter possible values"
 	ErrorUnauthorisedSubscriptionsWebhookRequest   = "missing or invalid webhook secret for subscriptions notification"
 	ErrorMessageAzureDevopsAccountAlreadyConnected = "azure devops account for %s is already connected"
+	ErrorFetchWorkItemTypes                        = "Error in fetching work item types"
+	ErrorFetchWorkItemFields                       = "Error in fetching work item fields"
+	ErrorMissingRequiredFields                     = "missing required field(s) for work item type %q: %s"
+	ErrorUnknownField                              = "field %q is not defined for work item type %q"
+	ErrorReadOnlyField                             = "field %q is read-only"
+	ErrorInvalidFieldValue                         = "value of field %q must be %s"
+	ErrorFieldValueNotAllowed                      = "%q is not an allowed value for field %q"
+	ErrorUnknownIdentity                           = "%q is not a user of the organization, field %q accepts the @username of a Mattermost user, a unique name or an email"
+	ErrorStorePreset                               = "Error in storing work item preset"
+	ErrorLoadPreset                                = "Error in loading work item presets"
+	ErrorDeletePreset                              = "Error in deleting work item preset"
+	ErrorLinkThread                                = "Error in linking the thread to the work item"
+	ErrorUnlinkThread                              = "Error in unlinking the thread from the work item"
+	ErrorLoadThreadLink                            = "Error in loading the thread link"
+	ErrorMirrorThreadReply                         = "Error in adding the thread reply as a work item comment"
+	ErrorFetchWorkItemTemplates                    = "Error in fetching work item templates"
+	ErrorBreakdownWorkItem                         = "Error in creating child work items"
+	ErrorFetchWorkItemHierarchy                    = "Error in fetching parent and child work items"
+	ErrorFetchSprintSummary                        = "Error in fetching the sprint summary"
+	ErrorInvalidStaleDays                          = "Invalid value for query param stale_days"
+	ErrorAttachFiles                               = "Error in attaching files to the work item"
+	ErrorUpdateWorkItemFilters                     = "Error in updating the work item filters of the subscription"
+	ErrorUpdatePullRequestVote                     = "Error in updating the vote on the pull request"
+	ErrorUpdatePullRequestReviewersPost            = "Error in updating the reviewers of the pull request post"
+	ErrorUpdatePullRequestStatus                   = "Error in updating the status of the pull request"
+	ErrorPullRequestBlockedByPolicies              = "pull request is blocked by policies"
+	ErrorLoadPullRequestThreadPosts                = "Error in loading the posts of the pull request thread"
+	ErrorStorePullRequestThreadPost                = "Error in storing the post of the pull request thread"
+	ErrorMirrorPullRequestThreadReply              = "Error in adding the thread reply to the pull request thread"
+	ErrorStoreMirroredPullRequestComment           = "Error in storing the comment mirrored to the pull request thread"
+	ErrorLoadMirroredPullRequestComment            = "Error in loading the comments mirrored to the pull request threads"
+	ErrorUpdatePullRequestThreadStatus             = "Error in updating the status of the pull request thread"
+	ErrorLoadPersonalNotificationSettings          = "Error in loading the personal notification settings"
+	ErrorStorePersonalNotificationSettings         = "Error in storing the personal notification settings"
+	ErrorTrackPullRequestReviewers                 = "Error in tracking the reviewers of the pull request"
+	ErrorSendPersonalNotification                  = "Error in sending the personal notification"
+	ErrorLoadIdentityOverrides                     = "Error in loading the Azure DevOps identities mapped to Mattermost users"
+	ErrorStoreIdentityOverride                     = "Error in mapping the Azure DevOps identity to the Mattermost user"
+	ErrorDeleteIdentityOverride                    = "Error in removing the mapping of the Azure DevOps identity"
+	ErrorResolveAzureIdentity                      = "Error in finding the Mattermost user of the Azure DevOps identity"
+	ErrorFetchPullRequests                         = "Error in fetching the active pull requests"
+	ErrorFetchPolicyEvaluations                    = "Error in fetching the policy evaluations of the pull request"
+	ErrorStoreReviewReminder                       = "Error in storing the review reminder"
+	ErrorLoadReviewReminders                       = "Error in loading the review reminders"
+	ErrorDeleteReviewReminder                      = "Error in deleting the review reminder"
+	ErrorSendReviewReminder                        = "Error in sending the review reminder"
+	ErrorStopReviewReminderJob                     = "Error in stopping the review reminder job"
+	ErrorUpdateCodePushFilters                     = "Error in updating the code push filters of the subscription"
+	ErrorUpdatePipelineFilters                     = "Error in updating the pipeline filters of the subscription"
+	ErrorNotifyFailedBuildRequester                = "Error in notifying the requester of the failed build"
+	ErrorTrackPipelineApproval                     = "Error in tracking the approval of the run stage"
+	ErrorGetApprovalCheckConfiguration             = "Error in getting the configuration of the approval check, the approval timeout setting is used"
+	ErrorLoadPipelineApprovals                     = "Error in loading the tracked approvals of run stages"
+	ErrorSyncPipelineApproval                      = "Error in syncing the approval of the run stage"
+	ErrorSendApprovalReminder                      = "Error in sending the approval reminder"
+	ErrorStopPipelineApprovalJob                   = "Error in stopping the pipeline approval job"
+	ErrorSendApprovalCard                          = "Error in sending the approval card"
+	ErrorExpandApprovalGroup                       = "Error in fetching the members of the group of approvers"
+	ErrorUpdateApprovalPosts                       = "Error in updating the posts of the approval"
+	ErrorFetchCommitDiffs                          = "Error in fetching the changes of the code push"
+	ErrorCreateWorkItemBranch                      = "Error in creating the branch of the work item"
+	ErrorActivateWorkItem                          = "Error in moving the work item to the active state"
+	ErrorOpenCreatePullRequestDialog               = "Error in opening the dialog to create the pull request"
+	ErrorCreatePullRequest                         = "Error in creating the pull request"
+	ErrorCreatePullRequestPost                     = "Error in posting the created pull request"
+	ErrorOpenRunPipelineDialog                     = "Error in opening the dialog to run the pipeline"
+	ErrorRunPipeline                               = "Error in running the pipeline"
+	ErrorCreatePipelineRunPost                     = "Error in posting the queued pipeline run"
+	ErrorParsePipelineParameters                   = "Error in parsing the runtime parameters of the pipeline"
+	ErrorUpdatePipelineRun                         = "Error in cancelling or retrying the pipeline run"
+	ErrorUpdatePipelineRunPost                     = "Error in updating the post of the pipeline run"
+	ErrorOpenRerunStageDialog                      = "Error in opening the dialog to rerun a stage of the pipeline run"
+	ErrorFetchBuildTimeline                        = "Error in fetching the timeline of the failed build"
+	ErrorFetchBuildTestRuns                        = "Error in fetching the test runs of the failed build"
+	ErrorFetchBuildLogTail                         = "Error in fetching the log tail of the failed build"
 )
@@ -19,23 +19,89 @@ const (
 	PathPipelineRunRequest                  = "/pipeline-run-request"
 	PathGetSubscriptionFilterPossibleValues = "/subscriptions/filters"
 	PathPipelineCommentModal                = "/pipeline-comment-modal"
+	PathGetWorkItemTypes                    = "/workitem-types/{organization:[A-Za-z0-9-]+}/{project:[^/]+}"
+	PathGetWorkItemTypeFields               = "/workitem-types/{organization:[A-Za-z0-9-]+}/{project:[^/]+}/{type:[^/]+}/fields"
+	PathLinkThread                          = "/thread/link"
+	PathLinkThreadDialog                    = "/thread/link/dialog"
+	PathUnlinkThread                        = "/thread/unlink"
+	PathGetSprintSummary                    = "/sprint/{organization:[A-Za-z0-9-]+}/{project:[^/]+}"
+	PathAttachFiles                         = "/attachments"
+	PathAttachFilesDialog                   = "/attachments/dialog"
+	PathSubscriptionWorkItemFilters         = "/subscriptions/workitem-filters"
+	PathPullRequestVote                     = "/pull-request-vote"
+	PathPullRequestAction                   = "/pull-request-action"
+	PathPullRequestCompletionDialog         = "/pull-request-completion"
+	PathCreatePullRequestDialog             = "/pull-request-create"
+	PathRunPipelineDialog                   = "/pipeline-run"
+	PathPipelineRunAction                   = "/pipeline-run-action"
+	PathRerunStageDialog                    = "/pipeline-run-stage-rerun"
+	PathBuildLogTail                        = "/build-log-tail"
+	PathPullRequestThreadStatus             = "/pull-request-thread-status"
+	PathGetPullRequestCounts                = "/pull-requests/counts"
 
 	// Mattermost API paths
 	PathOpenCommentModal = "/api/v4/actions/dialogs/open"
+	PathPostPermalink    = "%s/%s/pl/%s"
 
 	// Azure API paths
 	CreateTask                          = "/%s/%s/_apis/wit/workitems/$%s?api-version=7.1-preview.3"
-	GetTask                             = "%s/%s/_apis/wit/workitems/%s?api-version=7.1-preview.3"
+	GetTask                             = "%s/%s/_apis/wit/workitems/%s?$expand=relations&api-version=7.1-preview.3"
+	GetTaskList                         = "%s/%s/_apis/wit/workitems?ids=%s&fields=%s&errorPolicy=omit&api-version=7.1-preview.3"
 	GetPullRequest                      = "%s/%s/_apis/git/pullrequests/%s?api-version=6.0"
+	GetPullRequests                     = "%s/%s/_apis/git/pullrequests?searchCriteria.status=active%s&$top=%d&api-version=7.1-preview.1"
+	GetRepositoryPullRequests           = "%s/%s/_apis/git/repositories/%s/pullrequests?searchCriteria.status=active%s&$top=%d&api-version=7.1-preview.1"
+	UpdatePullRequestReviewer           = "%s/%s/_apis/git/repositories/%s/pullrequests/%d/reviewers/%s?api-version=7.1-preview.1"
+	UpdatePullRequest                   = "%s/%s/_apis/git/repositories/%s/pullrequests/%d?api-version=7.1-preview.1"
+	CreatePullRequest                   = "%s/%s/_apis/git/repositories/%s/pullrequests?api-version=7.1-preview.1"
+	GetCommitDiffs                      = "%s/%s/_apis/git/repositories/%s/diffs/commits?baseVersion=%s&baseVersionType=commit&targetVersion=%s&targetVersionType=commit&api-version=7.1-preview.1"
+	GetPolicyEvaluations                = "%s/%s/_apis/policy/evaluations?artifactId=%s&api-version=7.1-preview.1"
+	AddPullRequestThreadComment         = "%s/%s/_apis/git/repositories/%s/pullrequests/%d/threads/%d/comments?api-version=7.1-preview.1"
+	UpdatePullRequestThread             = "%s/%s/_apis/git/repositories/%s/pullrequests/%d/threads/%d?api-version=7.1-preview.1"
 	GetBuildDetails                     = "%s/%s/_apis/build/builds/%s?api-version=6.0"
+	UpdateBuild                         = "%s/%s/_apis/build/builds/%d?api-version=7.1-preview.7"
+	RetryBuild                          = "%s/%s/_apis/build/builds/%d?retry=true&api-version=7.1-preview.7"
+	UpdateBuildStage                    = "%s/%s/_apis/build/builds/%d/stages/%s?api-version=7.1-preview.1"
+	GetBuildTimeline                    = "%s/%s/_apis/build/builds/%d/timeline?api-version=7.1-preview.2"
+	GetBuildLogs                        = "%s/%s/_apis/build/builds/%d/logs?api-version=7.1-preview.2"
+	GetBuildLogLines                    = "%s/%s/_apis/build/builds/%d/logs/%d?startLine=%d&api-version=7.1-preview.2"
+	GetTestRuns                         = "%s/%s/_apis/test/runs?buildUri=%s&includeRunDetails=true&api-version=7.1-preview.3"
+	GetFailedTestResults                = "%s/%s/_apis/test/Runs/%d/results?outcomes=Failed&$top=%d&api-version=7.1-preview.6"
 	GetReleaseDetails                   = "%s/%s/_apis/release/releases/%s?api-version=6.0"
+	GetIdentityWithMembers              = "%s/_apis/identities?identityIds=%s&queryMembership=expandedDown&api-version=7.1-preview.1"
+	GetIdentitiesByDescriptors          = "%s/_apis/identities?descriptors=%s&api-version=7.1-preview.1"
+	SearchIdentities                    = "%s/_apis/identities?searchFilter=General&filterValue=%s&queryMembership=None&api-version=7.1-preview.1"
 	GetGitRepositories                  = "%s/%s/_apis/git/repositories?api-version=6.0"
-	GetGitRepositoryBranches            = "%s/%s/_apis/git/repositories/%s/refs?filter=heads"
+	GetGitRepositoryBranches            = "%s/%s/_apis/git/repositories/%s/refs?filter=heads&api-version=6.0"
+	CreateGitRefs                       = "%s/%s/_apis/git/repositories/%s/refs?api-version=6.0"
 	GetSubscriptionFilterPossibleValues = "%s/_apis/hooks/inputValuesQuery?api-version=6.0"
+	GetWorkItemTypes                    = "%s/%s/_apis/wit/workitemtypes?api-version=7.1-preview.2"
+	GetWorkItemTypeFields               = "%s/%s/_apis/wit/workitemtypes/%s/fields?$expand=allowedValues&api-version=7.1-preview.3"
+	GetWorkItemFields                   = "%s/%s/_apis/wit/fields?api-version=7.1-preview.3"
+	UpdateWorkItem                      = "%s/%s/_apis/wit/workitems/%s?api-version=7.1-preview.3"
+	UploadAttachment                    = "%s/%s/_apis/wit/attachments?fileName=%s&uploadType=%s&api-version=7.1-preview.3"
+	UploadAttachmentChunk               = "%s/%s/_apis/wit/attachments/%s?fileName=%s&uploadType=Chunked&api-version=7.1-preview.3"
+	AddWorkItemComment                  = "%s/%s/_apis/wit/workItems/%s/comments?api-version=7.1-preview.3"
+	GetWorkItemTemplates                = "%s/%s/%s/_apis/wit/templates?api-version=7.1-preview.1"
+	GetWorkItemTemplate                 = "%s/%s/%s/_apis/wit/templates/%s?api-version=7.1-preview.1"
+	GetCurrentIteration                 = "%s/%s/%s/_apis/work/teamsettings/iterations?$timeframe=current&api-version=7.1-preview.1"
+	GetIterationWorkItems               = "%s/%s/%s/_apis/work/teamsettings/iterations/%s/workitems?api-version=7.1-preview.1"
+	GetIterationCapacities              = "%s/%s/%s/_apis/work/teamsettings/iterations/%s/capacities?api-version=7.1-preview.3"
+	GetTeamSettings                     = "%s/%s/%s/_apis/work/teamsettings?api-version=7.1-preview.1"
 	PipelineApproveRequest              = "%s/%s/_apis/release/approvals/%d?api-version=6.0"
 	PipelineRunApproveDetails           = "/%s/%s/_apis/pipelines/approvals/%s?$expand=steps&api-version=7.0-preview.1"
 	PipelineRunApproveRequest           = "%s/%s/_apis/pipelines/approvals?api-version=7.0-preview.1"
+	GetCheckConfiguration               = "%s/%s/_apis/pipelines/checks/configurations/%d?api-version=7.1-preview.1"
+	GetPipelines                        = "%s/%s/_apis/pipelines?api-version=7.1-preview.1"
+	PreviewPipelineRun                  = "%s/%s/_apis/pipelines/%d/preview?api-version=7.1-preview.1"
+	RunPipeline                         = "%s/%s/_apis/pipelines/%d/runs?api-version=7.1-preview.1"
 	GetProject                          = "/%s/_apis/projects/%s?api-version=7.1-preview.4"
 	CreateSubscription                  = "/%s/_apis/hooks/subscriptions?api-version=6.0"
 	DeleteSubscription                  = "/%s/_apis/hooks/subscriptions/%s?api-version=6.0"
+
+	// Azure web paths
+	WorkItemWebURL    = "%s/%s/%s/_workitems/edit/%d"
+	PullRequestWebURL = "%s/%s/%s/_git/%s/pullrequest/%d"
+
+	// Artifact ID of a pull request used to fetch its policy evaluations
+	PullRequestArtifactID = "vstfs:///CodeReview/CodeReviewId/%s/%d"
 )
@@ -3,17 +3,60 @@ package constants
 import "time"
 
 const (
-	AtomicRetryLimit                     = 5
-	AtomicRetryWait                      = 30 * time.Millisecond
-	TTLSecondsForOAuthState        int64 = 60
-	TokenExpiryTimeBufferInMinutes       = 5
-	UsersPerPage                         = 100
+	AtomicRetryLimit                            = 5
+	AtomicRetryWait                             = 30 * time.Millisecond
+	TTLSecondsForOAuthState               int64 = 60
+	TokenExpiryTimeBufferInMinutes              = 5
+	TTLSecondsForPersonalNotificationSent int64 = 24 * 60 * 60
+	TTLSecondsForPullRequestReviewers     int64 = 90 * 24 * 60 * 60
+	IdentityCacheTTL                            = 10 * time.Minute
+	TTLSecondsForReviewReminderSent       int64 = 2 * 24 * 60 * 60
+	ReviewReminderJobInterval                   = time.Minute
+	ReviewReminderJobKey                        = "review_reminder_job"
+	// Reminders are still sent if the job runs late e.g. while the plugin is restarting
+	ReviewReminderWindow = 15 * time.Minute
+	UsersPerPage         = 100
+	// Approvals of the approval checks of Azure DevOps expire after 30 days by default
+	DefaultApprovalTimeout = 30 * 24 * time.Hour
+	// The approvals are fetched from Azure DevOps on each run of the job so it runs less often than the review reminder job
+	PipelineApprovalJobInterval             = 5 * time.Minute
+	PipelineApprovalJobKey                  = "pipeline_approval_job"
+	TTLSecondsForApprovalReminderSent int64 = 2 * 24 * 60 * 60
+	// Approvals are no longer synced once they are expired for longer than this e.g. if they are deleted along with their runs
+	PipelineApprovalTrackingGracePeriod = 24 * time.Hour
+	// The posts of an approval are kept for as long as the approval can be pending
+	TTLSecondsForApprovalPosts int64 = 90 * 24 * 60 * 60
+	// The notifications of the comments mirrored to the pull request threads are delivered soon after the comments are added
+	TTLSecondsForMirroredPullRequestComment int64 = 24 * 60 * 60
 
 	// KV store prefix keys
-	OAuthPrefix           = "oAuth_%s"
-	ProjectKey            = "%s_%s"
-	ProjectPrefix         = "project_list"
-	SubscriptionPrefix    = "subscription_list"
-	UserIDPrefix          = "oAuth"
-	AzureDevOpsUserPrefix = "azd_userID_%s"
+	OAuthPrefix                        = "oAuth_%s"
+	ProjectKey                         = "%s_%s"
+	ProjectPrefix                      = "project_list"
+	SubscriptionPrefix                 = "subscription_list"
+	UserIDPrefix                       = "oAuth"
+	AzureDevOpsUserPrefix              = "azd_userID_%s"
+	PresetPrefix                       = "preset_list_%s"
+	ThreadLinkPrefix                   = "thread_link_%s"
+	WorkItemThreadsPrefix              = "workitem_threads_%s"
+	WorkItemKey                        = "%s_%s"
+	PullRequestThreadPrefix            = "pr_thread_%s"
+	PullRequestThreadKey               = "%s_%d_%d"
+	MirroredPullRequestCommentPrefix   = "pr_mirrored_%s"
+	MirroredPullRequestCommentKey      = "%s_%d_%d_%d"
+	PersonalNotificationSettingsPrefix = "personal_notifications_%s"
+	PersonalNotificationSentPrefix     = "pn_sent_%s"
+	PersonalNotificationSentKey        = "%s_%s_%s"
+	PullRequestReviewersPrefix         = "pr_reviewers_%s"
+	PullRequestReviewersKey            = "%s_%d"
+	AzureDevOpsUserEmailPrefix         = "azd_email_%s"
+	IdentityOverridesKey               = "identity_overrides"
+	ReviewRemindersKey                 = "review_reminders"
+	ReviewReminderSentPrefix           = "rr_sent_%s"
+	ReviewReminderSentKey              = "%s_%s"
+	PipelineApprovalsKey               = "pipeline_approvals"
+	ApprovalReminderSentPrefix         = "ar_sent_%s"
+	ApprovalReminderSentKey            = "%s_%d"
+	ApprovalPostsPrefix                = "approval_posts_%s"
+	ApprovalPostsKey                   = "%s_%s_%s"
 )
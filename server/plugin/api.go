@@ -54,7 +54,26 @@ func (p *Plugin) InitRoutes() {
 	s.HandleFunc(constants.PathPipelineReleaseRequest, p.handleAuthRequired(p.checkOAuth(p.handlePipelineApproveOrRejectReleaseRequest))).Methods(http.MethodPost)
 	s.HandleFunc(constants.PathPipelineRunRequest, p.handleAuthRequired(p.checkOAuth(p.handlePipelineApproveOrRejectRunRequest))).Methods(http.MethodPost)
 	s.HandleFunc(constants.PathPipelineCommentModal, p.handleAuthRequired(p.checkOAuth(p.handlePipelineCommentModal))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathPullRequestVote, p.handleAuthRequired(p.checkOAuth(p.handlePullRequestVote))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathPullRequestAction, p.handleAuthRequired(p.checkOAuth(p.handlePullRequestAction))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathPullRequestCompletionDialog, p.handleAuthRequired(p.checkOAuth(p.handlePullRequestCompletionDialog))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathCreatePullRequestDialog, p.handleAuthRequired(p.checkOAuth(p.handleCreatePullRequestDialog))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathRunPipelineDialog, p.handleAuthRequired(p.checkOAuth(p.handleRunPipelineDialog))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathPipelineRunAction, p.handleAuthRequired(p.checkOAuth(p.handlePipelineRunAction))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathRerunStageDialog, p.handleAuthRequired(p.checkOAuth(p.handleRerunStageDialog))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathBuildLogTail, p.handleAuthRequired(p.checkOAuth(p.handleShowBuildLogTail))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathPullRequestThreadStatus, p.handleAuthRequired(p.checkOAuth(p.handlePullRequestThreadStatus))).Methods(http.MethodPost)
 	s.HandleFunc(constants.PathGetSubscriptionFilterPossibleValues, p.handleAuthRequired(p.checkOAuth(p.handleGetSubscriptionFilterPossibleValues))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathGetWorkItemTypes, p.handleAuthRequired(p.checkOAuth(p.handleGetWorkItemTypes))).Methods(http.MethodGet)
+	s.HandleFunc(constants.PathGetWorkItemTypeFields, p.handleAuthRequired(p.checkOAuth(p.handleGetWorkItemTypeFields))).Methods(http.MethodGet)
+	s.HandleFunc(constants.PathLinkThread, p.handleAuthRequired(p.checkOAuth(p.handleLinkThread))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathLinkThreadDialog, p.handleAuthRequired(p.checkOAuth(p.handleLinkThreadDialog))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathUnlinkThread, p.handleAuthRequired(p.checkOAuth(p.handleUnlinkThread))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathGetSprintSummary, p.handleAuthRequired(p.checkOAuth(p.handleGetSprintSummary))).Methods(http.MethodGet)
+	s.HandleFunc(constants.PathAttachFiles, p.handleAuthRequired(p.checkOAuth(p.handleAttachFiles))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathAttachFilesDialog, p.handleAuthRequired(p.checkOAuth(p.handleAttachFilesDialog))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathSubscriptionWorkItemFilters, p.handleAuthRequired(p.checkOAuth(p.handleUpdateWorkItemFilters))).Methods(http.MethodPost)
+	s.HandleFunc(constants.PathGetPullRequestCounts, p.handleAuthRequired(p.checkOAuth(p.handleGetPullRequestCounts))).Methods(http.MethodGet)
 }
 
 // API to create task of a project in an organization.
@@ -73,7 +92,7 @@ func (p *Plugin) handleCreateTask(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	task, statusCode, err := p.Client.CreateTask(body, mattermostUserID)
+	task, statusCode, err := p.CreateWorkItem(body, mattermostUserID)
 	if err != nil {
 		p.API.LogError(constants.ErrorCreateTask)
 		p.handleError(w, r, &serializers.Error{Code: statusCode, Message: err.Error()})
@@ -81,7 +100,7 @@ func (p *Plugin) handleCreateTask(w http.ResponseWriter, r *http.Request) {
 	}
 
 	p.writeJSON(w, task)
-	message := fmt.Sprintf(constants.CreatedTask, task.ID, task.Fields.Title, task.Link.HTML.Href, task.Fields.Type, task.Fields.CreatedBy.DisplayName)
+	message := fmt.Sprintf(constants.CreatedTask, task.ID, task.Fields.Title, task.Link.HTML.Href, task.Fields.Type, p.getAzureIdentityMention(task.Fields.CreatedBy.GetIdentity()))
 
 	// Send message to DM.
 	if _, DMErr := p.DM(mattermostUserID, message, true); DMErr != nil {
@@ -89,6 +108,248 @@ func (p *Plugin) handleCreateTask(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// API to get the work item types of a project.
+func (p *Plugin) handleGetWorkItemTypes(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+	pathParams := mux.Vars(r)
+
+	workItemTypes, statusCode, err := p.Client.GetWorkItemTypes(pathParams[constants.PathParamOrganization], pathParams[constants.PathParamProject], mattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchWorkItemTypes, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: statusCode, Message: err.Error()})
+		return
+	}
+
+	enabledWorkItemTypes := []*serializers.WorkItemType{}
+	for _, workItemType := range workItemTypes.Value {
+		if !workItemType.IsDisabled {
+			enabledWorkItemTypes = append(enabledWorkItemTypes, workItemType)
+		}
+	}
+
+	p.writeJSON(w, enabledWorkItemTypes)
+}
+
+// API to get the field definitions of a work item type.
+func (p *Plugin) handleGetWorkItemTypeFields(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+	pathParams := mux.Vars(r)
+
+	fieldDefinitions, statusCode, err := p.GetWorkItemFieldDefinitions(pathParams[constants.PathParamOrganization], pathParams[constants.PathParamProject], pathParams[constants.PathParamWorkItemType], mattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchWorkItemFields, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: statusCode, Message: err.Error()})
+		return
+	}
+
+	p.writeJSON(w, fieldDefinitions)
+}
+
+// API to link a Mattermost thread to a work item.
+func (p *Plugin) handleLinkThread(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+
+	body, err := serializers.LinkThreadRequestPayloadFromJSON(r.Body)
+	if err != nil {
+		p.API.LogError(constants.ErrorDecodingBody, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	if validationErr := body.IsValid(); validationErr != nil {
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: validationErr.Error()})
+		return
+	}
+
+	threadLink, statusCode, err := p.LinkThreadToWorkItem(mattermostUserID, body.PostID, body.Organization, body.Project, body.WorkItemID, body.MirrorReplies)
+	if err != nil {
+		p.API.LogError(constants.ErrorLinkThread, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: statusCode, Message: err.Error()})
+		return
+	}
+
+	p.writeJSON(w, threadLink)
+}
+
+// API to handle the submission of the dialog opened from the message action to link the thread of a post to a work item.
+// The ID of the post is sent as the callback ID of the dialog, the link is confirmed by a reply in the thread.
+func (p *Plugin) handleLinkThreadDialog(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+
+	submitRequest := &model.SubmitDialogRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&submitRequest); err != nil {
+		p.API.LogError(constants.ErrorDecodingBody, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	workItemLink, _ := submitRequest.Submission[constants.DialogFieldNameWorkItemLink].(string)
+	taskData, _, isValid := IsLinkPresent(workItemLink, constants.TaskLinkRegex)
+	if !isValid {
+		p.writeJSON(w, &model.SubmitDialogResponse{
+			Errors: map[string]string{constants.DialogFieldNameWorkItemLink: constants.WorkItemLinkRequired},
+		})
+		return
+	}
+
+	mirrorReplies, _ := submitRequest.Submission[constants.DialogFieldNameMirrorReplies].(bool)
+	if _, statusCode, err := p.LinkThreadToWorkItem(mattermostUserID, submitRequest.CallbackId, taskData[3], taskData[4], taskData[7], mirrorReplies); err != nil {
+		p.API.LogError(constants.ErrorLinkThread, "Error", err.Error())
+		message := constants.GenericErrorMessage
+		if statusCode >= http.StatusBadRequest && statusCode < http.StatusInternalServerError {
+			message = err.Error()
+		}
+
+		p.writeJSON(w, &model.SubmitDialogResponse{Error: message})
+		return
+	}
+
+	returnStatusOK(w)
+}
+
+// API to unlink a Mattermost thread from its work item.
+func (p *Plugin) handleUnlinkThread(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+
+	body, err := serializers.LinkThreadRequestPayloadFromJSON(r.Body)
+	if err != nil {
+		p.API.LogError(constants.ErrorDecodingBody, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	if body.PostID == "" {
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: constants.PostIDRequired})
+		return
+	}
+
+	if _, statusCode, err := p.UnlinkThread(mattermostUserID, body.PostID); err != nil {
+		p.API.LogError(constants.ErrorUnlinkThread, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: statusCode, Message: err.Error()})
+		return
+	}
+
+	returnStatusOK(w)
+}
+
+// API to attach the files of a post to a work item.
+func (p *Plugin) handleAttachFiles(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+
+	body, err := serializers.AttachFilesRequestPayloadFromJSON(r.Body)
+	if err != nil {
+		p.API.LogError(constants.ErrorDecodingBody, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	if validationErr := body.IsValid(); validationErr != nil {
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: validationErr.Error()})
+		return
+	}
+
+	attachedFiles, _, statusCode, err := p.AttachPostFilesToWorkItem(mattermostUserID, body.PostID, body.Organization, body.Project, body.WorkItemID)
+	if err != nil {
+		p.API.LogError(constants.ErrorAttachFiles, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: statusCode, Message: err.Error()})
+		return
+	}
+
+	p.writeJSON(w, attachedFiles)
+}
+
+// API to handle the submission of the dialog opened from the message action to attach the files of a post to a work item.
+// The ID of the post is sent as the callback ID of the dialog.
+func (p *Plugin) handleAttachFilesDialog(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+
+	submitRequest := &model.SubmitDialogRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&submitRequest); err != nil {
+		p.API.LogError(constants.ErrorDecodingBody, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	workItemLink, _ := submitRequest.Submission[constants.DialogFieldNameWorkItemLink].(string)
+	taskData, _, isValid := IsLinkPresent(workItemLink, constants.TaskLinkRegex)
+	if !isValid {
+		p.writeJSON(w, &model.SubmitDialogResponse{
+			Errors: map[string]string{constants.DialogFieldNameWorkItemLink: constants.WorkItemLinkRequired},
+		})
+		return
+	}
+
+	workItemID := taskData[7]
+	attachedFiles, task, statusCode, err := p.AttachPostFilesToWorkItem(mattermostUserID, submitRequest.CallbackId, taskData[3], taskData[4], workItemID)
+	if err != nil {
+		p.API.LogError(constants.ErrorAttachFiles, "Error", err.Error())
+		message := constants.GenericErrorMessage
+		if statusCode == http.StatusBadRequest {
+			message = err.Error()
+		}
+
+		p.writeJSON(w, &model.SubmitDialogResponse{Error: message})
+		return
+	}
+
+	p.API.SendEphemeralPost(mattermostUserID, &model.Post{
+		UserId:    p.botUserID,
+		ChannelId: submitRequest.ChannelId,
+		Message:   getAttachedFilesMessage(attachedFiles, workItemID, task),
+	})
+
+	returnStatusOK(w)
+}
+
+// API to get the summary of the current iteration of a team.
+func (p *Plugin) handleGetSprintSummary(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+	pathParams := mux.Vars(r)
+	organization := pathParams[constants.PathParamOrganization]
+	project := pathParams[constants.PathParamProject]
+	team := r.URL.Query().Get(constants.QueryParamTeam)
+
+	staleDays := constants.DefaultStaleDays
+	if staleDaysParam := r.URL.Query().Get(constants.QueryParamStaleDays); staleDaysParam != "" {
+		var err error
+		if staleDays, err = strconv.Atoi(staleDaysParam); err != nil || staleDays <= 0 {
+			p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: constants.ErrorInvalidStaleDays})
+			return
+		}
+	}
+
+	summary, statusCode, err := p.GetSprintSummary(mattermostUserID, organization, project, team, staleDays)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchSprintSummary, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: statusCode, Message: err.Error()})
+		return
+	}
+
+	if summary == nil {
+		if team == "" {
+			team = fmt.Sprintf(constants.DefaultTeamNameFormat, project)
+		}
+		p.handleError(w, r, &serializers.Error{Code: http.StatusNotFound, Message: fmt.Sprintf(constants.NoCurrentIteration, team, project)})
+		return
+	}
+
+	p.writeJSON(w, summary)
+}
+
+// API to get the counts of the active pull requests of the user e.g. the ones waiting for the review of the user, across their linked projects.
+func (p *Plugin) handleGetPullRequestCounts(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+
+	counts, err := p.GetPullRequestCounts(mattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchPullRequests, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusInternalServerError, Message: err.Error()})
+		return
+	}
+
+	p.writeJSON(w, counts)
+}
+
 // API to link a project and an organization to a user.
 func (p *Plugin) handleLink(w http.ResponseWriter, r *http.Request) {
 	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
@@ -407,6 +668,7 @@ func (p *Plugin) handleCreateSubscription(w http.ResponseWriter, r *http.Request
 		RunStateID:                       body.RunStateID,
 		RunStateIDName:                   body.RunStateIDName,
 		RunResultID:                      body.RunResultID,
+		WorkItemFilters:                  body.WorkItemFilters,
 	}); storeErr != nil {
 		p.API.LogError("Error in creating a subscription", "Error", storeErr.Error())
 		p.handleError(w, r, &serializers.Error{Code: http.StatusInternalServerError, Message: storeErr.Error()})
@@ -415,6 +677,32 @@ func (p *Plugin) handleCreateSubscription(w http.ResponseWriter, r *http.Request
 	p.writeJSON(w, subscription)
 }
 
+// API to set the work item filters of a Boards subscription.
+func (p *Plugin) handleUpdateWorkItemFilters(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+
+	body, err := serializers.UpdateWorkItemFiltersRequestPayloadFromJSON(r.Body)
+	if err != nil {
+		p.API.LogError(constants.ErrorDecodingBody, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	if validationErr := body.IsValid(); validationErr != nil {
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: validationErr.Error()})
+		return
+	}
+
+	subscription, statusCode, err := p.UpdateWorkItemFilters(mattermostUserID, body.SubscriptionID, body.WorkItemFilters)
+	if err != nil {
+		p.API.LogError(constants.ErrorUpdateWorkItemFilters, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: statusCode, Message: err.Error()})
+		return
+	}
+
+	p.writeJSON(w, subscription)
+}
+
 func (p *Plugin) handleGetSubscriptions(w http.ResponseWriter, r *http.Request) {
 	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
 
@@ -538,10 +826,15 @@ func (p *Plugin) handleGetSubscriptions(w http.ResponseWriter, r *http.Request)
 func (p *Plugin) getReviewersListString(reviewersList []serializers.Reviewer) string {
 	reviewers := ""
 	for i := 0; i < len(reviewersList); i++ {
+		reviewer := p.getAzureIdentityMention(reviewersList[i].GetIdentity())
+		if voteEmoji, ok := constants.PullRequestVoteEmoji[reviewersList[i].Vote]; ok {
+			reviewer = fmt.Sprintf("%s %s", voteEmoji, reviewer)
+		}
+
 		if i != len(reviewersList)-1 {
-			reviewers += fmt.Sprintf("%s, ", reviewersList[i].DisplayName)
+			reviewers += fmt.Sprintf("%s, ", reviewer)
 		} else {
-			reviewers += reviewersList[i].DisplayName
+			reviewers += reviewer
 		}
 	}
 
@@ -589,7 +882,17 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 		return
 	}
 
+	p.SendPersonalNotifications(body)
+
+	if !p.ShouldPostWorkItemNotification(body) {
+		returnStatusOK(w)
+		return
+	}
+
 	var attachment *model.SlackAttachment
+	var rootID string
+	var pullRequestThread *serializers.PullRequestThreadReference
+	var pipelineApproval *serializers.PipelineApproval
 	switch body.EventType {
 	case constants.SubscriptionEventWorkItemCreated, constants.SubscriptionEventWorkItemDeleted:
 		attachment = &model.SlackAttachment{
@@ -638,6 +941,7 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 			Color:      constants.IconColorBoards,
 			Pretext:    body.Message.Markdown,
 			Title:      body.Resource.Revision.Fields.Title.(string),
+			Text:       p.getWorkItemFieldChanges(body.Resource.Fields.Changes),
 			Fields: []*model.SlackAttachmentField{
 				{
 					Title: "Area Path",
@@ -687,13 +991,17 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 					Short: true,
 				},
 				{
-					Title: "Reviewer(s)",
+					Title: constants.PullRequestReviewersFieldTitle,
 					Value: reviewers,
 				},
 			},
 			Footer:     body.Resource.Repository.Name,
 			FooterIcon: fmt.Sprintf(constants.PublicFiles, p.GetSiteURL(), constants.PluginID, constants.FileNameProjectIcon),
 		}
+
+		if urlPaths := strings.Split(body.Resource.URL, "/"); len(urlPaths) >= 4 {
+			attachment.Actions = p.getPullRequestActions(urlPaths[3], body.Resource.Repository.Project.ID, body.Resource.Repository.ID, body.Resource.PullRequestID, body.Resource.Status)
+		}
 	case constants.SubscriptionEventPullRequestCommented:
 		reviewers := p.getReviewersListString(body.Resource.PullRequest.Reviewers)
 
@@ -706,22 +1014,13 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 			sourceBranchName = strings.Split(body.Resource.PullRequest.SourceRefName, "/")[2]
 		}
 
-		// Convert map to json string
-		jsonBytes, err := json.Marshal(body.Resource.Comment)
+		comment, err := body.Resource.GetComment()
 		if err != nil {
 			p.API.LogError(err.Error())
 			p.handleError(w, r, &serializers.Error{Code: http.StatusInternalServerError, Message: err.Error()})
 			return
 		}
 
-		// Convert json string to struct
-		var comment *serializers.Comment
-		if err := json.Unmarshal(jsonBytes, &comment); err != nil {
-			p.API.LogError(err.Error())
-			p.handleError(w, r, &serializers.Error{Code: http.StatusInternalServerError, Message: err.Error()})
-			return
-		}
-
 		attachment = &model.SlackAttachment{
 			Pretext:    body.Message.Markdown,
 			AuthorName: constants.SlackAttachmentAuthorNameRepos,
@@ -751,26 +1050,30 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 			Footer:     body.Resource.PullRequest.Repository.Name,
 			FooterIcon: fmt.Sprintf(constants.PublicFiles, p.GetSiteURL(), constants.PluginID, constants.FileNameProjectIcon),
 		}
-	case constants.SubscriptionEventCodePushed:
-		commits := ""
-		for i := 0; i < len(body.Resource.Commits); i++ {
-			commits += fmt.Sprintf("\n[%s](%s): **%s**", body.Resource.Commits[i].CommitID[0:8], body.Resource.Commits[i].URL, body.Resource.Commits[i].Comment)
-		}
 
-		if commits == "" {
-			commits = "None" // When no commits are present
-		}
-
-		attachment = &model.SlackAttachment{
-			Pretext:    body.Message.Markdown,
-			AuthorName: constants.SlackAttachmentAuthorNameRepos,
-			AuthorIcon: fmt.Sprintf(constants.PublicFiles, p.GetSiteURL(), constants.PluginID, constants.FileNameReposIcon),
-			Color:      constants.IconColorRepos,
-			Title:      "Commit(s)",
-			Text:       commits,
-			Footer:     fmt.Sprintf("%s | %s", strings.Split(body.Resource.RefUpdates[0].Name, "/")[2], body.Resource.Repository.Name),
-			FooterIcon: fmt.Sprintf(constants.PublicFiles, p.GetSiteURL(), constants.PluginID, constants.FileNameGitBranchIcon),
+		if thread := getPullRequestThreadForComment(body.Resource.PullRequest, comment); thread != nil {
+			rootID = p.GetPullRequestThreadRootPost(thread, channelID)
+			switch {
+			case rootID != "" && p.isMirroredPullRequestComment(thread):
+				// Replies mirrored from the thread are already present in the thread
+				returnStatusOK(w)
+				return
+			case rootID != "":
+				// Later comments in the pull request thread are posted as replies to the notification of the thread
+				attachment = &model.SlackAttachment{
+					Pretext:    body.Message.Markdown,
+					AuthorName: constants.SlackAttachmentAuthorNameRepos,
+					AuthorIcon: fmt.Sprintf(constants.PublicFiles, p.GetSiteURL(), constants.PluginID, constants.FileNameReposIcon),
+					Color:      constants.IconColorRepos,
+					Text:       comment.Content,
+				}
+			default:
+				attachment.Actions = p.getPullRequestThreadActions(thread, constants.PullRequestThreadStatusActive)
+				pullRequestThread = thread
+			}
 		}
+	case constants.SubscriptionEventCodePushed:
+		attachment = p.GetCodePushAttachment(body)
 	case constants.SubscriptionEventBuildCompleted:
 		startTime, err := time.Parse(constants.DateTimeLayout, strings.Split(body.Resource.StartTime, ".")[0])
 		if err != nil {
@@ -804,7 +1107,7 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 				},
 				{
 					Title: "Requested for",
-					Value: body.Resource.RequestedFor.Name,
+					Value: p.getAzureIdentityMention(body.Resource.RequestedFor.GetIdentity()),
 					Short: true,
 				},
 				{
@@ -816,6 +1119,15 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 			Footer:     body.Resource.Project.Name,
 			FooterIcon: fmt.Sprintf(constants.PublicFiles, p.GetSiteURL(), constants.PluginID, constants.FileNameProjectIcon),
 		}
+
+		buildID, _ := body.Resource.ID.(float64)
+		if urlPaths := strings.Split(body.Resource.URL, "/"); len(urlPaths) >= 4 {
+			attachment.Actions = p.getPipelineRunActions(urlPaths[3], body.Resource.Project.ID, int(buildID), body.Resource.Status, body.Resource.Result, "")
+		}
+
+		if body.Resource.Result == constants.BuildResultFailed || body.Resource.Result == constants.BuildResultPartiallySucceeded {
+			p.AddBuildFailureDetails(attachment, body.SubscriptionID, int(buildID))
+		}
 	case constants.SubscriptionEventReleaseCreated:
 		artifacts := ""
 		for i := 0; i < len(body.Resource.Release.Artifacts); i++ {
@@ -842,7 +1154,7 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 				},
 				{
 					Title: "Created by",
-					Value: body.Resource.Release.CreatedBy.DisplayName,
+					Value: p.getAzureIdentityMention(body.Resource.Release.CreatedBy.GetIdentity()),
 					Short: true,
 				},
 				{
@@ -880,7 +1192,7 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 				},
 				{
 					Title: "Abandoned by",
-					Value: body.Resource.Release.ModifiedBy.DisplayName,
+					Value: p.getAzureIdentityMention(body.Resource.Release.ModifiedBy.GetIdentity()),
 					Short: true,
 				},
 				{
@@ -956,7 +1268,17 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 				},
 			},
 		}
+
+		organization, project, buildID := getBuildFromWebLink(body.Resource.Stage.Links.Web.Href)
+		attachment.Actions = p.getPipelineRunActions(organization, project, buildID, body.Resource.Stage.State, body.Resource.Stage.Result, body.Resource.Stage.Name)
 	case constants.SubscriptionEventRunStageWaitingForApproval:
+		// The countdown of the post is the same as the one of the tracked approval, the timeout setting is only used if the approval is not tracked
+		now := time.Now()
+		expiry := fmt.Sprintf(constants.ApprovalExpiresIn, formatDuration(p.getConfiguration().GetApprovalTimeout()))
+		if pipelineApproval = p.NewPipelineApproval(body, channelID, now); pipelineApproval != nil {
+			expiry = getPipelineRunApprovalExpiry(pipelineApproval.ExpiresAt, now)
+		}
+
 		organization := ""
 		webLinkPaths := strings.Split(body.Resource.Pipeline.Links.Web.Href, "/")
 		if len(webLinkPaths) >= 4 {
@@ -972,7 +1294,7 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 
 		approvers := ""
 		for _, approvalStep := range body.Resource.Approval.Steps {
-			approvers += approvalStep.AssignedApprover.DisplayName + "\n"
+			approvers += p.getAzureIdentityMention(approvalStep.AssignedApprover.GetIdentity()) + "\n"
 		}
 
 		attachment = &model.SlackAttachment{
@@ -995,6 +1317,11 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 					Title: approverTitle,
 					Value: approvers,
 				},
+				{
+					Title: constants.ApprovalExpiresFieldTitle,
+					Value: expiry,
+					Short: true,
+				},
 			},
 			Actions: []*model.PostAction{
 				{
@@ -1067,7 +1394,7 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 				},
 				{
 					Title: "Approver(s)",
-					Value: body.Resource.Approval.Approver.DisplayName,
+					Value: p.getAzureIdentityMention(body.Resource.Approval.Approver.GetIdentity()),
 				},
 			},
 			Actions: []*model.PostAction{
@@ -1135,6 +1462,9 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 				},
 			},
 		}
+
+		organization, project, buildID := getBuildFromWebLink(body.Resource.Run.Links.Web.Href)
+		attachment.Actions = p.getPipelineRunActions(organization, project, buildID, body.Resource.Run.State, body.Resource.Run.Result, "")
 	case constants.SubscriptionEventRunStageApprovalCompleted:
 		attachment = &model.SlackAttachment{
 			Pretext:    body.Message.Markdown,
@@ -1153,14 +1483,56 @@ func (p *Plugin) handleSubscriptionNotifications(w http.ResponseWriter, r *http.
 		}
 	}
 
+	if body.EventType == constants.SubscriptionEventWorkItemCommented {
+		var isMirroredComment bool
+		rootID, isMirroredComment = p.GetLinkedThreadForWorkItemComment(body, channelID)
+		// Comments mirrored from the linked thread are already present in the thread
+		if isMirroredComment {
+			returnStatusOK(w)
+			return
+		}
+	}
+
 	post := &model.Post{
 		UserId:    p.botUserID,
 		ChannelId: channelID,
+		RootId:    rootID,
+		Message:   p.NotifyFailedBuildRequester(body, channelID),
+	}
+
+	// Replies in the thread of the notification of a pull request comment are added to the pull request thread
+	if pullRequestThread != nil {
+		setPullRequestThreadProps(post, pullRequestThread)
 	}
 
 	model.ParseSlackAttachment(post, []*model.SlackAttachment{attachment})
-	if _, err := p.API.CreatePost(post); err != nil {
-		p.API.LogError("Error in creating post", "Error", err.Error())
+	createdPost, appErr := p.API.CreatePost(post)
+	if appErr != nil {
+		p.API.LogError("Error in creating post", "Error", appErr.Error())
+		returnStatusOK(w)
+		return
+	}
+
+	if pullRequestThread != nil {
+		if err := p.Store.StorePullRequestThreadPost(pullRequestThread.Organization, pullRequestThread.PullRequestID, pullRequestThread.ThreadID, channelID, createdPost.Id); err != nil {
+			p.API.LogError(constants.ErrorStorePullRequestThreadPost, "Error", err.Error())
+		}
+	}
+
+	switch body.EventType {
+	case constants.SubscriptionEventRunStageWaitingForApproval:
+		if pipelineApproval != nil {
+			p.TrackPipelineApproval(pipelineApproval, createdPost.Id)
+		}
+
+		p.SendApprovalCards(body, attachment, createdPost.Id)
+	case constants.SubscriptionEventReleaseDeploymentEventPending:
+		p.SendApprovalCards(body, attachment, createdPost.Id)
+	case constants.SubscriptionEventRunStageApprovalCompleted:
+		// Approvals completed from Azure DevOps are synced to their posts
+		if approvalID, ok := body.Resource.Approval.ID.(string); ok {
+			p.SyncPipelineApprovalPosts(approvalID, time.Now())
+		}
 	}
 
 	returnStatusOK(w)
@@ -1448,6 +1820,8 @@ func (p *Plugin) handlePipelineApproveOrRejectReleaseRequest(w http.ResponseWrit
 			p.handleError(w, r, &serializers.Error{Code: http.StatusInternalServerError, Message: err.Error()})
 			return
 		}
+
+		p.updateReleaseApprovalPosts(organization, approvalID, requestType, submitRequest.CallbackId)
 	case http.StatusBadRequest:
 		pipelineApprovalDetails, statusCode, err := p.Client.GetApprovalDetails(organization, projectName, mattermostUserID, int(approvalID))
 		if err != nil {
@@ -1462,6 +1836,8 @@ func (p *Plugin) handlePipelineApproveOrRejectReleaseRequest(w http.ResponseWrit
 			return
 		}
 
+		p.updateReleaseApprovalPosts(organization, approvalID, pipelineApprovalDetails.Status, submitRequest.CallbackId)
+
 		alreadyUpdatedInformationPost := &model.Post{
 			UserId:    p.botUserID,
 			ChannelId: submitRequest.ChannelId,
@@ -1524,12 +1900,17 @@ func (p *Plugin) handlePipelineApproveOrRejectRunRequest(w http.ResponseWriter,
 	pipelineRunApproveResponse, statusCode, updatePipelineApprovalRequestErr := p.Client.UpdatePipelineRunApprovalRequest(pipelineApproveRequestPayload, organization, projectID, mattermostUserID)
 	switch statusCode {
 	case http.StatusOK:
-		if err := p.UpdatePipelineRunApprovalPost(pipelineRunApproveResponse.Value[0].ApprovalSteps, pipelineRunApproveResponse.Value[0].MinRequiredApprovers, pipelineRunApproveResponse.Value[0].Status, submitRequest.CallbackId, mattermostUserID); err != nil {
+		approvalResponse := pipelineRunApproveResponse.Value[0]
+		if err := p.UpdatePipelineRunApprovalPost(approvalResponse.ApprovalSteps, approvalResponse.MinRequiredApprovers, approvalResponse.Status, submitRequest.CallbackId, mattermostUserID); err != nil {
 			p.handlePipelineApprovalRequestUpdateError(constants.GenericErrorMessage, mattermostUserID, err)
 			p.handleError(w, r, &serializers.Error{Code: http.StatusInternalServerError, Message: err.Error()})
 			return
 		}
 
+		p.UpdateApprovalPosts(constants.PipelineRequestNameRun, organization, approvalID, submitRequest.CallbackId, func(slackAttachment *model.SlackAttachment) {
+			p.setPipelineRunApprovalStatus(slackAttachment, approvalResponse.ApprovalSteps, approvalResponse.MinRequiredApprovers, approvalResponse.Status)
+		})
+
 	case http.StatusInternalServerError, http.StatusConflict:
 		if strings.Contains(updatePipelineApprovalRequestErr.Error(), "not permitted to complete approval") || strings.Contains(updatePipelineApprovalRequestErr.Error(), "Approval is already in completed state.") {
 			pipelineApprovalDetails, getApprovalDetailsStatusCode, err := p.Client.GetRunApprovalDetails(organization, projectID, mattermostUserID, approvalID)
@@ -1545,6 +1926,10 @@ func (p *Plugin) handlePipelineApproveOrRejectRunRequest(w http.ResponseWriter,
 				return
 			}
 
+			p.UpdateApprovalPosts(constants.PipelineRequestNameRun, organization, approvalID, submitRequest.CallbackId, func(slackAttachment *model.SlackAttachment) {
+				p.setPipelineRunApprovalStatus(slackAttachment, pipelineApprovalDetails.ApprovalSteps, pipelineApprovalDetails.MinRequiredApprovers, pipelineApprovalDetails.Status)
+			})
+
 			alreadyUpdatedInformationPost := &model.Post{
 				UserId:    p.botUserID,
 				ChannelId: submitRequest.ChannelId,
@@ -1568,6 +1953,438 @@ func (p *Plugin) handlePipelineApproveOrRejectRunRequest(w http.ResponseWriter,
 	p.returnPostActionIntegrationResponse(w, response)
 }
 
+func (p *Plugin) handlePullRequestVote(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+	postActionIntegrationRequest := &model.PostActionIntegrationRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postActionIntegrationRequest); err != nil {
+		p.API.LogError("Error decoding PostActionIntegrationRequest param", "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	organization, _ := postActionIntegrationRequest.Context[constants.PullRequestContextOrganization].(string)
+	projectID, _ := postActionIntegrationRequest.Context[constants.PullRequestContextProjectID].(string)
+	repositoryID, _ := postActionIntegrationRequest.Context[constants.PullRequestContextRepositoryID].(string)
+	// Numbers in the context of a post action are decoded as float64
+	pullRequestID, _ := postActionIntegrationRequest.Context[constants.PullRequestContextPullRequestID].(float64)
+	vote, _ := postActionIntegrationRequest.Context[constants.PullRequestContextVote].(float64)
+	if _, ok := constants.PullRequestVoteNames[int(vote)]; !ok || organization == "" || projectID == "" || repositoryID == "" || pullRequestID == 0 {
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: constants.GenericErrorMessage})
+		return
+	}
+
+	reviewerID, err := p.Store.LoadAzureDevopsUserIDFromMattermostUser(mattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadingUserData, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusInternalServerError, Message: constants.GenericErrorMessage})
+		return
+	}
+
+	response := &model.PostActionIntegrationResponse{}
+	if _, statusCode, err := p.Client.UpdatePullRequestVote(organization, projectID, repositoryID, reviewerID, int(pullRequestID), int(vote), mattermostUserID); err != nil {
+		p.API.LogError(constants.ErrorUpdatePullRequestVote, "Error", err.Error())
+		response.EphemeralText = constants.GenericErrorMessage
+		if statusCode == http.StatusForbidden || statusCode == http.StatusUnauthorized {
+			response.EphemeralText = fmt.Sprintf(constants.PullRequestVoteNotPermitted, int(pullRequestID))
+		}
+		p.returnPostActionIntegrationResponse(w, response)
+		return
+	}
+
+	if err := p.UpdatePullRequestReviewersPost(organization, projectID, int(pullRequestID), postActionIntegrationRequest.PostId, mattermostUserID); err != nil {
+		p.API.LogError(constants.ErrorUpdatePullRequestReviewersPost, "Error", err.Error())
+	}
+
+	response.EphemeralText = fmt.Sprintf(constants.PullRequestVoted, constants.PullRequestVoteNames[int(vote)], int(pullRequestID))
+	p.returnPostActionIntegrationResponse(w, response)
+}
+
+func (p *Plugin) handlePullRequestThreadStatus(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+	postActionIntegrationRequest := &model.PostActionIntegrationRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postActionIntegrationRequest); err != nil {
+		p.API.LogError("Error decoding PostActionIntegrationRequest param", "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	organization, _ := postActionIntegrationRequest.Context[constants.PullRequestContextOrganization].(string)
+	projectID, _ := postActionIntegrationRequest.Context[constants.PullRequestContextProjectID].(string)
+	repositoryID, _ := postActionIntegrationRequest.Context[constants.PullRequestContextRepositoryID].(string)
+	pullRequestID, _ := postActionIntegrationRequest.Context[constants.PullRequestContextPullRequestID].(float64)
+	threadID, _ := postActionIntegrationRequest.Context[constants.PullRequestContextThreadID].(float64)
+	status, _ := postActionIntegrationRequest.Context[constants.PullRequestContextThreadStatus].(string)
+	if _, ok := constants.PullRequestThreadStatusNames[status]; !ok || organization == "" || projectID == "" || repositoryID == "" || pullRequestID == 0 || threadID == 0 {
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: constants.GenericErrorMessage})
+		return
+	}
+
+	response := &model.PostActionIntegrationResponse{}
+	thread, statusCode, err := p.Client.UpdatePullRequestThreadStatus(organization, projectID, repositoryID, int(pullRequestID), int(threadID), status, mattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorUpdatePullRequestThreadStatus, "Error", err.Error())
+		response.EphemeralText = constants.GenericErrorMessage
+		if statusCode == http.StatusForbidden || statusCode == http.StatusUnauthorized {
+			response.EphemeralText = fmt.Sprintf(constants.PullRequestThreadUpdateNotPermitted, int(pullRequestID))
+		}
+		p.returnPostActionIntegrationResponse(w, response)
+		return
+	}
+
+	if thread != nil && thread.Status != "" {
+		status = thread.Status
+	}
+
+	if err := p.UpdatePullRequestThreadPost(postActionIntegrationRequest.PostId, status); err != nil {
+		p.API.LogError("Error in updating the pull request thread post", "Error", err.Error())
+	}
+
+	response.EphemeralText = fmt.Sprintf(constants.PullRequestThreadStatusUpdated, int(pullRequestID), getPullRequestThreadStatusName(status))
+	p.returnPostActionIntegrationResponse(w, response)
+}
+
+func (p *Plugin) handlePullRequestAction(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+	postActionIntegrationRequest := &model.PostActionIntegrationRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postActionIntegrationRequest); err != nil {
+		p.API.LogError("Error decoding PostActionIntegrationRequest param", "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	organization, _ := postActionIntegrationRequest.Context[constants.PullRequestContextOrganization].(string)
+	projectID, _ := postActionIntegrationRequest.Context[constants.PullRequestContextProjectID].(string)
+	pullRequestID, _ := postActionIntegrationRequest.Context[constants.PullRequestContextPullRequestID].(float64)
+	// The action is selected from a menu for active pull requests and set by the button for abandoned ones
+	action, _ := postActionIntegrationRequest.Context[constants.PullRequestContextSelectedOption].(string)
+	if action == "" {
+		action, _ = postActionIntegrationRequest.Context[constants.PullRequestContextAction].(string)
+	}
+
+	if organization == "" || projectID == "" || pullRequestID == 0 || action == "" {
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: constants.GenericErrorMessage})
+		return
+	}
+
+	response := &model.PostActionIntegrationResponse{}
+	// The completion options are chosen in a dialog before completing the pull request or setting auto-complete
+	if action == constants.CommandComplete || action == constants.CommandAutoComplete {
+		dialogTitle := "Complete Pull Request"
+		if action == constants.CommandAutoComplete {
+			dialogTitle = "Set Auto-complete"
+		}
+
+		requestBody := model.OpenDialogRequest{
+			TriggerId: postActionIntegrationRequest.TriggerId,
+			URL:       fmt.Sprintf("%s%s", p.GetPluginURL(), constants.PathPullRequestCompletionDialog),
+			Dialog: model.Dialog{
+				Title:       dialogTitle,
+				CallbackId:  postActionIntegrationRequest.PostId,
+				SubmitLabel: "Submit",
+				Elements:    getPullRequestCompletionDialogElements(),
+				State:       fmt.Sprintf("%s$%s$%d$%s", organization, projectID, int(pullRequestID), action),
+			},
+		}
+
+		if _, err := p.Client.OpenDialogRequest(&requestBody, mattermostUserID); err != nil {
+			p.API.LogError("Error opening the pull request completion dialog", "Error", err.Error())
+			response.EphemeralText = constants.GenericErrorMessage
+		}
+
+		p.returnPostActionIntegrationResponse(w, response)
+		return
+	}
+
+	response.EphemeralText = p.updatePullRequestStatusFromPost(mattermostUserID, organization, projectID, int(pullRequestID), action, nil, postActionIntegrationRequest.PostId)
+	p.returnPostActionIntegrationResponse(w, response)
+}
+
+func (p *Plugin) handlePullRequestCompletionDialog(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+	submitRequest := &model.SubmitDialogRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&submitRequest); err != nil {
+		p.API.LogError(constants.ErrorDecodingBody, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	values := strings.Split(submitRequest.State, "$")
+	if len(values) != 4 {
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: constants.GenericErrorMessage})
+		return
+	}
+
+	pullRequestID, err := strconv.Atoi(values[2])
+	if err != nil {
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	options := &serializers.PullRequestCompletionOptions{}
+	options.MergeStrategy, _ = submitRequest.Submission[constants.DialogFieldNameMergeStrategy].(string)
+	options.DeleteSourceBranch, _ = submitRequest.Submission[constants.DialogFieldNameDeleteSourceBranch].(bool)
+	options.TransitionWorkItems, _ = submitRequest.Submission[constants.DialogFieldNameTransitionWorkItems].(bool)
+
+	p.API.SendEphemeralPost(mattermostUserID, &model.Post{
+		UserId:    p.botUserID,
+		ChannelId: submitRequest.ChannelId,
+		Message:   p.updatePullRequestStatusFromPost(mattermostUserID, values[0], values[1], pullRequestID, values[3], options, submitRequest.CallbackId),
+	})
+
+	returnStatusOK(w)
+}
+
+// API to handle the submission of the dialog opened from the "repos pr create" command to create a pull request.
+// The created pull request is posted in the channel of the command.
+func (p *Plugin) handleCreatePullRequestDialog(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+	submitRequest := &model.SubmitDialogRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&submitRequest); err != nil {
+		p.API.LogError(constants.ErrorDecodingBody, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	pullRequest, fieldErrors, statusCode, err := p.CreatePullRequestFromDialog(mattermostUserID, submitRequest.State, submitRequest.Submission)
+	if len(fieldErrors) > 0 {
+		p.writeJSON(w, &model.SubmitDialogResponse{Errors: fieldErrors})
+		return
+	}
+
+	if err != nil {
+		message := constants.GenericErrorMessage
+		switch statusCode {
+		case http.StatusBadRequest, http.StatusConflict:
+			message = err.Error()
+		case http.StatusForbidden, http.StatusUnauthorized:
+			message = constants.PullRequestCreateNotPermitted
+		default:
+			p.API.LogError(constants.ErrorCreatePullRequest, "Error", err.Error())
+		}
+
+		p.writeJSON(w, &model.SubmitDialogResponse{Error: message})
+		return
+	}
+
+	organization := strings.Split(submitRequest.State, "$")[0]
+	if err := p.PostCreatedPullRequest(mattermostUserID, submitRequest.ChannelId, organization, pullRequest); err != nil {
+		p.API.LogError(constants.ErrorCreatePullRequestPost, "Error", err.Error())
+	}
+
+	returnStatusOK(w)
+}
+
+// API to handle the submission of the dialog opened from the "pipelines run" command to queue a pipeline run.
+// The link of the queued run is posted in the channel of the command.
+func (p *Plugin) handleRunPipelineDialog(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+	submitRequest := &model.SubmitDialogRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&submitRequest); err != nil {
+		p.API.LogError(constants.ErrorDecodingBody, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	pipelineRun, fieldErrors, statusCode, err := p.RunPipelineFromDialog(mattermostUserID, submitRequest.State, submitRequest.Submission)
+	if len(fieldErrors) > 0 {
+		p.writeJSON(w, &model.SubmitDialogResponse{Errors: fieldErrors})
+		return
+	}
+
+	if err != nil {
+		message := constants.GenericErrorMessage
+		if statusCode == http.StatusForbidden {
+			message = constants.ErrorQueueAccess
+		} else {
+			p.API.LogError(constants.ErrorRunPipeline, "Error", err.Error())
+		}
+
+		p.writeJSON(w, &model.SubmitDialogResponse{Error: message})
+		return
+	}
+
+	if err := p.PostPipelineRun(mattermostUserID, submitRequest.ChannelId, pipelineRun); err != nil {
+		p.API.LogError(constants.ErrorCreatePipelineRunPost, "Error", err.Error())
+	}
+
+	returnStatusOK(w)
+}
+
+func (p *Plugin) handlePipelineRunAction(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+	postActionIntegrationRequest := &model.PostActionIntegrationRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postActionIntegrationRequest); err != nil {
+		p.API.LogError("Error decoding PostActionIntegrationRequest param", "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	organization, _ := postActionIntegrationRequest.Context[constants.PipelineRunContextOrganization].(string)
+	project, _ := postActionIntegrationRequest.Context[constants.PipelineRunContextProject].(string)
+	buildID, _ := postActionIntegrationRequest.Context[constants.PipelineRunContextBuildID].(float64)
+	action, _ := postActionIntegrationRequest.Context[constants.PipelineRunContextAction].(string)
+	stageName, _ := postActionIntegrationRequest.Context[constants.PipelineRunContextStage].(string)
+	if organization == "" || project == "" || buildID == 0 || action == "" {
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: constants.GenericErrorMessage})
+		return
+	}
+
+	response := &model.PostActionIntegrationResponse{}
+	// The stage to rerun is picked in a dialog when the post is about the whole run
+	if action == constants.PipelineRunActionRerunStage && stageName == "" {
+		response.EphemeralText = p.openRerunStageDialogFromPost(mattermostUserID, postActionIntegrationRequest.TriggerId, postActionIntegrationRequest.PostId, organization, project, int(buildID))
+		p.returnPostActionIntegrationResponse(w, response)
+		return
+	}
+
+	response.EphemeralText = p.updatePipelineRunFromPost(mattermostUserID, organization, project, int(buildID), action, stageName, postActionIntegrationRequest.PostId)
+	p.returnPostActionIntegrationResponse(w, response)
+}
+
+// openRerunStageDialogFromPost opens the dialog to pick the stage to rerun and returns the message for the user if it can not be opened
+func (p *Plugin) openRerunStageDialogFromPost(mattermostUserID, triggerID, postID, organization, project string, buildID int) string {
+	stages, statusCode, err := p.GetRerunnableStages(mattermostUserID, organization, project, buildID)
+	if err != nil {
+		p.API.LogError(constants.ErrorOpenRerunStageDialog, "Error", err.Error())
+		if statusCode == http.StatusForbidden || statusCode == http.StatusUnauthorized {
+			return fmt.Sprintf(constants.PipelineRunUpdateNotPermitted, buildID)
+		}
+		return constants.GenericErrorMessage
+	}
+
+	if len(stages) == 0 {
+		return fmt.Sprintf(constants.NoRerunnableStages, buildID)
+	}
+
+	if _, err := p.OpenRerunStageDialog(mattermostUserID, triggerID, postID, organization, project, buildID, stages); err != nil {
+		p.API.LogError(constants.ErrorOpenRerunStageDialog, "Error", err.Error())
+		return constants.GenericErrorMessage
+	}
+
+	return ""
+}
+
+func (p *Plugin) handleRerunStageDialog(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+	submitRequest := &model.SubmitDialogRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&submitRequest); err != nil {
+		p.API.LogError(constants.ErrorDecodingBody, "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	values := strings.Split(submitRequest.State, "$")
+	if len(values) != 3 {
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: constants.GenericErrorMessage})
+		return
+	}
+
+	buildID, err := strconv.Atoi(values[2])
+	if err != nil {
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	stageName, _ := submitRequest.Submission[constants.DialogFieldNameStage].(string)
+	p.API.SendEphemeralPost(mattermostUserID, &model.Post{
+		UserId:    p.botUserID,
+		ChannelId: submitRequest.ChannelId,
+		Message:   p.updatePipelineRunFromPost(mattermostUserID, values[0], values[1], buildID, constants.PipelineRunActionRerunStage, stageName, submitRequest.CallbackId),
+	})
+
+	returnStatusOK(w)
+}
+
+func (p *Plugin) handleShowBuildLogTail(w http.ResponseWriter, r *http.Request) {
+	mattermostUserID := r.Header.Get(constants.HeaderMattermostUserID)
+	postActionIntegrationRequest := &model.PostActionIntegrationRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postActionIntegrationRequest); err != nil {
+		p.API.LogError("Error decoding PostActionIntegrationRequest param", "Error", err.Error())
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+
+	organization, _ := postActionIntegrationRequest.Context[constants.PipelineRunContextOrganization].(string)
+	project, _ := postActionIntegrationRequest.Context[constants.PipelineRunContextProject].(string)
+	buildID, _ := postActionIntegrationRequest.Context[constants.PipelineRunContextBuildID].(float64)
+	logID, _ := postActionIntegrationRequest.Context[constants.BuildLogContextLogID].(float64)
+	taskName, _ := postActionIntegrationRequest.Context[constants.BuildLogContextTaskName].(string)
+	if organization == "" || project == "" || buildID == 0 || logID == 0 {
+		p.handleError(w, r, &serializers.Error{Code: http.StatusBadRequest, Message: constants.GenericErrorMessage})
+		return
+	}
+
+	response := &model.PostActionIntegrationResponse{}
+	lines, statusCode, err := p.GetBuildLogTail(mattermostUserID, organization, project, int(buildID), int(logID))
+	switch {
+	case err != nil && (statusCode == http.StatusForbidden || statusCode == http.StatusUnauthorized):
+		response.EphemeralText = constants.BuildLogNotPermitted
+	case err != nil:
+		p.API.LogError(constants.ErrorFetchBuildLogTail, "Error", err.Error())
+		response.EphemeralText = constants.GenericErrorMessage
+	case len(lines) == 0:
+		response.EphemeralText = fmt.Sprintf(constants.BuildLogEmpty, taskName)
+	default:
+		response.EphemeralText = getBuildLogTailMessage(taskName, lines)
+	}
+
+	p.returnPostActionIntegrationResponse(w, response)
+}
+
+// updatePullRequestStatusFromPost updates the status of a pull request from the actions of its post and returns the message for the user
+func (p *Plugin) updatePullRequestStatusFromPost(mattermostUserID, organization, projectID string, pullRequestID int, action string, options *serializers.PullRequestCompletionOptions, postID string) string {
+	pullRequest, blockingPolicies, statusCode, err := p.UpdatePullRequestStatus(mattermostUserID, organization, projectID, strconv.Itoa(pullRequestID), action, options)
+	if err != nil && len(blockingPolicies) == 0 {
+		p.API.LogError(constants.ErrorUpdatePullRequestStatus, "Error", err.Error())
+		switch statusCode {
+		case http.StatusBadRequest:
+			return err.Error()
+		case http.StatusForbidden, http.StatusUnauthorized:
+			return fmt.Sprintf(constants.PullRequestUpdateNotPermitted, pullRequestID)
+		default:
+			return constants.GenericErrorMessage
+		}
+	}
+
+	if err := p.UpdatePullRequestReviewersPost(organization, projectID, pullRequestID, postID, mattermostUserID); err != nil {
+		p.API.LogError(constants.ErrorUpdatePullRequestReviewersPost, "Error", err.Error())
+	}
+
+	return p.getPullRequestStatusMessage(organization, action, pullRequest, blockingPolicies)
+}
+
+func getPullRequestCompletionDialogElements() []model.DialogElement {
+	return []model.DialogElement{
+		{
+			DisplayName: "Merge type",
+			Name:        constants.DialogFieldNameMergeStrategy,
+			Type:        "select",
+			Default:     constants.PullRequestMergeStrategyNoFastForward,
+			Options: []*model.PostActionOptions{
+				{Text: "Merge (no fast-forward)", Value: constants.PullRequestMergeStrategyNoFastForward},
+				{Text: "Squash commit", Value: constants.PullRequestMergeStrategySquash},
+				{Text: "Rebase and fast-forward", Value: constants.PullRequestMergeStrategyRebase},
+				{Text: "Semi-linear merge", Value: constants.PullRequestMergeStrategyRebaseMerge},
+			},
+		},
+		{
+			DisplayName: "Delete source branch",
+			Name:        constants.DialogFieldNameDeleteSourceBranch,
+			Type:        "bool",
+			Placeholder: "Delete the source branch after merging",
+			Optional:    true,
+		},
+		{
+			DisplayName: "Complete associated work items",
+			Name:        constants.DialogFieldNameTransitionWorkItems,
+			Type:        "bool",
+			Placeholder: "Complete the linked work items after merging",
+			Default:     "true",
+			Optional:    true,
+		},
+	}
+}
+
 func (p *Plugin) returnPostActionIntegrationResponse(w http.ResponseWriter, res *model.PostActionIntegrationResponse) {
 	w.Header().Set("Content-Type", "application/json")
 	if _, err := w.Write(res.ToJson()); err != nil {
@@ -14,6 +14,7 @@ import (
 
 	"bou.ke/monkey"
 	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
 	"github.com/stretchr/testify/assert"
@@ -110,13 +111,17 @@ func TestHandleCreateTask(t *testing.T) {
 	mockedClient := mocks.NewMockClient(mockCtrl)
 	p := setupMockPlugin(mockAPI, nil, mockedClient)
 	for _, testCase := range []struct {
-		description        string
-		body               string
-		err                error
-		marshalError       error
-		statusCode         int
-		expectedStatusCode int
-		clientError        error
+		description           string
+		body                  string
+		err                   error
+		marshalError          error
+		statusCode            int
+		expectedStatusCode    int
+		clientError           error
+		fieldDefinitions      []*serializers.WorkItemTypeField
+		fieldDefinitionsError error
+		fields                []*serializers.WorkItemField
+		identities            []*serializers.Identity
 	}{
 		{
 			description: "CreateTask: valid fields",
@@ -161,6 +166,160 @@ func TestHandleCreateTask(t *testing.T) {
 			statusCode:         http.StatusBadRequest,
 			expectedStatusCode: http.StatusBadRequest,
 		},
+		{
+			description: "CreateTask: missing required field of the work item type",
+			body: `{
+				"organization": "mockOrganization",
+				"project": "mockProjectName",
+				"type": "mockType",
+				"fields": {
+					"title": "mockTitle"
+					}
+				}`,
+			fieldDefinitions: []*serializers.WorkItemTypeField{
+				{
+					Name:           "Severity",
+					ReferenceName:  "Microsoft.VSTS.Common.Severity",
+					AlwaysRequired: true,
+				},
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description: "CreateTask: value not allowed for a picklist field",
+			body: `{
+				"organization": "mockOrganization",
+				"project": "mockProjectName",
+				"type": "mockType",
+				"fields": {
+					"title": "mockTitle",
+					"additionalFields": {
+						"Microsoft.VSTS.Common.Severity": "5 - Unknown"
+						}
+					}
+				}`,
+			fieldDefinitions: []*serializers.WorkItemTypeField{
+				{
+					Name:          "Title",
+					ReferenceName: "System.Title",
+				},
+				{
+					Name:          "Severity",
+					ReferenceName: "Microsoft.VSTS.Common.Severity",
+					AllowedValues: []interface{}{"1 - Critical", "2 - High"},
+				},
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description: "CreateTask: invalid HTML field",
+			body: `{
+				"organization": "mockOrganization",
+				"project": "mockProjectName",
+				"type": "mockType",
+				"fields": {
+					"title": "mockTitle",
+					"additionalFields": {
+						"Microsoft.VSTS.TCM.ReproSteps": "<div><b>mockSteps</div>"
+						}
+					}
+				}`,
+			fieldDefinitions: []*serializers.WorkItemTypeField{
+				{ReferenceName: "System.Title"},
+				{Name: "Repro Steps", ReferenceName: "Microsoft.VSTS.TCM.ReproSteps"},
+			},
+			fields: []*serializers.WorkItemField{
+				{ReferenceName: "Microsoft.VSTS.TCM.ReproSteps", Type: constants.FieldTypeHTML},
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description: "CreateTask: empty HTML field",
+			body: `{
+				"organization": "mockOrganization",
+				"project": "mockProjectName",
+				"type": "mockType",
+				"fields": {
+					"title": "mockTitle",
+					"additionalFields": {
+						"Microsoft.VSTS.TCM.ReproSteps": "<div><p>&nbsp;</p></div>"
+						}
+					}
+				}`,
+			fieldDefinitions: []*serializers.WorkItemTypeField{
+				{ReferenceName: "System.Title"},
+				{Name: "Repro Steps", ReferenceName: "Microsoft.VSTS.TCM.ReproSteps"},
+			},
+			fields: []*serializers.WorkItemField{
+				{ReferenceName: "Microsoft.VSTS.TCM.ReproSteps", Type: constants.FieldTypeHTML},
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description: "CreateTask: valid HTML and identity fields",
+			body: `{
+				"organization": "mockOrganization",
+				"project": "mockProjectName",
+				"type": "mockType",
+				"fields": {
+					"title": "mockTitle",
+					"additionalFields": {
+						"Microsoft.VSTS.TCM.ReproSteps": "<div><p>Open the page<br>Click <b>Save</b></div>",
+						"Custom.Customer": "mockUser@example.com"
+						}
+					}
+				}`,
+			fieldDefinitions: []*serializers.WorkItemTypeField{
+				{ReferenceName: "System.Title"},
+				{Name: "Repro Steps", ReferenceName: "Microsoft.VSTS.TCM.ReproSteps"},
+				{Name: "Customer", ReferenceName: "Custom.Customer"},
+			},
+			fields: []*serializers.WorkItemField{
+				{ReferenceName: "Microsoft.VSTS.TCM.ReproSteps", Type: constants.FieldTypeHTML},
+				{ReferenceName: "Custom.Customer", Type: constants.FieldTypeIdentity},
+			},
+			identities: []*serializers.Identity{
+				{ID: "mockIdentityID", Properties: serializers.IdentityProperties{Mail: serializers.IdentityProperty{Value: "mockuser@example.com"}}},
+			},
+			statusCode:         http.StatusOK,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description: "CreateTask: identity field of an unknown user",
+			body: `{
+				"organization": "mockOrganization",
+				"project": "mockProjectName",
+				"type": "mockType",
+				"fields": {
+					"title": "mockTitle",
+					"additionalFields": {
+						"Custom.Customer": "unknown@example.com"
+						}
+					}
+				}`,
+			fieldDefinitions: []*serializers.WorkItemTypeField{
+				{ReferenceName: "System.Title"},
+				{Name: "Customer", ReferenceName: "Custom.Customer"},
+			},
+			fields: []*serializers.WorkItemField{
+				{ReferenceName: "Custom.Customer", Type: constants.FieldTypeIdentity},
+			},
+			identities:         []*serializers.Identity{},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description: "CreateTask: failed to get the work item type fields",
+			body: `{
+				"organization": "mockOrganization",
+				"project": "mockProjectName",
+				"type": "mockType",
+				"fields": {
+					"title": "mockTitle"
+					}
+				}`,
+			fieldDefinitionsError: errors.New("error getting the work item type fields"),
+			expectedStatusCode:    http.StatusInternalServerError,
+		},
 		{
 			description: "CreateTask: marshaling gives error",
 			body: `{
@@ -186,8 +345,38 @@ func TestHandleCreateTask(t *testing.T) {
 				return []byte{}, testCase.marshalError
 			})
 
+			if testCase.statusCode == http.StatusOK || testCase.fieldDefinitions != nil || testCase.fieldDefinitionsError != nil {
+				fieldDefinitions := testCase.fieldDefinitions
+				if fieldDefinitions == nil {
+					fieldDefinitions = []*serializers.WorkItemTypeField{
+						{ReferenceName: "System.Title"},
+						{ReferenceName: "System.Description"},
+					}
+				}
+
+				fieldDefinitionsStatusCode := http.StatusOK
+				if testCase.fieldDefinitionsError != nil {
+					fieldDefinitionsStatusCode = testCase.expectedStatusCode
+				}
+
+				mockedClient.EXPECT().GetWorkItemTypeFields(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&serializers.WorkItemTypeFieldList{Value: fieldDefinitions}, fieldDefinitionsStatusCode, testCase.fieldDefinitionsError)
+				if testCase.fieldDefinitionsError == nil {
+					mockedClient.EXPECT().GetWorkItemFields(gomock.Any(), gomock.Any(), gomock.Any()).Return(&serializers.WorkItemFieldList{Value: testCase.fields}, http.StatusOK, nil)
+				}
+			}
+
+			if testCase.identities != nil {
+				mockedClient.EXPECT().SearchIdentities(testutils.MockOrganization, gomock.Any(), testutils.MockMattermostUserID).Return(&serializers.IdentityList{Value: testCase.identities}, http.StatusOK, nil)
+			}
+
 			if testCase.statusCode == http.StatusOK {
-				mockedClient.EXPECT().CreateTask(gomock.Any(), gomock.Any()).Return(&serializers.TaskValue{}, testCase.statusCode, testCase.err)
+				mockedClient.EXPECT().CreateTask(gomock.Any(), gomock.Any()).DoAndReturn(func(body *serializers.CreateTaskRequestPayload, _ string) (*serializers.TaskValue, int, error) {
+					// The identities are sent using their unique names
+					if testCase.identities != nil {
+						assert.Equal(t, "mockuser@example.com", body.Fields.AdditionalFields["Custom.Customer"])
+					}
+					return &serializers.TaskValue{}, testCase.statusCode, testCase.err
+				})
 			}
 
 			req := httptest.NewRequest(http.MethodPost, "/tasks", bytes.NewBufferString(testCase.body))
@@ -1051,6 +1240,15 @@ func TestHandleSubscriptionNotifications(t *testing.T) {
 				return testCase.channelID, testCase.statusCode, testCase.err
 			})
 
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "ShouldPostWorkItemNotification", func(_ *Plugin, _ *serializers.SubscriptionNotification) bool {
+				return true
+			})
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "SendPersonalNotifications", func(_ *Plugin, _ *serializers.SubscriptionNotification) {})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetCodePushAttachment", func(_ *Plugin, _ *serializers.SubscriptionNotification) *model.SlackAttachment {
+				return &model.SlackAttachment{}
+			})
+
 			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("%s?%s=%s&%s=%s", constants.PathSubscriptionNotifications, constants.AzureDevopsQueryParamChannelID, testCase.channelID, constants.AzureDevopsQueryParamWebhookSecret, testCase.webhookSecret), bytes.NewBufferString(testCase.body))
 
 			w := httptest.NewRecorder()
@@ -1235,6 +1433,13 @@ func TestHandlePipelineApproveOrRejectRunRequest(t *testing.T) {
 				return testCase.updatePipelineRunApprovalPostError
 			})
 
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdateApprovalPosts", func(_ *Plugin, requestName, organization, approvalID, postID string, _ func(*model.SlackAttachment)) {
+				assert.Equal(t, constants.PipelineRequestNameRun, requestName)
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, testutils.MockApproverID, approvalID)
+				assert.Equal(t, "mockCallbackID", postID)
+			})
+
 			monkey.PatchInstanceMethod(reflect.TypeOf(p), "DM", func(_ *Plugin, _, _ string, _ bool, _ ...interface{}) (string, error) {
 				return "", nil
 			})
@@ -1345,6 +1550,13 @@ func TestHandlePipelineApproveOrRejectReleaseRequest(t *testing.T) {
 				return testCase.updatePipelineReleaseApprovalPostError
 			})
 
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdateApprovalPosts", func(_ *Plugin, requestName, organization, approvalID, postID string, _ func(*model.SlackAttachment)) {
+				assert.Equal(t, constants.PipelineRequestNameRelease, requestName)
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, "1234", approvalID)
+				assert.Equal(t, "mockCallbackID", postID)
+			})
+
 			monkey.PatchInstanceMethod(reflect.TypeOf(p), "DM", func(_ *Plugin, _, _ string, _ bool, _ ...interface{}) (string, error) {
 				return "", nil
 			})
@@ -1554,3 +1766,503 @@ func TestHandleGetSubscriptionFilterPossibleValues(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleGetSprintSummary(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	for _, testCase := range []struct {
+		description       string
+		query             string
+		summary           *serializers.SprintSummary
+		err               error
+		expectedTeam      string
+		expectedStaleDays int
+		statusCode        int
+	}{
+		{
+			description:       "HandleGetSprintSummary: valid",
+			query:             "?team=mockTeam&stale_days=5",
+			summary:           &serializers.SprintSummary{},
+			expectedTeam:      "mockTeam",
+			expectedStaleDays: 5,
+			statusCode:        http.StatusOK,
+		},
+		{
+			description:       "HandleGetSprintSummary: default team and number of days",
+			summary:           &serializers.SprintSummary{},
+			expectedStaleDays: constants.DefaultStaleDays,
+			statusCode:        http.StatusOK,
+		},
+		{
+			description: "HandleGetSprintSummary: invalid number of days",
+			query:       "?stale_days=-1",
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			description:       "HandleGetSprintSummary: no current iteration",
+			expectedStaleDays: constants.DefaultStaleDays,
+			statusCode:        http.StatusNotFound,
+		},
+		{
+			description:       "HandleGetSprintSummary: error while fetching the summary",
+			err:               errors.New("failed to get the current iteration"),
+			expectedStaleDays: constants.DefaultStaleDays,
+			statusCode:        http.StatusForbidden,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetSprintSummary", func(_ *Plugin, _, organization, project, team string, staleDays int) (*serializers.SprintSummary, int, error) {
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, testutils.MockProjectName, project)
+				assert.Equal(t, testCase.expectedTeam, team)
+				assert.Equal(t, testCase.expectedStaleDays, staleDays)
+				return testCase.summary, testCase.statusCode, testCase.err
+			})
+
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/sprint/%s/%s%s", testutils.MockOrganization, testutils.MockProjectName, testCase.query), nil)
+			req = mux.SetURLVars(req, map[string]string{
+				constants.PathParamOrganization: testutils.MockOrganization,
+				constants.PathParamProject:      testutils.MockProjectName,
+			})
+			req.Header.Add(constants.HeaderMattermostUserID, testutils.MockMattermostUserID)
+
+			w := httptest.NewRecorder()
+			p.handleGetSprintSummary(w, req)
+			resp := w.Result()
+			assert.Equal(t, testCase.statusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandleAttachFiles(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	for _, testCase := range []struct {
+		description   string
+		body          string
+		attachedFiles []*serializers.AttachedFile
+		err           error
+		statusCode    int
+	}{
+		{
+			description:   "HandleAttachFiles: valid",
+			body:          `{"postID": "mockPostID", "organization": "mockOrganization", "project": "mockProjectName", "workItemID": "1"}`,
+			attachedFiles: []*serializers.AttachedFile{{FileName: "mockFile.png"}},
+			statusCode:    http.StatusOK,
+		},
+		{
+			description: "HandleAttachFiles: empty body",
+			body:        `{}`,
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			description: "HandleAttachFiles: invalid body",
+			body:        `{`,
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			description: "HandleAttachFiles: error while attaching the files",
+			body:        `{"postID": "mockPostID", "organization": "mockOrganization", "project": "mockProjectName", "workItemID": "1"}`,
+			err:         errors.New(constants.PostHasNoFiles),
+			statusCode:  http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "AttachPostFilesToWorkItem", func(_ *Plugin, _, postID, organization, project, workItemID string) ([]*serializers.AttachedFile, *serializers.TaskValue, int, error) {
+				return testCase.attachedFiles, &serializers.TaskValue{}, testCase.statusCode, testCase.err
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/attachments", bytes.NewBufferString(testCase.body))
+			req.Header.Add(constants.HeaderMattermostUserID, testutils.MockMattermostUserID)
+
+			w := httptest.NewRecorder()
+			p.handleAttachFiles(w, req)
+			resp := w.Result()
+			assert.Equal(t, testCase.statusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandleAttachFilesDialog(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	for _, testCase := range []struct {
+		description         string
+		workItemLink        string
+		err                 error
+		statusCode          int
+		expectAttach        bool
+		expectedFieldError  bool
+		expectedDialogError string
+	}{
+		{
+			description:  "HandleAttachFilesDialog: valid",
+			workItemLink: "https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/1",
+			statusCode:   http.StatusOK,
+			expectAttach: true,
+		},
+		{
+			description:        "HandleAttachFilesDialog: invalid work item link",
+			workItemLink:       "mockLink",
+			statusCode:         http.StatusOK,
+			expectedFieldError: true,
+		},
+		{
+			description:         "HandleAttachFilesDialog: file is too large",
+			workItemLink:        "https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/1",
+			err:                 errors.New("file is too large"),
+			statusCode:          http.StatusBadRequest,
+			expectAttach:        true,
+			expectedDialogError: "file is too large",
+		},
+		{
+			description:         "HandleAttachFilesDialog: error while uploading the files",
+			workItemLink:        "https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/1",
+			err:                 errors.New("failed to upload the attachment"),
+			statusCode:          http.StatusInternalServerError,
+			expectAttach:        true,
+			expectedDialogError: constants.GenericErrorMessage,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			mockAPI.On("SendEphemeralPost", testutils.MockMattermostUserID, mock.AnythingOfType("*model.Post")).Return(&model.Post{})
+
+			isAttached := false
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "AttachPostFilesToWorkItem", func(_ *Plugin, _, postID, organization, project, workItemID string) ([]*serializers.AttachedFile, *serializers.TaskValue, int, error) {
+				isAttached = true
+				assert.Equal(t, "mockPostID", postID)
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, testutils.MockProjectName, project)
+				assert.Equal(t, "1", workItemID)
+				return []*serializers.AttachedFile{{FileName: "mockFile.png"}}, &serializers.TaskValue{}, testCase.statusCode, testCase.err
+			})
+
+			body, err := json.Marshal(&model.SubmitDialogRequest{
+				CallbackId: "mockPostID",
+				ChannelId:  testutils.MockChannelID,
+				Submission: map[string]interface{}{
+					constants.DialogFieldNameWorkItemLink: testCase.workItemLink,
+				},
+			})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/attachments/dialog", bytes.NewBuffer(body))
+			req.Header.Add(constants.HeaderMattermostUserID, testutils.MockMattermostUserID)
+
+			w := httptest.NewRecorder()
+			p.handleAttachFilesDialog(w, req)
+			resp := w.Result()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, testCase.expectAttach, isAttached)
+
+			response := &model.SubmitDialogResponse{}
+			_ = json.NewDecoder(resp.Body).Decode(response)
+			assert.Equal(t, testCase.expectedDialogError, response.Error)
+			assert.Equal(t, testCase.expectedFieldError, response.Errors[constants.DialogFieldNameWorkItemLink] != "")
+		})
+	}
+}
+
+func TestHandleCreatePullRequestDialog(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	for _, testCase := range []struct {
+		description         string
+		fieldErrors         map[string]string
+		err                 error
+		statusCode          int
+		expectPost          bool
+		expectedDialogError string
+	}{
+		{
+			description: "HandleCreatePullRequestDialog: valid",
+			statusCode:  http.StatusCreated,
+			expectPost:  true,
+		},
+		{
+			description: "HandleCreatePullRequestDialog: reviewer is not found in the organization",
+			fieldErrors: map[string]string{"reviewer1": fmt.Sprintf(constants.PullRequestReviewerNotFound, "mockUser")},
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			description:         "HandleCreatePullRequestDialog: pull request already exists",
+			err:                 fmt.Errorf(constants.PullRequestAlreadyExists, "feature/login", "main"),
+			statusCode:          http.StatusConflict,
+			expectedDialogError: fmt.Sprintf(constants.PullRequestAlreadyExists, "feature/login", "main"),
+		},
+		{
+			description:         "HandleCreatePullRequestDialog: user does not have permission",
+			err:                 errors.New("failed to create the pull request"),
+			statusCode:          http.StatusForbidden,
+			expectedDialogError: constants.PullRequestCreateNotPermitted,
+		},
+		{
+			description:         "HandleCreatePullRequestDialog: error while creating the pull request",
+			err:                 errors.New("failed to create the pull request"),
+			statusCode:          http.StatusInternalServerError,
+			expectedDialogError: constants.GenericErrorMessage,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "CreatePullRequestFromDialog", func(_ *Plugin, _, state string, _ map[string]interface{}) (*serializers.PullRequest, map[string]string, int, error) {
+				assert.Equal(t, "mockOrganization$mockProjectID$mockRepositoryID$feature/login$main", state)
+				if testCase.fieldErrors != nil || testCase.err != nil {
+					return nil, testCase.fieldErrors, testCase.statusCode, testCase.err
+				}
+				return &serializers.PullRequest{PullRequestID: 1}, nil, testCase.statusCode, nil
+			})
+
+			isPosted := false
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "PostCreatedPullRequest", func(_ *Plugin, _, channelID, organization string, pullRequest *serializers.PullRequest) error {
+				isPosted = true
+				assert.Equal(t, testutils.MockChannelID, channelID)
+				assert.Equal(t, testutils.MockOrganization, organization)
+				return nil
+			})
+
+			body, err := json.Marshal(&model.SubmitDialogRequest{
+				ChannelId: testutils.MockChannelID,
+				State:     "mockOrganization$mockProjectID$mockRepositoryID$feature/login$main",
+			})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/pull-request-create", bytes.NewBuffer(body))
+			req.Header.Add(constants.HeaderMattermostUserID, testutils.MockMattermostUserID)
+
+			w := httptest.NewRecorder()
+			p.handleCreatePullRequestDialog(w, req)
+			resp := w.Result()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, testCase.expectPost, isPosted)
+
+			response := &model.SubmitDialogResponse{}
+			_ = json.NewDecoder(resp.Body).Decode(response)
+			assert.Equal(t, testCase.expectedDialogError, response.Error)
+			assert.Equal(t, len(testCase.fieldErrors), len(response.Errors))
+		})
+	}
+}
+
+func TestHandleRunPipelineDialog(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	for _, testCase := range []struct {
+		description         string
+		fieldErrors         map[string]string
+		err                 error
+		statusCode          int
+		expectPost          bool
+		expectedDialogError string
+	}{
+		{
+			description: "HandleRunPipelineDialog: valid",
+			statusCode:  http.StatusOK,
+			expectPost:  true,
+		},
+		{
+			description: "HandleRunPipelineDialog: invalid variable",
+			fieldErrors: map[string]string{constants.DialogFieldNameVariables: fmt.Sprintf(constants.InvalidPipelineVariable, "logLevel")},
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			description:         "HandleRunPipelineDialog: user can not queue the pipeline",
+			err:                 errors.New("failed to run the pipeline"),
+			statusCode:          http.StatusForbidden,
+			expectedDialogError: constants.ErrorQueueAccess,
+		},
+		{
+			description:         "HandleRunPipelineDialog: error while running the pipeline",
+			err:                 errors.New("failed to run the pipeline"),
+			statusCode:          http.StatusInternalServerError,
+			expectedDialogError: constants.GenericErrorMessage,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "RunPipelineFromDialog", func(_ *Plugin, _, state string, _ map[string]interface{}) (*serializers.PipelineRun, map[string]string, int, error) {
+				assert.Equal(t, "mockOrganization$mockProjectName$1", state)
+				if testCase.fieldErrors != nil || testCase.err != nil {
+					return nil, testCase.fieldErrors, testCase.statusCode, testCase.err
+				}
+				return &serializers.PipelineRun{ID: 10}, nil, testCase.statusCode, nil
+			})
+
+			isPosted := false
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "PostPipelineRun", func(_ *Plugin, _, channelID string, pipelineRun *serializers.PipelineRun) error {
+				isPosted = true
+				assert.Equal(t, testutils.MockChannelID, channelID)
+				assert.Equal(t, 10, pipelineRun.ID)
+				return nil
+			})
+
+			body, err := json.Marshal(&model.SubmitDialogRequest{
+				ChannelId: testutils.MockChannelID,
+				State:     "mockOrganization$mockProjectName$1",
+			})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, constants.PathRunPipelineDialog, bytes.NewBuffer(body))
+			req.Header.Add(constants.HeaderMattermostUserID, testutils.MockMattermostUserID)
+
+			w := httptest.NewRecorder()
+			p.handleRunPipelineDialog(w, req)
+			resp := w.Result()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, testCase.expectPost, isPosted)
+
+			response := &model.SubmitDialogResponse{}
+			_ = json.NewDecoder(resp.Body).Decode(response)
+			assert.Equal(t, testCase.expectedDialogError, response.Error)
+			assert.Equal(t, len(testCase.fieldErrors), len(response.Errors))
+		})
+	}
+}
+
+func TestHandleUpdateWorkItemFilters(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	for _, testCase := range []struct {
+		description string
+		body        string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "HandleUpdateWorkItemFilters: valid",
+			body:        `{"subscriptionID": "mockSubscriptionID", "workItemFilters": {"workItemTypes": ["Bug"], "maxPriority": 2}}`,
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "HandleUpdateWorkItemFilters: empty body",
+			body:        `{}`,
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			description: "HandleUpdateWorkItemFilters: invalid body",
+			body:        `{`,
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			description: "HandleUpdateWorkItemFilters: error while updating the filters",
+			body:        `{"subscriptionID": "mockSubscriptionID", "workItemFilters": {"stateFrom": "New"}}`,
+			err:         errors.New(constants.WorkItemStateFiltersNotSupported),
+			statusCode:  http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdateWorkItemFilters", func(_ *Plugin, _, subscriptionID string, filters *serializers.WorkItemFilters) (*serializers.SubscriptionDetails, int, error) {
+				assert.Equal(t, "mockSubscriptionID", subscriptionID)
+				return &serializers.SubscriptionDetails{SubscriptionID: subscriptionID, WorkItemFilters: filters}, testCase.statusCode, testCase.err
+			})
+
+			req := httptest.NewRequest(http.MethodPost, constants.PathSubscriptionWorkItemFilters, bytes.NewBufferString(testCase.body))
+			req.Header.Add(constants.HeaderMattermostUserID, testutils.MockMattermostUserID)
+
+			w := httptest.NewRecorder()
+			p.handleUpdateWorkItemFilters(w, req)
+			resp := w.Result()
+			assert.Equal(t, testCase.statusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandleLinkThreadDialog(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	for _, testCase := range []struct {
+		description         string
+		workItemLink        string
+		mirrorReplies       bool
+		err                 error
+		statusCode          int
+		expectLink          bool
+		expectedFieldError  bool
+		expectedDialogError string
+	}{
+		{
+			description:   "HandleLinkThreadDialog: valid",
+			workItemLink:  "https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/1",
+			mirrorReplies: true,
+			statusCode:    http.StatusOK,
+			expectLink:    true,
+		},
+		{
+			description:        "HandleLinkThreadDialog: invalid work item link",
+			workItemLink:       "mockLink",
+			statusCode:         http.StatusOK,
+			expectedFieldError: true,
+		},
+		{
+			description:         "HandleLinkThreadDialog: work item is not found",
+			workItemLink:        "https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/1",
+			err:                 errors.New("work item is not found"),
+			statusCode:          http.StatusNotFound,
+			expectLink:          true,
+			expectedDialogError: "work item is not found",
+		},
+		{
+			description:         "HandleLinkThreadDialog: error while linking the thread",
+			workItemLink:        "https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/1",
+			err:                 errors.New("failed to store the thread link"),
+			statusCode:          http.StatusInternalServerError,
+			expectLink:          true,
+			expectedDialogError: constants.GenericErrorMessage,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+
+			isLinked := false
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "LinkThreadToWorkItem", func(_ *Plugin, _, postID, organization, project, workItemID string, mirrorReplies bool) (*serializers.ThreadLink, int, error) {
+				isLinked = true
+				assert.Equal(t, "mockPostID", postID)
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, testutils.MockProjectName, project)
+				assert.Equal(t, "1", workItemID)
+				assert.Equal(t, testCase.mirrorReplies, mirrorReplies)
+				return &serializers.ThreadLink{}, testCase.statusCode, testCase.err
+			})
+
+			body, err := json.Marshal(&model.SubmitDialogRequest{
+				CallbackId: "mockPostID",
+				ChannelId:  testutils.MockChannelID,
+				Submission: map[string]interface{}{
+					constants.DialogFieldNameWorkItemLink:  testCase.workItemLink,
+					constants.DialogFieldNameMirrorReplies: testCase.mirrorReplies,
+				},
+			})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/thread/link/dialog", bytes.NewBuffer(body))
+			req.Header.Add(constants.HeaderMattermostUserID, testutils.MockMattermostUserID)
+
+			w := httptest.NewRecorder()
+			p.handleLinkThreadDialog(w, req)
+			resp := w.Result()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, testCase.expectLink, isLinked)
+
+			response := &model.SubmitDialogResponse{}
+			_ = json.NewDecoder(resp.Body).Decode(response)
+			assert.Equal(t, testCase.expectedDialogError, response.Error)
+			assert.Equal(t, testCase.expectedFieldError, response.Errors[constants.DialogFieldNameWorkItemLink] != "")
+		})
+	}
+}
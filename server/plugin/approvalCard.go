@@ -0,0 +1,239 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// SendApprovalCards sends the notification of a release deployment or run stage waiting for approval to each of its approvers connected to Mattermost by direct message,
+// so that the approvers who are not members of the channel can approve or reject it. The groups of approvers are expanded into their members.
+// The cards have the same actions as the notification and all the posts of the approval are kept in sync once it is approved or rejected from any of them.
+func (p *Plugin) SendApprovalCards(body *serializers.SubscriptionNotification, attachment *model.SlackAttachment, postID string) {
+	requestName, organization, approvalID := getApprovalOfActions(attachment.Actions)
+	if approvalID == "" {
+		return
+	}
+
+	if err := p.Store.AddApprovalPost(requestName, organization, approvalID, postID); err != nil {
+		p.API.LogError(constants.ErrorSendApprovalCard, "ApprovalID", approvalID, "Error", err.Error())
+		return
+	}
+
+	// The groups are expanded using the Azure DevOps account of the user who added the subscription
+	subscriptionCreatorID := ""
+	subscription, err := p.getSubscriptionDetails(body.SubscriptionID)
+	if err != nil {
+		p.API.LogError(constants.FetchSubscriptionListError, "Error", err.Error())
+	} else if subscription != nil {
+		subscriptionCreatorID = subscription.MattermostUserID
+	}
+
+	var approvers []*serializers.UserID
+	if requestName == constants.PipelineRequestNameRun {
+		for _, step := range body.Resource.Approval.Steps {
+			approvers = append(approvers, step.AssignedApprover.GetIdentity())
+		}
+	} else {
+		approvers = append(approvers, body.Resource.Approval.Approver.GetIdentity())
+	}
+
+	for _, mattermostUserID := range p.getApproverMattermostUserIDs(organization, approvers, subscriptionCreatorID) {
+		// The event is delivered once for each subscription, so a card is sent only once to an approver
+		if body.ID != "" {
+			isNotSent, err := p.Store.MarkPersonalNotificationSent(body.ID, constants.ApprovalCardNotificationKind, mattermostUserID)
+			if err != nil {
+				p.API.LogError(constants.ErrorSendApprovalCard, "ApprovalID", approvalID, "Error", err.Error())
+				continue
+			}
+
+			if !isNotSent {
+				continue
+			}
+		}
+
+		channel, appErr := p.API.GetDirectChannel(mattermostUserID, p.botUserID)
+		if appErr != nil {
+			p.API.LogError(constants.ErrorSendApprovalCard, "ApprovalID", approvalID, "Error", appErr.Error())
+			continue
+		}
+
+		card := &model.Post{
+			UserId:    p.botUserID,
+			ChannelId: channel.Id,
+			Message:   constants.ApprovalCardMessage,
+		}
+		model.ParseSlackAttachment(card, []*model.SlackAttachment{attachment})
+		createdCard, appErr := p.API.CreatePost(card)
+		if appErr != nil {
+			p.API.LogError(constants.ErrorSendApprovalCard, "ApprovalID", approvalID, "Error", appErr.Error())
+			continue
+		}
+
+		if err := p.Store.AddApprovalPost(requestName, organization, approvalID, createdCard.Id); err != nil {
+			p.API.LogError(constants.ErrorSendApprovalCard, "ApprovalID", approvalID, "Error", err.Error())
+		}
+	}
+}
+
+// getApproverMattermostUserIDs returns the IDs of the Mattermost users of the approvers of an approval without duplicates.
+// The approvers who do not resolve to a Mattermost user are expanded into their members if they are groups.
+func (p *Plugin) getApproverMattermostUserIDs(organization string, approvers []*serializers.UserID, subscriptionCreatorID string) []string {
+	var mattermostUserIDs []string
+	isAdded := map[string]bool{}
+	add := func(mattermostUserID string) {
+		if mattermostUserID != "" && !isAdded[mattermostUserID] {
+			isAdded[mattermostUserID] = true
+			mattermostUserIDs = append(mattermostUserIDs, mattermostUserID)
+		}
+	}
+
+	for _, approver := range approvers {
+		if mattermostUserID := p.GetMattermostUserIDForAzureIdentity(approver); mattermostUserID != "" {
+			add(mattermostUserID)
+			continue
+		}
+
+		if subscriptionCreatorID == "" || approver.ID == "" {
+			continue
+		}
+
+		for _, member := range p.GetAzureGroupMembers(organization, approver.ID, subscriptionCreatorID) {
+			add(p.GetMattermostUserIDForAzureIdentity(member))
+		}
+	}
+
+	return mattermostUserIDs
+}
+
+// GetAzureGroupMembers returns the users who are direct or indirect members of an Azure DevOps group, nothing is returned if the identity is not a group.
+// Only the first constants.MaxApprovalGroupMembers members are returned.
+func (p *Plugin) GetAzureGroupMembers(organization, groupID, mattermostUserID string) []*serializers.UserID {
+	identityList, _, err := p.Client.GetIdentityWithMembers(organization, groupID, mattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorExpandApprovalGroup, "GroupID", groupID, "Error", err.Error())
+		return nil
+	}
+
+	if len(identityList.Value) == 0 || identityList.Value[0] == nil || !identityList.Value[0].IsContainer || len(identityList.Value[0].Members) == 0 {
+		return nil
+	}
+
+	descriptors := identityList.Value[0].Members
+	if len(descriptors) > constants.MaxApprovalGroupMembers {
+		descriptors = descriptors[:constants.MaxApprovalGroupMembers]
+	}
+
+	memberList, _, err := p.Client.GetIdentitiesByDescriptors(organization, descriptors, mattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorExpandApprovalGroup, "GroupID", groupID, "Error", err.Error())
+		return nil
+	}
+
+	var members []*serializers.UserID
+	for _, member := range memberList.Value {
+		// The nested groups are already expanded as the members include the indirect members
+		if member == nil || member.IsContainer {
+			continue
+		}
+
+		members = append(members, member.GetIdentity())
+	}
+
+	return members
+}
+
+// UpdateApprovalPosts updates the posts of an approval other than the post it is updated from, i.e. its notifications in the other channels and its approval cards
+func (p *Plugin) UpdateApprovalPosts(requestName, organization, approvalID, postID string, update func(slackAttachment *model.SlackAttachment)) {
+	postIDs, err := p.Store.GetApprovalPosts(requestName, organization, approvalID)
+	if err != nil {
+		p.API.LogError(constants.ErrorUpdateApprovalPosts, "ApprovalID", approvalID, "Error", err.Error())
+		return
+	}
+
+	for _, approvalPostID := range postIDs {
+		if approvalPostID == postID {
+			continue
+		}
+
+		if err := p.updateApprovalPost(approvalPostID, update); err != nil {
+			p.API.LogError(constants.ErrorUpdateApprovalPosts, "ApprovalID", approvalID, "PostID", approvalPostID, "Error", err.Error())
+		}
+	}
+}
+
+// updateReleaseApprovalPosts marks the approver of the other posts of a release approval as approved or rejected.
+// The posts which are already updated are skipped as the approver is marked by prefixing the status to it.
+func (p *Plugin) updateReleaseApprovalPosts(organization string, approvalID float64, status, postID string) {
+	p.UpdateApprovalPosts(constants.PipelineRequestNameRelease, organization, getApprovalID(approvalID), postID, func(slackAttachment *model.SlackAttachment) {
+		if len(slackAttachment.Actions) > 0 {
+			setPipelineReleaseApprovalStatus(slackAttachment, status)
+		}
+	})
+}
+
+// updateApprovalPost updates the attachment of a post of an approval, the post is only updated if the attachment is changed
+func (p *Plugin) updateApprovalPost(postID string, update func(slackAttachment *model.SlackAttachment)) error {
+	post, appErr := p.API.GetPost(postID)
+	if appErr != nil {
+		return appErr
+	}
+
+	attachments := post.Attachments()
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	slackAttachment := attachments[0]
+	initialAttachment, err := json.Marshal(slackAttachment)
+	if err != nil {
+		return err
+	}
+
+	update(slackAttachment)
+
+	finalAttachment, err := json.Marshal(slackAttachment)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(initialAttachment, finalAttachment) {
+		return nil
+	}
+
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{slackAttachment})
+	if _, appErr := p.API.UpdatePost(post); appErr != nil {
+		return appErr
+	}
+
+	return nil
+}
+
+// getApprovalOfActions returns the request name, organization and ID of the approval of the approve and reject actions of a notification
+func getApprovalOfActions(actions []*model.PostAction) (string, string, string) {
+	if len(actions) == 0 || actions[0].Integration == nil {
+		return "", "", ""
+	}
+
+	context := actions[0].Integration.Context
+	requestName, _ := context[constants.PipelineRequestContextRequestName].(string)
+	organization, _ := context[constants.PipelineRequestContextOrganization].(string)
+	return requestName, organization, getApprovalID(context[constants.PipelineRequestContextApprovalID])
+}
+
+// getApprovalID returns the ID of a release or run approval as a string, the IDs of release approvals are numbers
+func getApprovalID(approvalID interface{}) string {
+	switch approvalID := approvalID.(type) {
+	case string:
+		return approvalID
+	case float64:
+		return strconv.FormatFloat(approvalID, 'f', -1, 64)
+	}
+
+	return ""
+}
@@ -0,0 +1,197 @@
+package plugin
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestSendApprovalCards(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description        string
+		attachment         *model.SlackAttachment
+		approval           serializers.Approval
+		sentApprovers      map[string]bool
+		expectedApprovalID string
+		expectedCards      []string
+	}{
+		{
+			description: "SendApprovalCards: approvers of a run stage and the members of its approver groups are sent a card once",
+			attachment:  testutils.GetMockApprovalAttachment(constants.PipelineRequestNameRun, "mockApprovalID"),
+			approval: serializers.Approval{
+				Steps: []*serializers.ApprovalStep{
+					{AssignedApprover: serializers.Approver{ID: "mockFirstApprover"}},
+					{AssignedApprover: serializers.Approver{ID: "mockGroup"}},
+					{AssignedApprover: serializers.Approver{ID: "mockUnknownApprover"}},
+				},
+			},
+			expectedApprovalID: "mockApprovalID",
+			expectedCards:      []string{"mockFirstChannelID", "mockSecondChannelID"},
+		},
+		{
+			description: "SendApprovalCards: approvers who are already sent a card for the event are skipped",
+			attachment:  testutils.GetMockApprovalAttachment(constants.PipelineRequestNameRun, "mockApprovalID"),
+			approval: serializers.Approval{
+				Steps: []*serializers.ApprovalStep{
+					{AssignedApprover: serializers.Approver{ID: "mockFirstApprover"}},
+					{AssignedApprover: serializers.Approver{ID: "mockGroup"}},
+				},
+			},
+			sentApprovers:      map[string]bool{"mockFirstUserID": true},
+			expectedApprovalID: "mockApprovalID",
+			expectedCards:      []string{"mockSecondChannelID"},
+		},
+		{
+			description:        "SendApprovalCards: approver of a release deployment is sent a card",
+			attachment:         testutils.GetMockApprovalAttachment(constants.PipelineRequestNameRelease, float64(1234)),
+			approval:           serializers.Approval{Approver: serializers.Approver{ID: "mockFirstApprover"}},
+			expectedApprovalID: "1234",
+			expectedCards:      []string{"mockFirstChannelID"},
+		},
+		{
+			description: "SendApprovalCards: notification without approval actions",
+			attachment:  &model.SlackAttachment{Title: "mockTitle"},
+			approval:    serializers.Approval{Approver: serializers.Approver{ID: "mockFirstApprover"}},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+			requestName, _, _ := getApprovalOfActions(testCase.attachment.Actions)
+			if testCase.expectedApprovalID != "" {
+				mockedStore.EXPECT().AddApprovalPost(requestName, testutils.MockOrganization, testCase.expectedApprovalID, "mockPostID").Return(nil)
+				mockedStore.EXPECT().GetAllSubscriptions("").Return(testutils.GetSuscriptionDetailsPayload(testutils.MockMattermostUserID, "", ""), nil)
+				mockedStore.EXPECT().MarkPersonalNotificationSent("mockEventID", constants.ApprovalCardNotificationKind, gomock.Any()).DoAndReturn(func(_, _, mattermostUserID string) (bool, error) {
+					return !testCase.sentApprovers[mattermostUserID], nil
+				}).AnyTimes()
+				mockedStore.EXPECT().AddApprovalPost(requestName, testutils.MockOrganization, testCase.expectedApprovalID, "mockCardPostID").Return(nil).Times(len(testCase.expectedCards))
+			}
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetMattermostUserIDForAzureIdentity", func(_ *Plugin, identity *serializers.UserID) string {
+				return map[string]string{"mockFirstApprover": "mockFirstUserID", "mockSecondApprover": "mockSecondUserID"}[identity.ID]
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetAzureGroupMembers", func(_ *Plugin, organization, groupID, mattermostUserID string) []*serializers.UserID {
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, testutils.MockMattermostUserID, mattermostUserID)
+				if groupID != "mockGroup" {
+					return nil
+				}
+
+				return []*serializers.UserID{{ID: "mockFirstApprover"}, {ID: "mockSecondApprover"}}
+			})
+
+			mockAPI.On("GetDirectChannel", "mockFirstUserID", mock.AnythingOfType("string")).Return(&model.Channel{Id: "mockFirstChannelID"}, nil)
+			mockAPI.On("GetDirectChannel", "mockSecondUserID", mock.AnythingOfType("string")).Return(&model.Channel{Id: "mockSecondChannelID"}, nil)
+
+			var cards []string
+			mockAPI.On("CreatePost", mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				card := args.Get(0).(*model.Post)
+				assert.Equal(t, constants.ApprovalCardMessage, card.Message)
+				assert.Equal(t, "mockTitle", card.Attachments()[0].Title)
+				cards = append(cards, card.ChannelId)
+			}).Return(&model.Post{Id: "mockCardPostID"}, nil)
+
+			p.SendApprovalCards(&serializers.SubscriptionNotification{
+				ID:             "mockEventID",
+				SubscriptionID: testutils.MockSubscriptionID,
+				Resource:       serializers.Resource{Approval: testCase.approval},
+			}, testCase.attachment, "mockPostID")
+
+			assert.Equal(t, testCase.expectedCards, cards)
+		})
+	}
+}
+
+func TestGetAzureGroupMembers(t *testing.T) {
+	for _, testCase := range []struct {
+		description     string
+		identityList    *serializers.IdentityList
+		err             error
+		memberList      *serializers.IdentityList
+		expectedMembers []*serializers.UserID
+	}{
+		{
+			description: "GetAzureGroupMembers: members of a group are returned without the nested groups",
+			identityList: &serializers.IdentityList{Value: []*serializers.Identity{
+				{ID: "mockGroup", IsContainer: true, Members: []string{"mockUserDescriptor", "mockGroupDescriptor"}},
+			}},
+			memberList: &serializers.IdentityList{Value: []*serializers.Identity{
+				{ID: "mockUser", ProviderDisplayName: "mockUserName", Properties: serializers.IdentityProperties{Mail: serializers.IdentityProperty{Value: "mockuser@example.com"}}},
+				{ID: "mockNestedGroup", IsContainer: true},
+			}},
+			expectedMembers: []*serializers.UserID{{ID: "mockUser", DisplayName: "mockUserName", UniqueName: "mockuser@example.com"}},
+		},
+		{
+			description: "GetAzureGroupMembers: identity is not a group",
+			identityList: &serializers.IdentityList{Value: []*serializers.Identity{
+				{ID: "mockUser"},
+			}},
+		},
+		{
+			description: "GetAzureGroupMembers: identity can not be fetched",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 5)...)
+			mockedClient.EXPECT().GetIdentityWithMembers(testutils.MockOrganization, "mockGroup", testutils.MockMattermostUserID).Return(testCase.identityList, 0, testCase.err)
+			if testCase.memberList != nil {
+				mockedClient.EXPECT().GetIdentitiesByDescriptors(testutils.MockOrganization, []string{"mockUserDescriptor", "mockGroupDescriptor"}, testutils.MockMattermostUserID).Return(testCase.memberList, 0, nil)
+			}
+
+			members := p.GetAzureGroupMembers(testutils.MockOrganization, "mockGroup", testutils.MockMattermostUserID)
+
+			assert.Equal(t, testCase.expectedMembers, members)
+		})
+	}
+}
+
+func TestUpdateApprovalPosts(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	mockCtrl := gomock.NewController(t)
+	mockedStore := mocks.NewMockKVStore(mockCtrl)
+	p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+	getMockPost := func(postID, title string) *model.Post {
+		post := &model.Post{Id: postID}
+		model.ParseSlackAttachment(post, []*model.SlackAttachment{{Title: title}})
+		return post
+	}
+
+	mockedStore.EXPECT().GetApprovalPosts(constants.PipelineRequestNameRun, testutils.MockOrganization, "mockApprovalID").Return([]string{"mockPostID", "mockCardPostID", "mockUpdatedCardPostID"}, nil)
+	mockAPI.On("GetPost", "mockCardPostID").Return(getMockPost("mockCardPostID", "mockTitle"), nil)
+	mockAPI.On("GetPost", "mockUpdatedCardPostID").Return(getMockPost("mockUpdatedCardPostID", "mockUpdatedTitle"), nil)
+	mockAPI.On("UpdatePost", mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+		post := args.Get(0).(*model.Post)
+		assert.Equal(t, "mockCardPostID", post.Id)
+		assert.Equal(t, "mockUpdatedTitle", post.Attachments()[0].Title)
+	}).Once().Return(&model.Post{}, nil)
+
+	// The post the approval is updated from is skipped and the posts which are not changed are not updated
+	p.UpdateApprovalPosts(constants.PipelineRequestNameRun, testutils.MockOrganization, "mockApprovalID", "mockPostID", func(slackAttachment *model.SlackAttachment) {
+		slackAttachment.Title = "mockUpdatedTitle"
+	})
+
+	mockAPI.AssertExpectations(t)
+}
@@ -0,0 +1,128 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/shared/filestore"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// AttachPostFilesToWorkItem uploads the files of the post to Azure DevOps and attaches them to the work item.
+// The size of all the files is checked before uploading any of them, so that either all or none of the files are attached.
+// The files are read from the file storage of the server in chunks while they are uploaded, instead of being loaded into memory.
+func (p *Plugin) AttachPostFilesToWorkItem(mattermostUserID, postID, organization, projectName, workItemID string) ([]*serializers.AttachedFile, *serializers.TaskValue, int, error) {
+	post, statusCode, err := p.getPostForUser(mattermostUserID, postID)
+	if err != nil {
+		return nil, nil, statusCode, err
+	}
+
+	if len(post.FileIds) == 0 {
+		return nil, nil, http.StatusBadRequest, errors.New(constants.PostHasNoFiles)
+	}
+
+	task, statusCode, err := p.Client.GetTask(organization, workItemID, projectName, mattermostUserID)
+	if err != nil {
+		return nil, nil, statusCode, err
+	}
+
+	fileInfos := make([]*model.FileInfo, 0, len(post.FileIds))
+	for _, fileID := range post.FileIds {
+		fileInfo, appErr := p.API.GetFileInfo(fileID)
+		if appErr != nil {
+			return nil, nil, appErr.StatusCode, appErr
+		}
+
+		if fileInfo.Size > constants.MaxAttachmentSizeInBytes {
+			return nil, nil, http.StatusBadRequest, fmt.Errorf(constants.FileTooLarge, fileInfo.Name, constants.MaxAttachmentSizeInBytes/(1024*1024))
+		}
+
+		fileInfos = append(fileInfos, fileInfo)
+	}
+
+	attachedFiles := make([]*serializers.AttachedFile, 0, len(fileInfos))
+	relations := make([]*serializers.WorkItemRelation, 0, len(fileInfos))
+	for _, fileInfo := range fileInfos {
+		attachment, statusCode, err := p.uploadPostFile(fileInfo, organization, projectName, mattermostUserID)
+		if err != nil {
+			return nil, nil, statusCode, err
+		}
+
+		relations = append(relations, &serializers.WorkItemRelation{
+			Rel: constants.RelationTypeAttachedFile,
+			URL: attachment.URL,
+			Attributes: map[string]interface{}{
+				"comment": constants.AttachedFileRelationComment,
+			},
+		})
+		attachedFiles = append(attachedFiles, &serializers.AttachedFile{
+			FileName: fileInfo.Name,
+			Size:     fileInfo.Size,
+			URL:      attachment.URL,
+		})
+	}
+
+	if statusCode, err = p.Client.AddWorkItemRelations(organization, projectName, workItemID, relations, mattermostUserID); err != nil {
+		return nil, nil, statusCode, err
+	}
+
+	return attachedFiles, task, http.StatusOK, nil
+}
+
+// FileReader reads the content of a file in ranges
+type FileReader interface {
+	io.ReaderAt
+	io.Closer
+}
+
+// OpenPostFile opens a file of a post in the file storage of the server, so that it can be read in ranges.
+// The plugin runs in the working directory of the server, so a relative directory of the local file storage points to the same files.
+func (p *Plugin) OpenPostFile(fileInfo *model.FileInfo) (FileReader, error) {
+	license := p.API.GetLicense()
+	isComplianceEnabled := license != nil && license.Features != nil && license.Features.Compliance != nil && *license.Features.Compliance
+	fileSettings := p.API.GetUnsanitizedConfig().FileSettings
+	backend, err := filestore.NewFileBackend(fileSettings.ToFileBackendSettings(isComplianceEnabled))
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := backend.Reader(fileInfo.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	fileReader, ok := reader.(FileReader)
+	if !ok {
+		_ = reader.Close()
+		return nil, fmt.Errorf(constants.FileNotReadableInRanges, fileInfo.Name)
+	}
+
+	return fileReader, nil
+}
+
+// uploadPostFile uploads a file of a post to Azure DevOps, the file is read in chunks by the uploader
+func (p *Plugin) uploadPostFile(fileInfo *model.FileInfo, organization, projectName, mattermostUserID string) (*serializers.WorkItemAttachment, int, error) {
+	file, err := p.OpenPostFile(fileInfo)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	defer file.Close()
+
+	return p.Client.UploadWorkItemAttachment(organization, projectName, fileInfo.Name, file, fileInfo.Size, mattermostUserID)
+}
+
+// getAttachedFilesMessage returns the message shown to the user after attaching files to a work item
+func getAttachedFilesMessage(attachedFiles []*serializers.AttachedFile, workItemID string, task *serializers.TaskValue) string {
+	fileNames := make([]string, 0, len(attachedFiles))
+	for _, attachedFile := range attachedFiles {
+		fileNames = append(fileNames, fmt.Sprintf("`%s`", attachedFile.FileName))
+	}
+
+	return fmt.Sprintf(constants.FilesAttached, len(attachedFiles), workItemID, task.Link.HTML.Href, strings.Join(fileNames, ", "))
+}
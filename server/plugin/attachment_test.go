@@ -0,0 +1,136 @@
+package plugin
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+type mockFileReader struct {
+	*bytes.Reader
+}
+
+func (r *mockFileReader) Close() error {
+	return nil
+}
+
+func TestAttachPostFilesToWorkItem(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description        string
+		fileIDs            []string
+		fileSize           int64
+		openError          error
+		uploadError        error
+		expectUpload       bool
+		expectRelations    bool
+		expectedStatusCode int
+	}{
+		{
+			description:        "AttachPostFilesToWorkItem: files are attached",
+			fileIDs:            []string{"mockFileID1", "mockFileID2"},
+			fileSize:           4,
+			expectUpload:       true,
+			expectRelations:    true,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "AttachPostFilesToWorkItem: post has no files",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:        "AttachPostFilesToWorkItem: file is too large",
+			fileIDs:            []string{"mockFileID1", "mockFileID2"},
+			fileSize:           constants.MaxAttachmentSizeInBytes + 1,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:        "AttachPostFilesToWorkItem: failed to open a file",
+			fileIDs:            []string{"mockFileID1", "mockFileID2"},
+			fileSize:           4,
+			openError:          errors.New("unable to open the file"),
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			description:        "AttachPostFilesToWorkItem: failed to upload a file",
+			fileIDs:            []string{"mockFileID1", "mockFileID2"},
+			fileSize:           4,
+			uploadError:        errors.New("failed to upload the attachment"),
+			expectUpload:       true,
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+
+			mockAPI.On("GetPost", "mockPostID").Return(&model.Post{Id: "mockPostID", ChannelId: testutils.MockChannelID, FileIds: testCase.fileIDs}, nil)
+			mockAPI.On("GetChannelMember", testutils.MockChannelID, testutils.MockMattermostUserID).Return(&model.ChannelMember{}, nil)
+			for _, fileID := range testCase.fileIDs {
+				mockAPI.On("GetFileInfo", fileID).Return(&model.FileInfo{Id: fileID, Name: fileID + ".png", Size: testCase.fileSize}, nil)
+			}
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "OpenPostFile", func(_ *Plugin, fileInfo *model.FileInfo) (FileReader, error) {
+				assert.Contains(t, testCase.fileIDs, fileInfo.Id)
+				if testCase.openError != nil {
+					return nil, testCase.openError
+				}
+
+				return &mockFileReader{Reader: bytes.NewReader([]byte("file"))}, nil
+			})
+
+			if len(testCase.fileIDs) > 0 {
+				task := &serializers.TaskValue{}
+				task.Link.HTML.Href = "mockWorkItemURL"
+				mockedClient.EXPECT().GetTask(testutils.MockOrganization, "1", testutils.MockProjectName, testutils.MockMattermostUserID).Return(task, http.StatusOK, nil)
+			}
+
+			if testCase.expectUpload {
+				mockedClient.EXPECT().UploadWorkItemAttachment(testutils.MockOrganization, testutils.MockProjectName, gomock.Any(), gomock.Any(), int64(4), testutils.MockMattermostUserID).DoAndReturn(
+					func(_, _, fileName string, content io.ReaderAt, size int64, _ string) (*serializers.WorkItemAttachment, int, error) {
+						data := make([]byte, size)
+						_, _ = content.ReadAt(data, 0)
+						assert.True(t, bytes.Equal([]byte("file"), data))
+						return &serializers.WorkItemAttachment{ID: fileName, URL: "mockURL/" + fileName}, testCase.expectedStatusCode, testCase.uploadError
+					}).MinTimes(1)
+			}
+
+			if testCase.expectRelations {
+				mockedClient.EXPECT().AddWorkItemRelations(testutils.MockOrganization, testutils.MockProjectName, "1", []*serializers.WorkItemRelation{
+					{Rel: constants.RelationTypeAttachedFile, URL: "mockURL/mockFileID1.png", Attributes: map[string]interface{}{"comment": constants.AttachedFileRelationComment}},
+					{Rel: constants.RelationTypeAttachedFile, URL: "mockURL/mockFileID2.png", Attributes: map[string]interface{}{"comment": constants.AttachedFileRelationComment}},
+				}, testutils.MockMattermostUserID).Return(http.StatusOK, nil)
+			}
+
+			attachedFiles, task, statusCode, err := p.AttachPostFilesToWorkItem(testutils.MockMattermostUserID, "mockPostID", testutils.MockOrganization, testutils.MockProjectName, "1")
+
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			if testCase.expectedStatusCode != http.StatusOK {
+				assert.NotNil(t, err)
+				assert.Nil(t, attachedFiles)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, attachedFiles, 2)
+			assert.Equal(t, "mockWorkItemURL", task.Link.HTML.Href)
+			assert.Equal(t, "Attached 2 file(s) to work item [#1](mockWorkItemURL): `mockFileID1.png`, `mockFileID2.png`", getAttachedFilesMessage(attachedFiles, "1", task))
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// AddBuildFailureDetails adds the failed tasks with their first errors and the summary of the tests of a failed build to its notification,
+// along with the action to show the log tail of the first failed task.
+// The details are fetched using the Azure DevOps account of the user who added the subscription and are omitted if they can not be fetched.
+func (p *Plugin) AddBuildFailureDetails(attachment *model.SlackAttachment, subscriptionID string, buildID int) {
+	subscription, err := p.getSubscriptionDetails(subscriptionID)
+	if err != nil {
+		p.API.LogError(constants.FetchSubscriptionListError, "Error", err.Error())
+		return
+	}
+
+	if subscription == nil || buildID == 0 {
+		return
+	}
+
+	organization, project, mattermostUserID := subscription.OrganizationName, subscription.ProjectID, subscription.MattermostUserID
+	timeline, _, err := p.Client.GetBuildTimeline(organization, project, buildID, mattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchBuildTimeline, "SubscriptionID", subscriptionID, "Error", err.Error())
+	} else if failedTasks, firstFailedTask := getBuildFailedTasks(timeline); failedTasks != "" {
+		attachment.Fields = append(attachment.Fields, &model.SlackAttachmentField{
+			Title: constants.BuildFailedTasksFieldTitle,
+			Value: failedTasks,
+		})
+
+		if firstFailedTask.Log != nil {
+			attachment.Actions = append(attachment.Actions, p.getBuildLogTailAction(organization, project, buildID, firstFailedTask))
+		}
+	}
+
+	if testSummary := p.getBuildTestSummary(organization, project, buildID, mattermostUserID); testSummary != "" {
+		attachment.Fields = append(attachment.Fields, &model.SlackAttachmentField{
+			Title: constants.BuildTestsFieldTitle,
+			Value: testSummary,
+		})
+	}
+}
+
+// getBuildFailedTasks returns the list of the failed tasks of a build with their jobs and first errors, and the first failed task.
+// The failed jobs are listed instead when none of their tasks failed e.g. when the agent of the job was lost.
+func getBuildFailedTasks(timeline *serializers.BuildTimeline) (string, *serializers.TimelineRecord) {
+	jobNames := map[string]string{}
+	var failedTasks, failedJobs []*serializers.TimelineRecord
+	for _, record := range timeline.Records {
+		if record.Type == constants.TimelineRecordTypeJob {
+			jobNames[record.ID] = record.Name
+		}
+
+		if record.Result != constants.BuildResultFailed {
+			continue
+		}
+
+		switch record.Type {
+		case constants.TimelineRecordTypeTask:
+			failedTasks = append(failedTasks, record)
+		case constants.TimelineRecordTypeJob:
+			failedJobs = append(failedJobs, record)
+		}
+	}
+
+	if len(failedTasks) == 0 {
+		failedTasks = failedJobs
+	}
+
+	if len(failedTasks) == 0 {
+		return "", nil
+	}
+
+	var lines []string
+	for index, record := range failedTasks {
+		if index == constants.MaxBuildFailedTasks {
+			lines = append(lines, fmt.Sprintf(constants.BuildMoreFailedTasks, len(failedTasks)-index))
+			break
+		}
+
+		name := record.Name
+		if jobName := jobNames[record.ParentID]; record.Type == constants.TimelineRecordTypeTask && jobName != "" {
+			name = fmt.Sprintf("%s / %s", jobName, record.Name)
+		}
+
+		line := fmt.Sprintf(constants.BuildFailedTask, name)
+		if message := record.GetFirstError(); message != "" {
+			// Only the first line of the error is shown, without backticks breaking its code span
+			message = strings.ReplaceAll(strings.TrimSpace(strings.Split(message, "\n")[0]), "`", "'")
+			line += fmt.Sprintf(constants.BuildFailedTaskError, truncateText(message, constants.MaxBuildErrorMessageLength))
+		}
+
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n"), failedTasks[0]
+}
+
+// getBuildTestSummary returns the total, failed and flaky counts of the tests of a build followed by the names of the first failed tests
+func (p *Plugin) getBuildTestSummary(organization, project string, buildID int, mattermostUserID string) string {
+	testRuns, _, err := p.Client.GetTestRuns(organization, project, buildID, mattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchBuildTestRuns, "Error", err.Error())
+		return ""
+	}
+
+	if len(testRuns.Value) == 0 {
+		return ""
+	}
+
+	total, failed, flaky := 0, 0, 0
+	for _, testRun := range testRuns.Value {
+		total += testRun.TotalTests
+		failed += testRun.GetCount(constants.TestOutcomeFailed, "")
+		flaky += testRun.GetCount("", constants.TestResultMetadataFlaky)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(constants.BuildTestSummary, total, failed, flaky))
+	failedTestNames := 0
+	for _, testRun := range testRuns.Value {
+		if failedTestNames >= constants.MaxBuildFailedTestNames {
+			break
+		}
+
+		if testRun.GetCount(constants.TestOutcomeFailed, "") == 0 {
+			continue
+		}
+
+		testResults, _, err := p.Client.GetFailedTestResults(organization, project, testRun.ID, constants.MaxBuildFailedTestNames-failedTestNames, mattermostUserID)
+		if err != nil {
+			p.API.LogError(constants.ErrorFetchBuildTestRuns, "Error", err.Error())
+			break
+		}
+
+		for _, testResult := range testResults.Value {
+			if failedTestNames >= constants.MaxBuildFailedTestNames {
+				break
+			}
+
+			sb.WriteString(fmt.Sprintf(constants.BuildFailedTest, testResult.GetName()))
+			failedTestNames++
+		}
+	}
+
+	return sb.String()
+}
+
+// getBuildLogTailAction returns the button to show the last lines of the log of a failed task
+func (p *Plugin) getBuildLogTailAction(organization, project string, buildID int, task *serializers.TimelineRecord) *model.PostAction {
+	return &model.PostAction{
+		Id:   "showBuildLogTail",
+		Type: model.POST_ACTION_TYPE_BUTTON,
+		Name: "Show log tail",
+		Integration: &model.PostActionIntegration{
+			URL: fmt.Sprintf("%s%s", p.GetPluginURL(), constants.PathBuildLogTail),
+			Context: map[string]interface{}{
+				constants.PipelineRunContextOrganization: organization,
+				constants.PipelineRunContextProject:      project,
+				constants.PipelineRunContextBuildID:      buildID,
+				constants.BuildLogContextLogID:           task.Log.ID,
+				constants.BuildLogContextTaskName:        task.Name,
+			},
+		},
+	}
+}
+
+// GetBuildLogTail returns the last lines of a log of a build
+func (p *Plugin) GetBuildLogTail(mattermostUserID, organization, project string, buildID, logID int) ([]string, int, error) {
+	buildLogList, statusCode, err := p.Client.GetBuildLogs(organization, project, buildID, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	lineCount := 0
+	for _, buildLog := range buildLogList.Value {
+		if buildLog.ID == logID {
+			lineCount = buildLog.LineCount
+			break
+		}
+	}
+
+	if lineCount == 0 {
+		return nil, http.StatusOK, nil
+	}
+
+	startLine := lineCount - constants.MaxBuildLogTailLines + 1
+	if startLine < 1 {
+		startLine = 1
+	}
+
+	buildLogLines, statusCode, err := p.Client.GetBuildLogLines(organization, project, buildID, logID, startLine, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	return buildLogLines.Value, statusCode, nil
+}
+
+// getBuildLogTailMessage returns the message showing the last lines of the log of a task, keeping the end of the lines if they are too long for the message
+func getBuildLogTailMessage(taskName string, lines []string) string {
+	logTail := strings.Join(lines, "\n")
+	if runes := []rune(logTail); len(runes) > constants.MaxBuildLogTailMessageLength {
+		logTail = "…" + string(runes[len(runes)-constants.MaxBuildLogTailMessageLength:])
+	}
+
+	return fmt.Sprintf(constants.BuildLogTail, len(lines), taskName, logTail)
+}
@@ -0,0 +1,312 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestGetBuildFailedTasks(t *testing.T) {
+	manyFailedTasks := &serializers.BuildTimeline{}
+	for i := 1; i <= 7; i++ {
+		manyFailedTasks.Records = append(manyFailedTasks.Records, &serializers.TimelineRecord{Type: constants.TimelineRecordTypeTask, Name: fmt.Sprintf("Task %d", i), Result: constants.BuildResultFailed})
+	}
+
+	for _, testCase := range []struct {
+		description       string
+		timeline          *serializers.BuildTimeline
+		expectedTasks     string
+		expectedFirstTask string
+	}{
+		{
+			description:       "GetBuildFailedTasks: failed task with its job and first error",
+			timeline:          testutils.GetMockBuildTimeline(),
+			expectedTasks:     "- **Linux / Run tests**: `Process exited with 'code' 1`",
+			expectedFirstTask: "Run tests",
+		},
+		{
+			description: "GetBuildFailedTasks: failed job without failed tasks",
+			timeline: &serializers.BuildTimeline{
+				Records: []*serializers.TimelineRecord{
+					{ID: "mockJobID", Type: constants.TimelineRecordTypeJob, Name: "Linux", Result: constants.BuildResultFailed, Issues: []*serializers.TimelineIssue{{Type: constants.TimelineIssueTypeError, Message: "The agent was lost"}}},
+					{ParentID: "mockJobID", Type: constants.TimelineRecordTypeTask, Name: "Checkout", Result: "succeeded"},
+				},
+			},
+			expectedTasks:     "- **Linux**: `The agent was lost`",
+			expectedFirstTask: "Linux",
+		},
+		{
+			description:       "GetBuildFailedTasks: number of failed tasks is limited",
+			timeline:          manyFailedTasks,
+			expectedTasks:     "- **Task 1**\n- **Task 2**\n- **Task 3**\n- **Task 4**\n- **Task 5**\n- and 2 more",
+			expectedFirstTask: "Task 1",
+		},
+		{
+			description: "GetBuildFailedTasks: no failed tasks",
+			timeline:    &serializers.BuildTimeline{Records: []*serializers.TimelineRecord{{Type: constants.TimelineRecordTypeTask, Name: "Checkout", Result: "succeeded"}}},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			failedTasks, firstFailedTask := getBuildFailedTasks(testCase.timeline)
+
+			assert.Equal(t, testCase.expectedTasks, failedTasks)
+			if testCase.expectedFirstTask == "" {
+				assert.Nil(t, firstFailedTask)
+				return
+			}
+
+			require.NotNil(t, firstFailedTask)
+			assert.Equal(t, testCase.expectedFirstTask, firstFailedTask.Name)
+		})
+	}
+}
+
+func TestAddBuildFailureDetails(t *testing.T) {
+	testRuns := &serializers.TestRunList{
+		Value: []*serializers.TestRun{
+			{
+				ID:         1,
+				TotalTests: 10,
+				RunStatistics: []*serializers.TestRunStatistic{
+					{Outcome: "Passed", Count: 8},
+					{Outcome: "Passed", Count: 1, ResultMetadata: constants.TestResultMetadataFlaky},
+					{Outcome: constants.TestOutcomeFailed, Count: 1},
+				},
+			},
+			{ID: 2, TotalTests: 5, RunStatistics: []*serializers.TestRunStatistic{{Outcome: "Passed", Count: 5}}},
+		},
+	}
+
+	for _, testCase := range []struct {
+		description     string
+		subscriptions   []*serializers.SubscriptionDetails
+		timelineErr     error
+		testRunsErr     error
+		expectedFields  []*model.SlackAttachmentField
+		expectedActions int
+	}{
+		{
+			description:   "AddBuildFailureDetails: failed tasks and tests are added",
+			subscriptions: []*serializers.SubscriptionDetails{{SubscriptionID: "mockSubscriptionID", MattermostUserID: testutils.MockMattermostUserID, OrganizationName: testutils.MockOrganization, ProjectID: testutils.MockProjectID}},
+			expectedFields: []*model.SlackAttachmentField{
+				{Title: constants.BuildFailedTasksFieldTitle, Value: "- **Linux / Run tests**: `Process exited with 'code' 1`"},
+				{Title: constants.BuildTestsFieldTitle, Value: "15 total, 1 failed, 1 flaky\n- `mockTestCase`"},
+			},
+			expectedActions: 1,
+		},
+		{
+			description:   "AddBuildFailureDetails: details which can not be fetched are omitted",
+			subscriptions: []*serializers.SubscriptionDetails{{SubscriptionID: "mockSubscriptionID", MattermostUserID: testutils.MockMattermostUserID, OrganizationName: testutils.MockOrganization, ProjectID: testutils.MockProjectID}},
+			timelineErr:   errors.New("failed to get the timeline of the build"),
+			testRunsErr:   errors.New("failed to get the test runs of the build"),
+		},
+		{
+			description: "AddBuildFailureDetails: subscription is not found",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, mockedClient)
+
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 5)...)
+			mockedStore.EXPECT().GetAllSubscriptions("").Return(testCase.subscriptions, nil)
+			if len(testCase.subscriptions) > 0 {
+				mockedClient.EXPECT().GetBuildTimeline(testutils.MockOrganization, testutils.MockProjectID, 1, testutils.MockMattermostUserID).Return(testutils.GetMockBuildTimeline(), http.StatusOK, testCase.timelineErr)
+				mockedClient.EXPECT().GetTestRuns(testutils.MockOrganization, testutils.MockProjectID, 1, testutils.MockMattermostUserID).Return(testRuns, http.StatusOK, testCase.testRunsErr)
+				if testCase.testRunsErr == nil {
+					mockedClient.EXPECT().GetFailedTestResults(testutils.MockOrganization, testutils.MockProjectID, 1, constants.MaxBuildFailedTestNames, testutils.MockMattermostUserID).Return(&serializers.TestResultList{
+						Value: []*serializers.TestResult{{ID: 1, TestCaseTitle: "mockTestCase", Outcome: constants.TestOutcomeFailed}},
+					}, http.StatusOK, nil)
+				}
+			}
+
+			attachment := &model.SlackAttachment{}
+			p.AddBuildFailureDetails(attachment, "mockSubscriptionID", 1)
+
+			assert.Equal(t, testCase.expectedFields, attachment.Fields)
+			require.Len(t, attachment.Actions, testCase.expectedActions)
+			if testCase.expectedActions > 0 {
+				assert.Equal(t, 7, attachment.Actions[0].Integration.Context[constants.BuildLogContextLogID])
+				assert.Equal(t, "Run tests", attachment.Actions[0].Integration.Context[constants.BuildLogContextTaskName])
+			}
+		})
+	}
+}
+
+func TestGetBuildLogTail(t *testing.T) {
+	for _, testCase := range []struct {
+		description       string
+		buildLogs         *serializers.BuildLogList
+		expectedStartLine int
+		expectedLines     []string
+	}{
+		{
+			description:       "GetBuildLogTail: last lines of a long log",
+			buildLogs:         &serializers.BuildLogList{Value: []*serializers.BuildLog{{ID: 6, LineCount: 10}, {ID: 7, LineCount: 100}}},
+			expectedStartLine: 71,
+			expectedLines:     []string{"mockLine"},
+		},
+		{
+			description:       "GetBuildLogTail: all the lines of a short log",
+			buildLogs:         &serializers.BuildLogList{Value: []*serializers.BuildLog{{ID: 7, LineCount: 10}}},
+			expectedStartLine: 1,
+			expectedLines:     []string{"mockLine"},
+		},
+		{
+			description: "GetBuildLogTail: log is not found",
+			buildLogs:   &serializers.BuildLogList{Value: []*serializers.BuildLog{{ID: 6, LineCount: 10}}},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+
+			mockedClient.EXPECT().GetBuildLogs(testutils.MockOrganization, testutils.MockProjectName, 1, testutils.MockMattermostUserID).Return(testCase.buildLogs, http.StatusOK, nil)
+			if testCase.expectedStartLine != 0 {
+				mockedClient.EXPECT().GetBuildLogLines(testutils.MockOrganization, testutils.MockProjectName, 1, 7, testCase.expectedStartLine, testutils.MockMattermostUserID).Return(&serializers.BuildLogLines{Value: []string{"mockLine"}}, http.StatusOK, nil)
+			}
+
+			lines, statusCode, err := p.GetBuildLogTail(testutils.MockMattermostUserID, testutils.MockOrganization, testutils.MockProjectName, 1, 7)
+
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, statusCode)
+			assert.Equal(t, testCase.expectedLines, lines)
+		})
+	}
+}
+
+func TestGetBuildLogTailMessage(t *testing.T) {
+	message := getBuildLogTailMessage("Run tests", []string{"line 1", "line 2"})
+	assert.Equal(t, "Last 2 lines of the log of **Run tests**:\n```\nline 1\nline 2\n```", message)
+
+	message = getBuildLogTailMessage("Run tests", []string{strings.Repeat("a", constants.MaxBuildLogTailMessageLength), "end"})
+	assert.Contains(t, message, "…"+strings.Repeat("a", constants.MaxBuildLogTailMessageLength-4)+"\nend\n```")
+}
+
+func TestUpdatePipelineRunPostKeepsOtherActions(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+
+	logTailAction := p.getBuildLogTailAction(testutils.MockOrganization, testutils.MockProjectName, 1, testutils.GetMockBuildTimeline().Records[2])
+	post := &model.Post{Id: "mockPostID"}
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{{
+		Actions: append(p.getPipelineRunActions(testutils.MockOrganization, testutils.MockProjectName, 1, constants.BuildStatusCompleted, constants.BuildResultFailed, ""), logTailAction),
+	}})
+	mockAPI.On("GetPost", "mockPostID").Return(post, nil)
+
+	var updatedPost *model.Post
+	mockAPI.On("UpdatePost", mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+		updatedPost = args.Get(0).(*model.Post)
+	}).Return(&model.Post{}, nil)
+
+	err := p.UpdatePipelineRunPost("mockPostID", testutils.MockOrganization, testutils.MockProjectName, testutils.GetMockBuild(constants.BuildStatusInProgress, ""))
+	require.NoError(t, err)
+	require.NotNil(t, updatedPost)
+
+	actions := updatedPost.Attachments()[0].Actions
+	require.Len(t, actions, 2)
+	assert.Equal(t, "Cancel", actions[0].Name)
+	assert.Equal(t, "Show log tail", actions[1].Name)
+}
+
+func TestHandleShowBuildLogTail(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description           string
+		context               map[string]interface{}
+		lines                 []string
+		statusCode            int
+		err                   error
+		expectedStatusCode    int
+		expectedEphemeralText string
+	}{
+		{
+			description:           "HandleShowBuildLogTail: valid",
+			context:               map[string]interface{}{"organization": "mockOrganization", "project": "mockProjectName", "buildId": 1, "logId": 7, "taskName": "Run tests"},
+			lines:                 []string{"mockLine"},
+			statusCode:            http.StatusOK,
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: "Last 1 lines of the log of **Run tests**:\n```\nmockLine\n```",
+		},
+		{
+			description:           "HandleShowBuildLogTail: empty log",
+			context:               map[string]interface{}{"organization": "mockOrganization", "project": "mockProjectName", "buildId": 1, "logId": 7, "taskName": "Run tests"},
+			statusCode:            http.StatusOK,
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: fmt.Sprintf(constants.BuildLogEmpty, "Run tests"),
+		},
+		{
+			description:           "HandleShowBuildLogTail: user is not permitted",
+			context:               map[string]interface{}{"organization": "mockOrganization", "project": "mockProjectName", "buildId": 1, "logId": 7, "taskName": "Run tests"},
+			statusCode:            http.StatusForbidden,
+			err:                   errors.New("failed to get the logs of the build"),
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: constants.BuildLogNotPermitted,
+		},
+		{
+			description:           "HandleShowBuildLogTail: error while fetching the log",
+			context:               map[string]interface{}{"organization": "mockOrganization", "project": "mockProjectName", "buildId": 1, "logId": 7, "taskName": "Run tests"},
+			statusCode:            http.StatusInternalServerError,
+			err:                   errors.New("failed to get the logs of the build"),
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: constants.GenericErrorMessage,
+		},
+		{
+			description:        "HandleShowBuildLogTail: log ID is missing",
+			context:            map[string]interface{}{"organization": "mockOrganization", "project": "mockProjectName", "buildId": 1, "taskName": "Run tests"},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			p := setupMockPlugin(mockAPI, nil, nil)
+
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetBuildLogTail", func(_ *Plugin, _, _, _ string, _, _ int) ([]string, int, error) {
+				return testCase.lines, testCase.statusCode, testCase.err
+			})
+
+			body, err := json.Marshal(&model.PostActionIntegrationRequest{PostId: "mockPostID", Context: testCase.context})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, constants.PathBuildLogTail, bytes.NewBuffer(body))
+			req.Header.Add(constants.HeaderMattermostUserID, testutils.MockMattermostUserID)
+
+			w := httptest.NewRecorder()
+			p.handleShowBuildLogTail(w, req)
+			assert.Equal(t, testCase.expectedStatusCode, w.Result().StatusCode)
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+
+			response := &model.PostActionIntegrationResponse{}
+			require.NoError(t, json.NewDecoder(w.Result().Body).Decode(response))
+			assert.Equal(t, testCase.expectedEphemeralText, response.EphemeralText)
+		})
+	}
+}
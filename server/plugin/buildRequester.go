@@ -0,0 +1,107 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// NotifyFailedBuildRequester notifies the user who triggered the failed build of a notification if its subscription has the notify filter.
+// The message mentioning the user is returned to be posted with the notification if they are a member of the channel, otherwise they are sent a direct message.
+func (p *Plugin) NotifyFailedBuildRequester(body *serializers.SubscriptionNotification, channelID string) string {
+	switch {
+	case body.EventType == constants.SubscriptionEventBuildCompleted && body.Resource.Result == constants.BuildResultFailed:
+	case body.EventType == constants.SubscriptionEventRunStateChanged && body.Resource.Run.Result == constants.BuildResultFailed:
+	default:
+		return ""
+	}
+
+	subscription, err := p.getSubscriptionDetails(body.SubscriptionID)
+	if err != nil {
+		p.API.LogError(constants.FetchSubscriptionListError, "Error", err.Error())
+		return ""
+	}
+
+	if subscription == nil || subscription.PipelineFilters.IsEmpty() {
+		return ""
+	}
+
+	requester := p.getFailedBuildRequester(body, subscription)
+	if requester == nil {
+		return ""
+	}
+
+	user := p.GetMattermostUserForAzureIdentity(requester)
+	if user == nil {
+		return ""
+	}
+
+	if _, appErr := p.API.GetChannelMember(channelID, user.Id); appErr == nil {
+		return fmt.Sprintf(constants.FailedBuildRequesterMention, user.Username)
+	}
+
+	if body.ID != "" {
+		isNotSent, err := p.Store.MarkPersonalNotificationSent(body.ID, constants.FailedBuildNotificationKind, user.Id)
+		if err != nil {
+			p.API.LogError(constants.ErrorNotifyFailedBuildRequester, "Error", err.Error())
+			return ""
+		}
+
+		if !isNotSent {
+			return ""
+		}
+	}
+
+	if _, err := p.DM(user.Id, constants.FailedBuildRequesterDM, false, body.Message.Markdown); err != nil {
+		p.API.LogError(constants.ErrorNotifyFailedBuildRequester, "Error", err.Error())
+	}
+
+	return ""
+}
+
+// getFailedBuildRequester returns the identity of the user who triggered the build of a notification.
+// The notifications of runs do not contain the requester so the build of the run is fetched using the Azure DevOps account of the user who added the subscription.
+func (p *Plugin) getFailedBuildRequester(body *serializers.SubscriptionNotification, subscription *serializers.SubscriptionDetails) *serializers.UserID {
+	if body.EventType == constants.SubscriptionEventBuildCompleted {
+		return body.Resource.RequestedFor.GetIdentity()
+	}
+
+	organization, project, buildID := getBuildFromWebLink(body.Resource.Run.Links.Web.Href)
+	if buildID == 0 {
+		return nil
+	}
+
+	build, _, err := p.Client.GetBuildDetails(organization, project, strconv.Itoa(buildID), subscription.MattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorNotifyFailedBuildRequester, "SubscriptionID", subscription.SubscriptionID, "Error", err.Error())
+		return nil
+	}
+
+	return build.GetRequester()
+}
+
+// UpdatePipelineFilters sets the pipeline filters of a Pipelines subscription, the filters are removed if they are empty.
+func (p *Plugin) UpdatePipelineFilters(mattermostUserID, subscriptionID string, filters *serializers.PipelineFilters) (*serializers.SubscriptionDetails, int, error) {
+	subscription, statusCode, err := p.getSubscriptionToFilter(mattermostUserID, subscriptionID, constants.CommandPipelines)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	if err = filters.IsValid(subscription.EventType); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	subscription.PipelineFilters = nil
+	if !filters.IsEmpty() {
+		subscription.PipelineFilters = filters
+	}
+
+	if err = p.Store.StoreSubscription(subscription); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return subscription, http.StatusOK, nil
+}
@@ -0,0 +1,269 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestNotifyFailedBuildRequester(t *testing.T) {
+	defer monkey.UnpatchAll()
+	pipelineFilters := &serializers.PipelineFilters{NotifyRequester: true}
+	for _, testCase := range []struct {
+		description       string
+		body              *serializers.SubscriptionNotification
+		subscription      *serializers.SubscriptionDetails
+		expectBuild       bool
+		user              *model.User
+		channelMemberErr  *model.AppError
+		isAlreadySent     bool
+		expectDM          bool
+		expectedMessage   string
+		expectedRequester string
+	}{
+		{
+			description:       "NotifyFailedBuildRequester: requester of a failed build is mentioned",
+			body:              testutils.GetMockFailedBuildNotification(constants.SubscriptionEventBuildCompleted, constants.BuildResultFailed),
+			subscription:      &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", PipelineFilters: pipelineFilters},
+			user:              &model.User{Id: testutils.MockMattermostUserID, Username: "mockUsername"},
+			expectedMessage:   "@mockUsername your build failed.",
+			expectedRequester: "mockAzureDevopsUserID",
+		},
+		{
+			description:       "NotifyFailedBuildRequester: requester who is not a member of the channel is sent a direct message",
+			body:              testutils.GetMockFailedBuildNotification(constants.SubscriptionEventBuildCompleted, constants.BuildResultFailed),
+			subscription:      &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", PipelineFilters: pipelineFilters},
+			user:              &model.User{Id: testutils.MockMattermostUserID, Username: "mockUsername"},
+			channelMemberErr:  &model.AppError{StatusCode: http.StatusNotFound},
+			expectDM:          true,
+			expectedRequester: "mockAzureDevopsUserID",
+		},
+		{
+			description:       "NotifyFailedBuildRequester: direct message is not sent again for the same event",
+			body:              testutils.GetMockFailedBuildNotification(constants.SubscriptionEventBuildCompleted, constants.BuildResultFailed),
+			subscription:      &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", PipelineFilters: pipelineFilters},
+			user:              &model.User{Id: testutils.MockMattermostUserID, Username: "mockUsername"},
+			channelMemberErr:  &model.AppError{StatusCode: http.StatusNotFound},
+			isAlreadySent:     true,
+			expectedRequester: "mockAzureDevopsUserID",
+		},
+		{
+			description:       "NotifyFailedBuildRequester: requester of a failed run is fetched from its build",
+			body:              testutils.GetMockFailedBuildNotification(constants.SubscriptionEventRunStateChanged, constants.BuildResultFailed),
+			subscription:      &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", MattermostUserID: testutils.MockMattermostUserID, PipelineFilters: pipelineFilters},
+			expectBuild:       true,
+			user:              &model.User{Id: testutils.MockMattermostUserID, Username: "mockUsername"},
+			expectedMessage:   "@mockUsername your build failed.",
+			expectedRequester: "mockRequestedByID",
+		},
+		{
+			description:       "NotifyFailedBuildRequester: requester is not mapped to a Mattermost user",
+			body:              testutils.GetMockFailedBuildNotification(constants.SubscriptionEventBuildCompleted, constants.BuildResultFailed),
+			subscription:      &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", PipelineFilters: pipelineFilters},
+			expectedRequester: "mockAzureDevopsUserID",
+		},
+		{
+			description:  "NotifyFailedBuildRequester: subscription does not notify the requester",
+			body:         testutils.GetMockFailedBuildNotification(constants.SubscriptionEventBuildCompleted, constants.BuildResultFailed),
+			subscription: &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID"},
+		},
+		{
+			description: "NotifyFailedBuildRequester: build succeeded",
+			body:        testutils.GetMockFailedBuildNotification(constants.SubscriptionEventBuildCompleted, "succeeded"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, mockedClient)
+
+			if testCase.subscription != nil {
+				mockedStore.EXPECT().GetAllSubscriptions("").Return([]*serializers.SubscriptionDetails{testCase.subscription}, nil)
+			}
+
+			if testCase.expectBuild {
+				build := testutils.GetMockBuild(constants.BuildStatusCompleted, constants.BuildResultFailed)
+				build.RequestedBy = serializers.RequestedBy{ID: "mockRequestedByID", DisplayName: "mockDisplayName"}
+				mockedClient.EXPECT().GetBuildDetails("mockOrganization", "mockProjectName", "1", testutils.MockMattermostUserID).Return(build, http.StatusOK, nil)
+			}
+
+			if testCase.user != nil && testCase.channelMemberErr != nil {
+				mockedStore.EXPECT().MarkPersonalNotificationSent("mockEventID", constants.FailedBuildNotificationKind, testutils.MockMattermostUserID).Return(!testCase.isAlreadySent, nil)
+			}
+
+			mockAPI.On("GetChannelMember", testutils.MockChannelID, testutils.MockMattermostUserID).Return(&model.ChannelMember{}, testCase.channelMemberErr)
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetMattermostUserForAzureIdentity", func(_ *Plugin, identity *serializers.UserID) *model.User {
+				assert.Equal(t, testCase.expectedRequester, identity.ID)
+				return testCase.user
+			})
+
+			isDMSent := false
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "DM", func(_ *Plugin, mattermostUserID, format string, _ bool, args ...interface{}) (string, error) {
+				assert.Equal(t, testutils.MockMattermostUserID, mattermostUserID)
+				assert.Equal(t, "Your build failed: Build [20240101.1](mockBuildURL) failed", fmt.Sprintf(format, args...))
+				isDMSent = true
+				return "mockPostID", nil
+			})
+
+			message := p.NotifyFailedBuildRequester(testCase.body, testutils.MockChannelID)
+
+			assert.Equal(t, testCase.expectedMessage, message)
+			assert.Equal(t, testCase.expectDM, isDMSent)
+		})
+	}
+}
+
+func TestUpdatePipelineFilters(t *testing.T) {
+	for _, testCase := range []struct {
+		description        string
+		subscription       *serializers.SubscriptionDetails
+		filters            *serializers.PipelineFilters
+		expectStore        bool
+		expectedFilters    *serializers.PipelineFilters
+		expectedStatusCode int
+	}{
+		{
+			description:        "UpdatePipelineFilters: filters are updated",
+			subscription:       &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", ServiceType: constants.CommandPipelines, EventType: constants.SubscriptionEventBuildCompleted, ChannelID: testutils.MockChannelID},
+			filters:            &serializers.PipelineFilters{NotifyRequester: true},
+			expectStore:        true,
+			expectedFilters:    &serializers.PipelineFilters{NotifyRequester: true},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "UpdatePipelineFilters: filters are removed",
+			subscription:       &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", ServiceType: constants.CommandPipelines, EventType: constants.SubscriptionEventRunStateChanged, ChannelID: testutils.MockChannelID, PipelineFilters: &serializers.PipelineFilters{NotifyRequester: true}},
+			filters:            &serializers.PipelineFilters{},
+			expectStore:        true,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "UpdatePipelineFilters: not a Pipelines subscription",
+			subscription:       &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", ServiceType: constants.CommandRepos, EventType: constants.SubscriptionEventCodePushed, ChannelID: testutils.MockChannelID},
+			filters:            &serializers.PipelineFilters{NotifyRequester: true},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			description:        "UpdatePipelineFilters: not a build completed or run state changed subscription",
+			subscription:       &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", ServiceType: constants.CommandPipelines, EventType: constants.SubscriptionEventReleaseCreated, ChannelID: testutils.MockChannelID},
+			filters:            &serializers.PipelineFilters{NotifyRequester: true},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+			mockedStore.EXPECT().GetAllSubscriptions("").Return([]*serializers.SubscriptionDetails{testCase.subscription}, nil)
+			mockAPI.On("GetChannelMember", testutils.MockChannelID, testutils.MockMattermostUserID).Return(&model.ChannelMember{}, nil)
+			if testCase.expectStore {
+				mockedStore.EXPECT().StoreSubscription(gomock.Any()).Return(nil)
+			}
+
+			subscription, statusCode, err := p.UpdatePipelineFilters(testutils.MockMattermostUserID, "mockSubscriptionID", testCase.filters)
+
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			if testCase.expectedStatusCode != http.StatusOK {
+				assert.NotNil(t, err)
+				assert.Nil(t, subscription)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedFilters, subscription.PipelineFilters)
+		})
+	}
+}
+
+func TestExecutePipelineFiltersCommand(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	for _, testCase := range []struct {
+		description      string
+		command          string
+		expectedFilters  *serializers.PipelineFilters
+		statusCode       int
+		err              error
+		ephemeralMessage string
+	}{
+		{
+			description:      "PipelineFiltersCommand: filters are not provided",
+			command:          "/azuredevops pipelines subscription filter mockSubscriptionID",
+			ephemeralMessage: constants.PipelineFiltersRequired,
+		},
+		{
+			description:      "PipelineFiltersCommand: invalid filter",
+			command:          "/azuredevops pipelines subscription filter mockSubscriptionID notify=approvers",
+			ephemeralMessage: fmt.Sprintf(constants.InvalidPipelineFilterArgument, "notify=approvers"),
+		},
+		{
+			description:      "PipelineFiltersCommand: filters are updated",
+			command:          "/azuredevops pipelines subscription filter mockSubscriptionID notify=requester",
+			expectedFilters:  &serializers.PipelineFilters{NotifyRequester: true},
+			statusCode:       http.StatusOK,
+			ephemeralMessage: fmt.Sprintf(constants.PipelineFiltersUpdated, "mockSubscriptionID", "requester of failed builds notified"),
+		},
+		{
+			description:      "PipelineFiltersCommand: filters are removed",
+			command:          "/azuredevops pipelines subscription filter mockSubscriptionID clear",
+			expectedFilters:  &serializers.PipelineFilters{},
+			statusCode:       http.StatusOK,
+			ephemeralMessage: fmt.Sprintf(constants.WorkItemFiltersCleared, "mockSubscriptionID"),
+		},
+		{
+			description:      "PipelineFiltersCommand: filters are not supported for the subscription",
+			command:          "/azuredevops pipelines subscription filter mockSubscriptionID notify=requester",
+			expectedFilters:  &serializers.PipelineFilters{NotifyRequester: true},
+			statusCode:       http.StatusBadRequest,
+			err:              errors.New(constants.PipelineFiltersNotSupported),
+			ephemeralMessage: constants.PipelineFiltersNotSupported,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				post := args.Get(1).(*model.Post)
+				assert.Equal(t, testCase.ephemeralMessage, post.Message)
+			}).Once().Return(&model.Post{})
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return true
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdatePipelineFilters", func(_ *Plugin, _, subscriptionID string, filters *serializers.PipelineFilters) (*serializers.SubscriptionDetails, int, error) {
+				assert.Equal(t, "mockSubscriptionID", subscriptionID)
+				assert.Equal(t, testCase.expectedFilters, filters)
+				if testCase.err != nil {
+					return nil, testCase.statusCode, testCase.err
+				}
+
+				subscription := &serializers.SubscriptionDetails{SubscriptionID: subscriptionID}
+				if !filters.IsEmpty() {
+					subscription.PipelineFilters = filters
+				}
+				return subscription, testCase.statusCode, nil
+			})
+
+			_, err := p.ExecuteCommand(nil, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID})
+			assert.Nil(t, err)
+		})
+	}
+}
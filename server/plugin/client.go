@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/mattermost/mattermost-server/v5/model"
@@ -20,7 +21,16 @@ type Client interface {
 	GenerateOAuthToken(encodedFormValues url.Values) (*serializers.OAuthSuccessResponse, int, error)
 	CreateTask(body *serializers.CreateTaskRequestPayload, mattermostUserID string) (*serializers.TaskValue, int, error)
 	GetTask(organization, taskID, projectName, mattermostUserID string) (*serializers.TaskValue, int, error)
+	GetTasks(organization, projectName string, taskIDs, fields []string, mattermostUserID string) (*serializers.TaskList, int, error)
 	GetPullRequest(organization, pullRequestID, projectName, mattermostUserID string) (*serializers.PullRequest, int, error)
+	GetPullRequests(organization, projectName, repository string, searchCriteria *serializers.PullRequestSearchCriteria, mattermostUserID string) (*serializers.PullRequestList, int, error)
+	GetCommitDiffs(organization, projectID, repositoryID, baseVersion, targetVersion, mattermostUserID string) (*serializers.CommitDiffs, int, error)
+	UpdatePullRequestVote(organization, projectID, repositoryID, reviewerID string, pullRequestID, vote int, mattermostUserID string) (*serializers.Reviewer, int, error)
+	UpdatePullRequest(organization, projectID, repositoryID string, pullRequestID int, payload *serializers.UpdatePullRequestRequest, mattermostUserID string) (*serializers.PullRequest, int, error)
+	CreatePullRequest(organization, projectID, repositoryID string, payload *serializers.CreatePullRequestRequest, mattermostUserID string) (*serializers.PullRequest, int, error)
+	GetPolicyEvaluations(organization, projectID string, pullRequestID int, mattermostUserID string) (*serializers.PolicyEvaluationList, int, error)
+	AddPullRequestThreadComment(organization, projectID, repositoryID string, pullRequestID, threadID int, payload *serializers.PullRequestThreadCommentRequest, mattermostUserID string) (*serializers.Comment, int, error)
+	UpdatePullRequestThreadStatus(organization, projectID, repositoryID string, pullRequestID, threadID int, status, mattermostUserID string) (*serializers.PullRequestCommentThread, int, error)
 	Link(body *serializers.LinkRequestPayload, mattermostUserID string) (*serializers.Project, int, error)
 	CreateSubscription(body *serializers.CreateSubscriptionRequestPayload, project *serializers.ProjectDetails, channelID, pluginURL, mattermostUserID, uuid string) (*serializers.SubscriptionValue, int, error)
 	DeleteSubscription(organization, subscriptionID, mattermostUserID string) (int, error)
@@ -28,11 +38,43 @@ type Client interface {
 	UpdatePipelineRunApprovalRequest(pipelineApproveRequestPayload []*serializers.PipelineApproveRequest, organization, projectID, mattermostUserID string) (*serializers.PipelineRunApproveResponse, int, error)
 	GetApprovalDetails(organization, projectName, mattermostUserID string, approvalID int) (*serializers.PipelineApprovalDetails, int, error)
 	GetRunApprovalDetails(organization, projectID, mattermostUserID, approvalID string) (*serializers.PipelineRunApprovalDetails, int, error)
+	GetCheckConfiguration(organization, projectID string, checkConfigurationID int, mattermostUserID string) (*serializers.CheckConfiguration, int, error)
+	GetPipelines(organization, projectName, mattermostUserID string) (*serializers.PipelineList, int, error)
+	PreviewPipelineRun(organization, projectName string, pipelineID int, mattermostUserID string) (*serializers.PipelinePreview, int, error)
+	RunPipeline(organization, projectName string, pipelineID int, payload *serializers.RunPipelineRequest, mattermostUserID string) (*serializers.PipelineRun, int, error)
 	GetBuildDetails(organization, projectName, buildID, mattermostUserID string) (*serializers.BuildDetails, int, error)
+	CancelBuild(organization, projectName string, buildID int, mattermostUserID string) (*serializers.BuildDetails, int, error)
+	RetryBuild(organization, projectName string, buildID int, mattermostUserID string) (*serializers.BuildDetails, int, error)
+	RetryBuildStage(organization, projectName string, buildID int, stageName, mattermostUserID string) (int, error)
+	GetBuildTimeline(organization, projectName string, buildID int, mattermostUserID string) (*serializers.BuildTimeline, int, error)
+	GetBuildLogs(organization, projectName string, buildID int, mattermostUserID string) (*serializers.BuildLogList, int, error)
+	GetBuildLogLines(organization, projectName string, buildID, logID, startLine int, mattermostUserID string) (*serializers.BuildLogLines, int, error)
+	GetTestRuns(organization, projectName string, buildID int, mattermostUserID string) (*serializers.TestRunList, int, error)
+	GetFailedTestResults(organization, projectName string, runID, top int, mattermostUserID string) (*serializers.TestResultList, int, error)
 	GetReleaseDetails(organization, projectName, releaseID, mattermostUserID string) (*serializers.ReleaseDetails, int, error)
+	GetIdentityWithMembers(organization, identityID, mattermostUserID string) (*serializers.IdentityList, int, error)
+	GetIdentitiesByDescriptors(organization string, descriptors []string, mattermostUserID string) (*serializers.IdentityList, int, error)
+	SearchIdentities(organization, filterValue, mattermostUserID string) (*serializers.IdentityList, int, error)
 	GetSubscriptionFilterPossibleValues(request *serializers.GetSubscriptionFilterPossibleValuesRequestPayload, mattermostUserID string) (*serializers.SubscriptionFilterPossibleValuesResponseFromClient, int, error)
 	OpenDialogRequest(body *model.OpenDialogRequest, mattermostUserID string) (int, error)
 	GetUserProfile(id, accessToken string) (*serializers.UserProfile, int, error)
+	GetWorkItemTypes(organization, projectName, mattermostUserID string) (*serializers.WorkItemTypeList, int, error)
+	GetWorkItemTypeFields(organization, projectName, workItemType, mattermostUserID string) (*serializers.WorkItemTypeFieldList, int, error)
+	GetWorkItemFields(organization, projectName, mattermostUserID string) (*serializers.WorkItemFieldList, int, error)
+	AddWorkItemHyperlink(organization, projectName, workItemID, hyperlink, comment, mattermostUserID string) (int, error)
+	AddWorkItemComment(organization, projectName, workItemID, text, mattermostUserID string) (*serializers.WorkItemComment, int, error)
+	AddWorkItemRelations(organization, projectName, workItemID string, relations []*serializers.WorkItemRelation, mattermostUserID string) (int, error)
+	UpdateWorkItemState(organization, projectName, workItemID, state, mattermostUserID string) (int, error)
+	GetGitRepositories(organization, projectName, mattermostUserID string) (*serializers.GitRepositoryList, int, error)
+	GetGitRepositoryBranches(organization, projectName, repositoryID, mattermostUserID string) (*serializers.GitRefList, int, error)
+	CreateGitBranch(organization, projectName, repositoryID, branchName, objectID, mattermostUserID string) (*serializers.GitRefUpdateResult, int, error)
+	UploadWorkItemAttachment(organization, projectName, fileName string, content io.ReaderAt, size int64, mattermostUserID string) (*serializers.WorkItemAttachment, int, error)
+	GetWorkItemTemplates(organization, projectName, teamName, mattermostUserID string) (*serializers.WorkItemTemplateList, int, error)
+	GetWorkItemTemplate(organization, projectName, teamName, templateID, mattermostUserID string) (*serializers.WorkItemTemplate, int, error)
+	GetCurrentIteration(organization, projectName, teamName, mattermostUserID string) (*serializers.IterationList, int, error)
+	GetIterationWorkItems(organization, projectName, teamName, iterationID, mattermostUserID string) (*serializers.IterationWorkItems, int, error)
+	GetIterationCapacities(organization, projectName, teamName, iterationID, mattermostUserID string) (*serializers.IterationCapacity, int, error)
+	GetTeamSettings(organization, projectName, teamName, mattermostUserID string) (*serializers.TeamSettings, int, error)
 }
 
 type client struct {
@@ -106,6 +148,33 @@ func (c *client) CreateTask(body *serializers.CreateTaskRequestPayload, mattermo
 			})
 	}
 
+	// Sort the additional fields to keep the order of the operations deterministic
+	additionalFields := make([]string, 0, len(body.Fields.AdditionalFields))
+	for referenceName := range body.Fields.AdditionalFields {
+		additionalFields = append(additionalFields, referenceName)
+	}
+	sort.Strings(additionalFields)
+
+	for _, referenceName := range additionalFields {
+		payload = append(payload,
+			&serializers.CreateTaskBodyPayload{
+				Operation: "add",
+				Path:      fmt.Sprintf("/fields/%s", referenceName),
+				From:      "",
+				Value:     body.Fields.AdditionalFields[referenceName],
+			})
+	}
+
+	for _, relation := range body.Relations {
+		payload = append(payload,
+			&serializers.CreateTaskBodyPayload{
+				Operation: "add",
+				Path:      "/relations/-",
+				From:      "",
+				Value:     relation,
+			})
+	}
+
 	var task *serializers.TaskValue
 	_, statusCode, err := c.CallPatchJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, createTaskPath, http.MethodPost, mattermostUserID, &payload, &task, nil)
 	if err != nil {
@@ -115,6 +184,343 @@ func (c *client) CreateTask(body *serializers.CreateTaskRequestPayload, mattermo
 	return task, statusCode, nil
 }
 
+// Function to get the work item types of a project.
+func (c *client) GetWorkItemTypes(organization, projectName, mattermostUserID string) (*serializers.WorkItemTypeList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getWorkItemTypesPath := fmt.Sprintf(constants.GetWorkItemTypes, organization, projectName)
+
+	var workItemTypeList *serializers.WorkItemTypeList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getWorkItemTypesPath, http.MethodGet, mattermostUserID, nil, &workItemTypeList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the work item types")
+	}
+
+	return workItemTypeList, statusCode, nil
+}
+
+// Function to get the fields of a work item type along with their allowed values.
+func (c *client) GetWorkItemTypeFields(organization, projectName, workItemType, mattermostUserID string) (*serializers.WorkItemTypeFieldList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, workItemType); err != nil {
+		return nil, statusCode, err
+	}
+	getWorkItemTypeFieldsPath := fmt.Sprintf(constants.GetWorkItemTypeFields, organization, projectName, url.PathEscape(workItemType))
+
+	var workItemTypeFieldList *serializers.WorkItemTypeFieldList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getWorkItemTypeFieldsPath, http.MethodGet, mattermostUserID, nil, &workItemTypeFieldList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the work item type fields")
+	}
+
+	return workItemTypeFieldList, statusCode, nil
+}
+
+// Function to get all the work item fields of a project along with their data types.
+func (c *client) GetWorkItemFields(organization, projectName, mattermostUserID string) (*serializers.WorkItemFieldList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getWorkItemFieldsPath := fmt.Sprintf(constants.GetWorkItemFields, organization, projectName)
+
+	var workItemFieldList *serializers.WorkItemFieldList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getWorkItemFieldsPath, http.MethodGet, mattermostUserID, nil, &workItemFieldList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the work item fields")
+	}
+
+	return workItemFieldList, statusCode, nil
+}
+
+// Function to add a hyperlink relation to a work item.
+func (c *client) AddWorkItemHyperlink(organization, projectName, workItemID, hyperlink, comment, mattermostUserID string) (int, error) {
+	relations := []*serializers.WorkItemRelation{
+		{
+			Rel: constants.RelationTypeHyperlink,
+			URL: hyperlink,
+			Attributes: map[string]interface{}{
+				"comment": comment,
+			},
+		},
+	}
+
+	statusCode, err := c.AddWorkItemRelations(organization, projectName, workItemID, relations, mattermostUserID)
+	if err != nil {
+		return statusCode, errors.Wrap(err, "failed to add the hyperlink to the work item")
+	}
+
+	return statusCode, nil
+}
+
+// Function to add relations to a work item.
+func (c *client) AddWorkItemRelations(organization, projectName, workItemID string, relations []*serializers.WorkItemRelation, mattermostUserID string) (int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, workItemID); err != nil {
+		return statusCode, err
+	}
+	updateWorkItemPath := fmt.Sprintf(constants.UpdateWorkItem, organization, projectName, workItemID)
+
+	payload := make([]*serializers.CreateTaskBodyPayload, 0, len(relations))
+	for _, relation := range relations {
+		payload = append(payload,
+			&serializers.CreateTaskBodyPayload{
+				Operation: "add",
+				Path:      "/relations/-",
+				Value:     relation,
+			})
+	}
+
+	_, statusCode, err := c.CallPatchJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, updateWorkItemPath, http.MethodPatch, mattermostUserID, &payload, nil, nil)
+	if err != nil {
+		return statusCode, errors.Wrap(err, "failed to add the relations to the work item")
+	}
+
+	return statusCode, nil
+}
+
+// Function to change the state of a work item.
+func (c *client) UpdateWorkItemState(organization, projectName, workItemID, state, mattermostUserID string) (int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, workItemID); err != nil {
+		return statusCode, err
+	}
+	updateWorkItemPath := fmt.Sprintf(constants.UpdateWorkItem, organization, projectName, workItemID)
+
+	payload := []*serializers.CreateTaskBodyPayload{
+		{
+			Operation: "add",
+			Path:      fmt.Sprintf("/fields/%s", constants.FieldReferenceNameState),
+			Value:     state,
+		},
+	}
+
+	_, statusCode, err := c.CallPatchJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, updateWorkItemPath, http.MethodPatch, mattermostUserID, &payload, nil, nil)
+	if err != nil {
+		return statusCode, errors.Wrap(err, "failed to update the state of the work item")
+	}
+
+	return statusCode, nil
+}
+
+// Function to get the git repositories of a project.
+func (c *client) GetGitRepositories(organization, projectName, mattermostUserID string) (*serializers.GitRepositoryList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getGitRepositoriesPath := fmt.Sprintf(constants.GetGitRepositories, organization, projectName)
+
+	var repositoryList *serializers.GitRepositoryList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getGitRepositoriesPath, http.MethodGet, mattermostUserID, nil, &repositoryList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the git repositories")
+	}
+
+	return repositoryList, statusCode, nil
+}
+
+// Function to get the branches of a git repository.
+func (c *client) GetGitRepositoryBranches(organization, projectName, repositoryID, mattermostUserID string) (*serializers.GitRefList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, repositoryID); err != nil {
+		return nil, statusCode, err
+	}
+	getGitRepositoryBranchesPath := fmt.Sprintf(constants.GetGitRepositoryBranches, organization, projectName, repositoryID)
+
+	var branchList *serializers.GitRefList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getGitRepositoryBranchesPath, http.MethodGet, mattermostUserID, nil, &branchList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the git repository branches")
+	}
+
+	return branchList, statusCode, nil
+}
+
+// Function to create a branch of a git repository pointing to a commit.
+func (c *client) CreateGitBranch(organization, projectName, repositoryID, branchName, objectID, mattermostUserID string) (*serializers.GitRefUpdateResult, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, repositoryID); err != nil {
+		return nil, statusCode, err
+	}
+	createGitRefsPath := fmt.Sprintf(constants.CreateGitRefs, organization, projectName, repositoryID)
+
+	// A ref is created by updating it from the zero object ID
+	payload := []*serializers.GitRefUpdate{
+		{
+			Name:        constants.RefNamePrefixBranch + branchName,
+			OldObjectID: constants.ZeroObjectID,
+			NewObjectID: objectID,
+		},
+	}
+
+	var refUpdateResultList *serializers.GitRefUpdateResultList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, createGitRefsPath, http.MethodPost, mattermostUserID, &payload, &refUpdateResultList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to create the git branch")
+	}
+
+	if refUpdateResultList == nil || len(refUpdateResultList.Value) == 0 {
+		return nil, http.StatusInternalServerError, errors.New("failed to create the git branch: no result is returned")
+	}
+
+	return refUpdateResultList.Value[0], statusCode, nil
+}
+
+// Function to upload a file which can be attached to work items.
+// Files larger than the maximum chunk size are uploaded in chunks, so that only one chunk of the content is read at a time.
+func (c *client) UploadWorkItemAttachment(organization, projectName, fileName string, content io.ReaderAt, size int64, mattermostUserID string) (*serializers.WorkItemAttachment, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	baseURL := c.plugin.getConfiguration().AzureDevopsAPIBaseURL
+
+	if size <= constants.MaxAttachmentChunkSizeInBytes {
+		uploadAttachmentPath := fmt.Sprintf(constants.UploadAttachment, organization, projectName, url.QueryEscape(fileName), constants.AttachmentUploadTypeSimple)
+
+		var attachment *serializers.WorkItemAttachment
+		_, statusCode, err := c.CallBinary(baseURL, uploadAttachmentPath, http.MethodPost, mattermostUserID, io.NewSectionReader(content, 0, size), nil, &attachment)
+		if err != nil {
+			return nil, statusCode, errors.Wrap(err, "failed to upload the attachment")
+		}
+
+		return attachment, statusCode, nil
+	}
+
+	// Start the chunked upload, the chunks are then uploaded to the returned attachment
+	startUploadPath := fmt.Sprintf(constants.UploadAttachment, organization, projectName, url.QueryEscape(fileName), constants.AttachmentUploadTypeChunked)
+
+	var attachment *serializers.WorkItemAttachment
+	_, statusCode, err := c.CallJSON(baseURL, startUploadPath, http.MethodPost, mattermostUserID, nil, &attachment, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to start the upload of the attachment")
+	}
+
+	uploadChunkPath := fmt.Sprintf(constants.UploadAttachmentChunk, organization, projectName, attachment.ID, url.QueryEscape(fileName))
+	for offset := int64(0); offset < size; offset += constants.MaxAttachmentChunkSizeInBytes {
+		chunkSize := size - offset
+		if chunkSize > constants.MaxAttachmentChunkSizeInBytes {
+			chunkSize = constants.MaxAttachmentChunkSizeInBytes
+		}
+
+		headers := map[string]string{
+			"Content-Range": fmt.Sprintf("bytes %d-%d/%d", offset, offset+chunkSize-1, size),
+		}
+
+		if _, statusCode, err = c.CallBinary(baseURL, uploadChunkPath, http.MethodPut, mattermostUserID, io.NewSectionReader(content, offset, chunkSize), headers, nil); err != nil {
+			return nil, statusCode, errors.Wrap(err, "failed to upload the attachment chunk")
+		}
+	}
+
+	return attachment, statusCode, nil
+}
+
+// Function to add a comment to a work item.
+func (c *client) AddWorkItemComment(organization, projectName, workItemID, text, mattermostUserID string) (*serializers.WorkItemComment, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, workItemID); err != nil {
+		return nil, statusCode, err
+	}
+	addWorkItemCommentPath := fmt.Sprintf(constants.AddWorkItemComment, organization, projectName, workItemID)
+
+	var comment *serializers.WorkItemComment
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, addWorkItemCommentPath, http.MethodPost, mattermostUserID, &serializers.WorkItemComment{Text: text}, &comment, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to add the comment to the work item")
+	}
+
+	return comment, statusCode, nil
+}
+
+// Function to get the work item templates of a team.
+func (c *client) GetWorkItemTemplates(organization, projectName, teamName, mattermostUserID string) (*serializers.WorkItemTemplateList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getWorkItemTemplatesPath := fmt.Sprintf(constants.GetWorkItemTemplates, organization, projectName, url.PathEscape(teamName))
+
+	var workItemTemplateList *serializers.WorkItemTemplateList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getWorkItemTemplatesPath, http.MethodGet, mattermostUserID, nil, &workItemTemplateList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the work item templates")
+	}
+
+	return workItemTemplateList, statusCode, nil
+}
+
+// Function to get a work item template along with its field values.
+func (c *client) GetWorkItemTemplate(organization, projectName, teamName, templateID, mattermostUserID string) (*serializers.WorkItemTemplate, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, templateID); err != nil {
+		return nil, statusCode, err
+	}
+	getWorkItemTemplatePath := fmt.Sprintf(constants.GetWorkItemTemplate, organization, projectName, url.PathEscape(teamName), templateID)
+
+	var workItemTemplate *serializers.WorkItemTemplate
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getWorkItemTemplatePath, http.MethodGet, mattermostUserID, nil, &workItemTemplate, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the work item template")
+	}
+
+	return workItemTemplate, statusCode, nil
+}
+
+// Function to get the current iteration of a team.
+func (c *client) GetCurrentIteration(organization, projectName, teamName, mattermostUserID string) (*serializers.IterationList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getCurrentIterationPath := fmt.Sprintf(constants.GetCurrentIteration, organization, projectName, url.PathEscape(teamName))
+
+	var iterationList *serializers.IterationList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getCurrentIterationPath, http.MethodGet, mattermostUserID, nil, &iterationList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the current iteration")
+	}
+
+	return iterationList, statusCode, nil
+}
+
+// Function to get the work items of an iteration of a team.
+func (c *client) GetIterationWorkItems(organization, projectName, teamName, iterationID, mattermostUserID string) (*serializers.IterationWorkItems, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, iterationID); err != nil {
+		return nil, statusCode, err
+	}
+	getIterationWorkItemsPath := fmt.Sprintf(constants.GetIterationWorkItems, organization, projectName, url.PathEscape(teamName), iterationID)
+
+	var iterationWorkItems *serializers.IterationWorkItems
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getIterationWorkItemsPath, http.MethodGet, mattermostUserID, nil, &iterationWorkItems, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the iteration work items")
+	}
+
+	return iterationWorkItems, statusCode, nil
+}
+
+// Function to get the capacities of the team members for an iteration of a team.
+func (c *client) GetIterationCapacities(organization, projectName, teamName, iterationID, mattermostUserID string) (*serializers.IterationCapacity, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, iterationID); err != nil {
+		return nil, statusCode, err
+	}
+	getIterationCapacitiesPath := fmt.Sprintf(constants.GetIterationCapacities, organization, projectName, url.PathEscape(teamName), iterationID)
+
+	var iterationCapacity *serializers.IterationCapacity
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getIterationCapacitiesPath, http.MethodGet, mattermostUserID, nil, &iterationCapacity, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the iteration capacities")
+	}
+
+	return iterationCapacity, statusCode, nil
+}
+
+// Function to get the settings of a team e.g. its working days.
+func (c *client) GetTeamSettings(organization, projectName, teamName, mattermostUserID string) (*serializers.TeamSettings, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getTeamSettingsPath := fmt.Sprintf(constants.GetTeamSettings, organization, projectName, url.PathEscape(teamName))
+
+	var teamSettings *serializers.TeamSettings
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getTeamSettingsPath, http.MethodGet, mattermostUserID, nil, &teamSettings, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the team settings")
+	}
+
+	return teamSettings, statusCode, nil
+}
+
 // Function to get the task.
 func (c *client) GetTask(organization, taskID, projectName, mattermostUserID string) (*serializers.TaskValue, int, error) {
 	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, taskID); err != nil {
@@ -131,6 +537,22 @@ func (c *client) GetTask(organization, taskID, projectName, mattermostUserID str
 	return task, statusCode, nil
 }
 
+// Function to get the given fields of multiple tasks.
+func (c *client) GetTasks(organization, projectName string, taskIDs, fields []string, mattermostUserID string) (*serializers.TaskList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getTasksPath := fmt.Sprintf(constants.GetTaskList, organization, projectName, strings.Join(taskIDs, ","), strings.Join(fields, ","))
+
+	var taskList *serializers.TaskList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getTasksPath, http.MethodGet, mattermostUserID, nil, &taskList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the Tasks")
+	}
+
+	return taskList, statusCode, nil
+}
+
 // Function to get the pull request.
 func (c *client) GetPullRequest(organization, pullRequestID, projectName, mattermostUserID string) (*serializers.PullRequest, int, error) {
 	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, pullRequestID); err != nil {
@@ -147,6 +569,146 @@ func (c *client) GetPullRequest(organization, pullRequestID, projectName, matter
 	return pullRequest, statusCode, nil
 }
 
+// Function to get the active pull requests of a project, or of one of its repositories if the repository is provided.
+func (c *client) GetPullRequests(organization, projectName, repository string, searchCriteria *serializers.PullRequestSearchCriteria, mattermostUserID string) (*serializers.PullRequestList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, repository); err != nil {
+		return nil, statusCode, err
+	}
+
+	getPullRequestsPath := fmt.Sprintf(constants.GetPullRequests, organization, projectName, searchCriteria.ToQueryParams(), constants.MaxActivePullRequests)
+	if repository != "" {
+		getPullRequestsPath = fmt.Sprintf(constants.GetRepositoryPullRequests, organization, projectName, repository, searchCriteria.ToQueryParams(), constants.MaxActivePullRequests)
+	}
+
+	var pullRequestList *serializers.PullRequestList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getPullRequestsPath, http.MethodGet, mattermostUserID, nil, &pullRequestList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the pull requests")
+	}
+
+	return pullRequestList, statusCode, nil
+}
+
+// Function to get the changes between two commits of a repository.
+func (c *client) GetCommitDiffs(organization, projectID, repositoryID, baseVersion, targetVersion, mattermostUserID string) (*serializers.CommitDiffs, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectID, repositoryID); err != nil {
+		return nil, statusCode, err
+	}
+	if statusCode, err := c.plugin.SanitizeURLPaths("", baseVersion, targetVersion); err != nil {
+		return nil, statusCode, err
+	}
+
+	getCommitDiffsPath := fmt.Sprintf(constants.GetCommitDiffs, organization, projectID, repositoryID, baseVersion, targetVersion)
+
+	var commitDiffs *serializers.CommitDiffs
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getCommitDiffsPath, http.MethodGet, mattermostUserID, nil, &commitDiffs, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the commit diffs")
+	}
+
+	return commitDiffs, statusCode, nil
+}
+
+// Function to set the vote of a reviewer on a pull request. The reviewer is added to the pull request if required.
+func (c *client) UpdatePullRequestVote(organization, projectID, repositoryID, reviewerID string, pullRequestID, vote int, mattermostUserID string) (*serializers.Reviewer, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectID, repositoryID); err != nil {
+		return nil, statusCode, err
+	}
+	if statusCode, err := c.plugin.SanitizeURLPaths("", "", reviewerID); err != nil {
+		return nil, statusCode, err
+	}
+	updatePullRequestReviewerPath := fmt.Sprintf(constants.UpdatePullRequestReviewer, organization, projectID, repositoryID, pullRequestID, reviewerID)
+
+	var reviewer *serializers.Reviewer
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, updatePullRequestReviewerPath, http.MethodPut, mattermostUserID, &serializers.PullRequestVoteRequest{Vote: vote}, &reviewer, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to update the vote on the pull request")
+	}
+
+	return reviewer, statusCode, nil
+}
+
+// Function to update the status, completion options or auto-complete of a pull request.
+func (c *client) UpdatePullRequest(organization, projectID, repositoryID string, pullRequestID int, payload *serializers.UpdatePullRequestRequest, mattermostUserID string) (*serializers.PullRequest, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectID, repositoryID); err != nil {
+		return nil, statusCode, err
+	}
+	updatePullRequestPath := fmt.Sprintf(constants.UpdatePullRequest, organization, projectID, repositoryID, pullRequestID)
+
+	var pullRequest *serializers.PullRequest
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, updatePullRequestPath, http.MethodPatch, mattermostUserID, payload, &pullRequest, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to update the pull request")
+	}
+
+	return pullRequest, statusCode, nil
+}
+
+// Function to create a pull request in a git repository.
+func (c *client) CreatePullRequest(organization, projectID, repositoryID string, payload *serializers.CreatePullRequestRequest, mattermostUserID string) (*serializers.PullRequest, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectID, repositoryID); err != nil {
+		return nil, statusCode, err
+	}
+	createPullRequestPath := fmt.Sprintf(constants.CreatePullRequest, organization, projectID, repositoryID)
+
+	var pullRequest *serializers.PullRequest
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, createPullRequestPath, http.MethodPost, mattermostUserID, payload, &pullRequest, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to create the pull request")
+	}
+
+	return pullRequest, statusCode, nil
+}
+
+// Function to get the evaluations of the branch policies of a pull request.
+func (c *client) GetPolicyEvaluations(organization, projectID string, pullRequestID int, mattermostUserID string) (*serializers.PolicyEvaluationList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectID, ""); err != nil {
+		return nil, statusCode, err
+	}
+	artifactID := url.QueryEscape(fmt.Sprintf(constants.PullRequestArtifactID, projectID, pullRequestID))
+	getPolicyEvaluationsPath := fmt.Sprintf(constants.GetPolicyEvaluations, organization, projectID, artifactID)
+
+	var policyEvaluationList *serializers.PolicyEvaluationList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getPolicyEvaluationsPath, http.MethodGet, mattermostUserID, nil, &policyEvaluationList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the policy evaluations of the pull request")
+	}
+
+	return policyEvaluationList, statusCode, nil
+}
+
+// Function to add a comment to a pull request thread.
+func (c *client) AddPullRequestThreadComment(organization, projectID, repositoryID string, pullRequestID, threadID int, payload *serializers.PullRequestThreadCommentRequest, mattermostUserID string) (*serializers.Comment, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectID, repositoryID); err != nil {
+		return nil, statusCode, err
+	}
+	addPullRequestThreadCommentPath := fmt.Sprintf(constants.AddPullRequestThreadComment, organization, projectID, repositoryID, pullRequestID, threadID)
+
+	var comment *serializers.Comment
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, addPullRequestThreadCommentPath, http.MethodPost, mattermostUserID, payload, &comment, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to add the comment to the pull request thread")
+	}
+
+	return comment, statusCode, nil
+}
+
+// Function to update the status of a pull request thread e.g. to resolve it.
+func (c *client) UpdatePullRequestThreadStatus(organization, projectID, repositoryID string, pullRequestID, threadID int, status, mattermostUserID string) (*serializers.PullRequestCommentThread, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectID, repositoryID); err != nil {
+		return nil, statusCode, err
+	}
+	updatePullRequestThreadPath := fmt.Sprintf(constants.UpdatePullRequestThread, organization, projectID, repositoryID, pullRequestID, threadID)
+
+	var thread *serializers.PullRequestCommentThread
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, updatePullRequestThreadPath, http.MethodPatch, mattermostUserID, &serializers.PullRequestThreadStatusRequest{Status: status}, &thread, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to update the status of the pull request thread")
+	}
+
+	return thread, statusCode, nil
+}
+
 // Function to get the pipeline build details.
 func (c *client) GetBuildDetails(organization, projectName, buildID, mattermostUserID string) (*serializers.BuildDetails, int, error) {
 	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, buildID); err != nil {
@@ -163,6 +725,137 @@ func (c *client) GetBuildDetails(organization, projectName, buildID, mattermostU
 	return buildDetails, statusCode, nil
 }
 
+// Function to cancel an in-progress build.
+func (c *client) CancelBuild(organization, projectName string, buildID int, mattermostUserID string) (*serializers.BuildDetails, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	cancelBuildPath := fmt.Sprintf(constants.UpdateBuild, organization, projectName, buildID)
+
+	var buildDetails *serializers.BuildDetails
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, cancelBuildPath, http.MethodPatch, mattermostUserID, &serializers.UpdateBuildRequest{Status: constants.BuildStatusCancelling}, &buildDetails, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to cancel the build")
+	}
+
+	return buildDetails, statusCode, nil
+}
+
+// Function to retry the failed jobs of a completed build.
+func (c *client) RetryBuild(organization, projectName string, buildID int, mattermostUserID string) (*serializers.BuildDetails, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	retryBuildPath := fmt.Sprintf(constants.RetryBuild, organization, projectName, buildID)
+
+	var buildDetails *serializers.BuildDetails
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, retryBuildPath, http.MethodPatch, mattermostUserID, nil, &buildDetails, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to retry the build")
+	}
+
+	return buildDetails, statusCode, nil
+}
+
+// Function to rerun all the jobs of a stage of a build.
+func (c *client) RetryBuildStage(organization, projectName string, buildID int, stageName, mattermostUserID string) (int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, stageName); err != nil {
+		return statusCode, err
+	}
+	retryBuildStagePath := fmt.Sprintf(constants.UpdateBuildStage, organization, projectName, buildID, stageName)
+
+	payload := &serializers.UpdateBuildStageRequest{
+		ForceRetryAllJobs: true,
+		State:             constants.BuildStageStateRetry,
+	}
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, retryBuildStagePath, http.MethodPatch, mattermostUserID, payload, nil, nil)
+	if err != nil {
+		return statusCode, errors.Wrap(err, "failed to rerun the stage of the build")
+	}
+
+	return statusCode, nil
+}
+
+// Function to get the timeline of the stages, jobs and tasks of a build.
+func (c *client) GetBuildTimeline(organization, projectName string, buildID int, mattermostUserID string) (*serializers.BuildTimeline, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getBuildTimelinePath := fmt.Sprintf(constants.GetBuildTimeline, organization, projectName, buildID)
+
+	var timeline *serializers.BuildTimeline
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getBuildTimelinePath, http.MethodGet, mattermostUserID, nil, &timeline, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the timeline of the build")
+	}
+
+	return timeline, statusCode, nil
+}
+
+// Function to get the logs of a build with their line counts.
+func (c *client) GetBuildLogs(organization, projectName string, buildID int, mattermostUserID string) (*serializers.BuildLogList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getBuildLogsPath := fmt.Sprintf(constants.GetBuildLogs, organization, projectName, buildID)
+
+	var buildLogList *serializers.BuildLogList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getBuildLogsPath, http.MethodGet, mattermostUserID, nil, &buildLogList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the logs of the build")
+	}
+
+	return buildLogList, statusCode, nil
+}
+
+// Function to get the lines of a log of a build from the given line.
+func (c *client) GetBuildLogLines(organization, projectName string, buildID, logID, startLine int, mattermostUserID string) (*serializers.BuildLogLines, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getBuildLogLinesPath := fmt.Sprintf(constants.GetBuildLogLines, organization, projectName, buildID, logID, startLine)
+
+	var buildLogLines *serializers.BuildLogLines
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getBuildLogLinesPath, http.MethodGet, mattermostUserID, nil, &buildLogLines, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the lines of the build log")
+	}
+
+	return buildLogLines, statusCode, nil
+}
+
+// Function to get the test runs of a build with their statistics.
+func (c *client) GetTestRuns(organization, projectName string, buildID int, mattermostUserID string) (*serializers.TestRunList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getTestRunsPath := fmt.Sprintf(constants.GetTestRuns, organization, projectName, url.QueryEscape(fmt.Sprintf(constants.BuildURI, buildID)))
+
+	var testRunList *serializers.TestRunList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getTestRunsPath, http.MethodGet, mattermostUserID, nil, &testRunList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the test runs of the build")
+	}
+
+	return testRunList, statusCode, nil
+}
+
+// Function to get the failed results of a test run.
+func (c *client) GetFailedTestResults(organization, projectName string, runID, top int, mattermostUserID string) (*serializers.TestResultList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getFailedTestResultsPath := fmt.Sprintf(constants.GetFailedTestResults, organization, projectName, runID, top)
+
+	var testResultList *serializers.TestResultList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getFailedTestResultsPath, http.MethodGet, mattermostUserID, nil, &testResultList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the failed results of the test run")
+	}
+
+	return testResultList, statusCode, nil
+}
+
 // Function to get the pipeline release details.
 func (c *client) GetReleaseDetails(organization, projectName, releaseID, mattermostUserID string) (*serializers.ReleaseDetails, int, error) {
 	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, releaseID); err != nil {
@@ -181,6 +874,65 @@ func (c *client) GetReleaseDetails(organization, projectName, releaseID, matterm
 	return releaseDetails, statusCode, nil
 }
 
+// Function to get an identity of an organization along with the descriptors of all the members of the identity if it is a group.
+func (c *client) GetIdentityWithMembers(organization, identityID, mattermostUserID string) (*serializers.IdentityList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, "", identityID); err != nil {
+		return nil, statusCode, err
+	}
+	getIdentityPath := fmt.Sprintf(constants.GetIdentityWithMembers, organization, url.QueryEscape(identityID))
+
+	var identityList *serializers.IdentityList
+	baseURL := c.plugin.getConfiguration().AzureDevopsAPIBaseURL
+	baseURL = strings.Replace(baseURL, "://", "://vssps.", 1)
+	_, statusCode, err := c.CallJSON(baseURL, getIdentityPath, http.MethodGet, mattermostUserID, nil, &identityList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the identity")
+	}
+
+	return identityList, statusCode, nil
+}
+
+// Function to get the identities of an organization with their descriptors.
+func (c *client) GetIdentitiesByDescriptors(organization string, descriptors []string, mattermostUserID string) (*serializers.IdentityList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, "", ""); err != nil {
+		return nil, statusCode, err
+	}
+
+	escapedDescriptors := make([]string, 0, len(descriptors))
+	for _, descriptor := range descriptors {
+		escapedDescriptors = append(escapedDescriptors, url.QueryEscape(descriptor))
+	}
+	getIdentitiesPath := fmt.Sprintf(constants.GetIdentitiesByDescriptors, organization, strings.Join(escapedDescriptors, ","))
+
+	var identityList *serializers.IdentityList
+	baseURL := c.plugin.getConfiguration().AzureDevopsAPIBaseURL
+	baseURL = strings.Replace(baseURL, "://", "://vssps.", 1)
+	_, statusCode, err := c.CallJSON(baseURL, getIdentitiesPath, http.MethodGet, mattermostUserID, nil, &identityList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the identities")
+	}
+
+	return identityList, statusCode, nil
+}
+
+// Function to search the identities of an organization by their unique name, email or display name.
+func (c *client) SearchIdentities(organization, filterValue, mattermostUserID string) (*serializers.IdentityList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, "", ""); err != nil {
+		return nil, statusCode, err
+	}
+	searchIdentitiesPath := fmt.Sprintf(constants.SearchIdentities, organization, url.QueryEscape(filterValue))
+
+	var identityList *serializers.IdentityList
+	baseURL := c.plugin.getConfiguration().AzureDevopsAPIBaseURL
+	baseURL = strings.Replace(baseURL, "://", "://vssps.", 1)
+	_, statusCode, err := c.CallJSON(baseURL, searchIdentitiesPath, http.MethodGet, mattermostUserID, nil, &identityList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to search the identities")
+	}
+
+	return identityList, statusCode, nil
+}
+
 // Function to link a project and an organization.
 func (c *client) Link(body *serializers.LinkRequestPayload, mattermostUserID string) (*serializers.Project, int, error) {
 	if statusCode, err := c.plugin.SanitizeURLPaths(body.Organization, body.Project, ""); err != nil {
@@ -420,6 +1172,70 @@ func (c *client) GetRunApprovalDetails(organization, projectID, mattermostUserID
 	return pipelineApprovalDetails, statusCode, nil
 }
 
+// Function to get the configuration of a check e.g. the approval check whose timeout sets the expiry of its approvals.
+func (c *client) GetCheckConfiguration(organization, projectID string, checkConfigurationID int, mattermostUserID string) (*serializers.CheckConfiguration, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectID, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getCheckConfigurationPath := fmt.Sprintf(constants.GetCheckConfiguration, organization, projectID, checkConfigurationID)
+
+	var checkConfiguration *serializers.CheckConfiguration
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getCheckConfigurationPath, http.MethodGet, mattermostUserID, nil, &checkConfiguration, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the check configuration")
+	}
+
+	return checkConfiguration, statusCode, nil
+}
+
+// Function to get the pipelines of a project.
+func (c *client) GetPipelines(organization, projectName, mattermostUserID string) (*serializers.PipelineList, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	getPipelinesPath := fmt.Sprintf(constants.GetPipelines, organization, projectName)
+
+	var pipelineList *serializers.PipelineList
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, getPipelinesPath, http.MethodGet, mattermostUserID, nil, &pipelineList, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to get the pipelines")
+	}
+
+	return pipelineList, statusCode, nil
+}
+
+// Function to preview a run of a pipeline to get the YAML of the pipeline with its templates expanded.
+func (c *client) PreviewPipelineRun(organization, projectName string, pipelineID int, mattermostUserID string) (*serializers.PipelinePreview, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	previewPipelineRunPath := fmt.Sprintf(constants.PreviewPipelineRun, organization, projectName, pipelineID)
+
+	var pipelinePreview *serializers.PipelinePreview
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, previewPipelineRunPath, http.MethodPost, mattermostUserID, &serializers.RunPipelineRequest{PreviewRun: true}, &pipelinePreview, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to preview the pipeline run")
+	}
+
+	return pipelinePreview, statusCode, nil
+}
+
+// Function to queue a run of a pipeline.
+func (c *client) RunPipeline(organization, projectName string, pipelineID int, payload *serializers.RunPipelineRequest, mattermostUserID string) (*serializers.PipelineRun, int, error) {
+	if statusCode, err := c.plugin.SanitizeURLPaths(organization, projectName, ""); err != nil {
+		return nil, statusCode, err
+	}
+	runPipelinePath := fmt.Sprintf(constants.RunPipeline, organization, projectName, pipelineID)
+
+	var pipelineRun *serializers.PipelineRun
+	_, statusCode, err := c.CallJSON(c.plugin.getConfiguration().AzureDevopsAPIBaseURL, runPipelinePath, http.MethodPost, mattermostUserID, payload, &pipelineRun, nil)
+	if err != nil {
+		return nil, statusCode, errors.Wrap(err, "failed to run the pipeline")
+	}
+
+	return pipelineRun, statusCode, nil
+}
+
 // Wrapper to make REST API requests with "application/json-patch+json" type content
 func (c *client) CallPatchJSON(url, path, method, mattermostUserID string, in, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
 	contentType := "application/json-patch+json"
@@ -440,8 +1256,17 @@ func (c *client) CallJSON(url, path, method, mattermostUserID string, in, out in
 	return c.Call(url, method, path, contentType, mattermostUserID, buf, out, formValues)
 }
 
+// CallBinary sends the body as it is read instead of encoding it first, which is used for uploading files.
+func (c *client) CallBinary(url, path, method, mattermostUserID string, in io.Reader, headers map[string]string, out interface{}) (responseData []byte, statusCode int, err error) {
+	return c.call(url, method, path, "application/octet-stream", mattermostUserID, in, headers, out, nil)
+}
+
 // Makes HTTP request to REST APIs
 func (c *client) Call(basePath, method, path, contentType string, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+	return c.call(basePath, method, path, contentType, mattermostUserID, inBody, nil, out, formValues)
+}
+
+func (c *client) call(basePath, method, path, contentType string, mattermostUserID string, inBody io.Reader, headers map[string]string, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
 	errContext := fmt.Sprintf("Azure DevOps: Call failed: method:%s, path:%s", method, path)
 	URL, err := c.parsePath(basePath, path, method)
 	if err != nil {
@@ -485,6 +1310,15 @@ func (c *client) Call(basePath, method, path, contentType string, mattermostUser
 		if err != nil {
 			return nil, http.StatusInternalServerError, err
 		}
+
+		// The length of the body is only set by the request for in-memory readers
+		if sectionReader, ok := inBody.(*io.SectionReader); ok {
+			req.ContentLength = sectionReader.Size()
+		}
+	}
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
 	}
 
 	if mattermostUserID != "" {
@@ -1,7 +1,9 @@
 package plugin
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -501,6 +503,42 @@ func TestGetRunApprovalDetails(t *testing.T) {
 	}
 }
 
+func TestGetCheckConfiguration(t *testing.T) {
+	defer monkey.UnpatchAll()
+	p := setupTestPlugin(&plugintest.API{})
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetCheckConfiguration: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetCheckConfiguration: with error",
+			err:         errors.New("error getting the check configuration"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetCheckConfiguration(testutils.MockOrganization, testutils.MockProjectID, 1, testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.ErrorContains(t, err, testCase.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
 func TestUpdatePipelineApprovalRequest(t *testing.T) {
 	defer monkey.UnpatchAll()
 	p := setupTestPlugin(&plugintest.API{})
@@ -672,3 +710,1555 @@ func setupTestPlugin(api *plugintest.API) *Plugin {
 	p.Client = c
 	return &p
 }
+
+func TestGetWorkItemTypes(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetWorkItemTypes: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetWorkItemTypes: with error",
+			err:         errors.New("error getting the work item types"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetWorkItemTypes(testutils.MockOrganization, testutils.MockProjectName, testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetWorkItemTypeFields(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetWorkItemTypeFields: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetWorkItemTypeFields: with error",
+			err:         errors.New("error getting the work item type fields"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetWorkItemTypeFields(testutils.MockOrganization, testutils.MockProjectName, "User Story", testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetWorkItemFields(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetWorkItemFields: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetWorkItemFields: with error",
+			err:         errors.New("error getting the work item fields"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetWorkItemFields(testutils.MockOrganization, testutils.MockProjectName, testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetWorkItemTemplates(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetWorkItemTemplates: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetWorkItemTemplates: with error",
+			err:         errors.New("error getting the work item templates"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetWorkItemTemplates(testutils.MockOrganization, testutils.MockProjectName, "mockTeam", testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetWorkItemTemplate(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetWorkItemTemplate: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetWorkItemTemplate: with error",
+			err:         errors.New("error getting the work item template"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetWorkItemTemplate(testutils.MockOrganization, testutils.MockProjectName, "mockTeam", "mockTemplateID", testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestAddWorkItemHyperlink(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "AddWorkItemHyperlink: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "AddWorkItemHyperlink: with error",
+			err:         errors.New("error adding the hyperlink to the work item"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			statusCode, err := p.Client.AddWorkItemHyperlink(testutils.MockOrganization, testutils.MockProjectName, "1", "mockPermalink", "mockComment", testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestAddWorkItemComment(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "AddWorkItemComment: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "AddWorkItemComment: with error",
+			err:         errors.New("error adding the comment to the work item"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.AddWorkItemComment(testutils.MockOrganization, testutils.MockProjectName, "1", "mockComment", testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetTasks(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetTasks: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetTasks: with error",
+			err:         errors.New("error getting the Tasks"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetTasks(testutils.MockOrganization, testutils.MockProjectName, []string{"1", "2"}, []string{"System.Title"}, testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetCurrentIteration(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetCurrentIteration: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetCurrentIteration: with error",
+			err:         errors.New("error getting the current iteration"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetCurrentIteration(testutils.MockOrganization, testutils.MockProjectName, "mockTeam", testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetIterationWorkItems(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetIterationWorkItems: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetIterationWorkItems: with error",
+			err:         errors.New("error getting the iteration work items"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetIterationWorkItems(testutils.MockOrganization, testutils.MockProjectName, "mockTeam", "mockIterationID", testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetIterationCapacities(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetIterationCapacities: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetIterationCapacities: with error",
+			err:         errors.New("error getting the iteration capacities"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetIterationCapacities(testutils.MockOrganization, testutils.MockProjectName, "mockTeam", "mockIterationID", testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetTeamSettings(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetTeamSettings: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetTeamSettings: with error",
+			err:         errors.New("error getting the team settings"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetTeamSettings(testutils.MockOrganization, testutils.MockProjectName, "mockTeam", testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestAddWorkItemRelations(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "AddWorkItemRelations: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "AddWorkItemRelations: with error",
+			err:         errors.New("failed to add the relations"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			statusCode, err := p.Client.AddWorkItemRelations(testutils.MockOrganization, testutils.MockProjectName, "1", []*serializers.WorkItemRelation{{Rel: constants.RelationTypeAttachedFile, URL: "mockAttachmentURL"}}, testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestUploadWorkItemAttachment(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description     string
+		size            int64
+		startUploadErr  error
+		uploadErr       error
+		expectedRanges  []string
+		expectedMethods []string
+		statusCode      int
+	}{
+		{
+			description:     "UploadWorkItemAttachment: file is uploaded in a single request",
+			size:            1024,
+			expectedRanges:  []string{""},
+			expectedMethods: []string{http.MethodPost},
+			statusCode:      http.StatusOK,
+		},
+		{
+			description: "UploadWorkItemAttachment: file is uploaded in chunks",
+			size:        constants.MaxAttachmentChunkSizeInBytes*2 + 10,
+			expectedRanges: []string{
+				fmt.Sprintf("bytes 0-%d/%d", constants.MaxAttachmentChunkSizeInBytes-1, constants.MaxAttachmentChunkSizeInBytes*2+10),
+				fmt.Sprintf("bytes %d-%d/%d", constants.MaxAttachmentChunkSizeInBytes, constants.MaxAttachmentChunkSizeInBytes*2-1, constants.MaxAttachmentChunkSizeInBytes*2+10),
+				fmt.Sprintf("bytes %d-%d/%d", constants.MaxAttachmentChunkSizeInBytes*2, constants.MaxAttachmentChunkSizeInBytes*2+9, constants.MaxAttachmentChunkSizeInBytes*2+10),
+			},
+			expectedMethods: []string{http.MethodPut, http.MethodPut, http.MethodPut},
+			statusCode:      http.StatusOK,
+		},
+		{
+			description:    "UploadWorkItemAttachment: failed to start the chunked upload",
+			size:           constants.MaxAttachmentChunkSizeInBytes + 1,
+			startUploadErr: errors.New("failed to start the upload"),
+			statusCode:     http.StatusInternalServerError,
+		},
+		{
+			description:     "UploadWorkItemAttachment: failed to upload a chunk",
+			size:            constants.MaxAttachmentChunkSizeInBytes + 1,
+			uploadErr:       errors.New("failed to upload the chunk"),
+			expectedRanges:  []string{fmt.Sprintf("bytes 0-%d/%d", constants.MaxAttachmentChunkSizeInBytes-1, constants.MaxAttachmentChunkSizeInBytes+1)},
+			expectedMethods: []string{http.MethodPut},
+			statusCode:      http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "CallJSON", func(_ *client, url, path, method, mattermostUserID string, in, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				assert.Contains(t, path, "uploadType=Chunked")
+				if testCase.startUploadErr != nil {
+					return nil, testCase.statusCode, testCase.startUploadErr
+				}
+
+				*(out.(**serializers.WorkItemAttachment)) = &serializers.WorkItemAttachment{ID: "mockAttachmentID", URL: "mockAttachmentURL"}
+				return nil, http.StatusOK, nil
+			})
+
+			var ranges, methods []string
+			var uploadedSize int64
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "CallBinary", func(_ *client, url, path, method, mattermostUserID string, in io.Reader, headers map[string]string, out interface{}) (responseData []byte, statusCode int, err error) {
+				ranges = append(ranges, headers["Content-Range"])
+				methods = append(methods, method)
+				uploadedSize += in.(*io.SectionReader).Size()
+				if testCase.uploadErr != nil {
+					return nil, testCase.statusCode, testCase.uploadErr
+				}
+
+				if out != nil {
+					*(out.(**serializers.WorkItemAttachment)) = &serializers.WorkItemAttachment{ID: "mockAttachmentID", URL: "mockAttachmentURL"}
+				}
+				return nil, http.StatusOK, nil
+			})
+
+			attachment, statusCode, err := p.Client.UploadWorkItemAttachment(testutils.MockOrganization, testutils.MockProjectName, "mock file.png", bytes.NewReader(make([]byte, testCase.size)), testCase.size, testutils.MockMattermostUserID)
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+			assert.Equal(t, testCase.expectedRanges, ranges)
+			assert.Equal(t, testCase.expectedMethods, methods)
+			if testCase.startUploadErr != nil || testCase.uploadErr != nil {
+				assert.Error(t, err)
+				assert.Nil(t, attachment)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.size, uploadedSize)
+			assert.Equal(t, "mockAttachmentURL", attachment.URL)
+		})
+	}
+}
+
+func TestUpdatePullRequestVote(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "UpdatePullRequestVote: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "UpdatePullRequestVote: with error",
+			err:         errors.New("failed to update the vote on the pull request"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.UpdatePullRequestVote("mockOrganization", "mockProjectID", "mockRepositoryID", "mockReviewerID", 1, constants.PullRequestVoteApproved, "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestUpdatePullRequest(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "UpdatePullRequest: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "UpdatePullRequest: with error",
+			err:         errors.New("failed to update the pull request"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.UpdatePullRequest("mockOrganization", "mockProjectID", "mockRepositoryID", 1, &serializers.UpdatePullRequestRequest{Status: "abandoned"}, "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetPolicyEvaluations(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetPolicyEvaluations: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetPolicyEvaluations: with error",
+			err:         errors.New("failed to get the policy evaluations"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetPolicyEvaluations("mockOrganization", "mockProjectID", 1, "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestAddPullRequestThreadComment(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "AddPullRequestThreadComment: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "AddPullRequestThreadComment: with error",
+			err:         errors.New("failed to add the comment to the pull request thread"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.AddPullRequestThreadComment("mockOrganization", "mockProjectID", "mockRepositoryID", 1, 5, &serializers.PullRequestThreadCommentRequest{Content: "mockContent"}, "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestUpdatePullRequestThreadStatus(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "UpdatePullRequestThreadStatus: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "UpdatePullRequestThreadStatus: with error",
+			err:         errors.New("failed to update the status of the pull request thread"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.UpdatePullRequestThreadStatus("mockOrganization", "mockProjectID", "mockRepositoryID", 1, 5, "fixed", "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetPullRequests(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetPullRequests: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetPullRequests: with error",
+			err:         errors.New("failed to get the pull requests"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetPullRequests("mockOrganization", "mockProjectName", "mockRepository", &serializers.PullRequestSearchCriteria{ReviewerID: "mockReviewerID"}, "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetCommitDiffs(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetCommitDiffs: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetCommitDiffs: with error",
+			err:         errors.New("failed to get the commit diffs"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetCommitDiffs("mockOrganization", "mockProjectID", "mockRepositoryID", "mockBaseVersion", "mockTargetVersion", "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestUpdateWorkItemState(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "UpdateWorkItemState: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "UpdateWorkItemState: with error",
+			err:         errors.New("failed to update the state of the work item"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			statusCode, err := p.Client.UpdateWorkItemState("mockOrganization", "mockProjectName", "1", "Active", "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetGitRepositories(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetGitRepositories: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetGitRepositories: with error",
+			err:         errors.New("failed to get the git repositories"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetGitRepositories("mockOrganization", "mockProjectName", "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetGitRepositoryBranches(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetGitRepositoryBranches: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetGitRepositoryBranches: with error",
+			err:         errors.New("failed to get the git repository branches"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetGitRepositoryBranches("mockOrganization", "mockProjectName", "mockRepositoryID", "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestCreateGitBranch(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		result      *serializers.GitRefUpdateResultList
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "CreateGitBranch: valid",
+			result:      &serializers.GitRefUpdateResultList{Count: 1, Value: []*serializers.GitRefUpdateResult{{Name: "refs/heads/mockBranch", Success: true}}},
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "CreateGitBranch: no result is returned",
+			result:      &serializers.GitRefUpdateResultList{},
+			err:         errors.New("failed to create the git branch: no result is returned"),
+			statusCode:  http.StatusInternalServerError,
+		},
+		{
+			description: "CreateGitBranch: with error",
+			err:         errors.New("failed to create the git branch: error in calling the API"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "CallJSON", func(_ *client, url, path, method, mattermostUserID string, in, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				payload := *(in.(*[]*serializers.GitRefUpdate))
+				assert.Equal(t, "refs/heads/mockBranch", payload[0].Name)
+				assert.Equal(t, constants.ZeroObjectID, payload[0].OldObjectID)
+				if testCase.result == nil {
+					return nil, testCase.statusCode, errors.New("error in calling the API")
+				}
+
+				*(out.(**serializers.GitRefUpdateResultList)) = testCase.result
+				return nil, http.StatusOK, nil
+			})
+
+			result, statusCode, err := p.Client.CreateGitBranch("mockOrganization", "mockProjectName", "mockRepositoryID", "mockBranch", "mockObjectID", "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.EqualError(t, err, testCase.err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, result.Success)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestCreatePullRequest(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "CreatePullRequest: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "CreatePullRequest: with error",
+			err:         errors.New("failed to create the pull request"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.CreatePullRequest(testutils.MockOrganization, testutils.MockProjectID, "mockRepositoryID", &serializers.CreatePullRequestRequest{}, testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetPipelines(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetPipelines: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetPipelines: with error",
+			err:         errors.New("failed to get the pipelines"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetPipelines(testutils.MockOrganization, testutils.MockProjectName, testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestPreviewPipelineRun(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "PreviewPipelineRun: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "PreviewPipelineRun: with error",
+			err:         errors.New("failed to preview the pipeline run"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.PreviewPipelineRun(testutils.MockOrganization, testutils.MockProjectName, 1, testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestRunPipeline(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "RunPipeline: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "RunPipeline: with error",
+			err:         errors.New("failed to run the pipeline"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.RunPipeline(testutils.MockOrganization, testutils.MockProjectName, 1, &serializers.RunPipelineRequest{}, testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestCancelBuild(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "CancelBuild: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "CancelBuild: with error",
+			err:         errors.New("failed to cancel the build"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.CancelBuild(testutils.MockOrganization, testutils.MockProjectName, 1, testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestRetryBuild(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "RetryBuild: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "RetryBuild: with error",
+			err:         errors.New("failed to retry the build"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.RetryBuild(testutils.MockOrganization, testutils.MockProjectName, 1, testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestRetryBuildStage(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "RetryBuildStage: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "RetryBuildStage: with error",
+			err:         errors.New("failed to rerun the stage of the build"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			statusCode, err := p.Client.RetryBuildStage(testutils.MockOrganization, testutils.MockProjectName, 1, "Build", testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetBuildTimeline(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetBuildTimeline: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetBuildTimeline: with error",
+			err:         errors.New("failed to get the timeline of the build"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetBuildTimeline(testutils.MockOrganization, testutils.MockProjectName, 1, testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetBuildLogs(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetBuildLogs: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetBuildLogs: with error",
+			err:         errors.New("failed to get the logs of the build"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetBuildLogs("mockOrganization", "mockProjectName", 1, "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetBuildLogLines(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetBuildLogLines: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetBuildLogLines: with error",
+			err:         errors.New("failed to get the lines of the build log"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetBuildLogLines("mockOrganization", "mockProjectName", 1, 2, 1, "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetTestRuns(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetTestRuns: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetTestRuns: with error",
+			err:         errors.New("failed to get the test runs of the build"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetTestRuns("mockOrganization", "mockProjectName", 1, "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetFailedTestResults(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetFailedTestResults: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetFailedTestResults: with error",
+			err:         errors.New("failed to get the failed results of the test run"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetFailedTestResults("mockOrganization", "mockProjectName", 1, 5, "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetIdentityWithMembers(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetIdentityWithMembers: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetIdentityWithMembers: with error",
+			err:         errors.New("failed to get the identity"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetIdentityWithMembers("mockOrganization", "mockIdentityID", "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestGetIdentitiesByDescriptors(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "GetIdentitiesByDescriptors: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "GetIdentitiesByDescriptors: with error",
+			err:         errors.New("failed to get the identities"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.GetIdentitiesByDescriptors("mockOrganization", []string{"mockDescriptor"}, "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
+
+func TestSearchIdentities(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupTestPlugin(mockAPI)
+	for _, testCase := range []struct {
+		description string
+		err         error
+		statusCode  int
+	}{
+		{
+			description: "SearchIdentities: valid",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "SearchIdentities: with error",
+			err:         errors.New("failed to search the identities"),
+			statusCode:  http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&client{}), "Call", func(_ *client, basePath, method, path, contentType, mattermostUserID string, inBody io.Reader, out interface{}, formValues url.Values) (responseData []byte, statusCode int, err error) {
+				return nil, testCase.statusCode, testCase.err
+			})
+
+			_, statusCode, err := p.Client.SearchIdentities("mockOrganization", "mockUniqueName", "mockMattermostUserID")
+
+			if testCase.err != nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package plugin
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-api/cluster"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin"
+	clustermodel "github.com/mattermost/mattermost-server/v6/model"
+)
+
+// clusterPluginAPI adapts the plugin API to the API required by the cluster package of mattermost-plugin-api, which uses the models of Mattermost server v6
+type clusterPluginAPI struct {
+	api plugin.API
+}
+
+func (c *clusterPluginAPI) KVGet(key string) ([]byte, *clustermodel.AppError) {
+	data, appErr := c.api.KVGet(key)
+	return data, toClusterAppError(appErr)
+}
+
+func (c *clusterPluginAPI) KVSetWithOptions(key string, value []byte, options clustermodel.PluginKVSetOptions) (bool, *clustermodel.AppError) {
+	isSet, appErr := c.api.KVSetWithOptions(key, value, model.PluginKVSetOptions{
+		Atomic:          options.Atomic,
+		OldValue:        options.OldValue,
+		ExpireInSeconds: options.ExpireInSeconds,
+	})
+	return isSet, toClusterAppError(appErr)
+}
+
+func (c *clusterPluginAPI) KVDelete(key string) *clustermodel.AppError {
+	return toClusterAppError(c.api.KVDelete(key))
+}
+
+func (c *clusterPluginAPI) KVList(page, count int) ([]string, *clustermodel.AppError) {
+	keys, appErr := c.api.KVList(page, count)
+	return keys, toClusterAppError(appErr)
+}
+
+func (c *clusterPluginAPI) LogError(msg string, keyValuePairs ...interface{}) {
+	c.api.LogError(msg, keyValuePairs...)
+}
+
+func toClusterAppError(appErr *model.AppError) *clustermodel.AppError {
+	if appErr == nil {
+		return nil
+	}
+
+	return &clustermodel.AppError{
+		Id:            appErr.Id,
+		Message:       appErr.Message,
+		DetailedError: appErr.DetailedError,
+		RequestId:     appErr.RequestId,
+		StatusCode:    appErr.StatusCode,
+		Where:         appErr.Where,
+	}
+}
+
+// scheduleClusterJob schedules a job which runs at an interval on only one server of the cluster at a time
+func (p *Plugin) scheduleClusterJob(key string, interval time.Duration, callback func()) (*cluster.Job, error) {
+	return cluster.Schedule(&clusterPluginAPI{api: p.API}, key, cluster.MakeWaitForInterval(interval), callback)
+}
@@ -0,0 +1,28 @@
+package plugin
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	clustermodel "github.com/mattermost/mattermost-server/v6/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterPluginAPI(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	api := &clusterPluginAPI{api: mockAPI}
+
+	mockAPI.On("KVSetWithOptions", "mockKey", []byte("mockValue"), model.PluginKVSetOptions{Atomic: true, OldValue: []byte("mockOldValue"), ExpireInSeconds: 10}).Return(true, nil)
+	isSet, appErr := api.KVSetWithOptions("mockKey", []byte("mockValue"), clustermodel.PluginKVSetOptions{Atomic: true, OldValue: []byte("mockOldValue"), ExpireInSeconds: 10})
+	assert.True(t, isSet)
+	assert.Nil(t, appErr)
+
+	mockAPI.On("KVGet", "mockKey").Return(nil, &model.AppError{Id: "mockErrorID", Message: "mockError", StatusCode: http.StatusInternalServerError})
+	data, appErr := api.KVGet("mockKey")
+	assert.Nil(t, data)
+	assert.Equal(t, &clustermodel.AppError{Id: "mockErrorID", Message: "mockError", StatusCode: http.StatusInternalServerError}, appErr)
+
+	mockAPI.AssertExpectations(t)
+}
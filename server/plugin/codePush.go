@@ -0,0 +1,166 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// GetCodePushAttachment returns the attachment of a code pushed notification listing the pushed commits.
+// Branch and tag creations and deletions are shown distinctly, and updates which rewrite the history of the branch are flagged as force pushes.
+// The changes of the push are fetched using the Azure DevOps account of the user who added the subscription and are omitted if they can not be fetched.
+func (p *Plugin) GetCodePushAttachment(body *serializers.SubscriptionNotification) *model.SlackAttachment {
+	attachment := &model.SlackAttachment{
+		Pretext:    body.Message.Markdown,
+		AuthorName: constants.SlackAttachmentAuthorNameRepos,
+		AuthorIcon: fmt.Sprintf(constants.PublicFiles, p.GetSiteURL(), constants.PluginID, constants.FileNameReposIcon),
+		Color:      constants.IconColorRepos,
+		Title:      "Commit(s)",
+		Footer:     body.Resource.Repository.Name,
+		FooterIcon: fmt.Sprintf(constants.PublicFiles, p.GetSiteURL(), constants.PluginID, constants.FileNameGitBranchIcon),
+	}
+
+	subscription, err := p.getSubscriptionDetails(body.SubscriptionID)
+	if err != nil {
+		p.API.LogError(constants.FetchSubscriptionListError, "Error", err.Error())
+	}
+
+	var filters *serializers.CodePushFilters
+	if subscription != nil {
+		filters = subscription.CodePushFilters
+	}
+
+	commits := getCodePushCommits(body.Resource.Commits, body.Resource.Repository, filters)
+	if len(body.Resource.RefUpdates) == 0 {
+		attachment.Text = getTextOrNone(commits)
+		return attachment
+	}
+
+	refUpdate := body.Resource.RefUpdates[0]
+	kind, name := refUpdate.GetKindAndName()
+	attachment.Footer = fmt.Sprintf("%s | %s", name, body.Resource.Repository.Name)
+
+	var lines []string
+	switch {
+	case refUpdate.IsCreated():
+		attachment.Title = fmt.Sprintf("%s created", kind)
+		lines = append(lines, fmt.Sprintf(constants.CodePushRefCreated, kind, name, getCommitLink(body.Resource.Repository, refUpdate.NewObjectID, "")))
+	case refUpdate.IsDeleted():
+		attachment.Title = fmt.Sprintf("%s deleted", kind)
+		attachment.Text = fmt.Sprintf(constants.CodePushRefDeleted, kind, name, serializers.GetShortObjectID(refUpdate.OldObjectID))
+		return attachment
+	case subscription != nil && refUpdate.OldObjectID != "" && refUpdate.NewObjectID != "":
+		commitDiffs, _, err := p.Client.GetCommitDiffs(subscription.OrganizationName, subscription.ProjectID, body.Resource.Repository.ID, refUpdate.OldObjectID, refUpdate.NewObjectID, subscription.MattermostUserID)
+		if err != nil {
+			p.API.LogError(constants.ErrorFetchCommitDiffs, "SubscriptionID", subscription.SubscriptionID, "Error", err.Error())
+			break
+		}
+
+		// The previous commit of the branch is not present in the branch anymore if its history was rewritten
+		if commitDiffs.BehindCount > 0 {
+			attachment.Title = "Force push"
+			lines = append(lines, fmt.Sprintf(constants.CodePushForcePush, name, serializers.GetShortObjectID(refUpdate.OldObjectID), serializers.GetShortObjectID(refUpdate.NewObjectID), commitDiffs.BehindCount))
+		}
+
+		attachment.Fields = []*model.SlackAttachmentField{
+			{
+				Title: "Changes",
+				Value: fmt.Sprintf(constants.CodePushChanges, commitDiffs.ChangeCounts["Add"], commitDiffs.ChangeCounts["Edit"], commitDiffs.ChangeCounts["Delete"]),
+			},
+		}
+	}
+
+	if commits != "" {
+		lines = append(lines, commits)
+	}
+
+	attachment.Text = getTextOrNone(strings.Join(lines, "\n"))
+	return attachment
+}
+
+func getTextOrNone(text string) string {
+	if text == "" {
+		return "None" // When no commits are present
+	}
+
+	return text
+}
+
+// getCodePushCommits lists up to constants.MaxCodePushCommits commits of a push with their short IDs, titles and authors, or returns an empty string if no commits are present.
+// The merge and bot commits are counted instead of being listed if they are collapsed by the filters of the subscription.
+func getCodePushCommits(commits []serializers.Commit, repository serializers.Repository, filters *serializers.CodePushFilters) string {
+	var lines []string
+	collapsedMergeCommits, collapsedBotCommits, moreCommits := 0, 0, 0
+	for index := range commits {
+		commit := &commits[index]
+		switch {
+		case filters != nil && filters.CollapseMergeCommits && commit.IsMergeCommit():
+			collapsedMergeCommits++
+		case filters != nil && filters.CollapseBotCommits && commit.IsBotCommit():
+			collapsedBotCommits++
+		case len(lines) >= constants.MaxCodePushCommits:
+			moreCommits++
+		default:
+			line := fmt.Sprintf(constants.CodePushCommit, getCommitLink(repository, commit.CommitID, commit.URL), commit.GetTitle())
+			if author := commit.GetAuthorName(); author != "" {
+				line += fmt.Sprintf(constants.CodePushCommitAuthor, author)
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	if moreCommits > 0 {
+		lines = append(lines, fmt.Sprintf(constants.CodePushMoreCommits, moreCommits))
+	}
+	if collapsedMergeCommits > 0 {
+		lines = append(lines, fmt.Sprintf(constants.CodePushCollapsedCommits, collapsedMergeCommits, "merge"))
+	}
+	if collapsedBotCommits > 0 {
+		lines = append(lines, fmt.Sprintf(constants.CodePushCollapsedCommits, collapsedBotCommits, "bot"))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// getCommitLink returns the short ID of a commit linked to the commit in the web UI of the repository.
+// The given URL is linked if the URL of the repository is not present, and the short ID is not linked if neither is present.
+func getCommitLink(repository serializers.Repository, commitID, defaultURL string) string {
+	commitURL := defaultURL
+	if repository.RemoteURL != "" {
+		commitURL = fmt.Sprintf(constants.CommitWebURL, repository.RemoteURL, commitID)
+	}
+
+	if commitURL == "" {
+		return fmt.Sprintf("`%s`", serializers.GetShortObjectID(commitID))
+	}
+
+	return fmt.Sprintf("[`%s`](%s)", serializers.GetShortObjectID(commitID), commitURL)
+}
+
+// UpdateCodePushFilters sets the code push filters of a Repos subscription, the filters are removed if they are empty.
+func (p *Plugin) UpdateCodePushFilters(mattermostUserID, subscriptionID string, filters *serializers.CodePushFilters) (*serializers.SubscriptionDetails, int, error) {
+	subscription, statusCode, err := p.getSubscriptionToFilter(mattermostUserID, subscriptionID, constants.CommandRepos)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	if err = filters.IsValid(subscription.EventType); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	subscription.CodePushFilters = nil
+	if !filters.IsEmpty() {
+		subscription.CodePushFilters = filters
+	}
+
+	if err = p.Store.StoreSubscription(subscription); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return subscription, http.StatusOK, nil
+}
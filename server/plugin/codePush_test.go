@@ -0,0 +1,287 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+const (
+	mockOldObjectID = "1111111111111111111111111111111111111111"
+	mockNewObjectID = "2222222222222222222222222222222222222222"
+)
+
+func TestGetCodePushAttachment(t *testing.T) {
+	defer monkey.UnpatchAll()
+	subscription := &serializers.SubscriptionDetails{
+		SubscriptionID:   "mockSubscriptionID",
+		MattermostUserID: testutils.MockMattermostUserID,
+		OrganizationName: testutils.MockOrganization,
+		ProjectID:        testutils.MockProjectID,
+	}
+	collapsingSubscription := *subscription
+	collapsingSubscription.CodePushFilters = &serializers.CodePushFilters{CollapseMergeCommits: true, CollapseBotCommits: true}
+
+	var manyCommits []serializers.Commit
+	for i := 0; i < constants.MaxCodePushCommits+2; i++ {
+		manyCommits = append(manyCommits, testutils.GetMockCommit(fmt.Sprintf("%040d", i), "mockComment", "mockAuthor"))
+	}
+
+	for _, testCase := range []struct {
+		description    string
+		body           *serializers.SubscriptionNotification
+		subscription   *serializers.SubscriptionDetails
+		commitDiffs    *serializers.CommitDiffs
+		commitDiffsErr error
+		expectDiffs    bool
+		expectedTitle  string
+		expectedText   string
+		expectedFields []*model.SlackAttachmentField
+	}{
+		{
+			description:   "GetCodePushAttachment: commits are listed with their authors and changes",
+			body:          testutils.GetMockCodePushNotification(mockOldObjectID, mockNewObjectID, testutils.GetMockCommit("abcdef0123456789", "mockTitle\n\nmockDescription", "mockAuthor")),
+			subscription:  subscription,
+			commitDiffs:   &serializers.CommitDiffs{AheadCount: 1, ChangeCounts: map[string]int{"Add": 1, "Edit": 2}},
+			expectDiffs:   true,
+			expectedTitle: "Commit(s)",
+			expectedText:  "[`abcdef01`](https://dev.azure.com/mockOrganization/mockProjectName/_git/mockRepo/commit/abcdef0123456789) mockTitle - mockAuthor",
+			expectedFields: []*model.SlackAttachmentField{
+				{Title: "Changes", Value: "1 added, 2 edited, 0 deleted"},
+			},
+		},
+		{
+			description:   "GetCodePushAttachment: commits are limited",
+			body:          testutils.GetMockCodePushNotification(mockOldObjectID, mockNewObjectID, manyCommits...),
+			expectedTitle: "Commit(s)",
+			expectedText:  fmt.Sprintf(constants.CodePushMoreCommits, 2),
+		},
+		{
+			description:   "GetCodePushAttachment: force push",
+			body:          testutils.GetMockCodePushNotification(mockOldObjectID, mockNewObjectID, testutils.GetMockCommit(mockNewObjectID, "mockTitle", "mockAuthor")),
+			subscription:  subscription,
+			commitDiffs:   &serializers.CommitDiffs{AheadCount: 1, BehindCount: 3},
+			expectDiffs:   true,
+			expectedTitle: "Force push",
+			expectedText:  fmt.Sprintf(constants.CodePushForcePush, "feature/mock", "11111111", "22222222", 3),
+			expectedFields: []*model.SlackAttachmentField{
+				{Title: "Changes", Value: "0 added, 0 edited, 0 deleted"},
+			},
+		},
+		{
+			description:    "GetCodePushAttachment: error while fetching the changes",
+			body:           testutils.GetMockCodePushNotification(mockOldObjectID, mockNewObjectID, testutils.GetMockCommit(mockNewObjectID, "mockTitle", "mockAuthor")),
+			subscription:   subscription,
+			commitDiffsErr: errors.New("failed to get the commit diffs"),
+			expectDiffs:    true,
+			expectedTitle:  "Commit(s)",
+			expectedText:   "mockTitle - mockAuthor",
+		},
+		{
+			description:   "GetCodePushAttachment: branch created",
+			body:          testutils.GetMockCodePushNotification(constants.ZeroObjectID, mockNewObjectID),
+			subscription:  subscription,
+			expectedTitle: "Branch created",
+			expectedText:  "Branch `feature/mock` created at [`22222222`](https://dev.azure.com/mockOrganization/mockProjectName/_git/mockRepo/commit/2222222222222222222222222222222222222222)",
+		},
+		{
+			description:   "GetCodePushAttachment: branch deleted",
+			body:          testutils.GetMockCodePushNotification(mockOldObjectID, constants.ZeroObjectID),
+			subscription:  subscription,
+			expectedTitle: "Branch deleted",
+			expectedText:  fmt.Sprintf(constants.CodePushRefDeleted, "Branch", "feature/mock", "11111111"),
+		},
+		{
+			description: "GetCodePushAttachment: merge and bot commits are collapsed",
+			body: testutils.GetMockCodePushNotification(constants.ZeroObjectID, mockNewObjectID,
+				testutils.GetMockCommit(mockOldObjectID, "Merged PR 42: mockTitle", "mockAuthor"),
+				testutils.GetMockCommit(mockOldObjectID, "Merge branch 'main'", "mockAuthor"),
+				testutils.GetMockCommit(mockNewObjectID, "Bump version", "Project Collection Build Service (mockOrganization)"),
+			),
+			subscription:  &collapsingSubscription,
+			expectedTitle: "Branch created",
+			expectedText:  fmt.Sprintf(constants.CodePushCollapsedCommits, 2, "merge") + "\n" + fmt.Sprintf(constants.CodePushCollapsedCommits, 1, "bot"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, mockedClient)
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 5)...)
+
+			var subscriptions []*serializers.SubscriptionDetails
+			if testCase.subscription != nil {
+				subscriptions = append(subscriptions, testCase.subscription)
+			}
+			mockedStore.EXPECT().GetAllSubscriptions("").Return(subscriptions, nil)
+
+			if testCase.expectDiffs {
+				mockedClient.EXPECT().GetCommitDiffs(testutils.MockOrganization, testutils.MockProjectID, "mockRepositoryID", mockOldObjectID, mockNewObjectID, testutils.MockMattermostUserID).Return(testCase.commitDiffs, http.StatusOK, testCase.commitDiffsErr)
+			}
+
+			attachment := p.GetCodePushAttachment(testCase.body)
+
+			assert.Equal(t, testCase.expectedTitle, attachment.Title)
+			assert.Contains(t, attachment.Text, testCase.expectedText)
+			assert.Equal(t, testCase.expectedFields, attachment.Fields)
+			assert.Equal(t, "feature/mock | mockRepo", attachment.Footer)
+			assert.LessOrEqual(t, strings.Count(attachment.Text, "\n"), constants.MaxCodePushCommits)
+		})
+	}
+}
+
+func TestUpdateCodePushFilters(t *testing.T) {
+	for _, testCase := range []struct {
+		description        string
+		subscription       *serializers.SubscriptionDetails
+		filters            *serializers.CodePushFilters
+		expectStore        bool
+		expectedFilters    *serializers.CodePushFilters
+		expectedStatusCode int
+	}{
+		{
+			description:        "UpdateCodePushFilters: filters are updated",
+			subscription:       &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", ServiceType: constants.CommandRepos, EventType: constants.SubscriptionEventCodePushed, ChannelID: testutils.MockChannelID},
+			filters:            &serializers.CodePushFilters{CollapseBotCommits: true},
+			expectStore:        true,
+			expectedFilters:    &serializers.CodePushFilters{CollapseBotCommits: true},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "UpdateCodePushFilters: filters are removed",
+			subscription:       &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", ServiceType: constants.CommandRepos, EventType: constants.SubscriptionEventCodePushed, ChannelID: testutils.MockChannelID, CodePushFilters: &serializers.CodePushFilters{CollapseMergeCommits: true}},
+			filters:            &serializers.CodePushFilters{},
+			expectStore:        true,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "UpdateCodePushFilters: not a Repos subscription",
+			subscription:       &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", ServiceType: constants.CommandBoards, EventType: constants.SubscriptionEventWorkItemCreated, ChannelID: testutils.MockChannelID},
+			filters:            &serializers.CodePushFilters{CollapseBotCommits: true},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			description:        "UpdateCodePushFilters: not a code pushed subscription",
+			subscription:       &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", ServiceType: constants.CommandRepos, EventType: constants.SubscriptionEventPullRequestCreated, ChannelID: testutils.MockChannelID},
+			filters:            &serializers.CodePushFilters{CollapseBotCommits: true},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+			mockedStore.EXPECT().GetAllSubscriptions("").Return([]*serializers.SubscriptionDetails{testCase.subscription}, nil)
+			mockAPI.On("GetChannelMember", testutils.MockChannelID, testutils.MockMattermostUserID).Return(&model.ChannelMember{}, nil)
+			if testCase.expectStore {
+				mockedStore.EXPECT().StoreSubscription(gomock.Any()).Return(nil)
+			}
+
+			subscription, statusCode, err := p.UpdateCodePushFilters(testutils.MockMattermostUserID, "mockSubscriptionID", testCase.filters)
+
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			if testCase.expectedStatusCode != http.StatusOK {
+				assert.NotNil(t, err)
+				assert.Nil(t, subscription)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedFilters, subscription.CodePushFilters)
+		})
+	}
+}
+
+func TestExecuteCodePushFiltersCommand(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	for _, testCase := range []struct {
+		description      string
+		command          string
+		expectedFilters  *serializers.CodePushFilters
+		statusCode       int
+		err              error
+		ephemeralMessage string
+	}{
+		{
+			description:      "CodePushFiltersCommand: filters are not provided",
+			command:          "/azuredevops repos subscription filter mockSubscriptionID",
+			ephemeralMessage: constants.CodePushFiltersRequired,
+		},
+		{
+			description:      "CodePushFiltersCommand: invalid filter",
+			command:          "/azuredevops repos subscription filter mockSubscriptionID collapse=reverts",
+			ephemeralMessage: fmt.Sprintf(constants.InvalidCodePushFilterArgument, "collapse=reverts"),
+		},
+		{
+			description:      "CodePushFiltersCommand: filters are updated",
+			command:          "/azuredevops repos subscription filter mockSubscriptionID collapse=merges,bots",
+			expectedFilters:  &serializers.CodePushFilters{CollapseMergeCommits: true, CollapseBotCommits: true},
+			statusCode:       http.StatusOK,
+			ephemeralMessage: fmt.Sprintf(constants.CodePushFiltersUpdated, "mockSubscriptionID", "merge commits collapsed, bot commits collapsed"),
+		},
+		{
+			description:      "CodePushFiltersCommand: filters are removed",
+			command:          "/azuredevops repos subscription filter mockSubscriptionID clear",
+			expectedFilters:  &serializers.CodePushFilters{},
+			statusCode:       http.StatusOK,
+			ephemeralMessage: fmt.Sprintf(constants.WorkItemFiltersCleared, "mockSubscriptionID"),
+		},
+		{
+			description:      "CodePushFiltersCommand: filters are not supported for the subscription",
+			command:          "/azuredevops repos subscription filter mockSubscriptionID collapse=bots",
+			expectedFilters:  &serializers.CodePushFilters{CollapseBotCommits: true},
+			statusCode:       http.StatusBadRequest,
+			err:              errors.New(constants.CodePushFiltersNotSupported),
+			ephemeralMessage: constants.CodePushFiltersNotSupported,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				post := args.Get(1).(*model.Post)
+				assert.Equal(t, testCase.ephemeralMessage, post.Message)
+			}).Once().Return(&model.Post{})
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return true
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdateCodePushFilters", func(_ *Plugin, _, subscriptionID string, filters *serializers.CodePushFilters) (*serializers.SubscriptionDetails, int, error) {
+				assert.Equal(t, "mockSubscriptionID", subscriptionID)
+				assert.Equal(t, testCase.expectedFilters, filters)
+				if testCase.err != nil {
+					return nil, testCase.statusCode, testCase.err
+				}
+
+				subscription := &serializers.SubscriptionDetails{SubscriptionID: subscriptionID}
+				if !filters.IsEmpty() {
+					subscription.CodePushFilters = filters
+				}
+				return subscription, testCase.statusCode, nil
+			})
+
+			_, err := p.ExecuteCommand(nil, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID})
+			assert.Nil(t, err)
+		})
+	}
+}
@@ -3,7 +3,10 @@ package plugin
 import (
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/pkg/errors"
 	"golang.org/x/text/cases"
@@ -14,6 +17,8 @@ import (
 	"github.com/mattermost/mattermost-server/v5/plugin"
 
 	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/store"
 )
 
 type HandlerFunc func(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError)
@@ -25,13 +30,15 @@ type Handler struct {
 
 var azureDevopsCommandHandler = Handler{
 	handlers: map[string]HandlerFunc{
-		constants.CommandHelp:       azureDevopsHelpCommand,
-		constants.CommandConnect:    azureDevopsConnectCommand,
-		constants.CommandDisconnect: azureDevopsDisconnectCommand,
-		constants.CommandLink:       azureDevopsAccountConnectionCheck,
-		constants.CommandBoards:     azureDevopsBoardsCommand,
-		constants.CommandRepos:      azureDevopsReposCommand,
-		constants.CommandPipelines:  azureDevopsPipelinesCommand,
+		constants.CommandHelp:          azureDevopsHelpCommand,
+		constants.CommandConnect:       azureDevopsConnectCommand,
+		constants.CommandDisconnect:    azureDevopsDisconnectCommand,
+		constants.CommandLink:          azureDevopsAccountConnectionCheck,
+		constants.CommandBoards:        azureDevopsBoardsCommand,
+		constants.CommandRepos:         azureDevopsReposCommand,
+		constants.CommandPipelines:     azureDevopsPipelinesCommand,
+		constants.CommandNotifications: azureDevopsNotificationsCommand,
+		constants.CommandIdentity:      azureDevopsIdentityCommand,
 	},
 	defaultHandler: executeDefault,
 }
@@ -65,7 +72,6 @@ func (p *Plugin) getAutoCompleteData() *model.AutocompleteData {
 	link.AddTextArgument("URL of the project to be linked", "[projectURL]", "")
 	azureDevops.AddCommand(link)
 
-	subscription := model.NewAutocompleteData(constants.CommandSubscription, "", "Add/list/delete subscriptions")
 	subscriptionAdd := model.NewAutocompleteData(constants.CommandAdd, "", "Add a new subscription")
 	subscriptionList := model.NewAutocompleteData(constants.CommandList, "", "List subscriptions")
 	subscriptionDelete := model.NewAutocompleteData(constants.CommandDelete, "", "Delete a subscription")
@@ -77,28 +83,180 @@ func (p *Plugin) getAutoCompleteData() *model.AutocompleteData {
 	subscriptionCreatedByAnyone := model.NewAutocompleteData(constants.FilterCreatedByAnyone, "", "Created By Anyone")
 	subscriptionCreatedByAnyone.AddCommand(subscriptionShowForAllChannels)
 	subscriptionList.AddCommand(subscriptionCreatedByAnyone)
-	subscription.AddCommand(subscriptionAdd)
-	subscription.AddCommand(subscriptionList)
-	subscription.AddCommand(subscriptionDelete)
 
 	boards := model.NewAutocompleteData(constants.CommandBoards, "", "Create a new work-item or add/list/delete board subscriptions")
 	workitem := model.NewAutocompleteData(constants.CommandWorkitem, "", "Create a new work-item")
 	create := model.NewAutocompleteData(constants.CommandCreate, "", "Create a new work-item")
 	create.AddTextArgument("Title", "[title]", "")
 	create.AddTextArgument("Description", "[description]", "")
+	create.AddTextArgument("Type of the work item, it can be changed in the dialog", "[type=type]", "")
 	workitem.AddCommand(create)
+	breakdown := model.NewAutocompleteData(constants.CommandBreakdown, "", "Create child tasks of a work item")
+	breakdown.AddTextArgument("ID or link of the parent work item", "[parent work item ID or link]", "")
+	breakdown.AddTextArgument("Titles of the child tasks, each within quotes", "[title...]", "")
+	workitem.AddCommand(breakdown)
 	boards.AddCommand(workitem)
-	boards.AddCommand(subscription)
+
+	preset := model.NewAutocompleteData(constants.CommandPreset, "", "Add/import/list/delete work item presets of the current channel")
+	presetAdd := model.NewAutocompleteData(constants.CommandAdd, "", "Add a new work item preset")
+	presetAdd.AddTextArgument("Name of the preset", "[preset name]", "")
+	presetAdd.AddTextArgument("Work item type and field values e.g. type=Bug area=Web\\Checkout tags=triage", "type=[work item type] [field=value...]", "")
+	presetImport := model.NewAutocompleteData(constants.CommandImport, "", "Import an Azure DevOps work item template as a preset")
+	presetImport.AddTextArgument("Name of the preset", "[preset name]", "")
+	presetImport.AddTextArgument("Name of the template and the team it belongs to", "template=[template name] [team=team name]", "")
+	presetList := model.NewAutocompleteData(constants.CommandList, "", "List work item presets")
+	presetDelete := model.NewAutocompleteData(constants.CommandDelete, "", "Delete a work item preset")
+	presetDelete.AddTextArgument("Name of the preset to be deleted", "[preset name]", "")
+	preset.AddCommand(presetAdd)
+	preset.AddCommand(presetImport)
+	preset.AddCommand(presetList)
+	preset.AddCommand(presetDelete)
+	boards.AddCommand(preset)
+
+	sprint := model.NewAutocompleteData(constants.CommandSprint, "", "View the current iteration of a team")
+	sprint.AddTextArgument("Name of one of your linked projects", "[project]", "")
+	sprint.AddTextArgument("Name of the team, defaults to the project team", "[team]", "")
+	sprint.AddNamedTextArgument(strings.TrimPrefix(constants.FlagStaleDays, "--"), "Highlight work items not updated in this number of days", "[number of days]", "", false)
+	boards.AddCommand(sprint)
+
+	thread := model.NewAutocompleteData(constants.CommandThread, "", "Link/unlink the current thread to a work item")
+	threadLink := model.NewAutocompleteData(constants.CommandLink, "", "Link the current thread to a work item")
+	threadLink.AddTextArgument("Link of the work item", "[work item link]", "")
+	threadLink.AddStaticListArgument("Add replies in the thread as comments on the work item", false, []model.AutocompleteListItem{
+		{Item: constants.FlagMirror, HelpText: "Add replies in the thread as comments on the work item"},
+	})
+	threadUnlink := model.NewAutocompleteData(constants.CommandUnlink, "", "Unlink the current thread from its work item")
+	thread.AddCommand(threadLink)
+	thread.AddCommand(threadUnlink)
+	boards.AddCommand(thread)
+
+	// Only the notifications of Boards subscriptions can be filtered by the plugin
+	boardsSubscription := model.NewAutocompleteData(constants.CommandSubscription, "", "Add/list/delete/filter subscriptions")
+	subscriptionFilter := model.NewAutocompleteData(constants.CommandFilter, "", "Only post the notifications of a subscription for matching work items")
+	subscriptionFilter.AddTextArgument("ID of the subscription to be filtered", "[subscription id]", "")
+	subscriptionFilter.AddTextArgument("Filters or clear to remove them e.g. type=Bug,Incident tag=customer from=Active to=Resolved priority<=2", "[filter...] or clear", "")
+	boardsSubscription.AddCommand(subscriptionAdd)
+	boardsSubscription.AddCommand(subscriptionList)
+	boardsSubscription.AddCommand(subscriptionDelete)
+	boardsSubscription.AddCommand(subscriptionFilter)
+	boards.AddCommand(boardsSubscription)
 	azureDevops.AddCommand(boards)
 
-	repos := model.NewAutocompleteData(constants.CommandRepos, "", "Add/list/delete repo subscriptions")
-	repos.AddCommand(subscription)
+	repos := model.NewAutocompleteData(constants.CommandRepos, "", "Manage pull requests or add/list/delete/filter repo subscriptions")
+	reposSubscription := model.NewAutocompleteData(constants.CommandSubscription, "", "Add/list/delete/filter subscriptions")
+	codePushFilter := model.NewAutocompleteData(constants.CommandFilter, "", "Collapse the merge and/or bot commits in the notifications of a code pushed subscription")
+	codePushFilter.AddTextArgument("ID of the subscription to be filtered", "[subscription id]", "")
+	codePushFilter.AddTextArgument("Commits to be collapsed or clear to remove the filters e.g. collapse=merges,bots", "[collapse=merges,bots] or clear", "")
+	reposSubscription.AddCommand(subscriptionAdd)
+	reposSubscription.AddCommand(subscriptionList)
+	reposSubscription.AddCommand(subscriptionDelete)
+	reposSubscription.AddCommand(codePushFilter)
+	repos.AddCommand(reposSubscription)
+	pullRequest := model.NewAutocompleteData(constants.CommandPullRequest, "", "Create, complete, abandon or reactivate a pull request")
+	pullRequestCreate := model.NewAutocompleteData(constants.CommandCreate, "", "Create a pull request, the description, reviewers and work items are chosen in a dialog")
+	pullRequestCreate.AddTextArgument("Name of the repo", "[repo]", "")
+	pullRequestCreate.AddTextArgument("Name of the source branch", "[source branch]", "")
+	pullRequestCreate.AddTextArgument("Name of the target branch", "[target branch]", "")
+	pullRequestCreate.AddTextArgument("Title of the pull request in quotes", "\"[title]\"", "")
+	pullRequestCreate.AddTextArgument("Organization and project of the repo, optional if you have linked a single project", "[organization=organization] [project=project]", "")
+	pullRequest.AddCommand(pullRequestCreate)
+	for _, action := range []struct {
+		name     string
+		helpText string
+	}{
+		{name: constants.CommandComplete, helpText: "Complete a pull request once all its blocking policies pass"},
+		{name: constants.CommandAutoComplete, helpText: "Complete a pull request automatically once all its policies pass"},
+		{name: constants.CommandAbandon, helpText: "Abandon an active pull request"},
+		{name: constants.CommandReactivate, helpText: "Reactivate an abandoned pull request"},
+	} {
+		pullRequestAction := model.NewAutocompleteData(action.name, "", action.helpText)
+		pullRequestAction.AddTextArgument("ID or link of the pull request", "[pull request ID or link]", "")
+		if action.name == constants.CommandComplete || action.name == constants.CommandAutoComplete {
+			pullRequestAction.AddTextArgument("Completion options", "[--merge-strategy strategy] [--delete-source-branch] [--transition-work-items]", "")
+		}
+		pullRequest.AddCommand(pullRequestAction)
+	}
+	repos.AddCommand(pullRequest)
+	pullRequests := model.NewAutocompleteData(constants.CommandPullRequests, "", "View the active pull requests of a project")
+	pullRequests.AddTextArgument("Name of one of your linked projects", "[project]", "")
+	pullRequests.AddTextArgument("Name of the repo, defaults to all the repos of the project", "[repo]", "")
+	pullRequests.AddStaticListArgument("Pull requests to view", false, []model.AutocompleteListItem{
+		{Item: constants.PullRequestFilterMine, HelpText: "Pull requests created by you"},
+		{Item: constants.PullRequestFilterReview, HelpText: "Pull requests you are a reviewer on"},
+		{Item: constants.PullRequestFilterAll, HelpText: "All the active pull requests"},
+	})
+	repos.AddCommand(pullRequests)
+	reminders := model.NewAutocompleteData(constants.CommandReminders, "", "Add/list/delete pull request review reminders of the current channel")
+	reminderAdd := model.NewAutocompleteData(constants.CommandAdd, "", "Add a reminder listing the pull requests waiting for review")
+	reminderAdd.AddStaticListArgument("Days of the reminder", true, []model.AutocompleteListItem{
+		{Item: constants.ReminderDaysWeekdays, HelpText: "Monday to Friday"},
+		{Item: constants.ReminderDaysDaily, HelpText: "Every day"},
+		{Item: "mon,wed,fri", HelpText: "Comma separated days of the week"},
+	})
+	reminderAdd.AddTextArgument("Time of the reminder in your timezone", "[HH:MM]", "")
+	reminderAdd.AddTextArgument("Repo and minimum age of the pull requests e.g. repo=web min-age=24h", "[repo=repo name] [min-age=duration] [organization=organization] [project=project]", "")
+	reminderList := model.NewAutocompleteData(constants.CommandList, "", "List the review reminders")
+	reminderDelete := model.NewAutocompleteData(constants.CommandDelete, "", "Delete a review reminder")
+	reminderDelete.AddTextArgument("ID of the reminder to be deleted", "[reminder ID]", "")
+	reminders.AddCommand(reminderAdd)
+	reminders.AddCommand(reminderList)
+	reminders.AddCommand(reminderDelete)
+	repos.AddCommand(reminders)
+	branch := model.NewAutocompleteData(constants.CommandBranch, "", "Create a branch to start working on a work item")
+	branchCreate := model.NewAutocompleteData(constants.CommandCreate, "", "Create a branch to start working on a work item and link it to the work item")
+	branchCreate.AddTextArgument("ID or link of the work item", "[work item ID or link]", "")
+	branchCreate.AddTextArgument("Name of the repo, optional if the project has a single repo or a repo named after the project", "[repo]", "")
+	branchCreate.AddTextArgument("Name of the base branch, defaults to the default branch of the repo", "[base branch]", "")
+	branchCreate.AddStaticListArgument("Move the work item to the Active state", false, []model.AutocompleteListItem{
+		{Item: constants.FlagActivate, HelpText: "Move the work item to the Active state"},
+	})
+	branch.AddCommand(branchCreate)
+	repos.AddCommand(branch)
 	azureDevops.AddCommand(repos)
 
-	pipelines := model.NewAutocompleteData(constants.CommandPipelines, "", "Add/list/delete pipeline subscriptions")
-	pipelines.AddCommand(subscription)
+	pipelines := model.NewAutocompleteData(constants.CommandPipelines, "", "Add/list/delete/filter pipeline subscriptions or run a pipeline")
+	pipelinesSubscription := model.NewAutocompleteData(constants.CommandSubscription, "", "Add/list/delete/filter subscriptions")
+	pipelineFilter := model.NewAutocompleteData(constants.CommandFilter, "", "Notify the user who triggered a failed build in the notifications of a build completed or run state changed subscription")
+	pipelineFilter.AddTextArgument("ID of the subscription to be filtered", "[subscription id]", "")
+	pipelineFilter.AddTextArgument("Users to be notified or clear to remove the filters e.g. notify=requester", "[notify=requester] or clear", "")
+	pipelinesSubscription.AddCommand(subscriptionAdd)
+	pipelinesSubscription.AddCommand(subscriptionList)
+	pipelinesSubscription.AddCommand(subscriptionDelete)
+	pipelinesSubscription.AddCommand(pipelineFilter)
+	pipelines.AddCommand(pipelinesSubscription)
+	pipelineRun := model.NewAutocompleteData(constants.CommandRun, "", "Run a pipeline, the branch, runtime parameters and variables are confirmed in a dialog")
+	pipelineRun.AddTextArgument("Name, ID or link of the pipeline", "[pipeline name, ID or link]", "")
+	pipelineRun.AddTextArgument("Branch to run the pipeline on, defaults to the default branch of the pipeline", "[branch]", "")
+	pipelineRun.AddTextArgument("Runtime parameters or variables of the run", "[name=value...]", "")
+	pipelines.AddCommand(pipelineRun)
 	azureDevops.AddCommand(pipelines)
 
+	notifications := model.NewAutocompleteData(constants.CommandNotifications, "", "View or change the direct messages you receive for reviews, mentions and assignments")
+	for _, state := range []string{constants.CommandOn, constants.CommandOff} {
+		notificationState := model.NewAutocompleteData(state, "", fmt.Sprintf("Turn %s direct messages for reviews, mentions or assignments", state))
+		notificationState.AddStaticListArgument("Kind of notifications", false, []model.AutocompleteListItem{
+			{Item: constants.PersonalNotificationReviews, HelpText: "Being added as a reviewer on a pull request"},
+			{Item: constants.PersonalNotificationMentions, HelpText: "Being mentioned in a pull request or work item comment"},
+			{Item: constants.PersonalNotificationAssignments, HelpText: "Being assigned a work item"},
+			{Item: constants.PersonalNotificationAll, HelpText: "All the kinds of notifications"},
+		})
+		notifications.AddCommand(notificationState)
+	}
+	azureDevops.AddCommand(notifications)
+
+	identity := model.NewAutocompleteData(constants.CommandIdentity, "", "Map Azure DevOps identities to Mattermost users (system admins only)")
+	identityMap := model.NewAutocompleteData(constants.CommandMap, "", "Map an Azure DevOps identity to a Mattermost user")
+	identityMap.AddTextArgument("Email, unique name or ID of the Azure DevOps identity", "[Azure DevOps identity]", "")
+	identityMap.AddTextArgument("Mattermost user", "[@username]", "")
+	identityUnmap := model.NewAutocompleteData(constants.CommandUnmap, "", "Remove the mapping of an Azure DevOps identity")
+	identityUnmap.AddTextArgument("Email, unique name or ID of the Azure DevOps identity", "[Azure DevOps identity]", "")
+	identityList := model.NewAutocompleteData(constants.CommandList, "", "List the Azure DevOps identities mapped to Mattermost users")
+	identity.AddCommand(identityMap)
+	identity.AddCommand(identityUnmap)
+	identity.AddCommand(identityList)
+	identity.RoleID = model.SYSTEM_ADMIN_ROLE_ID
+	azureDevops.AddCommand(identity)
+
 	return azureDevops
 }
 
@@ -133,8 +291,35 @@ func azureDevopsBoardsCommand(p *Plugin, c *plugin.Context, commandArgs *model.C
 
 	// Validate commands and their arguments
 	switch {
-	case len(args) >= 1 && args[0] == constants.CommandWorkitem && args[1] == constants.CommandCreate:
+	case len(args) >= 2 && args[0] == constants.CommandWorkitem && args[1] == constants.CommandCreate:
+		if len(args) >= 3 && args[2] == constants.FlagPreset {
+			return azureDevopsCreateWorkItemWithPresetCommand(p, c, commandArgs, args...)
+		}
 		return &model.CommandResponse{}, nil
+	case len(args) >= 2 && args[0] == constants.CommandWorkitem && args[1] == constants.CommandBreakdown:
+		return azureDevopsBreakdownWorkItemCommand(p, c, commandArgs, args...)
+	case len(args) >= 1 && args[0] == constants.CommandSprint:
+		return azureDevopsSprintCommand(p, c, commandArgs, args...)
+		// For "thread" command there must be at least 2 arguments
+	case len(args) >= 2 && args[0] == constants.CommandThread:
+		switch args[1] {
+		case constants.CommandLink:
+			return azureDevopsLinkThreadCommand(p, c, commandArgs, args...)
+		case constants.CommandUnlink:
+			return azureDevopsUnlinkThreadCommand(p, c, commandArgs, args...)
+		}
+		// For "preset" command there must be at least 2 arguments
+	case len(args) >= 2 && args[0] == constants.CommandPreset:
+		switch args[1] {
+		case constants.CommandAdd:
+			return azureDevopsAddPresetCommand(p, c, commandArgs, args...)
+		case constants.CommandImport:
+			return azureDevopsImportPresetCommand(p, c, commandArgs, args...)
+		case constants.CommandList:
+			return azureDevopsListPresetsCommand(p, c, commandArgs, args...)
+		case constants.CommandDelete:
+			return azureDevopsDeletePresetCommand(p, c, commandArgs, args...)
+		}
 		// For "subscription" command there must be at least 2 arguments
 	case len(args) >= 2 && args[0] == constants.CommandSubscription:
 		switch args[1] {
@@ -142,6 +327,8 @@ func azureDevopsBoardsCommand(p *Plugin, c *plugin.Context, commandArgs *model.C
 			return azureDevopsListSubscriptionsCommand(p, c, commandArgs, constants.CommandBoards, args...)
 		case constants.CommandDelete:
 			return azureDevopsDeleteCommand(p, c, commandArgs, constants.CommandBoards, args...)
+		case constants.CommandFilter:
+			return azureDevopsWorkItemFiltersCommand(p, c, commandArgs, args...)
 		case constants.CommandAdd:
 			return &model.CommandResponse{}, nil
 		}
@@ -157,16 +344,41 @@ func azureDevopsReposCommand(p *Plugin, c *plugin.Context, commandArgs *model.Co
 	}
 
 	// Validate commands and their arguments
+	switch {
 	// For "subscription" command there must be at least 2 arguments
-	if len(args) >= 2 && args[0] == constants.CommandSubscription {
+	case len(args) >= 2 && args[0] == constants.CommandSubscription:
 		switch args[1] {
 		case constants.CommandList:
 			return azureDevopsListSubscriptionsCommand(p, c, commandArgs, constants.CommandRepos, args...)
 		case constants.CommandDelete:
 			return azureDevopsDeleteCommand(p, c, commandArgs, constants.CommandRepos, args...)
+		case constants.CommandFilter:
+			return azureDevopsCodePushFiltersCommand(p, c, commandArgs, args...)
 		case constants.CommandAdd:
 			return &model.CommandResponse{}, nil
 		}
+		// For "pr" command there must be at least 2 arguments
+	case len(args) >= 2 && args[0] == constants.CommandPullRequest:
+		switch args[1] {
+		case constants.CommandComplete, constants.CommandAutoComplete, constants.CommandAbandon, constants.CommandReactivate:
+			return azureDevopsUpdatePullRequestStatusCommand(p, c, commandArgs, args...)
+		case constants.CommandCreate:
+			return azureDevopsCreatePullRequestCommand(p, c, commandArgs, args...)
+		}
+	case len(args) >= 1 && args[0] == constants.CommandPullRequests:
+		return azureDevopsPullRequestsCommand(p, c, commandArgs, args...)
+	case len(args) >= 2 && args[0] == constants.CommandBranch && args[1] == constants.CommandCreate:
+		return azureDevopsCreateWorkItemBranchCommand(p, c, commandArgs, args...)
+		// For "reminders" command there must be at least 2 arguments
+	case len(args) >= 2 && args[0] == constants.CommandReminders:
+		switch args[1] {
+		case constants.CommandAdd:
+			return azureDevopsAddReviewReminderCommand(p, c, commandArgs, args...)
+		case constants.CommandList:
+			return azureDevopsListReviewRemindersCommand(p, c, commandArgs, args...)
+		case constants.CommandDelete:
+			return azureDevopsDeleteReviewReminderCommand(p, c, commandArgs, args...)
+		}
 	}
 
 	return executeDefault(p, c, commandArgs, args...)
@@ -186,16 +398,77 @@ func azureDevopsPipelinesCommand(p *Plugin, c *plugin.Context, commandArgs *mode
 			return azureDevopsListSubscriptionsCommand(p, c, commandArgs, constants.CommandPipelines, args...)
 		case constants.CommandDelete:
 			return azureDevopsDeleteCommand(p, c, commandArgs, constants.CommandPipelines, args...)
+		case constants.CommandFilter:
+			return azureDevopsPipelineFiltersCommand(p, c, commandArgs, args...)
 		case constants.CommandAdd:
 			return &model.CommandResponse{}, nil
 		}
 	}
 
+	if len(args) >= 1 && args[0] == constants.CommandRun {
+		return azureDevopsRunPipelineCommand(p, c, commandArgs, args...)
+	}
+
 	return executeDefault(p, c, commandArgs, args...)
 }
 
+func azureDevopsRunPipelineCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if len(args) < 2 || args[1] == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PipelineRunUsage)
+	}
+
+	// The branch is the only argument which is not of the form "name=value"
+	argumentValues := args[2:]
+	branch := ""
+	if len(argumentValues) > 0 && !strings.Contains(argumentValues[0], constants.PresetArgumentSeparator) {
+		branch, argumentValues = argumentValues[0], argumentValues[1:]
+	}
+
+	arguments, err := serializers.ParsePresetArguments(argumentValues)
+	if err != nil {
+		return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+	}
+
+	var organization, projectName, pipelineNameOrID string
+	if pipelineData, link, isValid := IsLinkPresent(args[1], constants.PipelineLinkRegex); isValid {
+		organization, projectName = pipelineData[3], pipelineData[4]
+		pipelineNameOrID = link[strings.Index(link, constants.PipelineDefinitionIDQueryParam)+len(constants.PipelineDefinitionIDQueryParam):]
+	} else {
+		project, err := p.GetLinkedProjectFromArguments(commandArgs.UserId, nil)
+		if err != nil {
+			p.API.LogError(constants.ErrorFetchProjectList, "Error", err.Error())
+			return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+		}
+
+		if project == nil {
+			return p.sendEphemeralPostForCommand(commandArgs, constants.PipelineRunProjectRequired)
+		}
+
+		organization, projectName, pipelineNameOrID = project.OrganizationName, project.ProjectName, args[1]
+	}
+
+	pipeline, parameters, statusCode, err := p.GetPipelineToRun(commandArgs.UserId, organization, projectName, pipelineNameOrID)
+	if err != nil {
+		switch statusCode {
+		case http.StatusForbidden:
+			return p.sendEphemeralPostForCommand(commandArgs, constants.ErrorQueueAccess)
+		case http.StatusBadRequest:
+			return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+		}
+		p.API.LogError(constants.ErrorOpenRunPipelineDialog, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	if _, err := p.OpenRunPipelineDialog(commandArgs.UserId, commandArgs.TriggerId, organization, projectName, pipeline, parameters, branch, arguments); err != nil {
+		p.API.LogError(constants.ErrorOpenRunPipelineDialog, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	return &model.CommandResponse{}, nil
+}
+
 func azureDevopsDeleteCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, command string, args ...string) (*model.CommandResponse, *model.AppError) {
-	if len(args) < 3 {
+	if len(args) < 3 || args[2] == "" {
 		return p.sendEphemeralPostForCommand(commandArgs, "Subscription ID is not provided")
 	}
 
@@ -238,6 +511,120 @@ func azureDevopsDeleteCommand(p *Plugin, c *plugin.Context, commandArgs *model.C
 	return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf("%s subscription with ID: %q does not exist", cases.Title(language.Und).String(command), subscriptionIDToBeDeleted))
 }
 
+func azureDevopsWorkItemFiltersCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if len(args) < 3 || args[2] == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.SubscriptionIDNotProvided)
+	}
+
+	if len(args) < 4 {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.WorkItemFiltersRequired)
+	}
+
+	filters := &serializers.WorkItemFilters{}
+	if len(args) != 4 || args[3] != constants.WorkItemFilterArgumentClear {
+		var err error
+		if filters, err = serializers.ParseWorkItemFilterArguments(args[3:]); err != nil {
+			return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+		}
+	}
+
+	subscriptionID := args[2]
+	subscription, statusCode, err := p.UpdateWorkItemFilters(commandArgs.UserId, subscriptionID, filters)
+	if err != nil {
+		switch statusCode {
+		case http.StatusBadRequest:
+			return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+		case http.StatusNotFound, http.StatusForbidden:
+			return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf("%s subscription with ID: %q does not exist", cases.Title(language.Und).String(constants.CommandBoards), subscriptionID))
+		default:
+			p.API.LogError(constants.ErrorUpdateWorkItemFilters, "Error", err.Error())
+			return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+		}
+	}
+
+	if subscription.WorkItemFilters.IsEmpty() {
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.WorkItemFiltersCleared, subscriptionID))
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.WorkItemFiltersUpdated, subscriptionID, subscription.WorkItemFilters.String()))
+}
+
+func azureDevopsCodePushFiltersCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if len(args) < 3 || args[2] == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.SubscriptionIDNotProvided)
+	}
+
+	if len(args) < 4 {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.CodePushFiltersRequired)
+	}
+
+	filters := &serializers.CodePushFilters{}
+	if len(args) != 4 || args[3] != constants.WorkItemFilterArgumentClear {
+		var err error
+		if filters, err = serializers.ParseCodePushFilterArguments(args[3:]); err != nil {
+			return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+		}
+	}
+
+	subscriptionID := args[2]
+	subscription, statusCode, err := p.UpdateCodePushFilters(commandArgs.UserId, subscriptionID, filters)
+	if err != nil {
+		switch statusCode {
+		case http.StatusBadRequest:
+			return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+		case http.StatusNotFound, http.StatusForbidden:
+			return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf("%s subscription with ID: %q does not exist", cases.Title(language.Und).String(constants.CommandRepos), subscriptionID))
+		default:
+			p.API.LogError(constants.ErrorUpdateCodePushFilters, "Error", err.Error())
+			return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+		}
+	}
+
+	if subscription.CodePushFilters.IsEmpty() {
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.WorkItemFiltersCleared, subscriptionID))
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.CodePushFiltersUpdated, subscriptionID, subscription.CodePushFilters.String()))
+}
+
+func azureDevopsPipelineFiltersCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if len(args) < 3 || args[2] == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.SubscriptionIDNotProvided)
+	}
+
+	if len(args) < 4 {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PipelineFiltersRequired)
+	}
+
+	filters := &serializers.PipelineFilters{}
+	if len(args) != 4 || args[3] != constants.WorkItemFilterArgumentClear {
+		var err error
+		if filters, err = serializers.ParsePipelineFilterArguments(args[3:]); err != nil {
+			return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+		}
+	}
+
+	subscriptionID := args[2]
+	subscription, statusCode, err := p.UpdatePipelineFilters(commandArgs.UserId, subscriptionID, filters)
+	if err != nil {
+		switch statusCode {
+		case http.StatusBadRequest:
+			return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+		case http.StatusNotFound, http.StatusForbidden:
+			return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf("%s subscription with ID: %q does not exist", cases.Title(language.Und).String(constants.CommandPipelines), subscriptionID))
+		default:
+			p.API.LogError(constants.ErrorUpdatePipelineFilters, "Error", err.Error())
+			return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+		}
+	}
+
+	if subscription.PipelineFilters.IsEmpty() {
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.WorkItemFiltersCleared, subscriptionID))
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.PipelineFiltersUpdated, subscriptionID, subscription.PipelineFilters.String()))
+}
+
 func azureDevopsListSubscriptionsCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, command string, args ...string) (*model.CommandResponse, *model.AppError) {
 	createdByArgument := constants.FilterCreatedByAnyone
 	// Check if 3rd argument is "me"
@@ -263,6 +650,662 @@ func azureDevopsListSubscriptionsCommand(p *Plugin, c *plugin.Context, commandAr
 	return p.sendEphemeralPostForCommand(commandArgs, p.ParseSubscriptionsToCommandResponse(subscriptionList, showForChannelID, createdByArgument, commandArgs.UserId, command, commandArgs.TeamId))
 }
 
+func azureDevopsAddPresetCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if len(args) < 3 || args[2] == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PresetNameRequired)
+	}
+
+	arguments, err := serializers.ParsePresetArguments(args[3:])
+	if err != nil {
+		return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+	}
+
+	if message := p.resolvePresetAssignee(arguments); message != "" {
+		return p.sendEphemeralPostForCommand(commandArgs, message)
+	}
+
+	preset, message := p.newPresetForCommand(commandArgs, args[2], arguments)
+	if preset == nil {
+		return p.sendEphemeralPostForCommand(commandArgs, message)
+	}
+
+	preset.SetFieldsFromArguments(arguments)
+	return p.storePresetForCommand(commandArgs, preset)
+}
+
+func azureDevopsImportPresetCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if len(args) < 3 || args[2] == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PresetNameRequired)
+	}
+
+	arguments, err := serializers.ParsePresetArguments(args[3:])
+	if err != nil {
+		return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+	}
+
+	if message := p.resolvePresetAssignee(arguments); message != "" {
+		return p.sendEphemeralPostForCommand(commandArgs, message)
+	}
+
+	templateName := arguments[constants.PresetArgumentTemplate]
+	if templateName == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PresetTemplateRequired)
+	}
+
+	preset, message := p.newPresetForCommand(commandArgs, args[2], arguments)
+	if preset == nil {
+		return p.sendEphemeralPostForCommand(commandArgs, message)
+	}
+
+	teamName := arguments[constants.PresetArgumentTeam]
+	if teamName == "" {
+		teamName = fmt.Sprintf(constants.DefaultTeamNameFormat, preset.ProjectName)
+	}
+
+	templates, _, err := p.Client.GetWorkItemTemplates(preset.OrganizationName, preset.ProjectName, teamName, commandArgs.UserId)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchWorkItemTemplates, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	templateID := ""
+	for _, template := range templates.Value {
+		if template.ID == templateName || strings.EqualFold(template.Name, templateName) {
+			templateID = template.ID
+			break
+		}
+	}
+
+	if templateID == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.PresetTemplateNotFound, templateName, teamName))
+	}
+
+	template, _, err := p.Client.GetWorkItemTemplate(preset.OrganizationName, preset.ProjectName, teamName, templateID, commandArgs.UserId)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchWorkItemTemplates, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	preset.Type = template.WorkItemTypeName
+	for referenceName, value := range template.Fields {
+		// The title is provided while creating a work item from the preset
+		if referenceName != constants.FieldReferenceNameTitle {
+			preset.Fields[referenceName] = value
+		}
+	}
+
+	// Any field provided in the command overrides the value of the field in the template
+	preset.SetFieldsFromArguments(arguments)
+	return p.storePresetForCommand(commandArgs, preset)
+}
+
+func azureDevopsListPresetsCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	presets, err := p.Store.GetAllPresets(commandArgs.ChannelId)
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadPreset, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, p.ParsePresetsToCommandResponse(presets))
+}
+
+func azureDevopsDeletePresetCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if len(args) < 3 || args[2] == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PresetNameRequired)
+	}
+
+	presetName := args[2]
+	preset, err := p.Store.GetPreset(commandArgs.ChannelId, presetName)
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadPreset, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	if preset == nil {
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.PresetNotFound, presetName))
+	}
+
+	if err := p.Store.DeletePreset(commandArgs.ChannelId, presetName); err != nil {
+		p.API.LogError(constants.ErrorDeletePreset, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.PresetDeleted, preset.Name))
+}
+
+func azureDevopsCreateWorkItemWithPresetCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if len(args) < 4 || args[3] == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PresetNameRequired)
+	}
+
+	title := strings.TrimSpace(strings.Join(args[4:], " "))
+	if title == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PresetTitleRequired)
+	}
+
+	presetName := args[3]
+	preset, err := p.Store.GetPreset(commandArgs.ChannelId, presetName)
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadPreset, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	if preset == nil {
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.PresetNotFound, presetName))
+	}
+
+	task, statusCode, err := p.CreateWorkItem(preset.ToCreateTaskRequestPayload(title), commandArgs.UserId)
+	if err != nil {
+		if statusCode == http.StatusBadRequest {
+			return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+		}
+		p.API.LogError(constants.ErrorCreateTask, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.CreatedTask, task.ID, task.Fields.Title, task.Link.HTML.Href, task.Fields.Type, task.Fields.CreatedBy.DisplayName))
+}
+
+func azureDevopsBreakdownWorkItemCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if len(args) < 3 || args[2] == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.ParentWorkItemRequired)
+	}
+
+	var titles []string
+	for _, title := range args[3:] {
+		if title = strings.TrimSpace(title); title != "" {
+			titles = append(titles, title)
+		}
+	}
+
+	if len(titles) == 0 {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.ChildWorkItemTitlesRequired)
+	}
+
+	var organization, projectName, parentID string
+	if taskData, _, isValid := IsLinkPresent(args[2], constants.TaskLinkRegex); isValid {
+		organization, projectName, parentID = taskData[3], taskData[4], taskData[7]
+	} else {
+		if _, err := strconv.Atoi(args[2]); err != nil {
+			return p.sendEphemeralPostForCommand(commandArgs, constants.ParentWorkItemRequired)
+		}
+
+		project, err := p.GetLinkedProjectFromArguments(commandArgs.UserId, nil)
+		if err != nil {
+			p.API.LogError(constants.ErrorFetchProjectList, "Error", err.Error())
+			return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+		}
+
+		if project == nil {
+			return p.sendEphemeralPostForCommand(commandArgs, constants.ParentWorkItemProjectRequired)
+		}
+
+		organization, projectName, parentID = project.OrganizationName, project.ProjectName, args[2]
+	}
+
+	parent, children, statusCode, err := p.BreakdownWorkItem(commandArgs.UserId, organization, projectName, parentID, titles)
+	if err != nil && len(children) == 0 {
+		if statusCode == http.StatusBadRequest && parent != nil {
+			return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+		}
+		p.API.LogError(constants.ErrorBreakdownWorkItem, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	var sb strings.Builder
+	if err != nil {
+		p.API.LogError(constants.ErrorBreakdownWorkItem, "Error", err.Error())
+		sb.WriteString(fmt.Sprintf(constants.ChildWorkItemsPartiallyCreated, len(children), len(titles), parent.Fields.Type, parent.ID, parent.Fields.Title, parent.Link.HTML.Href, constants.GenericErrorMessage))
+	} else {
+		sb.WriteString(fmt.Sprintf(constants.ChildWorkItemsCreated, len(children), parent.Fields.Type, parent.ID, parent.Fields.Title, parent.Link.HTML.Href))
+	}
+
+	for _, child := range children {
+		sb.WriteString(fmt.Sprintf(constants.ChildWorkItem, child.Fields.Type, child.ID, child.Fields.Title, child.Link.HTML.Href))
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, sb.String())
+}
+
+func azureDevopsCreateWorkItemBranchCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	activate := false
+	var arguments []string
+	for _, arg := range args[2:] {
+		if arg == constants.FlagActivate {
+			activate = true
+			continue
+		}
+		arguments = append(arguments, arg)
+	}
+
+	if len(arguments) == 0 || arguments[0] == "" || len(arguments) > 3 {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.WorkItemBranchUsage)
+	}
+
+	var organization, projectName, workItemID string
+	if taskData, _, isValid := IsLinkPresent(arguments[0], constants.TaskLinkRegex); isValid {
+		organization, projectName, workItemID = taskData[3], taskData[4], taskData[7]
+	} else {
+		if _, err := strconv.Atoi(arguments[0]); err != nil {
+			return p.sendEphemeralPostForCommand(commandArgs, constants.WorkItemBranchUsage)
+		}
+
+		project, err := p.GetLinkedProjectFromArguments(commandArgs.UserId, nil)
+		if err != nil {
+			p.API.LogError(constants.ErrorFetchProjectList, "Error", err.Error())
+			return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+		}
+
+		if project == nil {
+			return p.sendEphemeralPostForCommand(commandArgs, constants.WorkItemBranchProjectRequired)
+		}
+
+		organization, projectName, workItemID = project.OrganizationName, project.ProjectName, arguments[0]
+	}
+
+	var repositoryName, baseBranch string
+	if len(arguments) >= 2 {
+		repositoryName = arguments[1]
+	}
+	if len(arguments) == 3 {
+		baseBranch = arguments[2]
+	}
+
+	branch, statusCode, err := p.CreateWorkItemBranch(commandArgs.UserId, organization, projectName, workItemID, repositoryName, baseBranch, activate)
+	if err != nil {
+		if statusCode == http.StatusBadRequest {
+			return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+		}
+		p.API.LogError(constants.ErrorCreateWorkItemBranch, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	workItem := branch.WorkItem
+	message := fmt.Sprintf(constants.WorkItemBranchCreated, branch.Name, branch.WebURL, branch.BaseBranch, branch.Repository.Name, workItem.Fields.Type, workItem.ID, workItem.Fields.Title, workItem.Link.HTML.Href)
+	if activate {
+		if branch.ActivateError != "" {
+			message += fmt.Sprintf(constants.WorkItemBranchNotActivated, constants.WorkItemStateActive, branch.ActivateError)
+		} else {
+			message += fmt.Sprintf(constants.WorkItemBranchActivated, constants.WorkItemStateActive)
+		}
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, message)
+}
+
+func azureDevopsCreatePullRequestCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if len(args) < 6 {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PullRequestCreateUsage)
+	}
+
+	repositoryName, sourceBranch, targetBranch, title := args[2], args[3], args[4], strings.TrimSpace(args[5])
+	if repositoryName == "" || sourceBranch == "" || targetBranch == "" || title == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PullRequestCreateUsage)
+	}
+
+	arguments, err := serializers.ParsePresetArguments(args[6:])
+	if err != nil {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PullRequestCreateUsage)
+	}
+
+	for key := range arguments {
+		if key != constants.PresetArgumentOrganization && key != constants.PresetArgumentProject {
+			return p.sendEphemeralPostForCommand(commandArgs, constants.PullRequestCreateUsage)
+		}
+	}
+
+	project, err := p.GetLinkedProjectFromArguments(commandArgs.UserId, arguments)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchProjectList, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	if project == nil {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PullRequestCreateProjectRequired)
+	}
+
+	repository, statusCode, err := p.GetPullRequestRepository(commandArgs.UserId, project.OrganizationName, project.ProjectName, repositoryName, sourceBranch, targetBranch)
+	if err != nil {
+		if statusCode == http.StatusBadRequest {
+			return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+		}
+		p.API.LogError(constants.ErrorOpenCreatePullRequestDialog, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	if _, err := p.OpenCreatePullRequestDialog(commandArgs.UserId, commandArgs.TriggerId, project.OrganizationName, repository, sourceBranch, targetBranch, title); err != nil {
+		p.API.LogError(constants.ErrorOpenCreatePullRequestDialog, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	return &model.CommandResponse{}, nil
+}
+
+func azureDevopsUpdatePullRequestStatusCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if len(args) < 3 || args[2] == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PullRequestRequired)
+	}
+
+	action := args[1]
+	var options *serializers.PullRequestCompletionOptions
+	if action == constants.CommandComplete || action == constants.CommandAutoComplete {
+		var err error
+		if options, err = serializers.ParsePullRequestCompletionOptions(args[3:]); err != nil {
+			return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.InvalidCommandArguments, err.Error()))
+		}
+	}
+
+	var organization, projectName, pullRequestID string
+	if pullRequestData, _, isValid := IsLinkPresent(args[2], constants.PullRequestLinkRegex); isValid {
+		organization, projectName, pullRequestID = pullRequestData[3], pullRequestData[4], pullRequestData[8]
+	} else {
+		if _, err := strconv.Atoi(args[2]); err != nil {
+			return p.sendEphemeralPostForCommand(commandArgs, constants.PullRequestRequired)
+		}
+
+		project, err := p.GetLinkedProjectFromArguments(commandArgs.UserId, nil)
+		if err != nil {
+			p.API.LogError(constants.ErrorFetchProjectList, "Error", err.Error())
+			return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+		}
+
+		if project == nil {
+			return p.sendEphemeralPostForCommand(commandArgs, constants.PullRequestProjectRequired)
+		}
+
+		organization, projectName, pullRequestID = project.OrganizationName, project.ProjectName, args[2]
+	}
+
+	pullRequest, blockingPolicies, statusCode, err := p.UpdatePullRequestStatus(commandArgs.UserId, organization, projectName, pullRequestID, action, options)
+	if err != nil && len(blockingPolicies) == 0 {
+		switch statusCode {
+		case http.StatusBadRequest:
+			if pullRequest != nil {
+				return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+			}
+		case http.StatusForbidden, http.StatusUnauthorized:
+			if pullRequest != nil {
+				return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.PullRequestUpdateNotPermitted, pullRequest.PullRequestID))
+			}
+		}
+		p.API.LogError(constants.ErrorUpdatePullRequestStatus, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, p.getPullRequestStatusMessage(organization, action, pullRequest, blockingPolicies))
+}
+
+func azureDevopsPullRequestsCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	positionalArgs := args[1:]
+	filter := constants.PullRequestFilterAll
+	if len(positionalArgs) > 0 {
+		switch lastArg := positionalArgs[len(positionalArgs)-1]; lastArg {
+		case constants.PullRequestFilterMine, constants.PullRequestFilterReview, constants.PullRequestFilterAll:
+			filter = lastArg
+			positionalArgs = positionalArgs[:len(positionalArgs)-1]
+		}
+	}
+
+	arguments := map[string]string{}
+	if len(positionalArgs) >= 1 {
+		arguments[constants.PresetArgumentProject] = positionalArgs[0]
+	}
+
+	var repository string
+	if len(positionalArgs) >= 2 {
+		repository = positionalArgs[1]
+	}
+
+	project, err := p.GetLinkedProjectFromArguments(commandArgs.UserId, arguments)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchProjectList, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	if project == nil {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.PullRequestsProjectRequired)
+	}
+
+	pullRequests, _, err := p.GetActivePullRequests(commandArgs.UserId, project.OrganizationName, project.ProjectName, repository, filter)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchPullRequests, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	if len(pullRequests) == 0 {
+		scope := project.ProjectName
+		if repository != "" {
+			scope = fmt.Sprintf("%s / %s", project.ProjectName, repository)
+		}
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.NoActivePullRequests, scope))
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, p.ParsePullRequestsToCommandResponse(commandArgs.UserId, project.OrganizationName, project.ProjectName, filter, pullRequests))
+}
+
+func azureDevopsAddReviewReminderCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	reminder, projectArguments, err := serializers.ParseReviewReminderArguments(args[2:])
+	if err != nil {
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.InvalidCommandArguments, err.Error()))
+	}
+
+	project, err := p.GetLinkedProjectFromArguments(commandArgs.UserId, projectArguments)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchProjectList, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	if project == nil {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.ReviewReminderProjectRequired)
+	}
+
+	user, appErr := p.API.GetUser(commandArgs.UserId)
+	if appErr != nil {
+		p.API.LogError(constants.GetUserError, "Error", appErr.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	// The reminders are sent at the time of the day in the timezone of the user who adds them
+	reminder.ID = model.NewId()[:8]
+	reminder.ChannelID = commandArgs.ChannelId
+	reminder.OrganizationName = project.OrganizationName
+	reminder.ProjectName = project.ProjectName
+	reminder.Timezone = user.GetPreferredTimezone()
+	reminder.CreatedBy = commandArgs.UserId
+	if err := p.Store.StoreReviewReminder(reminder); err != nil {
+		p.API.LogError(constants.ErrorStoreReviewReminder, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.ReviewReminderAdded, reminder.ID, reminder.GetScopeString(), reminder.GetScheduleString()))
+}
+
+func azureDevopsListReviewRemindersCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	reminders, err := p.Store.GetReviewReminders()
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadReviewReminders, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	var channelReminders []*serializers.ReviewReminder
+	for _, reminder := range reminders {
+		if reminder.ChannelID == commandArgs.ChannelId {
+			channelReminders = append(channelReminders, reminder)
+		}
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, p.ParseReviewRemindersToCommandResponse(channelReminders))
+}
+
+func azureDevopsDeleteReviewReminderCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if len(args) < 3 || args[2] == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.ReviewReminderIDRequired)
+	}
+
+	isDeleted, err := p.Store.DeleteReviewReminder(commandArgs.ChannelId, args[2])
+	if err != nil {
+		p.API.LogError(constants.ErrorDeleteReviewReminder, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	if !isDeleted {
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.ReviewReminderNotFound, args[2]))
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.ReviewReminderDeleted, args[2]))
+}
+
+func azureDevopsSprintCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	staleDays := constants.DefaultStaleDays
+	var positionalArgs []string
+	for i := 1; i < len(args); i++ {
+		if args[i] != constants.FlagStaleDays {
+			positionalArgs = append(positionalArgs, args[i])
+			continue
+		}
+
+		var err error
+		if i+1 >= len(args) {
+			return p.sendEphemeralPostForCommand(commandArgs, constants.InvalidStaleDays)
+		}
+		if staleDays, err = strconv.Atoi(args[i+1]); err != nil || staleDays <= 0 {
+			return p.sendEphemeralPostForCommand(commandArgs, constants.InvalidStaleDays)
+		}
+		i++
+	}
+
+	arguments := map[string]string{}
+	if len(positionalArgs) >= 1 {
+		arguments[constants.PresetArgumentProject] = positionalArgs[0]
+	}
+
+	var team string
+	if len(positionalArgs) >= 2 {
+		team = strings.Join(positionalArgs[1:], " ")
+	}
+
+	project, err := p.GetLinkedProjectFromArguments(commandArgs.UserId, arguments)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchProjectList, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	if project == nil {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.SprintProjectRequired)
+	}
+
+	summary, _, err := p.GetSprintSummary(commandArgs.UserId, project.OrganizationName, project.ProjectName, team, staleDays)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchSprintSummary, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	if summary == nil {
+		if team == "" {
+			team = fmt.Sprintf(constants.DefaultTeamNameFormat, project.ProjectName)
+		}
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.NoCurrentIteration, team, project.ProjectName))
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, p.ParseSprintSummaryToCommandResponse(summary))
+}
+
+func azureDevopsLinkThreadCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if commandArgs.RootId == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.ThreadRequired)
+	}
+
+	if len(args) < 3 {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.WorkItemLinkRequired)
+	}
+
+	taskData, _, isValid := IsLinkPresent(args[2], constants.TaskLinkRegex)
+	if !isValid {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.WorkItemLinkRequired)
+	}
+
+	mirrorReplies := len(args) >= 4 && args[3] == constants.FlagMirror
+	if _, _, err := p.LinkThreadToWorkItem(commandArgs.UserId, commandArgs.RootId, taskData[3], taskData[4], taskData[7], mirrorReplies); err != nil {
+		p.API.LogError(constants.ErrorLinkThread, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	return &model.CommandResponse{}, nil
+}
+
+func azureDevopsUnlinkThreadCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if commandArgs.RootId == "" {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.ThreadRequired)
+	}
+
+	threadLink, statusCode, err := p.UnlinkThread(commandArgs.UserId, commandArgs.RootId)
+	if err != nil {
+		if statusCode == http.StatusNotFound {
+			return p.sendEphemeralPostForCommand(commandArgs, constants.ThreadNotLinked)
+		}
+		p.API.LogError(constants.ErrorUnlinkThread, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.ThreadUnlinked, threadLink.WorkItemID))
+}
+
+// newPresetForCommand creates an empty preset for the current channel in one of the linked projects of the user.
+// If the preset cannot be created, the message to be shown to the user is returned.
+func (p *Plugin) newPresetForCommand(commandArgs *model.CommandArgs, name string, arguments map[string]string) (*serializers.WorkItemPreset, string) {
+	project, err := p.GetLinkedProjectFromArguments(commandArgs.UserId, arguments)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchProjectList, "Error", err.Error())
+		return nil, constants.GenericErrorMessage
+	}
+
+	if project == nil {
+		return nil, constants.PresetProjectRequired
+	}
+
+	user, userErr := p.API.GetUser(commandArgs.UserId)
+	if userErr != nil {
+		p.API.LogError(constants.GetUserError, "Error", userErr.Error())
+		return nil, constants.GenericErrorMessage
+	}
+
+	return &serializers.WorkItemPreset{
+		Name:             name,
+		ChannelID:        commandArgs.ChannelId,
+		OrganizationName: project.OrganizationName,
+		ProjectName:      project.ProjectName,
+		Fields:           map[string]string{},
+		CreatedBy:        user.Username,
+	}, ""
+}
+
+// storePresetForCommand validates the fields of the preset against the work item type and stores it
+func (p *Plugin) storePresetForCommand(commandArgs *model.CommandArgs, preset *serializers.WorkItemPreset) (*model.CommandResponse, *model.AppError) {
+	if err := preset.IsValid(); err != nil {
+		return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+	}
+
+	fieldDefinitions, _, err := p.GetWorkItemFieldDefinitions(preset.OrganizationName, preset.ProjectName, preset.Type, commandArgs.UserId)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchWorkItemFields, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	if err := preset.ValidateFields(fieldDefinitions); err != nil {
+		return p.sendEphemeralPostForCommand(commandArgs, err.Error())
+	}
+
+	if err := p.Store.StorePreset(preset); err != nil {
+		if errors.Is(err, store.ErrPresetAlreadyExists) {
+			return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.PresetAlreadyExists, preset.Name))
+		}
+		p.API.LogError(constants.ErrorStorePreset, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.PresetAdded, preset.Name))
+}
+
 func azureDevopsHelpCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
 	return p.sendEphemeralPostForCommand(commandArgs, constants.HelpText)
 }
@@ -286,6 +1329,7 @@ func azureDevopsDisconnectCommand(p *Plugin, c *plugin.Context, commandArgs *mod
 			}
 			message = constants.GenericErrorMessage
 		}
+		p.clearIdentityCache()
 
 		p.API.PublishWebSocketEvent(
 			constants.WSEventDisconnect,
@@ -296,6 +1340,137 @@ func azureDevopsDisconnectCommand(p *Plugin, c *plugin.Context, commandArgs *mod
 	return p.sendEphemeralPostForCommand(commandArgs, message)
 }
 
+// resolvePresetAssignee replaces the @username of a Mattermost user in the assignee argument of a preset with the Azure DevOps identity of the user.
+// It returns the message to show if the identity is not found.
+func (p *Plugin) resolvePresetAssignee(arguments map[string]string) string {
+	assignee := arguments[constants.PresetArgumentAssignee]
+	if !strings.HasPrefix(assignee, "@") {
+		return ""
+	}
+
+	username := strings.TrimPrefix(assignee, "@")
+	user, appErr := p.API.GetUserByUsername(username)
+	if appErr != nil {
+		return fmt.Sprintf(constants.MattermostUserNotFound, username)
+	}
+
+	identity := p.GetAzureIdentityForMattermostUser(user.Id)
+	if identity == nil {
+		return fmt.Sprintf(constants.AssigneeNotFound, username)
+	}
+
+	arguments[constants.PresetArgumentAssignee] = identity.UniqueName
+	return ""
+}
+
+func azureDevopsNotificationsCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if isConnected := p.MattermostUserAlreadyConnected(commandArgs.UserId); !isConnected {
+		return p.sendEphemeralPostForCommand(commandArgs, p.getConnectAccountFirstMessage())
+	}
+
+	if len(args) > 2 || (len(args) > 0 && args[0] != constants.CommandOn && args[0] != constants.CommandOff) {
+		return executeDefault(p, c, commandArgs, args...)
+	}
+
+	settings, err := p.Store.GetPersonalNotificationSettings(commandArgs.UserId)
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadPersonalNotificationSettings, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	if len(args) == 0 {
+		getState := func(isEnabled bool) string {
+			if isEnabled {
+				return constants.CommandOn
+			}
+			return constants.CommandOff
+		}
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.PersonalNotificationsStatus, getState(settings.Reviews), getState(settings.Mentions), getState(settings.Assignments)))
+	}
+
+	kind := constants.PersonalNotificationAll
+	if len(args) == 2 {
+		kind = strings.ToLower(args[1])
+	}
+
+	if isValidKind := settings.Update(kind, args[0] == constants.CommandOn); !isValidKind {
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.InvalidPersonalNotificationKind, kind))
+	}
+
+	if err := p.Store.StorePersonalNotificationSettings(commandArgs.UserId, settings); err != nil {
+		p.API.LogError(constants.ErrorStorePersonalNotificationSettings, "Error", err.Error())
+		return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+	}
+
+	return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.PersonalNotificationsUpdated, kind, args[0]))
+}
+
+func azureDevopsIdentityCommand(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
+	if !p.API.HasPermissionTo(commandArgs.UserId, model.PERMISSION_MANAGE_SYSTEM) {
+		return p.sendEphemeralPostForCommand(commandArgs, constants.IdentityOverrideNotPermitted)
+	}
+
+	switch {
+	case len(args) == 1 && args[0] == constants.CommandList:
+		overrides, err := p.Store.GetIdentityOverrides()
+		if err != nil {
+			p.API.LogError(constants.ErrorLoadIdentityOverrides, "Error", err.Error())
+			return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+		}
+
+		if len(overrides) == 0 {
+			return p.sendEphemeralPostForCommand(commandArgs, constants.IdentityOverridesEmpty)
+		}
+
+		azureIdentities := make([]string, 0, len(overrides))
+		for azureIdentity := range overrides {
+			azureIdentities = append(azureIdentities, azureIdentity)
+		}
+		sort.Strings(azureIdentities)
+
+		var sb strings.Builder
+		sb.WriteString(constants.IdentityOverridesListHeader)
+		for _, azureIdentity := range azureIdentities {
+			mattermostUser := overrides[azureIdentity]
+			if user, appErr := p.API.GetUser(overrides[azureIdentity]); appErr == nil {
+				mattermostUser = fmt.Sprintf("@%s", user.Username)
+			}
+			sb.WriteString(fmt.Sprintf(constants.IdentityOverridesListItem, azureIdentity, mattermostUser))
+		}
+
+		return p.sendEphemeralPostForCommand(commandArgs, sb.String())
+	case len(args) == 3 && args[0] == constants.CommandMap:
+		username := strings.TrimPrefix(args[2], "@")
+		user, appErr := p.API.GetUserByUsername(username)
+		if appErr != nil {
+			return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.MattermostUserNotFound, username))
+		}
+
+		if err := p.Store.StoreIdentityOverride(args[1], user.Id); err != nil {
+			p.API.LogError(constants.ErrorStoreIdentityOverride, "Error", err.Error())
+			return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+		}
+
+		p.clearIdentityCache()
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.IdentityOverrideAdded, args[1], user.Username))
+	case len(args) == 2 && args[0] == constants.CommandUnmap:
+		isDeleted, err := p.Store.DeleteIdentityOverride(args[1])
+		if err != nil {
+			p.API.LogError(constants.ErrorDeleteIdentityOverride, "Error", err.Error())
+			return p.sendEphemeralPostForCommand(commandArgs, constants.GenericErrorMessage)
+		}
+
+		if !isDeleted {
+			return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.IdentityOverrideNotFound, args[1]))
+		}
+
+		p.clearIdentityCache()
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.IdentityOverrideDeleted, args[1]))
+	}
+
+	return executeDefault(p, c, commandArgs, args...)
+}
+
 func executeDefault(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs, args ...string) (*model.CommandResponse, *model.AppError) {
 	out := constants.InvalidCommand + constants.HelpText
 
@@ -304,7 +1479,92 @@ func executeDefault(p *Plugin, c *plugin.Context, commandArgs *model.CommandArgs
 
 // Handles executing a slash command
 func (p *Plugin) ExecuteCommand(c *plugin.Context, commandArgs *model.CommandArgs) (*model.CommandResponse, *model.AppError) {
-	args := strings.Fields(commandArgs.Command)
+	args, err := parseCommandArgs(commandArgs.Command)
+	if err != nil {
+		return p.sendEphemeralPostForCommand(commandArgs, fmt.Sprintf(constants.InvalidCommandArguments, err.Error()))
+	}
+
+	if len(args) == 0 {
+		return executeDefault(p, c, commandArgs)
+	}
 
 	return azureDevopsCommandHandler.Handle(p, c, commandArgs, args[1:]...)
 }
+
+// parseCommandArgs splits a slash command into arguments in a shell-like way.
+// Arguments are separated by whitespace unless the whitespace is quoted with single or double quotes or escaped with a backslash.
+// A backslash escapes a following quote, backslash or whitespace and is kept as it is before any other character, so that values like area paths can be typed as they are.
+// Options of the form "--flag=value" are split into the separate arguments "--flag" and "value".
+func parseCommandArgs(command string) ([]string, error) {
+	var (
+		args       []string
+		current    strings.Builder
+		inArgument bool
+		quote      rune
+		escaped    bool
+		// isFlag is set if the argument starts with an unquoted "--"
+		isFlag bool
+	)
+
+	addArgument := func() {
+		if !inArgument {
+			return
+		}
+
+		argument := current.String()
+		if separatorIndex := strings.Index(argument, "="); isFlag && separatorIndex >= 0 {
+			args = append(args, argument[:separatorIndex], argument[separatorIndex+1:])
+		} else {
+			args = append(args, argument)
+		}
+
+		current.Reset()
+		inArgument = false
+		isFlag = false
+	}
+
+	runes := []rune(command)
+	for index, char := range runes {
+		switch {
+		case escaped:
+			current.WriteRune(char)
+			escaped = false
+		case char == '\\' && quote != '\'':
+			next := rune(0)
+			if index+1 < len(runes) {
+				next = runes[index+1]
+			}
+
+			if next == '\\' || next == '"' || next == '\'' || (quote == 0 && unicode.IsSpace(next)) {
+				escaped = true
+			} else {
+				current.WriteRune(char)
+			}
+			inArgument = true
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			} else {
+				current.WriteRune(char)
+			}
+		case char == '"' || char == '\'':
+			quote = char
+			inArgument = true
+		case unicode.IsSpace(char):
+			addArgument()
+		default:
+			if !inArgument && strings.HasPrefix(string(runes[index:]), "--") {
+				isFlag = true
+			}
+			current.WriteRune(char)
+			inArgument = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("missing closing quote %q", quote)
+	}
+
+	addArgument()
+	return args, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 
 	"bou.ke/monkey"
@@ -18,6 +19,7 @@ import (
 	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
 	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
 	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/store"
 	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
 )
 
@@ -84,6 +86,52 @@ func TestExecuteCommand(t *testing.T) {
 			commandArgs:      &model.CommandArgs{Command: "/azuredevops boards wrong [title] [description]"},
 			ephemeralMessage: constants.InvalidCommand + constants.HelpText,
 		},
+		{
+			description:      "ExecuteCommand: boards workitem command without subcommand",
+			isConnected:      true,
+			commandArgs:      &model.CommandArgs{Command: "/azuredevops boards workitem"},
+			ephemeralMessage: constants.InvalidCommand + constants.HelpText,
+		},
+		{
+			description:      "ExecuteCommand: boards run command is not a boards command",
+			isConnected:      true,
+			commandArgs:      &model.CommandArgs{Command: "/azuredevops boards run mockPipeline"},
+			ephemeralMessage: constants.InvalidCommand + constants.HelpText,
+		},
+		{
+			description:      "ExecuteCommand: boards subscription command without subcommand",
+			isConnected:      true,
+			commandArgs:      &model.CommandArgs{Command: "/azuredevops boards subscription"},
+			ephemeralMessage: constants.InvalidCommand + constants.HelpText,
+		},
+		{
+			description:      "ExecuteCommand: boards preset command without subcommand",
+			isConnected:      true,
+			commandArgs:      &model.CommandArgs{Command: "/azuredevops boards preset"},
+			ephemeralMessage: constants.InvalidCommand + constants.HelpText,
+		},
+		{
+			description:      "ExecuteCommand: boards delete subscription command without subscription ID",
+			isConnected:      true,
+			commandArgs:      &model.CommandArgs{Command: "/azuredevops boards subscription delete"},
+			ephemeralMessage: "Subscription ID is not provided",
+		},
+		{
+			description:      "ExecuteCommand: boards delete subscription command with empty subscription ID",
+			isConnected:      true,
+			commandArgs:      &model.CommandArgs{Command: `/azuredevops boards subscription delete ""`},
+			ephemeralMessage: "Subscription ID is not provided",
+		},
+		{
+			description:      "ExecuteCommand: command with missing closing quote",
+			commandArgs:      &model.CommandArgs{Command: `/azuredevops boards workitem create "Login fails`},
+			ephemeralMessage: fmt.Sprintf(constants.InvalidCommandArguments, `missing closing quote '"'`),
+		},
+		{
+			description: "ExecuteCommand: boards create command with quoted arguments",
+			isConnected: true,
+			commandArgs: &model.CommandArgs{Command: `/azuredevops boards workitem create "Login fails" "Steps to repro..."`},
+		},
 		{
 			description:      "ExecuteCommand: boards create command",
 			isConnected:      true,
@@ -256,3 +304,316 @@ func TestExecuteCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutePresetCommand(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	mockCtrl := gomock.NewController(t)
+	mockedStore := mocks.NewMockKVStore(mockCtrl)
+	mockedClient := mocks.NewMockClient(mockCtrl)
+	p := setupMockPlugin(mockAPI, mockedStore, mockedClient)
+	preset := &serializers.WorkItemPreset{
+		Name:             "bug-triage",
+		ChannelID:        testutils.MockChannelID,
+		OrganizationName: testutils.MockOrganization,
+		ProjectName:      testutils.MockProjectName,
+		Type:             "Bug",
+		Fields: map[string]string{
+			"System.AreaPath": `Web\Checkout`,
+			"System.Tags":     "triage",
+		},
+	}
+	fieldDefinitions := []*serializers.WorkItemFieldDefinition{
+		{Name: "Title", ReferenceName: "System.Title", Type: "string", AlwaysRequired: true},
+		{Name: "Area Path", ReferenceName: "System.AreaPath", Type: "treePath"},
+		{Name: "Tags", ReferenceName: "System.Tags", Type: "plainText"},
+	}
+	for _, testCase := range []struct {
+		description        string
+		command            string
+		ephemeralMessage   string
+		linkedProjects     []serializers.ProjectDetails
+		storedPreset       *serializers.WorkItemPreset
+		expectedPreset     *serializers.WorkItemPreset
+		storeErr           error
+		expectedCreateTask *serializers.CreateTaskRequestPayload
+		expectDelete       bool
+		templates          []*serializers.WorkItemTemplateReference
+		template           *serializers.WorkItemTemplate
+	}{
+		{
+			description:      "PresetCommand: add command without preset name",
+			command:          "/azuredevops boards preset add",
+			ephemeralMessage: constants.PresetNameRequired,
+		},
+		{
+			description:      "PresetCommand: add command with invalid argument",
+			command:          "/azuredevops boards preset add bug-triage Bug",
+			ephemeralMessage: fmt.Sprintf(constants.PresetInvalidArgument, "Bug"),
+		},
+		{
+			description:      "PresetCommand: add command with no matching linked project",
+			command:          "/azuredevops boards preset add bug-triage type=Bug project=mockProject",
+			linkedProjects:   testutils.GetProjectDetailsPayload(),
+			ephemeralMessage: constants.PresetProjectRequired,
+		},
+		{
+			description:      "PresetCommand: add command",
+			command:          `/azuredevops boards preset add bug-triage type=Bug area=Web\\Checkout tags=triage`,
+			linkedProjects:   testutils.GetProjectDetailsPayload(),
+			expectedPreset:   preset,
+			ephemeralMessage: fmt.Sprintf(constants.PresetAdded, "bug-triage"),
+		},
+		{
+			description:      "PresetCommand: add command with the name of an existing preset",
+			command:          `/azuredevops boards preset add bug-triage type=Bug area=Web\\Checkout tags=triage`,
+			linkedProjects:   testutils.GetProjectDetailsPayload(),
+			expectedPreset:   preset,
+			storeErr:         store.ErrPresetAlreadyExists,
+			ephemeralMessage: fmt.Sprintf(constants.PresetAlreadyExists, "bug-triage"),
+		},
+		{
+			description:      "PresetCommand: add command with unknown field",
+			command:          "/azuredevops boards preset add bug-triage type=Bug Custom.Severity=High",
+			linkedProjects:   testutils.GetProjectDetailsPayload(),
+			ephemeralMessage: fmt.Sprintf(constants.ErrorUnknownField, "Custom.Severity", "Bug"),
+		},
+		{
+			description:      "PresetCommand: import command without template",
+			command:          "/azuredevops boards preset import bug-triage",
+			ephemeralMessage: constants.PresetTemplateRequired,
+		},
+		{
+			description:      "PresetCommand: import command with unknown template",
+			command:          "/azuredevops boards preset import bug-triage template=triage",
+			linkedProjects:   testutils.GetProjectDetailsPayload(),
+			templates:        []*serializers.WorkItemTemplateReference{{ID: "mockTemplateID", Name: "feature"}},
+			ephemeralMessage: fmt.Sprintf(constants.PresetTemplateNotFound, "triage", "mockProjectName Team"),
+		},
+		{
+			description:    "PresetCommand: import command",
+			command:        "/azuredevops boards preset import bug-triage template=Triage",
+			linkedProjects: testutils.GetProjectDetailsPayload(),
+			templates:      []*serializers.WorkItemTemplateReference{{ID: "mockTemplateID", Name: "triage"}},
+			template: &serializers.WorkItemTemplate{
+				ID:               "mockTemplateID",
+				WorkItemTypeName: "Bug",
+				Fields: map[string]string{
+					"System.Title":    "mockTitle",
+					"System.AreaPath": `Web\Checkout`,
+					"System.Tags":     "triage",
+				},
+			},
+			expectedPreset:   preset,
+			ephemeralMessage: fmt.Sprintf(constants.PresetAdded, "bug-triage"),
+		},
+		{
+			description:      "PresetCommand: list command without presets",
+			command:          "/azuredevops boards preset list",
+			ephemeralMessage: constants.NoPresetsFound,
+		},
+		{
+			description:      "PresetCommand: delete command for a preset that does not exist",
+			command:          "/azuredevops boards preset delete bug-triage",
+			ephemeralMessage: fmt.Sprintf(constants.PresetNotFound, "bug-triage"),
+		},
+		{
+			description:      "PresetCommand: delete command",
+			command:          "/azuredevops boards preset delete bug-triage",
+			storedPreset:     preset,
+			expectDelete:     true,
+			ephemeralMessage: fmt.Sprintf(constants.PresetDeleted, "bug-triage"),
+		},
+		{
+			description:      "PresetCommand: create work item without title",
+			command:          "/azuredevops boards workitem create --preset bug-triage",
+			ephemeralMessage: constants.PresetTitleRequired,
+		},
+		{
+			description:      "PresetCommand: create work item with a preset that does not exist",
+			command:          `/azuredevops boards workitem create --preset bug-triage "mockTitle"`,
+			ephemeralMessage: fmt.Sprintf(constants.PresetNotFound, "bug-triage"),
+		},
+		{
+			description:  "PresetCommand: create work item with preset",
+			command:      `/azuredevops boards workitem create --preset bug-triage "Checkout button is broken"`,
+			storedPreset: preset,
+			expectedCreateTask: &serializers.CreateTaskRequestPayload{
+				Organization: testutils.MockOrganization,
+				Project:      testutils.MockProjectName,
+				Type:         "Bug",
+				Fields: serializers.CreateTaskFieldValue{
+					Title:            "Checkout button is broken",
+					AreaPath:         `Web\Checkout`,
+					AdditionalFields: map[string]interface{}{"System.Tags": "triage"},
+				},
+			},
+			ephemeralMessage: fmt.Sprintf(constants.CreatedTask, 1, "Checkout button is broken", "mockLink", "Bug", "mockUser"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				post := args.Get(1).(*model.Post)
+				assert.Equal(t, testCase.ephemeralMessage, post.Message)
+			}).Once().Return(&model.Post{})
+			mockAPI.On("GetUser", testutils.MockMattermostUserID).Return(&model.User{Username: "mockUser"}, nil)
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return true
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetWorkItemFieldDefinitions", func(_ *Plugin, _, _, _, _ string) ([]*serializers.WorkItemFieldDefinition, int, error) {
+				return fieldDefinitions, http.StatusOK, nil
+			})
+
+			if testCase.linkedProjects != nil {
+				mockedStore.EXPECT().GetAllProjects(testutils.MockMattermostUserID).Return(testCase.linkedProjects, nil)
+			}
+
+			if testCase.templates != nil {
+				mockedClient.EXPECT().GetWorkItemTemplates(testutils.MockOrganization, testutils.MockProjectName, "mockProjectName Team", testutils.MockMattermostUserID).Return(&serializers.WorkItemTemplateList{Value: testCase.templates}, http.StatusOK, nil)
+			}
+
+			if testCase.template != nil {
+				mockedClient.EXPECT().GetWorkItemTemplate(testutils.MockOrganization, testutils.MockProjectName, "mockProjectName Team", testCase.template.ID, testutils.MockMattermostUserID).Return(testCase.template, http.StatusOK, nil)
+			}
+
+			if testCase.expectedPreset != nil {
+				mockedStore.EXPECT().StorePreset(gomock.Any()).DoAndReturn(func(preset *serializers.WorkItemPreset) error {
+					assert.Equal(t, testCase.expectedPreset.Type, preset.Type)
+					assert.Equal(t, testCase.expectedPreset.Fields, preset.Fields)
+					assert.Equal(t, testCase.expectedPreset.OrganizationName, preset.OrganizationName)
+					assert.Equal(t, testCase.expectedPreset.ProjectName, preset.ProjectName)
+					assert.Equal(t, "mockUser", preset.CreatedBy)
+					return testCase.storeErr
+				})
+			}
+
+			switch {
+			case strings.Contains(testCase.command, "preset list"):
+				mockedStore.EXPECT().GetAllPresets(testutils.MockChannelID).Return([]*serializers.WorkItemPreset{}, nil)
+			case strings.Contains(testCase.command, "preset delete") || (strings.Contains(testCase.command, "--preset") && testCase.ephemeralMessage != constants.PresetTitleRequired):
+				mockedStore.EXPECT().GetPreset(testutils.MockChannelID, "bug-triage").Return(testCase.storedPreset, nil)
+			}
+
+			if testCase.expectDelete {
+				mockedStore.EXPECT().DeletePreset(testutils.MockChannelID, "bug-triage").Return(nil)
+			}
+
+			if testCase.expectedCreateTask != nil {
+				task := &serializers.TaskValue{ID: 1}
+				task.Fields.Title = testCase.expectedCreateTask.Fields.Title
+				task.Fields.Type = testCase.expectedCreateTask.Type
+				task.Fields.CreatedBy.DisplayName = "mockUser"
+				task.Link.HTML.Href = "mockLink"
+				mockedClient.EXPECT().CreateTask(testCase.expectedCreateTask, testutils.MockMattermostUserID).Return(task, http.StatusOK, nil)
+			}
+
+			res, err := p.ExecuteCommand(&plugin.Context{}, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID, ChannelId: testutils.MockChannelID})
+			assert.Nil(t, err)
+			assert.NotNil(t, res)
+		})
+	}
+}
+
+func TestParseCommandArgs(t *testing.T) {
+	for _, testCase := range []struct {
+		description  string
+		command      string
+		expectedArgs []string
+		expectedErr  string
+	}{
+		{
+			description:  "ParseCommandArgs: empty command",
+			command:      "",
+			expectedArgs: nil,
+		},
+		{
+			description:  "ParseCommandArgs: arguments separated by whitespace",
+			command:      "/azuredevops  boards\tsubscription   list ",
+			expectedArgs: []string{"/azuredevops", "boards", "subscription", "list"},
+		},
+		{
+			description:  "ParseCommandArgs: double quoted arguments",
+			command:      `/azuredevops boards workitem create "Login fails" "Steps to repro..."`,
+			expectedArgs: []string{"/azuredevops", "boards", "workitem", "create", "Login fails", "Steps to repro..."},
+		},
+		{
+			description:  "ParseCommandArgs: single quoted arguments",
+			command:      `/azuredevops boards workitem create 'Login "fails"'`,
+			expectedArgs: []string{"/azuredevops", "boards", "workitem", "create", `Login "fails"`},
+		},
+		{
+			description:  "ParseCommandArgs: empty quoted argument",
+			command:      `/azuredevops boards subscription delete ""`,
+			expectedArgs: []string{"/azuredevops", "boards", "subscription", "delete", ""},
+		},
+		{
+			description:  "ParseCommandArgs: quotes in the middle of an argument",
+			command:      `/azuredevops boards preset add triage area="Web App"\\Checkout`,
+			expectedArgs: []string{"/azuredevops", "boards", "preset", "add", "triage", `area=Web App\Checkout`},
+		},
+		{
+			description:  "ParseCommandArgs: escaped quotes and whitespace",
+			command:      `/azuredevops boards workitem create "Login \"fails\"" Steps\ to\ repro`,
+			expectedArgs: []string{"/azuredevops", "boards", "workitem", "create", `Login "fails"`, "Steps to repro"},
+		},
+		{
+			description:  "ParseCommandArgs: escaped backslash",
+			command:      `/azuredevops boards preset add bug-triage type=Bug area=Web\\Checkout tags=triage`,
+			expectedArgs: []string{"/azuredevops", "boards", "preset", "add", "bug-triage", "type=Bug", `area=Web\Checkout`, "tags=triage"},
+		},
+		{
+			description:  "ParseCommandArgs: backslash before other characters is kept",
+			command:      `/azuredevops boards preset add bug-triage area=Web\Checkout`,
+			expectedArgs: []string{"/azuredevops", "boards", "preset", "add", "bug-triage", `area=Web\Checkout`},
+		},
+		{
+			description:  "ParseCommandArgs: backslash is not an escape character in single quotes",
+			command:      `/azuredevops boards workitem create 'C:\\temp'`,
+			expectedArgs: []string{"/azuredevops", "boards", "workitem", "create", `C:\\temp`},
+		},
+		{
+			description:  "ParseCommandArgs: flag with value",
+			command:      `/azuredevops boards workitem create --preset=bug-triage "Login fails"`,
+			expectedArgs: []string{"/azuredevops", "boards", "workitem", "create", "--preset", "bug-triage", "Login fails"},
+		},
+		{
+			description:  "ParseCommandArgs: flag with quoted value",
+			command:      `/azuredevops boards workitem create --preset="bug triage" "Login fails"`,
+			expectedArgs: []string{"/azuredevops", "boards", "workitem", "create", "--preset", "bug triage", "Login fails"},
+		},
+		{
+			description:  "ParseCommandArgs: quoted flag is not split",
+			command:      `/azuredevops boards workitem create "--preset=bug-triage"`,
+			expectedArgs: []string{"/azuredevops", "boards", "workitem", "create", "--preset=bug-triage"},
+		},
+		{
+			description:  "ParseCommandArgs: flag without value",
+			command:      `/azuredevops boards workitem create --preset bug-triage`,
+			expectedArgs: []string{"/azuredevops", "boards", "workitem", "create", "--preset", "bug-triage"},
+		},
+		{
+			description: "ParseCommandArgs: missing closing double quote",
+			command:     `/azuredevops boards workitem create "Login fails`,
+			expectedErr: `missing closing quote '"'`,
+		},
+		{
+			description: "ParseCommandArgs: missing closing single quote",
+			command:     `/azuredevops boards workitem create 'Login fails`,
+			expectedErr: `missing closing quote '\''`,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			args, err := parseCommandArgs(testCase.command)
+
+			if testCase.expectedErr != "" {
+				assert.EqualError(t, err, testCase.expectedErr)
+				assert.Nil(t, args)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedArgs, args)
+		})
+	}
+}
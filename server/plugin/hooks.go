@@ -66,5 +66,20 @@ func (p *Plugin) OnActivate() error {
 	p.Store = store.NewStore(p.API)
 	p.router = p.InitAPI()
 	p.InitRoutes()
+	if err = p.startReviewReminderJob(); err != nil {
+		return errors.Wrap(err, "failed to schedule the review reminder job")
+	}
+
+	if err = p.startPipelineApprovalJob(); err != nil {
+		return errors.Wrap(err, "failed to schedule the pipeline approval job")
+	}
+
+	return nil
+}
+
+// Invoked when the plugin is deactivated
+func (p *Plugin) OnDeactivate() error {
+	p.stopReviewReminderJob()
+	p.stopPipelineApprovalJob()
 	return nil
 }
@@ -0,0 +1,259 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// identityCacheEntry is the Mattermost user of an Azure DevOps identity, the user is nil if the identity does not resolve to a Mattermost user
+type identityCacheEntry struct {
+	user      *model.User
+	expiresAt time.Time
+}
+
+// getAzureIdentityKeys returns the lower case keys which identify an Azure DevOps identity
+func getAzureIdentityKeys(identity *serializers.UserID) []string {
+	var keys []string
+	for _, key := range []string{identity.ID, identity.Descriptor, identity.UniqueName} {
+		if key != "" {
+			keys = append(keys, strings.ToLower(key))
+		}
+	}
+
+	return keys
+}
+
+// GetMattermostUserForAzureIdentity returns the Mattermost user of an Azure DevOps identity, or nil if the identity does not resolve to a Mattermost user.
+// Identities mapped by the admins are resolved first, then the identities of the connected users and at last the Mattermost users with the verified email of the identity.
+func (p *Plugin) GetMattermostUserForAzureIdentity(identity *serializers.UserID) *model.User {
+	if identity == nil {
+		return nil
+	}
+
+	keys := getAzureIdentityKeys(identity)
+	if len(keys) == 0 {
+		return nil
+	}
+
+	cacheKey := strings.Join(keys, "|")
+	p.identityCacheLock.Lock()
+	entry, ok := p.identityCache[cacheKey]
+	p.identityCacheLock.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.user
+	}
+
+	user := p.resolveMattermostUserForAzureIdentity(identity, keys)
+
+	now := time.Now()
+	p.identityCacheLock.Lock()
+	if p.identityCache == nil {
+		p.identityCache = make(map[string]*identityCacheEntry)
+	}
+	// Expired entries are evicted on write so that the identities which are no longer seen do not stay in the cache
+	for key, entry := range p.identityCache {
+		if !now.Before(entry.expiresAt) {
+			delete(p.identityCache, key)
+		}
+	}
+	p.identityCache[cacheKey] = &identityCacheEntry{user: user, expiresAt: now.Add(constants.IdentityCacheTTL)}
+	p.identityCacheLock.Unlock()
+
+	return user
+}
+
+func (p *Plugin) resolveMattermostUserForAzureIdentity(identity *serializers.UserID, keys []string) *model.User {
+	overrides, err := p.Store.GetIdentityOverrides()
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadIdentityOverrides, "Error", err.Error())
+	}
+
+	for _, key := range keys {
+		if mattermostUserID, ok := overrides[key]; ok {
+			return p.getActiveMattermostUser(mattermostUserID)
+		}
+	}
+
+	azureDevopsUserID := identity.ID
+	email := ""
+	if strings.Contains(identity.UniqueName, "@") {
+		email = identity.UniqueName
+	}
+
+	if azureDevopsUserID == "" && email != "" {
+		if azureDevopsUserID, err = p.Store.LoadAzureDevopsUserIDFromEmail(email); err != nil {
+			p.API.LogError(constants.ErrorResolveAzureIdentity, "Error", err.Error())
+		}
+	}
+
+	if azureDevopsUserID != "" {
+		user, err := p.Store.LoadAzureDevopsUserDetails(azureDevopsUserID)
+		if err != nil {
+			p.API.LogError(constants.ErrorLoadingUserData, "Error", err.Error())
+		} else if user.MattermostUserID != "" {
+			return p.getActiveMattermostUser(user.MattermostUserID)
+		}
+	}
+
+	if email == "" {
+		return nil
+	}
+
+	mattermostUser, appErr := p.API.GetUserByEmail(email)
+	if appErr != nil {
+		if appErr.StatusCode != http.StatusNotFound {
+			p.API.LogError(constants.ErrorResolveAzureIdentity, "Error", appErr.Error())
+		}
+		return nil
+	}
+
+	// The email of an identity is only trusted to resolve to a Mattermost user who has verified it
+	if mattermostUser.DeleteAt != 0 || mattermostUser.IsBot || !mattermostUser.EmailVerified {
+		return nil
+	}
+
+	return mattermostUser
+}
+
+// getActiveMattermostUser returns the Mattermost user with an ID, or nil if the user is not found or is deactivated
+func (p *Plugin) getActiveMattermostUser(mattermostUserID string) *model.User {
+	user, appErr := p.API.GetUser(mattermostUserID)
+	if appErr != nil {
+		p.API.LogError(constants.ErrorResolveAzureIdentity, "Error", appErr.Error())
+		return nil
+	}
+
+	if user.DeleteAt != 0 {
+		return nil
+	}
+
+	return user
+}
+
+// GetMattermostUserIDForAzureIdentity returns the ID of the Mattermost user of an Azure DevOps identity, or an empty string if there is none
+func (p *Plugin) GetMattermostUserIDForAzureIdentity(identity *serializers.UserID) string {
+	if user := p.GetMattermostUserForAzureIdentity(identity); user != nil {
+		return user.Id
+	}
+
+	return ""
+}
+
+// getAzureIdentityMention returns the @mention of the Mattermost user of an Azure DevOps identity to show in the posts, or the display name of the identity if there is none
+func (p *Plugin) getAzureIdentityMention(identity *serializers.UserID) string {
+	if identity.ID == "" && identity.Descriptor == "" && identity.UniqueName == "" {
+		return identity.DisplayName
+	}
+
+	if user := p.GetMattermostUserForAzureIdentity(identity); user != nil {
+		return fmt.Sprintf("@%s", user.Username)
+	}
+
+	return identity.DisplayName
+}
+
+// GetAzureIdentityForMattermostUser returns the Azure DevOps identity of a Mattermost user, or nil if it is not found.
+// It is the reverse of GetMattermostUserForAzureIdentity and is used to assign work items to Mattermost users.
+func (p *Plugin) GetAzureIdentityForMattermostUser(mattermostUserID string) *serializers.UserID {
+	overrides, err := p.Store.GetIdentityOverrides()
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadIdentityOverrides, "Error", err.Error())
+	}
+
+	// Overrides are mapped using the emails or unique names as well as the IDs, only the former can be used to assign work items
+	for azureIdentity, overrideMattermostUserID := range overrides {
+		if overrideMattermostUserID == mattermostUserID && strings.Contains(azureIdentity, "@") {
+			return &serializers.UserID{UniqueName: azureIdentity}
+		}
+	}
+
+	azureDevopsUserID, err := p.Store.LoadAzureDevopsUserIDFromMattermostUser(mattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadingUserData, "Error", err.Error())
+	}
+
+	if azureDevopsUserID != "" {
+		user, err := p.Store.LoadAzureDevopsUserDetails(azureDevopsUserID)
+		if err != nil {
+			p.API.LogError(constants.ErrorLoadingUserData, "Error", err.Error())
+		} else if user.Email != "" {
+			return &serializers.UserID{ID: user.ID, DisplayName: user.DisplayName, UniqueName: user.Email}
+		}
+	}
+
+	mattermostUser, appErr := p.API.GetUser(mattermostUserID)
+	if appErr != nil {
+		p.API.LogError(constants.ErrorResolveAzureIdentity, "Error", appErr.Error())
+		return nil
+	}
+
+	if mattermostUser.Email == "" {
+		return nil
+	}
+
+	return &serializers.UserID{DisplayName: mattermostUser.GetFullName(), UniqueName: mattermostUser.Email}
+}
+
+// ResolveAzureIdentity returns the identity of an organization which is referred to by the @username of a Mattermost user, a unique name or an email,
+// or nil if it is not found. The Mattermost users are mapped to their identities using GetAzureIdentityForMattermostUser.
+func (p *Plugin) ResolveAzureIdentity(organization, value, mattermostUserID string) (*serializers.UserID, error) {
+	uniqueName := strings.TrimSpace(value)
+	if strings.HasPrefix(uniqueName, "@") {
+		mattermostUser, appErr := p.API.GetUserByUsername(strings.TrimPrefix(uniqueName, "@"))
+		if appErr != nil {
+			if appErr.StatusCode == http.StatusNotFound {
+				return nil, nil
+			}
+			return nil, appErr
+		}
+
+		identity := p.GetAzureIdentityForMattermostUser(mattermostUser.Id)
+		if identity == nil {
+			return nil, nil
+		}
+
+		// The identities of the connected users are already known
+		if identity.ID != "" {
+			return identity, nil
+		}
+
+		uniqueName = identity.UniqueName
+	}
+
+	if uniqueName == "" {
+		return nil, nil
+	}
+
+	identityList, _, err := p.Client.SearchIdentities(organization, uniqueName, mattermostUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, identity := range identityList.Value {
+		if identity == nil || identity.IsContainer {
+			continue
+		}
+
+		if azureIdentity := identity.GetIdentity(); strings.EqualFold(azureIdentity.UniqueName, uniqueName) {
+			return azureIdentity, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// clearIdentityCache removes the cached Mattermost users of the Azure DevOps identities once the mappings change.
+// The caches of the other servers of a cluster expire after constants.IdentityCacheTTL.
+func (p *Plugin) clearIdentityCache() {
+	p.identityCacheLock.Lock()
+	defer p.identityCacheLock.Unlock()
+
+	p.identityCache = nil
+}
@@ -0,0 +1,422 @@
+package plugin
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/store"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestGetMattermostUserForAzureIdentity(t *testing.T) {
+	for _, testCase := range []struct {
+		description        string
+		identity           *serializers.UserID
+		overrides          store.IdentityOverrideList
+		connectedUserID    string
+		emailUserID        string
+		mattermostUserID   string
+		isEmailUserMissing bool
+		isEmailUnverified  bool
+		expectedUserID     string
+	}{
+		{
+			description: "GetMattermostUserForAzureIdentity: identity without any key",
+			identity:    &serializers.UserID{DisplayName: "John Doe"},
+		},
+		{
+			description:    "GetMattermostUserForAzureIdentity: identity mapped by the admins",
+			identity:       &serializers.UserID{ID: mockIdentityID1, UniqueName: "John@example.com"},
+			overrides:      store.IdentityOverrideList{"john@example.com": "mockOverrideUserID"},
+			expectedUserID: "mockOverrideUserID",
+		},
+		{
+			description:      "GetMattermostUserForAzureIdentity: identity of a connected user",
+			identity:         &serializers.UserID{ID: mockIdentityID1, UniqueName: "john@example.com"},
+			mattermostUserID: testutils.MockMattermostUserID,
+			expectedUserID:   testutils.MockMattermostUserID,
+		},
+		{
+			description:      "GetMattermostUserForAzureIdentity: unique name of a connected user",
+			identity:         &serializers.UserID{UniqueName: "john@example.com"},
+			connectedUserID:  mockIdentityID1,
+			mattermostUserID: testutils.MockMattermostUserID,
+			expectedUserID:   testutils.MockMattermostUserID,
+		},
+		{
+			description:    "GetMattermostUserForAzureIdentity: email of a Mattermost user",
+			identity:       &serializers.UserID{ID: mockIdentityID1, UniqueName: "john@example.com"},
+			emailUserID:    "mockEmailUserID",
+			expectedUserID: "mockEmailUserID",
+		},
+		{
+			description:       "GetMattermostUserForAzureIdentity: unverified email of a Mattermost user",
+			identity:          &serializers.UserID{ID: mockIdentityID1, UniqueName: "john@example.com"},
+			emailUserID:       "mockEmailUserID",
+			isEmailUnverified: true,
+		},
+		{
+			description:        "GetMattermostUserForAzureIdentity: identity is not found",
+			identity:           &serializers.UserID{ID: mockIdentityID1, UniqueName: "john@example.com"},
+			isEmailUserMissing: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+			if testCase.identity.ID != "" || testCase.identity.UniqueName != "" {
+				mockedStore.EXPECT().GetIdentityOverrides().Return(testCase.overrides, nil).Times(1)
+			}
+
+			if testCase.overrides == nil && testCase.identity.ID == "" && testCase.identity.UniqueName != "" {
+				mockedStore.EXPECT().LoadAzureDevopsUserIDFromEmail("john@example.com").Return(testCase.connectedUserID, nil)
+			}
+
+			if testCase.overrides == nil && (testCase.identity.ID != "" || testCase.connectedUserID != "") {
+				mockedStore.EXPECT().LoadAzureDevopsUserDetails(mockIdentityID1).Return(&serializers.User{MattermostUserID: testCase.mattermostUserID}, nil)
+			}
+
+			for _, userID := range []string{"mockOverrideUserID", testutils.MockMattermostUserID} {
+				mockAPI.On("GetUser", userID).Return(&model.User{Id: userID}, nil)
+			}
+
+			if testCase.emailUserID != "" {
+				mockAPI.On("GetUserByEmail", "john@example.com").Return(&model.User{Id: testCase.emailUserID, EmailVerified: !testCase.isEmailUnverified}, nil)
+			}
+
+			if testCase.isEmailUserMissing {
+				mockAPI.On("GetUserByEmail", "john@example.com").Return(nil, &model.AppError{StatusCode: http.StatusNotFound})
+			}
+
+			user := p.GetMattermostUserForAzureIdentity(testCase.identity)
+			if testCase.expectedUserID == "" {
+				assert.Nil(t, user)
+			} else {
+				assert.Equal(t, testCase.expectedUserID, user.Id)
+			}
+
+			// The resolved user is cached
+			assert.Equal(t, user, p.GetMattermostUserForAzureIdentity(testCase.identity))
+		})
+	}
+}
+
+func TestGetMattermostUserForAzureIdentityEvictsExpiredEntries(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	mockCtrl := gomock.NewController(t)
+	mockedStore := mocks.NewMockKVStore(mockCtrl)
+	p := setupMockPlugin(mockAPI, mockedStore, nil)
+	p.identityCache = map[string]*identityCacheEntry{
+		"mockexpiredkey": {expiresAt: time.Now().Add(-time.Minute)},
+		"mockvalidkey":   {expiresAt: time.Now().Add(time.Minute)},
+	}
+
+	mockedStore.EXPECT().GetIdentityOverrides().Return(store.IdentityOverrideList{mockIdentityID1: testutils.MockMattermostUserID}, nil)
+	mockAPI.On("GetUser", testutils.MockMattermostUserID).Return(&model.User{Id: testutils.MockMattermostUserID}, nil)
+
+	p.GetMattermostUserForAzureIdentity(&serializers.UserID{ID: mockIdentityID1})
+
+	assert.NotContains(t, p.identityCache, "mockexpiredkey")
+	assert.Contains(t, p.identityCache, "mockvalidkey")
+	assert.Contains(t, p.identityCache, strings.ToLower(mockIdentityID1))
+}
+
+func TestGetAzureIdentityMention(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	mockCtrl := gomock.NewController(t)
+	mockedStore := mocks.NewMockKVStore(mockCtrl)
+	p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+	assert.Equal(t, "John Doe", p.getAzureIdentityMention(&serializers.UserID{DisplayName: "John Doe"}))
+
+	mockedStore.EXPECT().GetIdentityOverrides().Return(store.IdentityOverrideList{mockIdentityID1: testutils.MockMattermostUserID}, nil)
+	mockAPI.On("GetUser", testutils.MockMattermostUserID).Return(&model.User{Id: testutils.MockMattermostUserID, Username: "john"}, nil)
+	assert.Equal(t, "@john", p.getAzureIdentityMention(&serializers.UserID{ID: mockIdentityID1, DisplayName: "John Doe"}))
+
+	mockedStore.EXPECT().GetIdentityOverrides().Return(nil, nil)
+	mockedStore.EXPECT().LoadAzureDevopsUserDetails(mockIdentityID2).Return(&serializers.User{}, nil)
+	assert.Equal(t, "Jane Doe", p.getAzureIdentityMention(&serializers.UserID{ID: mockIdentityID2, DisplayName: "Jane Doe"}))
+}
+
+func TestGetAzureIdentityForMattermostUser(t *testing.T) {
+	for _, testCase := range []struct {
+		description       string
+		overrides         store.IdentityOverrideList
+		azureDevopsUserID string
+		mattermostUser    *model.User
+		expectedIdentity  *serializers.UserID
+	}{
+		{
+			description:      "GetAzureIdentityForMattermostUser: email mapped by the admins",
+			overrides:        store.IdentityOverrideList{mockIdentityID1: testutils.MockMattermostUserID, "john@example.com": testutils.MockMattermostUserID},
+			expectedIdentity: &serializers.UserID{UniqueName: "john@example.com"},
+		},
+		{
+			description:       "GetAzureIdentityForMattermostUser: connected user",
+			azureDevopsUserID: mockIdentityID1,
+			expectedIdentity:  &serializers.UserID{ID: mockIdentityID1, DisplayName: "John Doe", UniqueName: "john@example.com"},
+		},
+		{
+			description:      "GetAzureIdentityForMattermostUser: email of the Mattermost user",
+			mattermostUser:   &model.User{Id: testutils.MockMattermostUserID, FirstName: "John", LastName: "Doe", Email: "john.doe@example.com"},
+			expectedIdentity: &serializers.UserID{DisplayName: "John Doe", UniqueName: "john.doe@example.com"},
+		},
+		{
+			description:    "GetAzureIdentityForMattermostUser: Mattermost user without an email",
+			mattermostUser: &model.User{Id: testutils.MockMattermostUserID},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+			mockedStore.EXPECT().GetIdentityOverrides().Return(testCase.overrides, nil)
+			if testCase.overrides == nil {
+				mockedStore.EXPECT().LoadAzureDevopsUserIDFromMattermostUser(testutils.MockMattermostUserID).Return(testCase.azureDevopsUserID, nil)
+			}
+
+			if testCase.azureDevopsUserID != "" {
+				mockedStore.EXPECT().LoadAzureDevopsUserDetails(testCase.azureDevopsUserID).Return(&serializers.User{
+					MattermostUserID: testutils.MockMattermostUserID,
+					UserProfile:      serializers.UserProfile{ID: mockIdentityID1, DisplayName: "John Doe", Email: "john@example.com"},
+				}, nil)
+			}
+
+			if testCase.mattermostUser != nil {
+				mockAPI.On("GetUser", testutils.MockMattermostUserID).Return(testCase.mattermostUser, nil)
+			}
+
+			assert.Equal(t, testCase.expectedIdentity, p.GetAzureIdentityForMattermostUser(testutils.MockMattermostUserID))
+		})
+	}
+}
+
+func TestResolveAzureIdentity(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description       string
+		value             string
+		mattermostUser    *model.User
+		mappedIdentity    *serializers.UserID
+		expectedSearch    string
+		identities        []*serializers.Identity
+		searchErr         error
+		expectedIdentity  *serializers.UserID
+		expectedErrorText string
+	}{
+		{
+			description:    "ResolveAzureIdentity: email of an identity of the organization",
+			value:          "John@example.com",
+			expectedSearch: "John@example.com",
+			identities: []*serializers.Identity{
+				{ID: "mockGroupID", IsContainer: true, Properties: serializers.IdentityProperties{Mail: serializers.IdentityProperty{Value: "john@example.com"}}},
+				{ID: mockIdentityID1, ProviderDisplayName: "John Doe", Properties: serializers.IdentityProperties{Mail: serializers.IdentityProperty{Value: "john@example.com"}}},
+			},
+			expectedIdentity: &serializers.UserID{ID: mockIdentityID1, DisplayName: "John Doe", UniqueName: "john@example.com"},
+		},
+		{
+			description:    "ResolveAzureIdentity: unknown unique name",
+			value:          "unknown@example.com",
+			expectedSearch: "unknown@example.com",
+			identities: []*serializers.Identity{
+				{ID: mockIdentityID1, Properties: serializers.IdentityProperties{Mail: serializers.IdentityProperty{Value: "john@example.com"}}},
+			},
+		},
+		{
+			description:      "ResolveAzureIdentity: Mattermost user who is connected",
+			value:            "@john",
+			mattermostUser:   &model.User{Id: testutils.MockMattermostUserID},
+			mappedIdentity:   &serializers.UserID{ID: mockIdentityID1, UniqueName: "john@example.com"},
+			expectedIdentity: &serializers.UserID{ID: mockIdentityID1, UniqueName: "john@example.com"},
+		},
+		{
+			description:    "ResolveAzureIdentity: Mattermost user mapped to an email",
+			value:          "@john",
+			mattermostUser: &model.User{Id: testutils.MockMattermostUserID},
+			mappedIdentity: &serializers.UserID{UniqueName: "john@example.com"},
+			expectedSearch: "john@example.com",
+			identities: []*serializers.Identity{
+				{ID: mockIdentityID1, Properties: serializers.IdentityProperties{Account: serializers.IdentityProperty{Value: "john@example.com"}}},
+			},
+			expectedIdentity: &serializers.UserID{ID: mockIdentityID1, UniqueName: "john@example.com"},
+		},
+		{
+			description:    "ResolveAzureIdentity: Mattermost user who is not mapped",
+			value:          "@john",
+			mattermostUser: &model.User{Id: testutils.MockMattermostUserID},
+		},
+		{
+			description:       "ResolveAzureIdentity: identities can not be searched",
+			value:             "john@example.com",
+			expectedSearch:    "john@example.com",
+			searchErr:         errors.New("mockError"),
+			expectedErrorText: "mockError",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+
+			if testCase.mattermostUser != nil {
+				mockAPI.On("GetUserByUsername", "john").Return(testCase.mattermostUser, nil)
+				monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetAzureIdentityForMattermostUser", func(_ *Plugin, mattermostUserID string) *serializers.UserID {
+					assert.Equal(t, testutils.MockMattermostUserID, mattermostUserID)
+					return testCase.mappedIdentity
+				})
+			}
+
+			if testCase.expectedSearch != "" {
+				mockedClient.EXPECT().SearchIdentities(testutils.MockOrganization, testCase.expectedSearch, testutils.MockMattermostUserID).Return(&serializers.IdentityList{Value: testCase.identities}, http.StatusOK, testCase.searchErr)
+			}
+
+			identity, err := p.ResolveAzureIdentity(testutils.MockOrganization, testCase.value, testutils.MockMattermostUserID)
+
+			if testCase.expectedErrorText != "" {
+				assert.EqualError(t, err, testCase.expectedErrorText)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedIdentity, identity)
+		})
+	}
+}
+
+func TestAzureDevopsIdentityCommand(t *testing.T) {
+	for _, testCase := range []struct {
+		description     string
+		command         string
+		isAdmin         bool
+		overrides       store.IdentityOverrideList
+		storeErr        error
+		isDeleted       bool
+		expectedMessage string
+	}{
+		{
+			description:     "IdentityCommand: user is not a system admin",
+			command:         "/azuredevops identity list",
+			expectedMessage: constants.IdentityOverrideNotPermitted,
+		},
+		{
+			description:     "IdentityCommand: list without any mappings",
+			command:         "/azuredevops identity list",
+			isAdmin:         true,
+			overrides:       store.IdentityOverrideList{},
+			expectedMessage: constants.IdentityOverridesEmpty,
+		},
+		{
+			description:     "IdentityCommand: list the mappings",
+			command:         "/azuredevops identity list",
+			isAdmin:         true,
+			overrides:       store.IdentityOverrideList{"john@example.com": testutils.MockMattermostUserID, "jane@example.com": "mockDeletedUserID"},
+			expectedMessage: constants.IdentityOverridesListHeader + "| jane@example.com | mockDeletedUserID |\n| john@example.com | @john |\n",
+		},
+		{
+			description:     "IdentityCommand: map an identity",
+			command:         "/azuredevops identity map John@example.com @john",
+			isAdmin:         true,
+			expectedMessage: `Azure DevOps identity "John@example.com" is mapped to @john.`,
+		},
+		{
+			description:     "IdentityCommand: map an identity to a missing user",
+			command:         "/azuredevops identity map john@example.com @jane",
+			isAdmin:         true,
+			expectedMessage: `Mattermost user "jane" is not found.`,
+		},
+		{
+			description:     "IdentityCommand: unmap an identity",
+			command:         "/azuredevops identity unmap john@example.com",
+			isAdmin:         true,
+			isDeleted:       true,
+			expectedMessage: `Azure DevOps identity "john@example.com" is no longer mapped to a Mattermost user.`,
+		},
+		{
+			description:     "IdentityCommand: unmap an identity which is not mapped",
+			command:         "/azuredevops identity unmap john@example.com",
+			isAdmin:         true,
+			expectedMessage: `Azure DevOps identity "john@example.com" is not mapped to a Mattermost user.`,
+		},
+		{
+			description:     "IdentityCommand: error in unmapping an identity",
+			command:         "/azuredevops identity unmap john@example.com",
+			isAdmin:         true,
+			storeErr:        errors.New("mockError"),
+			expectedMessage: constants.GenericErrorMessage,
+		},
+		{
+			description:     "IdentityCommand: missing arguments",
+			command:         "/azuredevops identity map john@example.com",
+			isAdmin:         true,
+			expectedMessage: constants.InvalidCommand + constants.HelpText,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			mockAPI.On("HasPermissionTo", testutils.MockMattermostUserID, model.PERMISSION_MANAGE_SYSTEM).Return(testCase.isAdmin)
+			mockAPI.On("GetUser", testutils.MockMattermostUserID).Return(&model.User{Id: testutils.MockMattermostUserID, Username: "john"}, nil)
+			mockAPI.On("GetUser", "mockDeletedUserID").Return(nil, &model.AppError{StatusCode: http.StatusNotFound})
+			mockAPI.On("GetUserByUsername", "john").Return(&model.User{Id: testutils.MockMattermostUserID, Username: "john"}, nil)
+			mockAPI.On("GetUserByUsername", "jane").Return(nil, &model.AppError{StatusCode: http.StatusNotFound})
+			mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				assert.Equal(t, testCase.expectedMessage, args.Get(1).(*model.Post).Message)
+			}).Once().Return(&model.Post{})
+
+			if testCase.overrides != nil {
+				mockedStore.EXPECT().GetIdentityOverrides().Return(testCase.overrides, nil)
+			}
+			mockedStore.EXPECT().StoreIdentityOverride("John@example.com", testutils.MockMattermostUserID).Return(nil).AnyTimes()
+			mockedStore.EXPECT().DeleteIdentityOverride("john@example.com").Return(testCase.isDeleted, testCase.storeErr).AnyTimes()
+
+			res, err := p.ExecuteCommand(&plugin.Context{}, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID, ChannelId: testutils.MockChannelID})
+			assert.Nil(t, err)
+			assert.NotNil(t, res)
+		})
+	}
+}
+
+func TestResolvePresetAssignee(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	mockCtrl := gomock.NewController(t)
+	mockedStore := mocks.NewMockKVStore(mockCtrl)
+	p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+	arguments := map[string]string{constants.PresetArgumentAssignee: "john@example.com"}
+	assert.Equal(t, "", p.resolvePresetAssignee(arguments))
+	assert.Equal(t, "john@example.com", arguments[constants.PresetArgumentAssignee])
+
+	mockAPI.On("GetUserByUsername", "jane").Return(nil, &model.AppError{StatusCode: http.StatusNotFound})
+	assert.Equal(t, `Mattermost user "jane" is not found.`, p.resolvePresetAssignee(map[string]string{constants.PresetArgumentAssignee: "@jane"}))
+
+	mockAPI.On("GetUserByUsername", "john").Return(&model.User{Id: testutils.MockMattermostUserID}, nil)
+	mockedStore.EXPECT().GetIdentityOverrides().Return(store.IdentityOverrideList{"john@example.com": testutils.MockMattermostUserID}, nil)
+	arguments = map[string]string{constants.PresetArgumentAssignee: "@john"}
+	assert.Equal(t, "", p.resolvePresetAssignee(arguments))
+	assert.Equal(t, "john@example.com", arguments[constants.PresetArgumentAssignee])
+}
@@ -247,6 +247,10 @@ func (p *Plugin) GenerateAndStoreOAuthToken(mattermostUserID string, oauthTokenF
 		return err
 	}
 
+	if !isTokenRefreshRequest {
+		p.clearIdentityCache()
+	}
+
 	return nil
 }
 
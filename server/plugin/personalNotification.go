@@ -0,0 +1,182 @@
+package plugin
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// personalNotification is a direct message sent to the Azure DevOps users involved in an event
+type personalNotification struct {
+	kind       string
+	format     string
+	identities []*serializers.UserID
+}
+
+// SendPersonalNotifications sends direct messages to the connected users added as reviewers, mentioned or assigned a work item in an event.
+// The event is delivered once for each of its subscriptions, so a notification of an event is sent only once to a user.
+// Only the events of the channel subscriptions are delivered to the plugin, so no notification is sent for the events without a subscription.
+func (p *Plugin) SendPersonalNotifications(body *serializers.SubscriptionNotification) {
+	message := body.DetailedMessage.Markdown
+	if message == "" {
+		message = body.Message.Markdown
+	}
+
+	for _, notification := range p.getPersonalNotifications(body) {
+		for _, identity := range notification.identities {
+			mattermostUserID := p.GetMattermostUserIDForAzureIdentity(identity)
+			if mattermostUserID == "" {
+				continue
+			}
+
+			settings, err := p.Store.GetPersonalNotificationSettings(mattermostUserID)
+			if err != nil {
+				p.API.LogError(constants.ErrorLoadPersonalNotificationSettings, "Error", err.Error())
+				continue
+			}
+
+			if !settings.IsEnabled(notification.kind) {
+				continue
+			}
+
+			if body.ID != "" {
+				isNotSent, err := p.Store.MarkPersonalNotificationSent(body.ID, notification.kind, mattermostUserID)
+				if err != nil {
+					p.API.LogError(constants.ErrorSendPersonalNotification, "Error", err.Error())
+					continue
+				}
+
+				if !isNotSent {
+					continue
+				}
+			}
+
+			if _, err := p.DM(mattermostUserID, notification.format, false, message); err != nil {
+				p.API.LogError(constants.ErrorSendPersonalNotification, "Error", err.Error())
+			}
+		}
+	}
+}
+
+// getPersonalNotifications returns the personal notifications of an event along with the identities to notify.
+// The user who caused the event is not notified.
+func (p *Plugin) getPersonalNotifications(body *serializers.SubscriptionNotification) []*personalNotification {
+	var notifications []*personalNotification
+	switch body.EventType {
+	case constants.SubscriptionEventPullRequestCreated, constants.SubscriptionEventPullRequestUpdated:
+		reviewerIDs := make([]string, 0, len(body.Resource.Reviewers))
+		for _, reviewer := range body.Resource.Reviewers {
+			reviewerIDs = append(reviewerIDs, reviewer.ID)
+		}
+
+		addedReviewerIDs, wasTracked, err := p.Store.AddPullRequestReviewers(body.Resource.Repository.ID, body.Resource.PullRequestID, reviewerIDs)
+		if err != nil {
+			p.API.LogError(constants.ErrorTrackPullRequestReviewers, "Error", err.Error())
+			return nil
+		}
+
+		// The reviewers added to a pull request can not be identified if the pull request was not tracked before it was updated
+		if body.EventType == constants.SubscriptionEventPullRequestUpdated && !wasTracked {
+			return nil
+		}
+
+		var reviewers []*serializers.UserID
+		for _, reviewerID := range addedReviewerIDs {
+			reviewers = append(reviewers, &serializers.UserID{ID: reviewerID})
+		}
+
+		notifications = append(notifications, &personalNotification{
+			kind:       constants.PersonalNotificationReviews,
+			format:     constants.PersonalNotificationReviewRequested,
+			identities: excludeIdentity(reviewers, body.Resource.CreatedBy.ID),
+		})
+	case constants.SubscriptionEventPullRequestCommented:
+		comment, err := body.Resource.GetComment()
+		if err != nil {
+			p.API.LogError(err.Error())
+			return nil
+		}
+
+		notifications = append(notifications, &personalNotification{
+			kind:       constants.PersonalNotificationMentions,
+			format:     constants.PersonalNotificationMentioned,
+			identities: excludeIdentity(getMentionedIdentities(constants.PullRequestCommentMentionRegex, comment.Content), comment.Author.ID),
+		})
+	case constants.SubscriptionEventWorkItemCreated, constants.SubscriptionEventWorkItemCommented:
+		var changedByID string
+		if changedBy := serializers.ParseIdentity(body.Resource.Fields.ChangedBy); changedBy != nil {
+			changedByID = changedBy.ID
+		}
+
+		history, _ := body.Resource.Fields.History.(string)
+		notifications = append(notifications, &personalNotification{
+			kind:       constants.PersonalNotificationMentions,
+			format:     constants.PersonalNotificationMentioned,
+			identities: excludeIdentity(getMentionedIdentities(constants.WorkItemCommentMentionRegex, history), changedByID),
+		})
+
+		if assignedTo := serializers.ParseIdentity(body.Resource.Fields.AssignedTo); assignedTo != nil && body.EventType == constants.SubscriptionEventWorkItemCreated {
+			notifications = append(notifications, &personalNotification{
+				kind:       constants.PersonalNotificationAssignments,
+				format:     constants.PersonalNotificationWorkItemAssigned,
+				identities: excludeIdentity([]*serializers.UserID{assignedTo}, changedByID),
+			})
+		}
+	case constants.SubscriptionEventWorkItemUpdated:
+		if change := body.Resource.Fields.Changes[constants.FieldReferenceNameHistory]; change != nil {
+			history, _ := change.NewValue.(string)
+			notifications = append(notifications, &personalNotification{
+				kind:       constants.PersonalNotificationMentions,
+				format:     constants.PersonalNotificationMentioned,
+				identities: excludeIdentity(getMentionedIdentities(constants.WorkItemCommentMentionRegex, history), body.Resource.RevisedBy.ID),
+			})
+		}
+
+		if change := body.Resource.Fields.Changes[constants.FieldReferenceNameAssignedTo]; change != nil {
+			if assignedTo := serializers.ParseIdentity(change.NewValue); assignedTo != nil {
+				notifications = append(notifications, &personalNotification{
+					kind:       constants.PersonalNotificationAssignments,
+					format:     constants.PersonalNotificationWorkItemAssigned,
+					identities: excludeIdentity([]*serializers.UserID{assignedTo}, body.Resource.RevisedBy.ID),
+				})
+			}
+		}
+	}
+
+	return notifications
+}
+
+// getMentionedIdentities returns the identities mentioned in a comment using the regex of the mentions in the comment
+func getMentionedIdentities(mentionRegex, text string) []*serializers.UserID {
+	var identities []*serializers.UserID
+	isMentioned := map[string]bool{}
+	for _, match := range regexp.MustCompile(mentionRegex).FindAllStringSubmatch(text, -1) {
+		id := strings.ToLower(match[1])
+		if isMentioned[id] {
+			continue
+		}
+
+		isMentioned[id] = true
+		identities = append(identities, &serializers.UserID{ID: id})
+	}
+
+	return identities
+}
+
+// excludeIdentity removes an identity from a list of identities
+func excludeIdentity(identities []*serializers.UserID, excludedID string) []*serializers.UserID {
+	if excludedID == "" {
+		return identities
+	}
+
+	var filteredIdentities []*serializers.UserID
+	for _, identity := range identities {
+		if !strings.EqualFold(identity.ID, excludedID) {
+			filteredIdentities = append(filteredIdentities, identity)
+		}
+	}
+
+	return filteredIdentities
+}
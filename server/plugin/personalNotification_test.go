@@ -0,0 +1,242 @@
+package plugin
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+const (
+	mockIdentityID1 = "11111111-1111-1111-1111-111111111111"
+	mockIdentityID2 = "22222222-2222-2222-2222-222222222222"
+)
+
+func TestGetPersonalNotifications(t *testing.T) {
+	for _, testCase := range []struct {
+		description         string
+		body                string
+		addedReviewerIDs    []string
+		wasTracked          bool
+		expectedKinds       []string
+		expectedIdentityIDs [][]string
+	}{
+		{
+			description:         "GetPersonalNotifications: reviewers of a created pull request except its author",
+			body:                `{"eventType": "git.pullrequest.created", "resource": {"pullRequestId": 1, "repository": {"id": "mockRepositoryID"}, "createdBy": {"id": "` + mockIdentityID2 + `"}, "reviewers": [{"id": "` + mockIdentityID1 + `"}, {"id": "` + mockIdentityID2 + `"}]}}`,
+			addedReviewerIDs:    []string{mockIdentityID1, mockIdentityID2},
+			expectedKinds:       []string{constants.PersonalNotificationReviews},
+			expectedIdentityIDs: [][]string{{mockIdentityID1}},
+		},
+		{
+			description:      "GetPersonalNotifications: reviewers of an untracked updated pull request",
+			body:             `{"eventType": "git.pullrequest.updated", "resource": {"pullRequestId": 1, "repository": {"id": "mockRepositoryID"}, "reviewers": [{"id": "` + mockIdentityID1 + `"}]}}`,
+			addedReviewerIDs: []string{mockIdentityID1},
+		},
+		{
+			description:         "GetPersonalNotifications: reviewers added to a tracked pull request",
+			body:                `{"eventType": "git.pullrequest.updated", "resource": {"pullRequestId": 1, "repository": {"id": "mockRepositoryID"}, "reviewers": [{"id": "` + mockIdentityID1 + `"}, {"id": "` + mockIdentityID2 + `"}]}}`,
+			addedReviewerIDs:    []string{mockIdentityID2},
+			wasTracked:          true,
+			expectedKinds:       []string{constants.PersonalNotificationReviews},
+			expectedIdentityIDs: [][]string{{mockIdentityID2}},
+		},
+		{
+			description:         "GetPersonalNotifications: mentions in a pull request comment except its author",
+			body:                `{"eventType": "ms.vss-code.git-pullrequest-comment-event", "resource": {"comment": {"content": "@<` + mockIdentityID1 + `> and @<` + mockIdentityID2 + `> please check", "author": {"id": "` + mockIdentityID2 + `"}}}}`,
+			expectedKinds:       []string{constants.PersonalNotificationMentions},
+			expectedIdentityIDs: [][]string{{mockIdentityID1}},
+		},
+		{
+			description:         "GetPersonalNotifications: mentions in a work item comment",
+			body:                `{"eventType": "workitem.commented", "resource": {"fields": {"System.History": "<a href=\"#\" data-vss-mention=\"version:2.0,` + mockIdentityID1 + `\">@John</a> <a href=\"#\" data-vss-mention=\"version:2.0,` + mockIdentityID1 + `\">@John</a>", "System.ChangedBy": "Jane Doe <jane@example.com>"}}}`,
+			expectedKinds:       []string{constants.PersonalNotificationMentions},
+			expectedIdentityIDs: [][]string{{mockIdentityID1}},
+		},
+		{
+			description:         "GetPersonalNotifications: assignee and mentions of an updated work item",
+			body:                `{"eventType": "workitem.updated", "resource": {"revisedBy": {"id": "` + mockIdentityID2 + `"}, "fields": {"System.History": {"newValue": "<a data-vss-mention=\"version:2.0,` + mockIdentityID2 + `\">@Jane</a>"}, "System.AssignedTo": {"oldValue": "Jane Doe <jane@example.com>", "newValue": {"id": "` + mockIdentityID1 + `", "uniqueName": "john@example.com", "displayName": "John Doe"}}}}}`,
+			expectedKinds:       []string{constants.PersonalNotificationMentions, constants.PersonalNotificationAssignments},
+			expectedIdentityIDs: [][]string{nil, {mockIdentityID1}},
+		},
+		{
+			description:         "GetPersonalNotifications: assignee of a created work item",
+			body:                `{"eventType": "workitem.created", "resource": {"fields": {"System.AssignedTo": {"id": "` + mockIdentityID1 + `"}, "System.ChangedBy": {"id": "` + mockIdentityID2 + `"}}}}`,
+			expectedKinds:       []string{constants.PersonalNotificationMentions, constants.PersonalNotificationAssignments},
+			expectedIdentityIDs: [][]string{nil, {mockIdentityID1}},
+		},
+		{
+			description: "GetPersonalNotifications: build completed",
+			body:        `{"eventType": "build.complete"}`,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+			body := testutils.ParseMockSubscriptionNotification(t, testCase.body)
+			if body.EventType == constants.SubscriptionEventPullRequestCreated || body.EventType == constants.SubscriptionEventPullRequestUpdated {
+				mockedStore.EXPECT().AddPullRequestReviewers("mockRepositoryID", 1, gomock.Any()).Return(testCase.addedReviewerIDs, testCase.wasTracked, nil)
+			}
+
+			notifications := p.getPersonalNotifications(body)
+
+			require.Len(t, notifications, len(testCase.expectedKinds))
+			for i, notification := range notifications {
+				assert.Equal(t, testCase.expectedKinds[i], notification.kind)
+				var identityIDs []string
+				for _, identity := range notification.identities {
+					identityIDs = append(identityIDs, identity.ID)
+				}
+				assert.Equal(t, testCase.expectedIdentityIDs[i], identityIDs)
+			}
+		})
+	}
+}
+
+func TestSendPersonalNotifications(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description       string
+		mattermostUserID  string
+		settings          *serializers.PersonalNotificationSettings
+		isNotSent         bool
+		expectedDMMessage string
+	}{
+		{
+			description: "SendPersonalNotifications: mentioned identity is not connected",
+		},
+		{
+			description:      "SendPersonalNotifications: mentions are turned off",
+			mattermostUserID: testutils.MockMattermostUserID,
+			settings:         &serializers.PersonalNotificationSettings{Reviews: true},
+		},
+		{
+			description:      "SendPersonalNotifications: notification is already sent for another subscription",
+			mattermostUserID: testutils.MockMattermostUserID,
+			settings:         &serializers.PersonalNotificationSettings{Mentions: true},
+		},
+		{
+			description:       "SendPersonalNotifications: notification is sent",
+			mattermostUserID:  testutils.MockMattermostUserID,
+			settings:          &serializers.PersonalNotificationSettings{Mentions: true},
+			isNotSent:         true,
+			expectedDMMessage: "You are mentioned in a comment.\nmockDetailedMarkdown",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetMattermostUserIDForAzureIdentity", func(_ *Plugin, identity *serializers.UserID) string {
+				assert.Equal(t, mockIdentityID1, identity.ID)
+				return testCase.mattermostUserID
+			})
+			if testCase.settings != nil {
+				mockedStore.EXPECT().GetPersonalNotificationSettings(testutils.MockMattermostUserID).Return(testCase.settings, nil)
+			}
+
+			if testCase.settings != nil && testCase.settings.Mentions {
+				mockedStore.EXPECT().MarkPersonalNotificationSent("mockEventID", constants.PersonalNotificationMentions, testutils.MockMattermostUserID).Return(testCase.isNotSent, nil)
+			}
+
+			var dmMessage string
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "DM", func(_ *Plugin, mattermostUserID, format string, _ bool, args ...interface{}) (string, error) {
+				assert.Equal(t, testutils.MockMattermostUserID, mattermostUserID)
+				dmMessage = fmt.Sprintf(format, args...)
+				return "mockPostID", nil
+			})
+
+			p.SendPersonalNotifications(testutils.ParseMockSubscriptionNotification(t, `{"id": "mockEventID", "eventType": "ms.vss-code.git-pullrequest-comment-event", "detailedMessage": {"markdown": "mockDetailedMarkdown"}, "message": {"markdown": "mockMarkdown"}, "resource": {"comment": {"content": "@<`+mockIdentityID1+`>"}}}`))
+
+			assert.Equal(t, testCase.expectedDMMessage, dmMessage)
+		})
+	}
+}
+
+func TestAzureDevopsNotificationsCommand(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description      string
+		command          string
+		settings         *serializers.PersonalNotificationSettings
+		expectedSettings *serializers.PersonalNotificationSettings
+		expectedMessage  string
+	}{
+		{
+			description:     "NotificationsCommand: view the settings",
+			command:         "/azuredevops notifications",
+			settings:        &serializers.PersonalNotificationSettings{Reviews: true},
+			expectedMessage: fmt.Sprintf(constants.PersonalNotificationsStatus, "on", "off", "off"),
+		},
+		{
+			description:      "NotificationsCommand: turn on all the notifications",
+			command:          "/azuredevops notifications on",
+			settings:         &serializers.PersonalNotificationSettings{},
+			expectedSettings: &serializers.PersonalNotificationSettings{Reviews: true, Mentions: true, Assignments: true},
+			expectedMessage:  "Personal notifications for all are turned on.",
+		},
+		{
+			description:      "NotificationsCommand: turn off mentions",
+			command:          "/azuredevops notifications off Mentions",
+			settings:         &serializers.PersonalNotificationSettings{Reviews: true, Mentions: true},
+			expectedSettings: &serializers.PersonalNotificationSettings{Reviews: true},
+			expectedMessage:  "Personal notifications for mentions are turned off.",
+		},
+		{
+			description:     "NotificationsCommand: invalid kind",
+			command:         "/azuredevops notifications on builds",
+			settings:        &serializers.PersonalNotificationSettings{},
+			expectedMessage: `invalid notification kind "builds", it must be one of reviews, mentions, assignments or all`,
+		},
+		{
+			description:     "NotificationsCommand: invalid state",
+			command:         "/azuredevops notifications enable",
+			expectedMessage: constants.InvalidCommand + constants.HelpText,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+			mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				assert.Equal(t, testCase.expectedMessage, args.Get(1).(*model.Post).Message)
+			}).Once().Return(&model.Post{})
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return true
+			})
+
+			if testCase.settings != nil {
+				mockedStore.EXPECT().GetPersonalNotificationSettings(testutils.MockMattermostUserID).Return(testCase.settings, nil)
+			}
+
+			if testCase.expectedSettings != nil {
+				mockedStore.EXPECT().StorePersonalNotificationSettings(testutils.MockMattermostUserID, testCase.expectedSettings).Return(nil)
+			}
+
+			res, err := p.ExecuteCommand(&plugin.Context{}, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID, ChannelId: testutils.MockChannelID})
+			assert.Nil(t, err)
+			assert.NotNil(t, res)
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// startPipelineApprovalJob syncs the posts of the pending approvals of run stages and reminds their pending approvers periodically on one server of the cluster at a time.
+// The reminders are still marked as sent, so that they are not sent again if a run is repeated.
+func (p *Plugin) startPipelineApprovalJob() error {
+	job, err := p.scheduleClusterJob(constants.PipelineApprovalJobKey, constants.PipelineApprovalJobInterval, func() {
+		p.RunPipelineApprovals(time.Now())
+	})
+	if err != nil {
+		return err
+	}
+
+	p.pipelineApprovalJob = job
+	return nil
+}
+
+func (p *Plugin) stopPipelineApprovalJob() {
+	if p.pipelineApprovalJob != nil {
+		if err := p.pipelineApprovalJob.Close(); err != nil {
+			p.API.LogError(constants.ErrorStopPipelineApprovalJob, "Error", err.Error())
+		}
+		p.pipelineApprovalJob = nil
+	}
+}
+
+// NewPipelineApproval returns the approval of the notification of a run stage waiting for approval, to be tracked once the post of the notification is created.
+// The approval is fetched using the Azure DevOps account of the user who added the subscription of the notification.
+// It expires after the timeout of its approval check counted from when it was created, nil is returned if it can not be tracked.
+func (p *Plugin) NewPipelineApproval(body *serializers.SubscriptionNotification, channelID string, now time.Time) *serializers.PipelineApproval {
+	approvalID, ok := body.Resource.Approval.ID.(string)
+	if !ok || approvalID == "" {
+		return nil
+	}
+
+	subscription, err := p.getSubscriptionDetails(body.SubscriptionID)
+	if err != nil {
+		p.API.LogError(constants.FetchSubscriptionListError, "Error", err.Error())
+		return nil
+	}
+
+	if subscription == nil {
+		return nil
+	}
+
+	createdAt := body.Resource.Approval.GetCreatedOn()
+	if createdAt.IsZero() {
+		createdAt = now
+	}
+
+	return &serializers.PipelineApproval{
+		ApprovalID:       approvalID,
+		OrganizationName: subscription.OrganizationName,
+		ProjectID:        body.Resource.ProjectID,
+		ChannelID:        channelID,
+		MattermostUserID: subscription.MattermostUserID,
+		PipelineName:     body.Resource.Pipeline.Name,
+		PipelineURL:      body.Resource.Pipeline.Links.Web.Href,
+		StageName:        body.Resource.Stage.Name,
+		StageURL:         body.Resource.Stage.Links.Web.Href,
+		CreatedAt:        createdAt,
+		ExpiresAt:        createdAt.Add(p.getPipelineApprovalTimeout(body, subscription)),
+	}
+}
+
+// TrackPipelineApproval tracks an approval with the post of its notification to sync the post and remind its approvers
+func (p *Plugin) TrackPipelineApproval(approval *serializers.PipelineApproval, postID string) {
+	approval.PostID = postID
+	if err := p.Store.StorePipelineApproval(approval); err != nil {
+		p.API.LogError(constants.ErrorTrackPipelineApproval, "ApprovalID", approval.ApprovalID, "Error", err.Error())
+	}
+}
+
+// getPipelineApprovalTimeout returns the timeout of the approval check of the approval of a notification.
+// The approval timeout setting is used if the configuration of the check can not be fetched or has no timeout.
+func (p *Plugin) getPipelineApprovalTimeout(body *serializers.SubscriptionNotification, subscription *serializers.SubscriptionDetails) time.Duration {
+	timeout := p.getConfiguration().GetApprovalTimeout()
+	checkConfigurationID := body.Resource.Approval.CheckConfiguration.ID
+	if checkConfigurationID == 0 {
+		return timeout
+	}
+
+	checkConfiguration, _, err := p.Client.GetCheckConfiguration(subscription.OrganizationName, body.Resource.ProjectID, checkConfigurationID, subscription.MattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorGetApprovalCheckConfiguration, "CheckConfigurationID", strconv.Itoa(checkConfigurationID), "Error", err.Error())
+		return timeout
+	}
+
+	if checkConfiguration.GetTimeout() > 0 {
+		return checkConfiguration.GetTimeout()
+	}
+
+	return timeout
+}
+
+// RunPipelineApprovals syncs the posts of the tracked approvals with Azure DevOps and reminds the pending approvers of the approvals whose reminders are due
+func (p *Plugin) RunPipelineApprovals(now time.Time) {
+	approvals, err := p.Store.GetPipelineApprovals()
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadPipelineApprovals, "Error", err.Error())
+		return
+	}
+
+	interval := p.getConfiguration().GetApprovalReminderInterval()
+	for _, approval := range approvals {
+		approvalDetails, err := p.SyncPipelineApproval(approval, now)
+		if err != nil {
+			p.API.LogError(constants.ErrorSyncPipelineApproval, "ApprovalID", approval.ApprovalID, "Error", err.Error())
+			continue
+		}
+
+		if approvalDetails == nil || approvalDetails.Status != constants.ApprovalStatusPending || !now.Before(approval.ExpiresAt) {
+			continue
+		}
+
+		reminder := approval.GetDueReminder(now, interval)
+		if reminder == 0 {
+			continue
+		}
+
+		isNotSent, err := p.Store.MarkApprovalReminderSent(approval.ApprovalID, reminder)
+		if err != nil {
+			p.API.LogError(constants.ErrorSendApprovalReminder, "ApprovalID", approval.ApprovalID, "Error", err.Error())
+			continue
+		}
+
+		if !isNotSent {
+			continue
+		}
+
+		p.SendApprovalReminder(approval, approvalDetails, now)
+	}
+}
+
+// SyncPipelineApprovalPosts syncs the posts of an approval with Azure DevOps e.g. once it is completed from Azure DevOps
+func (p *Plugin) SyncPipelineApprovalPosts(approvalID string, now time.Time) {
+	approvals, err := p.Store.GetPipelineApprovals()
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadPipelineApprovals, "Error", err.Error())
+		return
+	}
+
+	for _, approval := range approvals {
+		if approval.ApprovalID != approvalID {
+			continue
+		}
+
+		if _, err := p.SyncPipelineApproval(approval, now); err != nil {
+			p.API.LogError(constants.ErrorSyncPipelineApproval, "ApprovalID", approvalID, "Error", err.Error())
+		}
+	}
+}
+
+// SyncPipelineApproval updates the approvers, actions and expiry of the post of an approval and of its approval cards with its status on Azure DevOps.
+// The approval is no longer tracked once it is completed, deleted or expired for longer than the grace period, nil is returned if it is not found.
+func (p *Plugin) SyncPipelineApproval(approval *serializers.PipelineApproval, now time.Time) (*serializers.PipelineRunApprovalDetails, error) {
+	approvalDetails, statusCode, err := p.Client.GetRunApprovalDetails(approval.OrganizationName, approval.ProjectID, approval.MattermostUserID, approval.ApprovalID)
+	if err != nil {
+		if statusCode == http.StatusNotFound {
+			return nil, p.Store.DeletePipelineApproval(approval.PostID)
+		}
+
+		return nil, err
+	}
+
+	update := func(slackAttachment *model.SlackAttachment) {
+		p.setPipelineRunApprovalStatus(slackAttachment, approvalDetails.ApprovalSteps, approvalDetails.MinRequiredApprovers, approvalDetails.Status)
+		if approvalDetails.Status == constants.ApprovalStatusPending {
+			setPipelineRunApprovalExpiry(slackAttachment, getPipelineRunApprovalExpiry(approval.ExpiresAt, now))
+		}
+	}
+
+	// The posts are only updated if they are changed as the countdown to the expiry changes rarely
+	if err := p.updateApprovalPost(approval.PostID, update); err != nil {
+		var appErr *model.AppError
+		if errors.As(err, &appErr) && appErr.StatusCode == http.StatusNotFound {
+			return nil, p.Store.DeletePipelineApproval(approval.PostID)
+		}
+
+		return nil, err
+	}
+
+	p.UpdateApprovalPosts(constants.PipelineRequestNameRun, approval.OrganizationName, approval.ApprovalID, approval.PostID, update)
+
+	if approvalDetails.Status != constants.ApprovalStatusPending || now.After(approval.ExpiresAt.Add(constants.PipelineApprovalTrackingGracePeriod)) {
+		if err := p.Store.DeletePipelineApproval(approval.PostID); err != nil {
+			return nil, err
+		}
+	}
+
+	return approvalDetails, nil
+}
+
+// SendApprovalReminder sends a direct message to each pending approver of an approval who is connected to Mattermost, linking to the post of the approval
+func (p *Plugin) SendApprovalReminder(approval *serializers.PipelineApproval, approvalDetails *serializers.PipelineRunApprovalDetails, now time.Time) {
+	var permalink string
+	for _, approver := range approvalDetails.GetPendingApprovers() {
+		mattermostUserID := p.GetMattermostUserIDForAzureIdentity(approver.GetIdentity())
+		if mattermostUserID == "" {
+			continue
+		}
+
+		if permalink == "" {
+			var err error
+			if permalink, err = p.getPostPermalink(mattermostUserID, &model.Post{Id: approval.PostID, ChannelId: approval.ChannelID}); err != nil {
+				p.API.LogError(constants.ErrorSendApprovalReminder, "ApprovalID", approval.ApprovalID, "Error", err.Error())
+				return
+			}
+		}
+
+		if _, err := p.DM(mattermostUserID, constants.ApprovalReminder, false,
+			fmt.Sprintf("[%s](%s)", approval.StageName, approval.StageURL),
+			fmt.Sprintf("[%s](%s)", approval.PipelineName, approval.PipelineURL),
+			formatDuration(approval.ExpiresAt.Sub(now)),
+			permalink,
+		); err != nil {
+			p.API.LogError(constants.ErrorSendApprovalReminder, "ApprovalID", approval.ApprovalID, "Error", err.Error())
+		}
+	}
+}
+
+// getPipelineRunApprovalExpiry returns the countdown to the expiry of a pending approval
+func getPipelineRunApprovalExpiry(expiresAt, now time.Time) string {
+	if !now.Before(expiresAt) {
+		return constants.ApprovalExpired
+	}
+
+	return fmt.Sprintf(constants.ApprovalExpiresIn, formatDuration(expiresAt.Sub(now)))
+}
@@ -0,0 +1,422 @@
+package plugin
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/config"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestNewPipelineApproval(t *testing.T) {
+	now := testutils.MockApprovalCreatedAt.Add(time.Hour)
+	for _, testCase := range []struct {
+		description           string
+		createdOn             string
+		checkConfigurationID  int
+		checkConfiguration    *serializers.CheckConfiguration
+		checkConfigurationErr error
+		expectedCreatedAt     time.Time
+		expectedExpiresAt     time.Time
+	}{
+		{
+			description:          "NewPipelineApproval: approval expires after the timeout of its check",
+			createdOn:            "2022-01-10T04:05:00Z",
+			checkConfigurationID: 1,
+			checkConfiguration:   &serializers.CheckConfiguration{ID: 1, Timeout: 72 * 60},
+			expectedCreatedAt:    testutils.MockApprovalCreatedAt,
+			expectedExpiresAt:    testutils.MockApprovalCreatedAt.Add(72 * time.Hour),
+		},
+		{
+			description:           "NewPipelineApproval: timeout setting is used if the check configuration is not fetched",
+			createdOn:             "2022-01-10T04:05:00Z",
+			checkConfigurationID:  1,
+			checkConfigurationErr: errors.New("error getting the check configuration"),
+			expectedCreatedAt:     testutils.MockApprovalCreatedAt,
+			expectedExpiresAt:     testutils.MockApprovalCreatedAt.Add(48 * time.Hour),
+		},
+		{
+			description:       "NewPipelineApproval: timeout setting is used if the approval has no check configuration",
+			createdOn:         "2022-01-10T04:05:00Z",
+			expectedCreatedAt: testutils.MockApprovalCreatedAt,
+			expectedExpiresAt: testutils.MockApprovalCreatedAt.Add(48 * time.Hour),
+		},
+		{
+			description:       "NewPipelineApproval: approval without the time of its creation is counted from the notification",
+			expectedCreatedAt: now,
+			expectedExpiresAt: now.Add(48 * time.Hour),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, mockedClient)
+			p.setConfiguration(&config.Configuration{ApprovalTimeout: "48"})
+
+			body := testutils.GetMockSubscriptionNotification(constants.SubscriptionEventRunStageWaitingForApproval)
+			body.Resource.ProjectID = testutils.MockProjectID
+			body.Resource.Approval = serializers.Approval{
+				ID:                 "mockApprovalID",
+				CreatedOn:          testCase.createdOn,
+				CheckConfiguration: serializers.CheckConfiguration{ID: testCase.checkConfigurationID},
+			}
+
+			mockedStore.EXPECT().GetAllSubscriptions("").Return(testutils.GetSuscriptionDetailsPayload(testutils.MockMattermostUserID, constants.CommandPipelines, constants.SubscriptionEventRunStageWaitingForApproval), nil)
+			if testCase.checkConfigurationID != 0 {
+				mockedClient.EXPECT().GetCheckConfiguration(testutils.MockOrganization, testutils.MockProjectID, testCase.checkConfigurationID, testutils.MockMattermostUserID).Return(testCase.checkConfiguration, http.StatusOK, testCase.checkConfigurationErr)
+			}
+
+			if testCase.checkConfigurationErr != nil {
+				mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 5)...)
+			}
+
+			approval := p.NewPipelineApproval(body, testutils.MockChannelID, now)
+
+			assert.Equal(t, "mockApprovalID", approval.ApprovalID)
+			assert.Equal(t, testutils.MockChannelID, approval.ChannelID)
+			assert.Equal(t, testCase.expectedCreatedAt, approval.CreatedAt)
+			assert.Equal(t, testCase.expectedExpiresAt, approval.ExpiresAt)
+		})
+	}
+}
+
+func TestTrackPipelineApproval(t *testing.T) {
+	for _, testCase := range []struct {
+		description string
+		err         error
+	}{
+		{
+			description: "TrackPipelineApproval: approval is tracked with its post",
+		},
+		{
+			description: "TrackPipelineApproval: approval is not stored",
+			err:         errors.New("error storing the approval"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+			mockedStore.EXPECT().StorePipelineApproval(gomock.Any()).DoAndReturn(func(approval *serializers.PipelineApproval) error {
+				assert.Equal(t, "mockPostID", approval.PostID)
+				return testCase.err
+			})
+			if testCase.err != nil {
+				mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 5)...)
+			}
+
+			approval := testutils.GetMockPipelineApproval()
+			approval.PostID = ""
+			p.TrackPipelineApproval(approval, "mockPostID")
+
+			mockAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRunPipelineApprovals(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description      string
+		now              time.Time
+		status           string
+		isNotFound       bool
+		expectedReminder int
+		isNotSent        bool
+		expectSend       bool
+	}{
+		{
+			description:      "RunPipelineApprovals: reminder is due",
+			now:              testutils.MockApprovalCreatedAt.Add(25 * time.Hour),
+			status:           constants.ApprovalStatusPending,
+			expectedReminder: 2,
+			isNotSent:        true,
+			expectSend:       true,
+		},
+		{
+			description:      "RunPipelineApprovals: reminder is already sent for another post of the approval",
+			now:              testutils.MockApprovalCreatedAt.Add(25 * time.Hour),
+			status:           constants.ApprovalStatusPending,
+			expectedReminder: 2,
+		},
+		{
+			description: "RunPipelineApprovals: reminder is not due before the interval",
+			now:         testutils.MockApprovalCreatedAt.Add(11 * time.Hour),
+			status:      constants.ApprovalStatusPending,
+		},
+		{
+			description: "RunPipelineApprovals: approval is completed",
+			now:         testutils.MockApprovalCreatedAt.Add(25 * time.Hour),
+			status:      "approved",
+		},
+		{
+			description: "RunPipelineApprovals: approval is expired",
+			now:         testutils.MockApprovalCreatedAt.Add(73 * time.Hour),
+			status:      constants.ApprovalStatusPending,
+		},
+		{
+			description: "RunPipelineApprovals: approval is not found",
+			now:         testutils.MockApprovalCreatedAt.Add(25 * time.Hour),
+			isNotFound:  true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+			p.setConfiguration(&config.Configuration{ApprovalReminderInterval: "12"})
+
+			mockedStore.EXPECT().GetPipelineApprovals().Return([]*serializers.PipelineApproval{testutils.GetMockPipelineApproval()}, nil)
+			if testCase.expectedReminder != 0 {
+				mockedStore.EXPECT().MarkApprovalReminderSent("mockApprovalID", testCase.expectedReminder).Return(testCase.isNotSent, nil)
+			}
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "SyncPipelineApproval", func(_ *Plugin, approval *serializers.PipelineApproval, _ time.Time) (*serializers.PipelineRunApprovalDetails, error) {
+				if testCase.isNotFound {
+					return nil, nil
+				}
+
+				return &serializers.PipelineRunApprovalDetails{ID: approval.ApprovalID, Status: testCase.status}, nil
+			})
+
+			isSent := false
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "SendApprovalReminder", func(_ *Plugin, approval *serializers.PipelineApproval, _ *serializers.PipelineRunApprovalDetails, _ time.Time) {
+				assert.Equal(t, "mockApprovalID", approval.ApprovalID)
+				isSent = true
+			})
+
+			p.RunPipelineApprovals(testCase.now)
+
+			assert.Equal(t, testCase.expectSend, isSent)
+		})
+	}
+}
+
+func TestSyncPipelineApproval(t *testing.T) {
+	for _, testCase := range []struct {
+		description       string
+		now               time.Time
+		approvalDetails   *serializers.PipelineRunApprovalDetails
+		statusCode        int
+		err               error
+		expectedFields    []*model.SlackAttachmentField
+		expectedActions   bool
+		expectUpdate      bool
+		expectDelete      bool
+		expectedErrorText string
+	}{
+		{
+			description: "SyncPipelineApproval: countdown to the expiry is updated",
+			now:         testutils.MockApprovalCreatedAt.Add(50 * time.Hour),
+			approvalDetails: &serializers.PipelineRunApprovalDetails{
+				Status: constants.ApprovalStatusPending,
+				ApprovalSteps: []*serializers.ApprovalStep{
+					{AssignedApprover: serializers.Approver{DisplayName: "mockApprover"}, Status: constants.ApprovalStatusPending},
+					{AssignedApprover: serializers.Approver{DisplayName: "mockOtherApprover"}, Status: constants.ApprovalStatusPending},
+				},
+				MinRequiredApprovers: 1,
+			},
+			expectedFields: []*model.SlackAttachmentField{
+				{Title: "Run pipeline", Value: "[mockPipeline](mockPipelineURL)", Short: true},
+				{Title: "Stage", Value: "[mockStage](mockStageURL)", Short: true},
+				{Title: "Approver(s)", Value: "mockApprover\nmockOtherApprover\n"},
+				{Title: constants.ApprovalExpiresFieldTitle, Value: "in 22 hour(s)", Short: true},
+			},
+			expectedActions: true,
+			expectUpdate:    true,
+		},
+		{
+			description: "SyncPipelineApproval: reassigned approval is updated",
+			now:         testutils.MockApprovalCreatedAt.Add(time.Hour),
+			approvalDetails: &serializers.PipelineRunApprovalDetails{
+				Status: constants.ApprovalStatusPending,
+				ApprovalSteps: []*serializers.ApprovalStep{
+					{AssignedApprover: serializers.Approver{DisplayName: "mockApprover"}, Status: constants.ApprovalStatusPending},
+					{AssignedApprover: serializers.Approver{DisplayName: "mockNewApprover"}, Status: constants.ApprovalStatusPending},
+				},
+				MinRequiredApprovers: 1,
+			},
+			expectedFields: []*model.SlackAttachmentField{
+				{Title: "Run pipeline", Value: "[mockPipeline](mockPipelineURL)", Short: true},
+				{Title: "Stage", Value: "[mockStage](mockStageURL)", Short: true},
+				{Title: "Approver(s)", Value: "mockApprover\nmockNewApprover\n"},
+				{Title: constants.ApprovalExpiresFieldTitle, Value: "in 2 day(s)", Short: true},
+			},
+			expectedActions: true,
+			expectUpdate:    true,
+		},
+		{
+			description: "SyncPipelineApproval: unchanged post is not updated",
+			now:         testutils.MockApprovalCreatedAt.Add(time.Minute),
+			approvalDetails: &serializers.PipelineRunApprovalDetails{
+				Status: constants.ApprovalStatusPending,
+				ApprovalSteps: []*serializers.ApprovalStep{
+					{AssignedApprover: serializers.Approver{DisplayName: "mockApprover"}, Status: constants.ApprovalStatusPending},
+					{AssignedApprover: serializers.Approver{DisplayName: "mockOtherApprover"}, Status: constants.ApprovalStatusPending},
+				},
+				MinRequiredApprovers: 1,
+			},
+		},
+		{
+			description: "SyncPipelineApproval: approval completed from Azure DevOps is updated and no longer tracked",
+			now:         testutils.MockApprovalCreatedAt.Add(time.Hour),
+			approvalDetails: &serializers.PipelineRunApprovalDetails{
+				Status: "approved",
+				ApprovalSteps: []*serializers.ApprovalStep{
+					{AssignedApprover: serializers.Approver{DisplayName: "mockApprover"}, Status: "approved"},
+					{AssignedApprover: serializers.Approver{DisplayName: "mockOtherApprover"}, Status: constants.ApprovalStatusPending},
+				},
+				MinRequiredApprovers: 1,
+			},
+			expectedFields: []*model.SlackAttachmentField{
+				{Title: "Run pipeline", Value: "[mockPipeline](mockPipelineURL)", Short: true},
+				{Title: "Stage", Value: "[mockStage](mockStageURL)", Short: true},
+				{Title: "Approver(s)", Value: "&#9989; mockApprover \nmockOtherApprover\n"},
+			},
+			expectUpdate: true,
+			expectDelete: true,
+		},
+		{
+			description: "SyncPipelineApproval: timed out approval is shown as expired",
+			now:         testutils.MockApprovalCreatedAt.Add(73 * time.Hour),
+			approvalDetails: &serializers.PipelineRunApprovalDetails{
+				Status: constants.ApprovalStatusTimedOut,
+				ApprovalSteps: []*serializers.ApprovalStep{
+					{AssignedApprover: serializers.Approver{DisplayName: "mockApprover"}, Status: constants.ApprovalStatusTimedOut},
+					{AssignedApprover: serializers.Approver{DisplayName: "mockOtherApprover"}, Status: constants.ApprovalStatusTimedOut},
+				},
+				MinRequiredApprovers: 1,
+			},
+			expectedFields: []*model.SlackAttachmentField{
+				{Title: "Run pipeline", Value: "[mockPipeline](mockPipelineURL)", Short: true},
+				{Title: "Stage", Value: "[mockStage](mockStageURL)", Short: true},
+				{Title: "Approver(s)", Value: "&#8987; mockApprover \n&#8987; mockOtherApprover \n"},
+				{Title: constants.ApprovalExpiresFieldTitle, Value: constants.ApprovalExpired, Short: true},
+			},
+			expectUpdate: true,
+			expectDelete: true,
+		},
+		{
+			description:  "SyncPipelineApproval: deleted approval is no longer tracked",
+			now:          testutils.MockApprovalCreatedAt.Add(time.Hour),
+			statusCode:   http.StatusNotFound,
+			err:          errors.New("mockError"),
+			expectDelete: true,
+		},
+		{
+			description:       "SyncPipelineApproval: approval can not be fetched",
+			now:               testutils.MockApprovalCreatedAt.Add(time.Hour),
+			statusCode:        http.StatusInternalServerError,
+			err:               errors.New("mockError"),
+			expectedErrorText: "mockError",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, mockedClient)
+
+			mockedClient.EXPECT().GetRunApprovalDetails(testutils.MockOrganization, testutils.MockProjectID, testutils.MockMattermostUserID, "mockApprovalID").Return(testCase.approvalDetails, testCase.statusCode, testCase.err)
+			if testCase.err == nil {
+				mockAPI.On("GetPost", "mockPostID").Return(testutils.GetMockPipelineApprovalPost(), nil)
+				mockAPI.On("GetPost", "mockCardPostID").Return(testutils.GetMockPipelineApprovalPost(), nil)
+				mockedStore.EXPECT().GetApprovalPosts(constants.PipelineRequestNameRun, testutils.MockOrganization, "mockApprovalID").Return([]string{"mockPostID", "mockCardPostID"}, nil)
+			}
+
+			if testCase.expectUpdate {
+				// The post of the approval and its approval card are updated
+				mockAPI.On("UpdatePost", mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+					attachment := args.Get(0).(*model.Post).Attachments()[0]
+					assert.Equal(t, testCase.expectedFields, attachment.Fields)
+					assert.Equal(t, testCase.expectedActions, len(attachment.Actions) > 0)
+				}).Twice().Return(&model.Post{}, nil)
+			}
+
+			if testCase.expectDelete {
+				mockedStore.EXPECT().DeletePipelineApproval("mockPostID").Return(nil)
+			}
+
+			approvalDetails, err := p.SyncPipelineApproval(testutils.GetMockPipelineApproval(), testCase.now)
+
+			if testCase.expectedErrorText != "" {
+				assert.EqualError(t, err, testCase.expectedErrorText)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.approvalDetails, approvalDetails)
+			mockAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSendApprovalReminder(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description       string
+		executionOrder    string
+		expectedReminders []string
+	}{
+		{
+			description:       "SendApprovalReminder: pending approvers in any order are reminded",
+			expectedReminders: []string{"mockFirstUserID", "mockSecondUserID"},
+		},
+		{
+			description:       "SendApprovalReminder: only the next pending approver in sequence is reminded",
+			executionOrder:    constants.ApprovalExecutionOrderSequence,
+			expectedReminders: []string{"mockFirstUserID"},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			p := setupMockPlugin(mockAPI, nil, nil)
+			p.setConfiguration(&config.Configuration{MattermostSiteURL: "https://mattermost.example.com"})
+
+			mockAPI.On("GetChannel", testutils.MockChannelID).Return(&model.Channel{TeamId: testutils.MockTeamID}, nil)
+			mockAPI.On("GetTeam", testutils.MockTeamID).Return(&model.Team{Name: "mockTeam"}, nil)
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetMattermostUserIDForAzureIdentity", func(_ *Plugin, identity *serializers.UserID) string {
+				return map[string]string{"mockFirstApprover": "mockFirstUserID", "mockSecondApprover": "mockSecondUserID"}[identity.ID]
+			})
+
+			var reminders []string
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "DM", func(_ *Plugin, mattermostUserID, format string, _ bool, args ...interface{}) (string, error) {
+				assert.Equal(t, constants.ApprovalReminder, format)
+				assert.Equal(t, []interface{}{"[mockStage](mockStageURL)", "[mockPipeline](mockPipelineURL)", "2 day(s)", "https://mattermost.example.com/mockTeam/pl/mockPostID"}, args)
+				reminders = append(reminders, mattermostUserID)
+				return "", nil
+			})
+
+			p.SendApprovalReminder(testutils.GetMockPipelineApproval(), &serializers.PipelineRunApprovalDetails{
+				Status:         constants.ApprovalStatusPending,
+				ExecutionOrder: testCase.executionOrder,
+				ApprovalSteps: []*serializers.ApprovalStep{
+					{AssignedApprover: serializers.Approver{ID: "mockApprovedApprover"}, Status: "approved", Order: 1},
+					{AssignedApprover: serializers.Approver{ID: "mockSecondApprover"}, Status: constants.ApprovalStatusPending, Order: 3},
+					{AssignedApprover: serializers.Approver{ID: "mockFirstApprover"}, Status: constants.ApprovalStatusPending, Order: 2},
+				},
+			}, testutils.MockApprovalCreatedAt.Add(24*time.Hour))
+
+			assert.Equal(t, testCase.expectedReminders, reminders)
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// GetPipelineToRun returns the pipeline of a project with the given name or ID along with the runtime parameters declared in its YAML
+func (p *Plugin) GetPipelineToRun(mattermostUserID, organization, projectName, pipelineNameOrID string) (*serializers.Pipeline, []*serializers.PipelineParameter, int, error) {
+	pipelineList, statusCode, err := p.Client.GetPipelines(organization, projectName, mattermostUserID)
+	if err != nil {
+		return nil, nil, statusCode, err
+	}
+
+	pipelineID, _ := strconv.Atoi(pipelineNameOrID)
+	var pipeline *serializers.Pipeline
+	for _, value := range pipelineList.Value {
+		if value.ID == pipelineID || strings.EqualFold(value.Name, pipelineNameOrID) {
+			pipeline = value
+			break
+		}
+	}
+
+	if pipeline == nil {
+		return nil, nil, http.StatusBadRequest, fmt.Errorf(constants.PipelineNotFound, pipelineNameOrID, projectName)
+	}
+
+	preview, statusCode, err := p.Client.PreviewPipelineRun(organization, projectName, pipeline.ID, mattermostUserID)
+	if err != nil {
+		return nil, nil, statusCode, err
+	}
+
+	// The pipeline can still be run with variables if its parameters can not be parsed
+	parameters, err := serializers.ParsePipelineParameters(preview.FinalYAML)
+	if err != nil {
+		p.API.LogError(constants.ErrorParsePipelineParameters, "PipelineID", strconv.Itoa(pipeline.ID), "Error", err.Error())
+	}
+
+	return pipeline, parameters, http.StatusOK, nil
+}
+
+// OpenRunPipelineDialog opens the dialog to confirm the branch, runtime parameters and variables of a pipeline run before queuing it.
+// The values given in the arguments are used for the runtime parameters of the same name and as variables otherwise.
+func (p *Plugin) OpenRunPipelineDialog(mattermostUserID, triggerID, organization, projectName string, pipeline *serializers.Pipeline, parameters []*serializers.PipelineParameter, branch string, arguments map[string]string) (int, error) {
+	requestBody := model.OpenDialogRequest{
+		TriggerId: triggerID,
+		URL:       fmt.Sprintf("%s%s", p.GetPluginURL(), constants.PathRunPipelineDialog),
+		Dialog: model.Dialog{
+			Title:            "Run Pipeline",
+			IntroductionText: fmt.Sprintf("Run the pipeline **%s** of the project %s.", pipeline.Name, projectName),
+			SubmitLabel:      "Run",
+			Elements:         getRunPipelineDialogElements(parameters, branch, arguments),
+			State:            fmt.Sprintf("%s$%s$%d", organization, projectName, pipeline.ID),
+		},
+	}
+
+	return p.Client.OpenDialogRequest(&requestBody, mattermostUserID)
+}
+
+func getRunPipelineDialogElements(parameters []*serializers.PipelineParameter, branch string, arguments map[string]string) []model.DialogElement {
+	elements := []model.DialogElement{
+		{
+			DisplayName: "Branch",
+			Name:        constants.DialogFieldNameBranch,
+			Type:        "text",
+			Default:     branch,
+			Placeholder: "Defaults to the default branch of the pipeline",
+			Optional:    true,
+		},
+	}
+
+	isParameter := map[string]bool{}
+	for _, parameter := range parameters {
+		// Parameters of the other types e.g. object or step lists can not be set in a dialog and keep their defaults
+		if parameter.Type != "" && parameter.Type != constants.PipelineParameterTypeString && parameter.Type != constants.PipelineParameterTypeNumber && parameter.Type != constants.PipelineParameterTypeBoolean {
+			continue
+		}
+		isParameter[parameter.Name] = true
+
+		value, ok := arguments[parameter.Name]
+		if !ok {
+			value = parameter.GetDefault()
+		}
+
+		element := model.DialogElement{
+			DisplayName: parameter.GetDisplayName(),
+			Name:        constants.DialogFieldNamePipelineParameterPrefix + parameter.Name,
+			Type:        "text",
+			Default:     value,
+			Optional:    parameter.Default != nil,
+		}
+
+		switch {
+		case parameter.Type == constants.PipelineParameterTypeBoolean:
+			element.Type = "bool"
+			element.Placeholder = parameter.GetDisplayName()
+			element.Optional = true
+		case len(parameter.Values) > 0:
+			element.Type = "select"
+			for _, allowedValue := range parameter.GetValues() {
+				element.Options = append(element.Options, &model.PostActionOptions{Text: allowedValue, Value: allowedValue})
+			}
+		case parameter.Type == constants.PipelineParameterTypeNumber:
+			element.SubType = "number"
+		}
+
+		elements = append(elements, element)
+	}
+
+	var variables []string
+	for name, value := range arguments {
+		if !isParameter[name] {
+			variables = append(variables, fmt.Sprintf("%s=%s", name, value))
+		}
+	}
+	sort.Strings(variables)
+
+	return append(elements, model.DialogElement{
+		DisplayName: "Variables",
+		Name:        constants.DialogFieldNameVariables,
+		Type:        "textarea",
+		Default:     strings.Join(variables, "\n"),
+		Placeholder: "name=value, one per line",
+		HelpText:    "Only the variables which can be set at queue time are applied",
+		Optional:    true,
+	})
+}
+
+// RunPipelineFromDialog queues a run of a pipeline from the submission of the dialog opened by OpenRunPipelineDialog.
+// The errors of the fields of the dialog are returned instead if the variables are not valid.
+func (p *Plugin) RunPipelineFromDialog(mattermostUserID, state string, submission map[string]interface{}) (*serializers.PipelineRun, map[string]string, int, error) {
+	values := strings.Split(state, "$")
+	if len(values) != 3 {
+		return nil, nil, http.StatusBadRequest, errors.New(constants.GenericErrorMessage)
+	}
+
+	pipelineID, err := strconv.Atoi(values[2])
+	if err != nil {
+		return nil, nil, http.StatusBadRequest, errors.New(constants.GenericErrorMessage)
+	}
+
+	payload := &serializers.RunPipelineRequest{}
+	if branch, _ := submission[constants.DialogFieldNameBranch].(string); strings.TrimSpace(branch) != "" {
+		refName := strings.TrimSpace(branch)
+		if !strings.HasPrefix(refName, "refs/") {
+			refName = constants.RefNamePrefixBranch + refName
+		}
+		payload.Resources = &serializers.PipelineRunResources{
+			Repositories: map[string]*serializers.PipelineRepositoryResource{
+				constants.PipelineRepositorySelf: {RefName: refName},
+			},
+		}
+	}
+
+	for fieldName, value := range submission {
+		// Cleared parameters keep the defaults of the pipeline
+		if !strings.HasPrefix(fieldName, constants.DialogFieldNamePipelineParameterPrefix) || value == nil || value == "" {
+			continue
+		}
+
+		if payload.TemplateParameters == nil {
+			payload.TemplateParameters = map[string]string{}
+		}
+		payload.TemplateParameters[strings.TrimPrefix(fieldName, constants.DialogFieldNamePipelineParameterPrefix)] = fmt.Sprint(value)
+	}
+
+	variablesValue, _ := submission[constants.DialogFieldNameVariables].(string)
+	for _, line := range strings.Split(variablesValue, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		separatorIndex := strings.Index(line, "=")
+		if separatorIndex <= 0 || strings.TrimSpace(line[:separatorIndex]) == "" {
+			return nil, map[string]string{constants.DialogFieldNameVariables: fmt.Sprintf(constants.InvalidPipelineVariable, line)}, http.StatusBadRequest, nil
+		}
+
+		if payload.Variables == nil {
+			payload.Variables = map[string]*serializers.PipelineVariable{}
+		}
+		payload.Variables[strings.TrimSpace(line[:separatorIndex])] = &serializers.PipelineVariable{Value: strings.TrimSpace(line[separatorIndex+1:])}
+	}
+
+	pipelineRun, statusCode, err := p.Client.RunPipeline(values[0], values[1], pipelineID, payload, mattermostUserID)
+	if err != nil {
+		return nil, nil, statusCode, err
+	}
+
+	return pipelineRun, nil, statusCode, nil
+}
+
+// PostPipelineRun posts the link of a pipeline run queued by the user in a channel
+func (p *Plugin) PostPipelineRun(mattermostUserID, channelID string, pipelineRun *serializers.PipelineRun) error {
+	pipelineName := ""
+	if pipelineRun.Pipeline != nil {
+		pipelineName = pipelineRun.Pipeline.Name
+	}
+
+	branch := ""
+	if branchName := pipelineRun.GetBranchName(); branchName != "" {
+		branch = fmt.Sprintf(constants.PipelineRunBranch, branchName)
+	}
+
+	if _, appErr := p.API.CreatePost(&model.Post{
+		UserId:    mattermostUserID,
+		ChannelId: channelID,
+		Message:   fmt.Sprintf(constants.PipelineRunQueued, pipelineRun.Name, pipelineRun.Links.Web.Href, pipelineName, branch),
+	}); appErr != nil {
+		return appErr
+	}
+
+	return nil
+}
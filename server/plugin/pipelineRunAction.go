@@ -0,0 +1,265 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// getPipelineRunActions returns the button to cancel an in-progress run or the buttons to retry the failed jobs or rerun a stage of a failed or canceled run.
+// The stage of the notifications of stages is kept in the context of the actions and rerun directly, it is picked in a dialog otherwise.
+func (p *Plugin) getPipelineRunActions(organization, project string, buildID int, status, result, stageName string) []*model.PostAction {
+	if organization == "" || project == "" || buildID == 0 {
+		return nil
+	}
+
+	getIntegration := func(action string) *model.PostActionIntegration {
+		return &model.PostActionIntegration{
+			URL: fmt.Sprintf("%s%s", p.GetPluginURL(), constants.PathPipelineRunAction),
+			Context: map[string]interface{}{
+				constants.PipelineRunContextOrganization: organization,
+				constants.PipelineRunContextProject:      project,
+				constants.PipelineRunContextBuildID:      buildID,
+				constants.PipelineRunContextAction:       action,
+				constants.PipelineRunContextStage:        stageName,
+			},
+		}
+	}
+
+	switch {
+	case isPipelineRunInProgress(status):
+		return []*model.PostAction{
+			{
+				Id:          "cancelPipelineRun",
+				Type:        model.POST_ACTION_TYPE_BUTTON,
+				Name:        "Cancel",
+				Style:       "danger",
+				Integration: getIntegration(constants.PipelineRunActionCancel),
+			},
+		}
+	case isPipelineRunRetryable(status, result):
+		return []*model.PostAction{
+			{
+				Id:          "retryPipelineRun",
+				Type:        model.POST_ACTION_TYPE_BUTTON,
+				Name:        "Retry failed jobs",
+				Style:       "primary",
+				Integration: getIntegration(constants.PipelineRunActionRetry),
+			},
+			{
+				Id:          "rerunPipelineRunStage",
+				Type:        model.POST_ACTION_TYPE_BUTTON,
+				Name:        "Rerun stage",
+				Integration: getIntegration(constants.PipelineRunActionRerunStage),
+			},
+		}
+	}
+
+	return nil
+}
+
+func isPipelineRunInProgress(status string) bool {
+	return status == constants.BuildStatusInProgress || status == constants.BuildStatusNotStarted || status == constants.BuildStatusPostponed
+}
+
+func isPipelineRunRetryable(status, result string) bool {
+	return status == constants.BuildStatusCompleted && (result == constants.BuildResultFailed || result == constants.BuildResultCanceled || result == constants.BuildResultPartiallySucceeded)
+}
+
+// getBuildFromWebLink returns the organization, project and ID of the build of a web link like https://dev.azure.com/{organization}/{project}/_build/results?buildId={buildID}
+func getBuildFromWebLink(link string) (string, string, int) {
+	webLink, err := url.Parse(link)
+	if err != nil {
+		return "", "", 0
+	}
+
+	paths := strings.Split(strings.Trim(webLink.EscapedPath(), "/"), "/")
+	buildID, _ := strconv.Atoi(webLink.Query().Get(constants.BuildIDQueryParam))
+	if len(paths) < 2 || buildID == 0 {
+		return "", "", 0
+	}
+
+	return paths[0], paths[1], buildID
+}
+
+// UpdatePipelineRun cancels an in-progress run, retries the failed jobs of a failed or canceled run or reruns one of its stages as the user.
+// The build of the run is returned with its new status.
+func (p *Plugin) UpdatePipelineRun(mattermostUserID, organization, project string, buildID int, action, stageName string) (*serializers.BuildDetails, int, error) {
+	build, statusCode, err := p.Client.GetBuildDetails(organization, project, strconv.Itoa(buildID), mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	switch action {
+	case constants.PipelineRunActionCancel:
+		if !isPipelineRunInProgress(build.Status) {
+			return nil, http.StatusBadRequest, fmt.Errorf(constants.PipelineRunNotInProgress, build.BuildNumber)
+		}
+
+		return p.Client.CancelBuild(organization, project, buildID, mattermostUserID)
+	case constants.PipelineRunActionRetry:
+		if !isPipelineRunRetryable(build.Status, build.Result) {
+			return nil, http.StatusBadRequest, fmt.Errorf(constants.PipelineRunNotFailed, build.BuildNumber)
+		}
+
+		return p.Client.RetryBuild(organization, project, buildID, mattermostUserID)
+	case constants.PipelineRunActionRerunStage:
+		if stageName == "" {
+			return nil, http.StatusBadRequest, errors.New(constants.StageRequired)
+		}
+
+		if statusCode, err := p.Client.RetryBuildStage(organization, project, buildID, stageName, mattermostUserID); err != nil {
+			return nil, statusCode, err
+		}
+
+		// The stage update does not return the build so it is fetched again for its new status
+		return p.Client.GetBuildDetails(organization, project, strconv.Itoa(buildID), mattermostUserID)
+	}
+
+	return nil, http.StatusBadRequest, fmt.Errorf(constants.InvalidCommandArguments, action)
+}
+
+// GetRerunnableStages returns the failed or canceled stages of a build in the order of the pipeline
+func (p *Plugin) GetRerunnableStages(mattermostUserID, organization, project string, buildID int) ([]*serializers.TimelineRecord, int, error) {
+	timeline, statusCode, err := p.Client.GetBuildTimeline(organization, project, buildID, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	var stages []*serializers.TimelineRecord
+	for _, record := range timeline.Records {
+		if record.Type == constants.TimelineRecordTypeStage && isPipelineRunRetryable(record.State, record.Result) {
+			stages = append(stages, record)
+		}
+	}
+
+	sort.Slice(stages, func(i, j int) bool {
+		return stages[i].Order < stages[j].Order
+	})
+
+	return stages, http.StatusOK, nil
+}
+
+// OpenRerunStageDialog opens the dialog to pick the stage to rerun of a build whose post is about the whole run
+func (p *Plugin) OpenRerunStageDialog(mattermostUserID, triggerID, postID, organization, project string, buildID int, stages []*serializers.TimelineRecord) (int, error) {
+	options := make([]*model.PostActionOptions, 0, len(stages))
+	for _, stage := range stages {
+		options = append(options, &model.PostActionOptions{Text: fmt.Sprintf("%s (%s)", stage.Name, stage.Result), Value: stage.Identifier})
+	}
+
+	requestBody := model.OpenDialogRequest{
+		TriggerId: triggerID,
+		URL:       fmt.Sprintf("%s%s", p.GetPluginURL(), constants.PathRerunStageDialog),
+		Dialog: model.Dialog{
+			Title:       "Rerun Stage",
+			CallbackId:  postID,
+			SubmitLabel: "Rerun",
+			Elements: []model.DialogElement{
+				{
+					DisplayName: "Stage",
+					Name:        constants.DialogFieldNameStage,
+					Type:        "select",
+					Options:     options,
+					HelpText:    "All the jobs of the stage are run again",
+				},
+			},
+			State: fmt.Sprintf("%s$%s$%d", organization, project, buildID),
+		},
+	}
+
+	return p.Client.OpenDialogRequest(&requestBody, mattermostUserID)
+}
+
+// UpdatePipelineRunPost updates the status and the actions of the post of a build, run or stage after the run is updated
+func (p *Plugin) UpdatePipelineRunPost(postID, organization, project string, build *serializers.BuildDetails) error {
+	post, appErr := p.API.GetPost(postID)
+	if appErr != nil {
+		return appErr
+	}
+
+	attachments := post.Attachments()
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	slackAttachment := attachments[0]
+	// The other actions of the post like showing the log tail of a failed build are kept after the actions of the run
+	stageName := ""
+	var otherActions []*model.PostAction
+	for _, action := range slackAttachment.Actions {
+		if action.Integration == nil {
+			otherActions = append(otherActions, action)
+			continue
+		}
+
+		if _, isRunAction := action.Integration.Context[constants.PipelineRunContextAction]; !isRunAction {
+			otherActions = append(otherActions, action)
+			continue
+		}
+
+		stageName, _ = action.Integration.Context[constants.PipelineRunContextStage].(string)
+	}
+
+	isStatusFieldPresent := false
+	for _, field := range slackAttachment.Fields {
+		if field.Title == constants.PipelineRunStatusFieldTitle {
+			field.Value = build.GetState()
+			isStatusFieldPresent = true
+		}
+	}
+
+	if !isStatusFieldPresent {
+		slackAttachment.Fields = append(slackAttachment.Fields, &model.SlackAttachmentField{
+			Title: constants.PipelineRunStatusFieldTitle,
+			Value: build.GetState(),
+			Short: true,
+		})
+	}
+
+	slackAttachment.Actions = append(p.getPipelineRunActions(organization, project, build.ID, build.Status, build.Result, stageName), otherActions...)
+
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{slackAttachment})
+	if _, appErr := p.API.UpdatePost(post); appErr != nil {
+		return appErr
+	}
+
+	return nil
+}
+
+// updatePipelineRunFromPost updates a pipeline run from the actions of its post and returns the message for the user
+func (p *Plugin) updatePipelineRunFromPost(mattermostUserID, organization, project string, buildID int, action, stageName, postID string) string {
+	build, statusCode, err := p.UpdatePipelineRun(mattermostUserID, organization, project, buildID, action, stageName)
+	if err != nil {
+		p.API.LogError(constants.ErrorUpdatePipelineRun, "Error", err.Error())
+		switch statusCode {
+		case http.StatusBadRequest:
+			return err.Error()
+		case http.StatusForbidden, http.StatusUnauthorized:
+			return fmt.Sprintf(constants.PipelineRunUpdateNotPermitted, buildID)
+		default:
+			return constants.GenericErrorMessage
+		}
+	}
+
+	if err := p.UpdatePipelineRunPost(postID, organization, project, build); err != nil {
+		p.API.LogError(constants.ErrorUpdatePipelineRunPost, "Error", err.Error())
+	}
+
+	switch action {
+	case constants.PipelineRunActionCancel:
+		return fmt.Sprintf(constants.PipelineRunCancelRequested, build.BuildNumber, build.Link.Web.Href)
+	case constants.PipelineRunActionRetry:
+		return fmt.Sprintf(constants.PipelineRunRetried, build.BuildNumber, build.Link.Web.Href)
+	default:
+		return fmt.Sprintf(constants.PipelineRunStageRerun, stageName, build.BuildNumber, build.Link.Web.Href)
+	}
+}
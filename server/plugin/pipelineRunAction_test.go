@@ -0,0 +1,447 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestGetPipelineRunActions(t *testing.T) {
+	p := setupMockPlugin(&plugintest.API{}, nil, nil)
+
+	actions := p.getPipelineRunActions(testutils.MockOrganization, testutils.MockProjectName, 1, constants.BuildStatusInProgress, "", "")
+	require.Len(t, actions, 1)
+	assert.Equal(t, constants.PipelineRunActionCancel, actions[0].Integration.Context[constants.PipelineRunContextAction])
+
+	actions = p.getPipelineRunActions(testutils.MockOrganization, testutils.MockProjectName, 1, constants.BuildStatusCompleted, constants.BuildResultFailed, "Build")
+	require.Len(t, actions, 2)
+	assert.Equal(t, constants.PipelineRunActionRetry, actions[0].Integration.Context[constants.PipelineRunContextAction])
+	assert.Equal(t, constants.PipelineRunActionRerunStage, actions[1].Integration.Context[constants.PipelineRunContextAction])
+	assert.Equal(t, "Build", actions[1].Integration.Context[constants.PipelineRunContextStage])
+
+	assert.Nil(t, p.getPipelineRunActions(testutils.MockOrganization, testutils.MockProjectName, 1, constants.BuildStatusCompleted, "succeeded", ""))
+	assert.Nil(t, p.getPipelineRunActions(testutils.MockOrganization, testutils.MockProjectName, 1, constants.BuildStatusCancelling, "", ""))
+	assert.Nil(t, p.getPipelineRunActions("", "", 0, constants.BuildStatusInProgress, "", ""))
+}
+
+func TestGetBuildFromWebLink(t *testing.T) {
+	for _, testCase := range []struct {
+		link                 string
+		expectedOrganization string
+		expectedProject      string
+		expectedBuildID      int
+	}{
+		{
+			link:                 "https://dev.azure.com/mockOrganization/mockProjectName/_build/results?buildId=12",
+			expectedOrganization: testutils.MockOrganization,
+			expectedProject:      testutils.MockProjectName,
+			expectedBuildID:      12,
+		},
+		{
+			link:                 "https://dev.azure.com/mockOrganization/mock%20Project/_build/results?buildId=12&view=results",
+			expectedOrganization: testutils.MockOrganization,
+			expectedProject:      "mock%20Project",
+			expectedBuildID:      12,
+		},
+		{
+			link: "https://dev.azure.com/mockOrganization/mockProjectName/_build?definitionId=2",
+		},
+		{
+			link: "",
+		},
+	} {
+		t.Run(testCase.link, func(t *testing.T) {
+			organization, project, buildID := getBuildFromWebLink(testCase.link)
+			assert.Equal(t, testCase.expectedOrganization, organization)
+			assert.Equal(t, testCase.expectedProject, project)
+			assert.Equal(t, testCase.expectedBuildID, buildID)
+		})
+	}
+}
+
+func TestUpdatePipelineRun(t *testing.T) {
+	for _, testCase := range []struct {
+		description        string
+		action             string
+		stageName          string
+		build              *serializers.BuildDetails
+		expectCancel       bool
+		expectRetry        bool
+		expectRetryStage   bool
+		updateStatusCode   int
+		updateErr          error
+		expectedErr        error
+		expectedStatusCode int
+	}{
+		{
+			description:        "UpdatePipelineRun: cancel an in-progress run",
+			action:             constants.PipelineRunActionCancel,
+			build:              testutils.GetMockBuild(constants.BuildStatusInProgress, ""),
+			expectCancel:       true,
+			updateStatusCode:   http.StatusOK,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "UpdatePipelineRun: cancel a completed run",
+			action:             constants.PipelineRunActionCancel,
+			build:              testutils.GetMockBuild(constants.BuildStatusCompleted, constants.BuildResultFailed),
+			expectedErr:        fmt.Errorf(constants.PipelineRunNotInProgress, "20240101.1"),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:        "UpdatePipelineRun: retry a failed run",
+			action:             constants.PipelineRunActionRetry,
+			build:              testutils.GetMockBuild(constants.BuildStatusCompleted, constants.BuildResultFailed),
+			expectRetry:        true,
+			updateStatusCode:   http.StatusOK,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "UpdatePipelineRun: retry a succeeded run",
+			action:             constants.PipelineRunActionRetry,
+			build:              testutils.GetMockBuild(constants.BuildStatusCompleted, "succeeded"),
+			expectedErr:        fmt.Errorf(constants.PipelineRunNotFailed, "20240101.1"),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:        "UpdatePipelineRun: retry without permission",
+			action:             constants.PipelineRunActionRetry,
+			build:              testutils.GetMockBuild(constants.BuildStatusCompleted, constants.BuildResultCanceled),
+			expectRetry:        true,
+			updateStatusCode:   http.StatusForbidden,
+			updateErr:          errors.New("failed to retry the build"),
+			expectedErr:        errors.New("failed to retry the build"),
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			description:        "UpdatePipelineRun: rerun a stage",
+			action:             constants.PipelineRunActionRerunStage,
+			stageName:          "Build",
+			build:              testutils.GetMockBuild(constants.BuildStatusCompleted, constants.BuildResultFailed),
+			expectRetryStage:   true,
+			updateStatusCode:   http.StatusOK,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "UpdatePipelineRun: rerun without a stage",
+			action:             constants.PipelineRunActionRerunStage,
+			build:              testutils.GetMockBuild(constants.BuildStatusCompleted, constants.BuildResultFailed),
+			expectedErr:        errors.New(constants.StageRequired),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:        "UpdatePipelineRun: invalid action",
+			action:             "mockAction",
+			build:              testutils.GetMockBuild(constants.BuildStatusCompleted, constants.BuildResultFailed),
+			expectedErr:        fmt.Errorf(constants.InvalidCommandArguments, "mockAction"),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+
+			mockedClient.EXPECT().GetBuildDetails(testutils.MockOrganization, testutils.MockProjectName, "1", testutils.MockMattermostUserID).Return(testCase.build, http.StatusOK, nil)
+			updatedBuild := testutils.GetMockBuild(constants.BuildStatusInProgress, "")
+			if testCase.expectCancel {
+				updatedBuild.Status = constants.BuildStatusCancelling
+				mockedClient.EXPECT().CancelBuild(testutils.MockOrganization, testutils.MockProjectName, 1, testutils.MockMattermostUserID).Return(updatedBuild, testCase.updateStatusCode, testCase.updateErr)
+			}
+			if testCase.expectRetry {
+				mockedClient.EXPECT().RetryBuild(testutils.MockOrganization, testutils.MockProjectName, 1, testutils.MockMattermostUserID).Return(updatedBuild, testCase.updateStatusCode, testCase.updateErr)
+			}
+			if testCase.expectRetryStage {
+				mockedClient.EXPECT().RetryBuildStage(testutils.MockOrganization, testutils.MockProjectName, 1, testCase.stageName, testutils.MockMattermostUserID).Return(testCase.updateStatusCode, testCase.updateErr)
+				mockedClient.EXPECT().GetBuildDetails(testutils.MockOrganization, testutils.MockProjectName, "1", testutils.MockMattermostUserID).Return(updatedBuild, http.StatusOK, nil)
+			}
+
+			build, statusCode, err := p.UpdatePipelineRun(testutils.MockMattermostUserID, testutils.MockOrganization, testutils.MockProjectName, 1, testCase.action, testCase.stageName)
+
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			if testCase.expectedErr != nil {
+				assert.EqualError(t, err, testCase.expectedErr.Error())
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, updatedBuild, build)
+		})
+	}
+}
+
+func TestGetRerunnableStages(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	mockCtrl := gomock.NewController(t)
+	mockedClient := mocks.NewMockClient(mockCtrl)
+	p := setupMockPlugin(mockAPI, nil, mockedClient)
+
+	mockedClient.EXPECT().GetBuildTimeline(testutils.MockOrganization, testutils.MockProjectName, 1, testutils.MockMattermostUserID).Return(&serializers.BuildTimeline{
+		Records: []*serializers.TimelineRecord{
+			{Type: constants.TimelineRecordTypeStage, Identifier: "Deploy", State: constants.BuildStatusCompleted, Result: constants.BuildResultCanceled, Order: 3},
+			{Type: constants.TimelineRecordTypeStage, Identifier: "Test", State: constants.BuildStatusCompleted, Result: "succeeded", Order: 2},
+			{Type: "Job", Identifier: "Build.Job", State: constants.BuildStatusCompleted, Result: constants.BuildResultFailed, Order: 1},
+			{Type: constants.TimelineRecordTypeStage, Identifier: "Build", State: constants.BuildStatusCompleted, Result: constants.BuildResultFailed, Order: 1},
+		},
+	}, http.StatusOK, nil)
+
+	stages, statusCode, err := p.GetRerunnableStages(testutils.MockMattermostUserID, testutils.MockOrganization, testutils.MockProjectName, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	require.Len(t, stages, 2)
+	assert.Equal(t, "Build", stages[0].Identifier)
+	assert.Equal(t, "Deploy", stages[1].Identifier)
+}
+
+func TestUpdatePipelineRunPost(t *testing.T) {
+	for _, testCase := range []struct {
+		description      string
+		fields           []*model.SlackAttachmentField
+		stageName        string
+		build            *serializers.BuildDetails
+		expectedStatus   string
+		expectedActions  int
+		expectedFieldLen int
+	}{
+		{
+			description:      "UpdatePipelineRunPost: status field is added",
+			fields:           []*model.SlackAttachmentField{{Title: "Pipeline", Value: "web-ci"}},
+			build:            testutils.GetMockBuild(constants.BuildStatusInProgress, ""),
+			expectedStatus:   constants.BuildStatusInProgress,
+			expectedActions:  1,
+			expectedFieldLen: 2,
+		},
+		{
+			description:      "UpdatePipelineRunPost: status field is updated",
+			fields:           []*model.SlackAttachmentField{{Title: constants.PipelineRunStatusFieldTitle, Value: constants.BuildStatusInProgress}},
+			stageName:        "Build",
+			build:            testutils.GetMockBuild(constants.BuildStatusCompleted, constants.BuildResultCanceled),
+			expectedStatus:   constants.BuildResultCanceled,
+			expectedActions:  2,
+			expectedFieldLen: 1,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			p := setupMockPlugin(mockAPI, nil, nil)
+
+			post := &model.Post{Id: "mockPostID"}
+			model.ParseSlackAttachment(post, []*model.SlackAttachment{{
+				Fields:  testCase.fields,
+				Actions: p.getPipelineRunActions(testutils.MockOrganization, testutils.MockProjectName, 1, constants.BuildStatusCompleted, constants.BuildResultFailed, testCase.stageName),
+			}})
+			mockAPI.On("GetPost", "mockPostID").Return(post, nil)
+
+			var updatedPost *model.Post
+			mockAPI.On("UpdatePost", mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				updatedPost = args.Get(0).(*model.Post)
+			}).Return(&model.Post{}, nil)
+
+			err := p.UpdatePipelineRunPost("mockPostID", testutils.MockOrganization, testutils.MockProjectName, testCase.build)
+			require.NoError(t, err)
+			require.NotNil(t, updatedPost)
+
+			attachment := updatedPost.Attachments()[0]
+			require.Len(t, attachment.Fields, testCase.expectedFieldLen)
+			assert.Equal(t, testCase.expectedStatus, attachment.Fields[testCase.expectedFieldLen-1].Value)
+			require.Len(t, attachment.Actions, testCase.expectedActions)
+			for _, action := range attachment.Actions {
+				assert.Equal(t, testCase.stageName, action.Integration.Context[constants.PipelineRunContextStage])
+			}
+		})
+	}
+}
+
+func TestHandlePipelineRunAction(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description           string
+		context               map[string]interface{}
+		stages                []*serializers.TimelineRecord
+		expectOpenDialog      bool
+		updateStatusCode      int
+		updateErr             error
+		expectedStatusCode    int
+		expectedEphemeralText string
+	}{
+		{
+			description:           "HandlePipelineRunAction: cancel",
+			context:               map[string]interface{}{"organization": "mockOrganization", "project": "mockProjectName", "buildId": 1, "action": constants.PipelineRunActionCancel},
+			updateStatusCode:      http.StatusOK,
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: "Requested to cancel the run [20240101.1](mockBuildURL).",
+		},
+		{
+			description:           "HandlePipelineRunAction: retry",
+			context:               map[string]interface{}{"organization": "mockOrganization", "project": "mockProjectName", "buildId": 1, "action": constants.PipelineRunActionRetry},
+			updateStatusCode:      http.StatusOK,
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: "Retrying the failed jobs of the run [20240101.1](mockBuildURL).",
+		},
+		{
+			description:           "HandlePipelineRunAction: rerun the stage of the post",
+			context:               map[string]interface{}{"organization": "mockOrganization", "project": "mockProjectName", "buildId": 1, "action": constants.PipelineRunActionRerunStage, "stage": "Build"},
+			updateStatusCode:      http.StatusOK,
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: "Rerunning the stage **Build** of the run [20240101.1](mockBuildURL).",
+		},
+		{
+			description:        "HandlePipelineRunAction: rerun a stage opens the dialog",
+			context:            map[string]interface{}{"organization": "mockOrganization", "project": "mockProjectName", "buildId": 1, "action": constants.PipelineRunActionRerunStage, "stage": ""},
+			stages:             []*serializers.TimelineRecord{{Identifier: "Build", Name: "Build", Result: constants.BuildResultFailed}},
+			expectOpenDialog:   true,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:           "HandlePipelineRunAction: no stages to rerun",
+			context:               map[string]interface{}{"organization": "mockOrganization", "project": "mockProjectName", "buildId": 1, "action": constants.PipelineRunActionRerunStage},
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: fmt.Sprintf(constants.NoRerunnableStages, 1),
+		},
+		{
+			description:           "HandlePipelineRunAction: cancel without permission",
+			context:               map[string]interface{}{"organization": "mockOrganization", "project": "mockProjectName", "buildId": 1, "action": constants.PipelineRunActionCancel},
+			updateStatusCode:      http.StatusForbidden,
+			updateErr:             errors.New("failed to cancel the build"),
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: fmt.Sprintf(constants.PipelineRunUpdateNotPermitted, 1),
+		},
+		{
+			description:           "HandlePipelineRunAction: run is not in progress",
+			context:               map[string]interface{}{"organization": "mockOrganization", "project": "mockProjectName", "buildId": 1, "action": constants.PipelineRunActionCancel},
+			updateStatusCode:      http.StatusBadRequest,
+			updateErr:             fmt.Errorf(constants.PipelineRunNotInProgress, "20240101.1"),
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: fmt.Sprintf(constants.PipelineRunNotInProgress, "20240101.1"),
+		},
+		{
+			description:        "HandlePipelineRunAction: missing build",
+			context:            map[string]interface{}{"organization": "mockOrganization", "project": "mockProjectName", "action": constants.PipelineRunActionCancel},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			if testCase.expectOpenDialog {
+				mockedClient.EXPECT().OpenDialogRequest(gomock.Any(), testutils.MockMattermostUserID).Return(http.StatusOK, nil)
+			}
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetRerunnableStages", func(_ *Plugin, _, _, _ string, _ int) ([]*serializers.TimelineRecord, int, error) {
+				return testCase.stages, http.StatusOK, nil
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdatePipelineRun", func(_ *Plugin, _, organization, project string, buildID int, _, _ string) (*serializers.BuildDetails, int, error) {
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, testutils.MockProjectName, project)
+				assert.Equal(t, 1, buildID)
+				if testCase.updateErr != nil {
+					return nil, testCase.updateStatusCode, testCase.updateErr
+				}
+				return testutils.GetMockBuild(constants.BuildStatusInProgress, ""), testCase.updateStatusCode, nil
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdatePipelineRunPost", func(_ *Plugin, postID, _, _ string, _ *serializers.BuildDetails) error {
+				assert.Equal(t, "mockPostID", postID)
+				return nil
+			})
+
+			body, err := json.Marshal(&model.PostActionIntegrationRequest{PostId: "mockPostID", Context: testCase.context})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, constants.PathPipelineRunAction, bytes.NewBuffer(body))
+			req.Header.Add(constants.HeaderMattermostUserID, testutils.MockMattermostUserID)
+
+			w := httptest.NewRecorder()
+			p.handlePipelineRunAction(w, req)
+			resp := w.Result()
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			if testCase.expectedStatusCode == http.StatusOK {
+				response := model.PostActionIntegrationResponseFromJson(resp.Body)
+				require.NotNil(t, response)
+				assert.Equal(t, testCase.expectedEphemeralText, response.EphemeralText)
+			}
+		})
+	}
+}
+
+func TestHandleRerunStageDialog(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description        string
+		state              string
+		expectedStatusCode int
+	}{
+		{
+			description:        "HandleRerunStageDialog: valid",
+			state:              "mockOrganization$mockProjectName$1",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "HandleRerunStageDialog: invalid state",
+			state:              "mockOrganization$mockProjectName",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:        "HandleRerunStageDialog: invalid build ID",
+			state:              "mockOrganization$mockProjectName$build",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			p := setupMockPlugin(mockAPI, nil, nil)
+
+			mockAPI.On("SendEphemeralPost", testutils.MockMattermostUserID, mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				assert.Equal(t, "Rerunning the stage **Build** of the run [20240101.1](mockBuildURL).", args.Get(1).(*model.Post).Message)
+			}).Return(&model.Post{})
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdatePipelineRun", func(_ *Plugin, _, _, _ string, _ int, action, stageName string) (*serializers.BuildDetails, int, error) {
+				assert.Equal(t, constants.PipelineRunActionRerunStage, action)
+				assert.Equal(t, "Build", stageName)
+				return testutils.GetMockBuild(constants.BuildStatusInProgress, ""), http.StatusOK, nil
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdatePipelineRunPost", func(_ *Plugin, postID, _, _ string, _ *serializers.BuildDetails) error {
+				assert.Equal(t, "mockPostID", postID)
+				return nil
+			})
+
+			body, err := json.Marshal(&model.SubmitDialogRequest{
+				CallbackId: "mockPostID",
+				ChannelId:  testutils.MockChannelID,
+				State:      testCase.state,
+				Submission: map[string]interface{}{constants.DialogFieldNameStage: "Build"},
+			})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, constants.PathRerunStageDialog, bytes.NewBuffer(body))
+			req.Header.Add(constants.HeaderMattermostUserID, testutils.MockMattermostUserID)
+
+			w := httptest.NewRecorder()
+			p.handleRerunStageDialog(w, req)
+			assert.Equal(t, testCase.expectedStatusCode, w.Result().StatusCode)
+		})
+	}
+}
@@ -0,0 +1,378 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+const mockPipelineYAML = `parameters:
+- name: environment
+  displayName: Environment
+  type: string
+  default: staging
+  values:
+  - staging
+  - production
+- name: runTests
+  type: boolean
+  default: true
+- name: steps
+  type: stepList
+  default: []
+trigger:
+- main
+`
+
+func TestGetPipelineToRun(t *testing.T) {
+	mockPipelineList := &serializers.PipelineList{Value: []*serializers.Pipeline{
+		{ID: 1, Name: "web-ci"},
+		{ID: 2, Name: "api-ci"},
+	}}
+	for _, testCase := range []struct {
+		description        string
+		pipeline           string
+		expectedPipelineID int
+		previewStatusCode  int
+		previewErr         error
+		expectedErr        error
+		expectedStatusCode int
+	}{
+		{
+			description:        "GetPipelineToRun: pipeline name",
+			pipeline:           "Web-CI",
+			expectedPipelineID: 1,
+			previewStatusCode:  http.StatusOK,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "GetPipelineToRun: pipeline ID",
+			pipeline:           "2",
+			expectedPipelineID: 2,
+			previewStatusCode:  http.StatusOK,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "GetPipelineToRun: pipeline does not exist",
+			pipeline:           "mobile-ci",
+			expectedErr:        fmt.Errorf(constants.PipelineNotFound, "mobile-ci", testutils.MockProjectName),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:        "GetPipelineToRun: user can not queue the pipeline",
+			pipeline:           "web-ci",
+			expectedPipelineID: 1,
+			previewStatusCode:  http.StatusForbidden,
+			previewErr:         errors.New("failed to preview the pipeline run"),
+			expectedErr:        errors.New("failed to preview the pipeline run"),
+			expectedStatusCode: http.StatusForbidden,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+
+			mockedClient.EXPECT().GetPipelines(testutils.MockOrganization, testutils.MockProjectName, testutils.MockMattermostUserID).Return(mockPipelineList, http.StatusOK, nil)
+			if testCase.expectedPipelineID != 0 {
+				mockedClient.EXPECT().PreviewPipelineRun(testutils.MockOrganization, testutils.MockProjectName, testCase.expectedPipelineID, testutils.MockMattermostUserID).Return(&serializers.PipelinePreview{FinalYAML: mockPipelineYAML}, testCase.previewStatusCode, testCase.previewErr)
+			}
+
+			pipeline, parameters, statusCode, err := p.GetPipelineToRun(testutils.MockMattermostUserID, testutils.MockOrganization, testutils.MockProjectName, testCase.pipeline)
+
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			if testCase.expectedErr != nil {
+				assert.EqualError(t, err, testCase.expectedErr.Error())
+				assert.Nil(t, pipeline)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedPipelineID, pipeline.ID)
+			assert.Equal(t, 3, len(parameters))
+			assert.Equal(t, "environment", parameters[0].Name)
+			assert.Equal(t, []string{"staging", "production"}, parameters[0].GetValues())
+			assert.Equal(t, "true", parameters[1].GetDefault())
+		})
+	}
+}
+
+func TestGetRunPipelineDialogElements(t *testing.T) {
+	parameters, err := serializers.ParsePipelineParameters(mockPipelineYAML)
+	assert.Nil(t, err)
+
+	elements := getRunPipelineDialogElements(parameters, "main", map[string]string{"environment": "production", "logLevel": "debug", "buildConfiguration": "Release"})
+
+	assert.Equal(t, 4, len(elements))
+	assert.Equal(t, constants.DialogFieldNameBranch, elements[0].Name)
+	assert.Equal(t, "main", elements[0].Default)
+
+	assert.Equal(t, "parameter_environment", elements[1].Name)
+	assert.Equal(t, "Environment", elements[1].DisplayName)
+	assert.Equal(t, "select", elements[1].Type)
+	assert.Equal(t, "production", elements[1].Default)
+	assert.Equal(t, 2, len(elements[1].Options))
+
+	assert.Equal(t, "parameter_runTests", elements[2].Name)
+	assert.Equal(t, "bool", elements[2].Type)
+	assert.Equal(t, "true", elements[2].Default)
+
+	assert.Equal(t, constants.DialogFieldNameVariables, elements[3].Name)
+	assert.Equal(t, "buildConfiguration=Release\nlogLevel=debug", elements[3].Default)
+}
+
+func TestRunPipelineFromDialog(t *testing.T) {
+	for _, testCase := range []struct {
+		description         string
+		state               string
+		submission          map[string]interface{}
+		expectedPayload     *serializers.RunPipelineRequest
+		runStatusCode       int
+		runErr              error
+		expectedFieldErrors map[string]string
+		expectedErr         error
+		expectedStatusCode  int
+	}{
+		{
+			description: "RunPipelineFromDialog: run is queued with parameters and variables",
+			state:       "mockOrganization$mockProjectName$1",
+			submission: map[string]interface{}{
+				constants.DialogFieldNameBranch:    "feature/login",
+				"parameter_environment":            "production",
+				"parameter_runTests":               false,
+				"parameter_region":                 "",
+				constants.DialogFieldNameVariables: "logLevel=debug\n\n buildConfiguration = Release ",
+			},
+			expectedPayload: &serializers.RunPipelineRequest{
+				Resources: &serializers.PipelineRunResources{
+					Repositories: map[string]*serializers.PipelineRepositoryResource{"self": {RefName: "refs/heads/feature/login"}},
+				},
+				TemplateParameters: map[string]string{"environment": "production", "runTests": "false"},
+				Variables: map[string]*serializers.PipelineVariable{
+					"logLevel":           {Value: "debug"},
+					"buildConfiguration": {Value: "Release"},
+				},
+			},
+			runStatusCode:      http.StatusOK,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description: "RunPipelineFromDialog: run is queued on the default branch",
+			state:       "mockOrganization$mockProjectName$1",
+			submission: map[string]interface{}{
+				constants.DialogFieldNameBranch: "",
+			},
+			expectedPayload:    &serializers.RunPipelineRequest{},
+			runStatusCode:      http.StatusOK,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description: "RunPipelineFromDialog: invalid variable",
+			state:       "mockOrganization$mockProjectName$1",
+			submission: map[string]interface{}{
+				constants.DialogFieldNameVariables: "logLevel",
+			},
+			expectedFieldErrors: map[string]string{constants.DialogFieldNameVariables: fmt.Sprintf(constants.InvalidPipelineVariable, "logLevel")},
+			expectedStatusCode:  http.StatusBadRequest,
+		},
+		{
+			description:        "RunPipelineFromDialog: user can not queue the pipeline",
+			state:              "mockOrganization$mockProjectName$1",
+			submission:         map[string]interface{}{},
+			expectedPayload:    &serializers.RunPipelineRequest{},
+			runStatusCode:      http.StatusForbidden,
+			runErr:             errors.New("failed to run the pipeline"),
+			expectedErr:        errors.New("failed to run the pipeline"),
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			description:        "RunPipelineFromDialog: invalid state",
+			state:              "mockOrganization$mockProjectName",
+			expectedErr:        errors.New(constants.GenericErrorMessage),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+
+			if testCase.expectedPayload != nil {
+				mockedClient.EXPECT().RunPipeline(testutils.MockOrganization, testutils.MockProjectName, 1, testCase.expectedPayload, testutils.MockMattermostUserID).Return(&serializers.PipelineRun{ID: 10}, testCase.runStatusCode, testCase.runErr)
+			}
+
+			pipelineRun, fieldErrors, statusCode, err := p.RunPipelineFromDialog(testutils.MockMattermostUserID, testCase.state, testCase.submission)
+
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			assert.Equal(t, testCase.expectedFieldErrors, fieldErrors)
+			if testCase.expectedErr != nil {
+				assert.EqualError(t, err, testCase.expectedErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			if testCase.expectedErr != nil || testCase.expectedFieldErrors != nil {
+				assert.Nil(t, pipelineRun)
+				return
+			}
+
+			assert.Equal(t, 10, pipelineRun.ID)
+		})
+	}
+}
+
+func TestPostPipelineRun(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	pipelineRun := &serializers.PipelineRun{
+		Name:     "20240101.1",
+		Pipeline: &serializers.Pipeline{Name: "web-ci"},
+		Resources: &serializers.PipelineRunResources{
+			Repositories: map[string]*serializers.PipelineRepositoryResource{"self": {RefName: "refs/heads/main"}},
+		},
+	}
+	pipelineRun.Links.Web.Href = "mockRunURL"
+
+	mockAPI.On("CreatePost", mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+		post := args.Get(0).(*model.Post)
+		assert.Equal(t, testutils.MockMattermostUserID, post.UserId)
+		assert.Equal(t, testutils.MockChannelID, post.ChannelId)
+		assert.Equal(t, "Queued the run [20240101.1](mockRunURL) of the pipeline **web-ci** on `main`.", post.Message)
+	}).Return(&model.Post{}, nil)
+
+	assert.Nil(t, p.PostPipelineRun(testutils.MockMattermostUserID, testutils.MockChannelID, pipelineRun))
+}
+
+func TestExecuteRunPipelineCommand(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	for _, testCase := range []struct {
+		description       string
+		command           string
+		linkedProject     *serializers.ProjectDetails
+		expectGetPipeline bool
+		expectedPipeline  string
+		expectedBranch    string
+		expectedArguments map[string]string
+		statusCode        int
+		err               error
+		expectOpenDialog  bool
+		ephemeralMessage  string
+	}{
+		{
+			description:      "RunPipelineCommand: pipeline is not provided",
+			command:          "/azuredevops pipelines run",
+			ephemeralMessage: constants.PipelineRunUsage,
+		},
+		{
+			description:      "RunPipelineCommand: invalid argument",
+			command:          "/azuredevops pipelines run web-ci main environment",
+			ephemeralMessage: fmt.Sprintf(constants.PresetInvalidArgument, "environment"),
+		},
+		{
+			description:      "RunPipelineCommand: project is not found",
+			command:          "/azuredevops pipelines run web-ci",
+			ephemeralMessage: constants.PipelineRunProjectRequired,
+		},
+		{
+			description:       "RunPipelineCommand: user can not queue the pipeline",
+			command:           "/azuredevops pipelines run https://dev.azure.com/mockOrganization/mockProjectName/_build?definitionId=12",
+			expectGetPipeline: true,
+			expectedPipeline:  "12",
+			statusCode:        http.StatusForbidden,
+			err:               errors.New("failed to preview the pipeline run"),
+			ephemeralMessage:  constants.ErrorQueueAccess,
+		},
+		{
+			description:       "RunPipelineCommand: pipeline does not exist",
+			command:           "/azuredevops pipelines run mobile-ci",
+			linkedProject:     &serializers.ProjectDetails{OrganizationName: testutils.MockOrganization, ProjectName: testutils.MockProjectName},
+			expectGetPipeline: true,
+			expectedPipeline:  "mobile-ci",
+			statusCode:        http.StatusBadRequest,
+			err:               fmt.Errorf(constants.PipelineNotFound, "mobile-ci", testutils.MockProjectName),
+			ephemeralMessage:  fmt.Sprintf(constants.PipelineNotFound, "mobile-ci", testutils.MockProjectName),
+		},
+		{
+			description:       "RunPipelineCommand: dialog is opened with the branch and the arguments",
+			command:           "/azuredevops pipelines run web-ci feature/login environment=production logLevel=debug",
+			linkedProject:     &serializers.ProjectDetails{OrganizationName: testutils.MockOrganization, ProjectName: testutils.MockProjectName},
+			expectGetPipeline: true,
+			expectedPipeline:  "web-ci",
+			expectedBranch:    "feature/login",
+			expectedArguments: map[string]string{"environment": "production", "logLevel": "debug"},
+			statusCode:        http.StatusOK,
+			expectOpenDialog:  true,
+		},
+		{
+			description:       "RunPipelineCommand: dialog is opened with the arguments only",
+			command:           "/azuredevops pipelines run web-ci environment=production",
+			linkedProject:     &serializers.ProjectDetails{OrganizationName: testutils.MockOrganization, ProjectName: testutils.MockProjectName},
+			expectGetPipeline: true,
+			expectedPipeline:  "web-ci",
+			expectedArguments: map[string]string{"environment": "production"},
+			statusCode:        http.StatusOK,
+			expectOpenDialog:  true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			if testCase.ephemeralMessage != "" {
+				mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+					post := args.Get(1).(*model.Post)
+					assert.Equal(t, testCase.ephemeralMessage, post.Message)
+				}).Once().Return(&model.Post{})
+			}
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return true
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetLinkedProjectFromArguments", func(_ *Plugin, _ string, _ map[string]string) (*serializers.ProjectDetails, error) {
+				return testCase.linkedProject, nil
+			})
+
+			isPipelineFetched := false
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetPipelineToRun", func(_ *Plugin, _, organization, projectName, pipelineNameOrID string) (*serializers.Pipeline, []*serializers.PipelineParameter, int, error) {
+				isPipelineFetched = true
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, testutils.MockProjectName, projectName)
+				assert.Equal(t, testCase.expectedPipeline, pipelineNameOrID)
+				if testCase.err != nil {
+					return nil, nil, testCase.statusCode, testCase.err
+				}
+				return &serializers.Pipeline{ID: 1, Name: "web-ci"}, nil, testCase.statusCode, nil
+			})
+
+			isDialogOpened := false
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "OpenRunPipelineDialog", func(_ *Plugin, _, triggerID, _, _ string, _ *serializers.Pipeline, _ []*serializers.PipelineParameter, branch string, arguments map[string]string) (int, error) {
+				isDialogOpened = true
+				assert.Equal(t, "mockTriggerID", triggerID)
+				assert.Equal(t, testCase.expectedBranch, branch)
+				assert.Equal(t, testCase.expectedArguments, arguments)
+				return http.StatusOK, nil
+			})
+
+			_, err := p.ExecuteCommand(nil, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID, TriggerId: "mockTriggerID"})
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectGetPipeline, isPipelineFetched)
+			assert.Equal(t, testCase.expectOpenDialog, isDialogOpened)
+		})
+	}
+}
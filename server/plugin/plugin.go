@@ -11,6 +11,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 
+	"github.com/mattermost/mattermost-plugin-api/cluster"
 	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/mattermost/mattermost-server/v5/plugin"
 
@@ -36,6 +37,15 @@ type Plugin struct {
 
 	// user ID of the bot account
 	botUserID string
+
+	// identityCacheLock synchronizes access to the cache of the Mattermost users of Azure DevOps identities.
+	identityCacheLock sync.Mutex
+	identityCache     map[string]*identityCacheEntry
+
+	// reviewReminderJob runs the review reminders, it is closed once the plugin is deactivated.
+	reviewReminderJob *cluster.Job
+	// pipelineApprovalJob syncs the approvals of run stages, it is closed once the plugin is deactivated.
+	pipelineApprovalJob *cluster.Job
 }
 
 // getConfiguration retrieves the active configuration under lock, making it safe to use
@@ -138,3 +148,10 @@ func (p *Plugin) MessageWillBePosted(c *plugin.Context, post *model.Post) (*mode
 
 	return nil, ""
 }
+
+func (p *Plugin) MessageHasBeenPosted(c *plugin.Context, post *model.Post) {
+	// Check if the post is a reply in a thread linked to a work item.
+	p.MirrorThreadReply(post)
+	// Check if the post is a reply in the thread of a pull request comment notification.
+	p.MirrorPullRequestThreadReply(post)
+}
@@ -0,0 +1,255 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// GetPullRequestRepository returns the repository in which a pull request is to be created after checking that its source and target branches exist
+func (p *Plugin) GetPullRequestRepository(mattermostUserID, organization, projectName, repositoryName, sourceBranch, targetBranch string) (*serializers.GitRepository, int, error) {
+	sourceBranch = strings.TrimPrefix(sourceBranch, constants.RefNamePrefixBranch)
+	targetBranch = strings.TrimPrefix(targetBranch, constants.RefNamePrefixBranch)
+	if sourceBranch == targetBranch {
+		return nil, http.StatusBadRequest, errors.New(constants.SameSourceAndTargetBranch)
+	}
+
+	repositoryList, statusCode, err := p.Client.GetGitRepositories(organization, projectName, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	repository, err := getGitRepository(repositoryList, projectName, repositoryName)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	branchList, statusCode, err := p.Client.GetGitRepositoryBranches(organization, projectName, repository.ID, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	for _, branchName := range []string{sourceBranch, targetBranch} {
+		isBranchPresent := false
+		for _, branch := range branchList.Value {
+			if branch.Name == constants.RefNamePrefixBranch+branchName {
+				isBranchPresent = true
+				break
+			}
+		}
+
+		if !isBranchPresent {
+			return nil, http.StatusBadRequest, fmt.Errorf(constants.BranchNotFound, branchName, repository.Name)
+		}
+	}
+
+	return repository, http.StatusOK, nil
+}
+
+// OpenCreatePullRequestDialog opens the dialog to choose the description, reviewers, linked work items and draft flag of a pull request before creating it.
+// The repository and the branches of the pull request are sent as the state of the dialog.
+func (p *Plugin) OpenCreatePullRequestDialog(mattermostUserID, triggerID, organization string, repository *serializers.GitRepository, sourceBranch, targetBranch, title string) (int, error) {
+	sourceBranch = strings.TrimPrefix(sourceBranch, constants.RefNamePrefixBranch)
+	targetBranch = strings.TrimPrefix(targetBranch, constants.RefNamePrefixBranch)
+	requestBody := model.OpenDialogRequest{
+		TriggerId: triggerID,
+		URL:       fmt.Sprintf("%s%s", p.GetPluginURL(), constants.PathCreatePullRequestDialog),
+		Dialog: model.Dialog{
+			Title:            "Create Pull Request",
+			IntroductionText: fmt.Sprintf("Create a pull request from `%s` into `%s` in the repo %s.", sourceBranch, targetBranch, repository.Name),
+			SubmitLabel:      "Create",
+			Elements:         getCreatePullRequestDialogElements(title),
+			State:            fmt.Sprintf("%s$%s$%s$%s$%s", organization, repository.Project.ID, repository.ID, sourceBranch, targetBranch),
+		},
+	}
+
+	return p.Client.OpenDialogRequest(&requestBody, mattermostUserID)
+}
+
+func getCreatePullRequestDialogElements(title string) []model.DialogElement {
+	elements := []model.DialogElement{
+		{
+			DisplayName: "Title",
+			Name:        constants.DialogFieldNameTitle,
+			Type:        "text",
+			Default:     title,
+		},
+		{
+			DisplayName: "Description",
+			Name:        constants.DialogFieldNameDescription,
+			Type:        "textarea",
+			Optional:    true,
+		},
+	}
+
+	for index := 1; index <= constants.MaxPullRequestDialogReviewers; index++ {
+		elements = append(elements, model.DialogElement{
+			DisplayName: fmt.Sprintf("Reviewer %d", index),
+			Name:        fmt.Sprintf(constants.DialogFieldNameReviewer, index),
+			Type:        "select",
+			DataSource:  "users",
+			HelpText:    "The users are added using their connected Azure DevOps account, the identity mapped by the admins or their email",
+			Optional:    true,
+		})
+	}
+
+	return append(elements,
+		model.DialogElement{
+			DisplayName: "Work items",
+			Name:        constants.DialogFieldNameWorkItems,
+			Type:        "text",
+			Placeholder: "IDs or links of the work items separated by commas",
+			Optional:    true,
+		},
+		model.DialogElement{
+			DisplayName: "Draft",
+			Name:        constants.DialogFieldNameDraft,
+			Type:        "bool",
+			Placeholder: "Create the pull request as a draft",
+			Optional:    true,
+		},
+	)
+}
+
+// CreatePullRequestFromDialog creates a pull request from the submission of the dialog opened by OpenCreatePullRequestDialog.
+// The errors of the fields of the dialog are returned instead if the reviewers or the work items are not valid.
+func (p *Plugin) CreatePullRequestFromDialog(mattermostUserID, state string, submission map[string]interface{}) (*serializers.PullRequest, map[string]string, int, error) {
+	values := strings.SplitN(state, "$", 5)
+	if len(values) != 5 {
+		return nil, nil, http.StatusBadRequest, errors.New(constants.GenericErrorMessage)
+	}
+	organization, projectID, repositoryID, sourceBranch, targetBranch := values[0], values[1], values[2], values[3], values[4]
+
+	fieldErrors := map[string]string{}
+	title, _ := submission[constants.DialogFieldNameTitle].(string)
+	if strings.TrimSpace(title) == "" {
+		fieldErrors[constants.DialogFieldNameTitle] = constants.PullRequestTitleRequired
+	}
+
+	reviewers := p.getPullRequestReviewers(organization, mattermostUserID, submission, fieldErrors)
+
+	workItemsValue, _ := submission[constants.DialogFieldNameWorkItems].(string)
+	workItemRefs, err := parsePullRequestWorkItems(workItemsValue)
+	if err != nil {
+		fieldErrors[constants.DialogFieldNameWorkItems] = err.Error()
+	}
+
+	if len(fieldErrors) > 0 {
+		return nil, fieldErrors, http.StatusBadRequest, nil
+	}
+
+	description, _ := submission[constants.DialogFieldNameDescription].(string)
+	isDraft, _ := submission[constants.DialogFieldNameDraft].(bool)
+	payload := &serializers.CreatePullRequestRequest{
+		SourceRefName: constants.RefNamePrefixBranch + sourceBranch,
+		TargetRefName: constants.RefNamePrefixBranch + targetBranch,
+		Title:         strings.TrimSpace(title),
+		Description:   description,
+		IsDraft:       isDraft,
+		Reviewers:     reviewers,
+		WorkItemRefs:  workItemRefs,
+	}
+
+	pullRequest, statusCode, err := p.Client.CreatePullRequest(organization, projectID, repositoryID, payload, mattermostUserID)
+	if err != nil {
+		// Azure DevOps responds with a conflict if an active pull request between the branches already exists
+		if statusCode == http.StatusConflict {
+			return nil, nil, statusCode, fmt.Errorf(constants.PullRequestAlreadyExists, sourceBranch, targetBranch)
+		}
+		return nil, nil, statusCode, err
+	}
+
+	return pullRequest, nil, statusCode, nil
+}
+
+// getPullRequestReviewers returns the Azure DevOps identities of the Mattermost users picked as reviewers in the dialog.
+// The users who are not found in the organization are reported in the field errors.
+func (p *Plugin) getPullRequestReviewers(organization, mattermostUserID string, submission map[string]interface{}, fieldErrors map[string]string) []*serializers.PullRequestIdentityRef {
+	var reviewers []*serializers.PullRequestIdentityRef
+	isAdded := map[string]bool{}
+	for index := 1; index <= constants.MaxPullRequestDialogReviewers; index++ {
+		fieldName := fmt.Sprintf(constants.DialogFieldNameReviewer, index)
+		reviewerID, _ := submission[fieldName].(string)
+		if reviewerID == "" {
+			continue
+		}
+
+		identity, err := p.getPullRequestReviewerIdentity(organization, reviewerID, mattermostUserID)
+		if err != nil {
+			p.API.LogError(constants.ErrorResolveAzureIdentity, "Error", err.Error())
+		}
+
+		if identity == nil {
+			username := reviewerID
+			if user, appErr := p.API.GetUser(reviewerID); appErr == nil {
+				username = user.Username
+			}
+			fieldErrors[fieldName] = fmt.Sprintf(constants.PullRequestReviewerNotFound, username)
+			continue
+		}
+
+		if !isAdded[identity.ID] {
+			isAdded[identity.ID] = true
+			reviewers = append(reviewers, &serializers.PullRequestIdentityRef{ID: identity.ID})
+		}
+	}
+
+	return reviewers
+}
+
+// getPullRequestReviewerIdentity returns the identity of a Mattermost user in an organization, or nil if it is not found.
+// Only the unique names of the identities mapped by the admins or of the users who have not connected their account are known, their IDs are looked up in the organization.
+func (p *Plugin) getPullRequestReviewerIdentity(organization, reviewerID, mattermostUserID string) (*serializers.UserID, error) {
+	identity := p.GetAzureIdentityForMattermostUser(reviewerID)
+	if identity == nil || identity.ID != "" {
+		return identity, nil
+	}
+
+	return p.ResolveAzureIdentity(organization, identity.UniqueName, mattermostUserID)
+}
+
+// parsePullRequestWorkItems parses the IDs or links of work items separated by commas or whitespace into the work items to be linked to a pull request
+func parsePullRequestWorkItems(value string) ([]*serializers.PullRequestWorkItemRef, error) {
+	items := strings.FieldsFunc(value, func(character rune) bool {
+		return character == ',' || unicode.IsSpace(character)
+	})
+
+	var workItemRefs []*serializers.PullRequestWorkItemRef
+	for _, item := range items {
+		if taskData, _, isValid := IsLinkPresent(item, constants.TaskLinkRegex); isValid {
+			workItemRefs = append(workItemRefs, &serializers.PullRequestWorkItemRef{ID: taskData[7]})
+			continue
+		}
+
+		if workItemID, err := strconv.Atoi(item); err != nil || workItemID <= 0 {
+			return nil, fmt.Errorf(constants.InvalidPullRequestWorkItem, item)
+		}
+		workItemRefs = append(workItemRefs, &serializers.PullRequestWorkItemRef{ID: item})
+	}
+
+	return workItemRefs, nil
+}
+
+// PostCreatedPullRequest posts the preview of a pull request created by the user in a channel
+func (p *Plugin) PostCreatedPullRequest(mattermostUserID, channelID, organization string, pullRequest *serializers.PullRequest) error {
+	link := fmt.Sprintf(constants.PullRequestWebURL, p.getConfiguration().AzureDevopsAPIBaseURL, organization, pullRequest.Repository.Project.Name, pullRequest.Repository.Name, pullRequest.PullRequestID)
+	post := &model.Post{
+		UserId:    mattermostUserID,
+		ChannelId: channelID,
+	}
+
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{p.getPullRequestPreviewAttachment(organization, pullRequest.Repository.Name, link, pullRequest)})
+	if _, appErr := p.API.CreatePost(post); appErr != nil {
+		return appErr
+	}
+
+	return nil
+}
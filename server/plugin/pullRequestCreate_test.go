@@ -0,0 +1,378 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestGetPullRequestRepository(t *testing.T) {
+	mockBranchList := &serializers.GitRefList{Value: []*serializers.GitRef{
+		{Name: "refs/heads/main", ObjectID: "mockMainObjectID"},
+		{Name: "refs/heads/feature/login", ObjectID: "mockFeatureObjectID"},
+	}}
+	for _, testCase := range []struct {
+		description         string
+		repositoryName      string
+		sourceBranch        string
+		targetBranch        string
+		expectGetRepository bool
+		expectGetBranches   bool
+		expectedErr         error
+		expectedStatusCode  int
+	}{
+		{
+			description:         "GetPullRequestRepository: branches exist",
+			repositoryName:      "mockRepo",
+			sourceBranch:        "feature/login",
+			targetBranch:        "refs/heads/main",
+			expectGetRepository: true,
+			expectGetBranches:   true,
+			expectedStatusCode:  http.StatusOK,
+		},
+		{
+			description:        "GetPullRequestRepository: same source and target branch",
+			repositoryName:     "mockRepo",
+			sourceBranch:       "main",
+			targetBranch:       "refs/heads/main",
+			expectedErr:        errors.New(constants.SameSourceAndTargetBranch),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:         "GetPullRequestRepository: repo does not exist",
+			repositoryName:      "mockUnknownRepo",
+			sourceBranch:        "feature/login",
+			targetBranch:        "main",
+			expectGetRepository: true,
+			expectedErr:         fmt.Errorf(constants.RepositoryNotFound, "mockUnknownRepo", "mockRepo, mockOtherRepo"),
+			expectedStatusCode:  http.StatusBadRequest,
+		},
+		{
+			description:         "GetPullRequestRepository: source branch does not exist",
+			repositoryName:      "mockRepo",
+			sourceBranch:        "feature/signup",
+			targetBranch:        "main",
+			expectGetRepository: true,
+			expectGetBranches:   true,
+			expectedErr:         fmt.Errorf(constants.BranchNotFound, "feature/signup", "mockRepo"),
+			expectedStatusCode:  http.StatusBadRequest,
+		},
+		{
+			description:         "GetPullRequestRepository: target branch does not exist",
+			repositoryName:      "mockRepo",
+			sourceBranch:        "feature/login",
+			targetBranch:        "develop",
+			expectGetRepository: true,
+			expectGetBranches:   true,
+			expectedErr:         fmt.Errorf(constants.BranchNotFound, "develop", "mockRepo"),
+			expectedStatusCode:  http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+
+			if testCase.expectGetRepository {
+				mockedClient.EXPECT().GetGitRepositories(testutils.MockOrganization, testutils.MockProjectName, testutils.MockMattermostUserID).Return(testutils.GetMockGitRepositoryList("mockRepo", "mockOtherRepo"), http.StatusOK, nil)
+			}
+
+			if testCase.expectGetBranches {
+				mockedClient.EXPECT().GetGitRepositoryBranches(testutils.MockOrganization, testutils.MockProjectName, "mockRepoID", testutils.MockMattermostUserID).Return(mockBranchList, http.StatusOK, nil)
+			}
+
+			repository, statusCode, err := p.GetPullRequestRepository(testutils.MockMattermostUserID, testutils.MockOrganization, testutils.MockProjectName, testCase.repositoryName, testCase.sourceBranch, testCase.targetBranch)
+
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			if testCase.expectedErr != nil {
+				assert.EqualError(t, err, testCase.expectedErr.Error())
+				assert.Nil(t, repository)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "mockRepoID", repository.ID)
+		})
+	}
+}
+
+func TestCreatePullRequestFromDialog(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockState := "mockOrganization$mockProjectID$mockRepositoryID$feature/login$main"
+	for _, testCase := range []struct {
+		description         string
+		state               string
+		submission          map[string]interface{}
+		reviewerIdentities  map[string]*serializers.UserID
+		resolvedIdentities  map[string]*serializers.UserID
+		expectedPayload     *serializers.CreatePullRequestRequest
+		createStatusCode    int
+		createErr           error
+		expectedFieldErrors map[string]string
+		expectedErr         error
+		expectedStatusCode  int
+	}{
+		{
+			description: "CreatePullRequestFromDialog: pull request is created with reviewers and work items",
+			state:       mockState,
+			submission: map[string]interface{}{
+				constants.DialogFieldNameTitle:       " mockTitle ",
+				constants.DialogFieldNameDescription: "mockDescription",
+				"reviewer1":                          "mockReviewerID1",
+				"reviewer2":                          "mockReviewerID2",
+				"reviewer3":                          "mockReviewerID1",
+				"reviewer4":                          "mockReviewerID3",
+				constants.DialogFieldNameWorkItems:   "42, https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/43",
+				constants.DialogFieldNameDraft:       true,
+			},
+			reviewerIdentities: map[string]*serializers.UserID{
+				"mockReviewerID1": {ID: "mockAzureDevopsUserID1", UniqueName: "reviewer1@example.com"},
+				"mockReviewerID2": {UniqueName: "reviewer2@example.com"},
+				"mockReviewerID3": {UniqueName: "reviewer3@example.com"},
+			},
+			resolvedIdentities: map[string]*serializers.UserID{
+				"reviewer2@example.com": {ID: "mockAzureDevopsUserID2", UniqueName: "reviewer2@example.com"},
+				"reviewer3@example.com": {ID: "mockAzureDevopsUserID1", UniqueName: "reviewer1@example.com"},
+			},
+			expectedPayload: &serializers.CreatePullRequestRequest{
+				SourceRefName: "refs/heads/feature/login",
+				TargetRefName: "refs/heads/main",
+				Title:         "mockTitle",
+				Description:   "mockDescription",
+				IsDraft:       true,
+				Reviewers:     []*serializers.PullRequestIdentityRef{{ID: "mockAzureDevopsUserID1"}, {ID: "mockAzureDevopsUserID2"}},
+				WorkItemRefs:  []*serializers.PullRequestWorkItemRef{{ID: "42"}, {ID: "43"}},
+			},
+			createStatusCode:   http.StatusCreated,
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			description: "CreatePullRequestFromDialog: reviewers are not found in the organization and work item is invalid",
+			state:       mockState,
+			submission: map[string]interface{}{
+				constants.DialogFieldNameTitle:     "mockTitle",
+				"reviewer1":                        "mockReviewerID1",
+				"reviewer2":                        "mockReviewerID2",
+				constants.DialogFieldNameWorkItems: "42 mockWorkItem",
+			},
+			reviewerIdentities: map[string]*serializers.UserID{"mockReviewerID2": {UniqueName: "reviewer2@example.com"}},
+			expectedFieldErrors: map[string]string{
+				"reviewer1":                        fmt.Sprintf(constants.PullRequestReviewerNotFound, "mockReviewer1"),
+				"reviewer2":                        fmt.Sprintf(constants.PullRequestReviewerNotFound, "mockReviewer2"),
+				constants.DialogFieldNameWorkItems: fmt.Sprintf(constants.InvalidPullRequestWorkItem, "mockWorkItem"),
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description: "CreatePullRequestFromDialog: title is empty",
+			state:       mockState,
+			submission: map[string]interface{}{
+				constants.DialogFieldNameTitle: " ",
+			},
+			expectedFieldErrors: map[string]string{constants.DialogFieldNameTitle: constants.PullRequestTitleRequired},
+			expectedStatusCode:  http.StatusBadRequest,
+		},
+		{
+			description: "CreatePullRequestFromDialog: active pull request already exists",
+			state:       mockState,
+			submission: map[string]interface{}{
+				constants.DialogFieldNameTitle: "mockTitle",
+			},
+			expectedPayload: &serializers.CreatePullRequestRequest{
+				SourceRefName: "refs/heads/feature/login",
+				TargetRefName: "refs/heads/main",
+				Title:         "mockTitle",
+			},
+			createStatusCode:   http.StatusConflict,
+			createErr:          errors.New("failed to create the pull request"),
+			expectedErr:        fmt.Errorf(constants.PullRequestAlreadyExists, "feature/login", "main"),
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			description: "CreatePullRequestFromDialog: error while creating the pull request",
+			state:       mockState,
+			submission: map[string]interface{}{
+				constants.DialogFieldNameTitle: "mockTitle",
+			},
+			expectedPayload: &serializers.CreatePullRequestRequest{
+				SourceRefName: "refs/heads/feature/login",
+				TargetRefName: "refs/heads/main",
+				Title:         "mockTitle",
+			},
+			createStatusCode:   http.StatusInternalServerError,
+			createErr:          errors.New("failed to create the pull request"),
+			expectedErr:        errors.New("failed to create the pull request"),
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			description:        "CreatePullRequestFromDialog: invalid state",
+			state:              "mockOrganization$mockProjectID",
+			expectedErr:        errors.New(constants.GenericErrorMessage),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, mockedClient)
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetAzureIdentityForMattermostUser", func(_ *Plugin, mattermostUserID string) *serializers.UserID {
+				return testCase.reviewerIdentities[mattermostUserID]
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "ResolveAzureIdentity", func(_ *Plugin, organization, value, mattermostUserID string) (*serializers.UserID, error) {
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, testutils.MockMattermostUserID, mattermostUserID)
+				return testCase.resolvedIdentities[value], nil
+			})
+			mockAPI.On("GetUser", "mockReviewerID1").Return(&model.User{Username: "mockReviewer1"}, nil)
+			mockAPI.On("GetUser", "mockReviewerID2").Return(&model.User{Username: "mockReviewer2"}, nil)
+
+			if testCase.expectedPayload != nil {
+				mockedClient.EXPECT().CreatePullRequest(testutils.MockOrganization, testutils.MockProjectID, "mockRepositoryID", testCase.expectedPayload, testutils.MockMattermostUserID).Return(&serializers.PullRequest{PullRequestID: 1}, testCase.createStatusCode, testCase.createErr)
+			}
+
+			pullRequest, fieldErrors, statusCode, err := p.CreatePullRequestFromDialog(testutils.MockMattermostUserID, testCase.state, testCase.submission)
+
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			assert.Equal(t, testCase.expectedFieldErrors, fieldErrors)
+			if testCase.expectedErr != nil {
+				assert.EqualError(t, err, testCase.expectedErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			if testCase.expectedErr != nil || testCase.expectedFieldErrors != nil {
+				assert.Nil(t, pullRequest)
+				return
+			}
+
+			assert.Equal(t, 1, pullRequest.PullRequestID)
+		})
+	}
+}
+
+func TestExecuteCreatePullRequestCommand(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	mockProject := &serializers.ProjectDetails{OrganizationName: testutils.MockOrganization, ProjectName: testutils.MockProjectName}
+	for _, testCase := range []struct {
+		description         string
+		command             string
+		linkedProject       *serializers.ProjectDetails
+		expectedArguments   map[string]string
+		expectGetRepository bool
+		statusCode          int
+		err                 error
+		openDialogErr       error
+		expectOpenDialog    bool
+		ephemeralMessage    string
+	}{
+		{
+			description:      "CreatePullRequestCommand: title is not provided",
+			command:          "/azuredevops repos pr create mockRepo feature/login main",
+			ephemeralMessage: constants.PullRequestCreateUsage,
+		},
+		{
+			description:      "CreatePullRequestCommand: invalid argument",
+			command:          `/azuredevops repos pr create mockRepo feature/login main "mockTitle" team=mockTeam`,
+			ephemeralMessage: constants.PullRequestCreateUsage,
+		},
+		{
+			description:       "CreatePullRequestCommand: project is not found",
+			command:           `/azuredevops repos pr create mockRepo feature/login main "mock title"`,
+			expectedArguments: map[string]string{},
+			ephemeralMessage:  constants.PullRequestCreateProjectRequired,
+		},
+		{
+			description:         "CreatePullRequestCommand: branch does not exist",
+			command:             `/azuredevops repos pr create mockRepo feature/login main "mock title" project=mockProjectName`,
+			linkedProject:       mockProject,
+			expectedArguments:   map[string]string{constants.PresetArgumentProject: testutils.MockProjectName},
+			expectGetRepository: true,
+			statusCode:          http.StatusBadRequest,
+			err:                 fmt.Errorf(constants.BranchNotFound, "feature/login", "mockRepo"),
+			ephemeralMessage:    fmt.Sprintf(constants.BranchNotFound, "feature/login", "mockRepo"),
+		},
+		{
+			description:         "CreatePullRequestCommand: error while opening the dialog",
+			command:             `/azuredevops repos pr create mockRepo feature/login main "mock title"`,
+			linkedProject:       mockProject,
+			expectedArguments:   map[string]string{},
+			expectGetRepository: true,
+			statusCode:          http.StatusOK,
+			expectOpenDialog:    true,
+			openDialogErr:       errors.New("failed to open the dialog"),
+			ephemeralMessage:    constants.GenericErrorMessage,
+		},
+		{
+			description:         "CreatePullRequestCommand: dialog is opened",
+			command:             `/azuredevops repos pr create mockRepo feature/login main "mock title"`,
+			linkedProject:       mockProject,
+			expectedArguments:   map[string]string{},
+			expectGetRepository: true,
+			statusCode:          http.StatusOK,
+			expectOpenDialog:    true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			if testCase.ephemeralMessage != "" {
+				mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+					post := args.Get(1).(*model.Post)
+					assert.Equal(t, testCase.ephemeralMessage, post.Message)
+				}).Once().Return(&model.Post{})
+			}
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return true
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetLinkedProjectFromArguments", func(_ *Plugin, _ string, arguments map[string]string) (*serializers.ProjectDetails, error) {
+				assert.Equal(t, testCase.expectedArguments, arguments)
+				return testCase.linkedProject, nil
+			})
+
+			isRepositoryFetched := false
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetPullRequestRepository", func(_ *Plugin, _, organization, projectName, repositoryName, sourceBranch, targetBranch string) (*serializers.GitRepository, int, error) {
+				isRepositoryFetched = true
+				assert.Equal(t, "mockRepo", repositoryName)
+				assert.Equal(t, "feature/login", sourceBranch)
+				assert.Equal(t, "main", targetBranch)
+				if testCase.err != nil {
+					return nil, testCase.statusCode, testCase.err
+				}
+				return &serializers.GitRepository{ID: "mockRepoID", Name: "mockRepo"}, testCase.statusCode, nil
+			})
+
+			isDialogOpened := false
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "OpenCreatePullRequestDialog", func(_ *Plugin, _, triggerID, organization string, repository *serializers.GitRepository, sourceBranch, targetBranch, title string) (int, error) {
+				isDialogOpened = true
+				assert.Equal(t, "mockTriggerID", triggerID)
+				assert.Equal(t, "mock title", title)
+				return http.StatusOK, testCase.openDialogErr
+			})
+
+			_, err := p.ExecuteCommand(nil, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID, TriggerId: "mockTriggerID"})
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectGetRepository, isRepositoryFetched)
+			assert.Equal(t, testCase.expectOpenDialog, isDialogOpened)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// getPullRequestSearchCriteria returns the search criteria of a pull request filter for the Azure DevOps identity of the user
+func (p *Plugin) getPullRequestSearchCriteria(mattermostUserID, filter string) (*serializers.PullRequestSearchCriteria, error) {
+	if filter != constants.PullRequestFilterMine && filter != constants.PullRequestFilterReview {
+		return nil, nil
+	}
+
+	azureDevopsUserID, err := p.Store.LoadAzureDevopsUserIDFromMattermostUser(mattermostUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter == constants.PullRequestFilterMine {
+		return &serializers.PullRequestSearchCriteria{CreatorID: azureDevopsUserID}, nil
+	}
+
+	return &serializers.PullRequestSearchCriteria{ReviewerID: azureDevopsUserID}, nil
+}
+
+// GetActivePullRequests returns the active pull requests of a project, or of one of its repositories, matching the filter
+func (p *Plugin) GetActivePullRequests(mattermostUserID, organization, project, repository, filter string) ([]*serializers.PullRequest, int, error) {
+	searchCriteria, err := p.getPullRequestSearchCriteria(mattermostUserID, filter)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	pullRequestList, statusCode, err := p.Client.GetPullRequests(organization, project, repository, searchCriteria, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	return pullRequestList.Value, statusCode, nil
+}
+
+// GetPullRequestCounts counts the active pull requests created by the user and the ones the user is a reviewer on across their linked projects.
+// The pull requests waiting for the review of the user are the non-draft ones the user has not voted on yet.
+func (p *Plugin) GetPullRequestCounts(mattermostUserID string) (*serializers.PullRequestCounts, error) {
+	projectList, err := p.Store.GetAllProjects(mattermostUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	azureDevopsUserID, err := p.Store.LoadAzureDevopsUserIDFromMattermostUser(mattermostUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := &serializers.PullRequestCounts{}
+	for _, project := range projectList {
+		// A project which can no longer be accessed should not prevent counting the pull requests of the other projects
+		reviewList, _, err := p.Client.GetPullRequests(project.OrganizationName, project.ProjectName, "", &serializers.PullRequestSearchCriteria{ReviewerID: azureDevopsUserID}, mattermostUserID)
+		if err != nil {
+			p.API.LogError(constants.ErrorFetchPullRequests, "Project", project.ProjectName, "Error", err.Error())
+			continue
+		}
+
+		for _, pullRequest := range reviewList.Value {
+			counts.Review++
+			if !pullRequest.IsDraft && getReviewerVote(pullRequest, azureDevopsUserID) == 0 {
+				counts.WaitingForReview++
+			}
+		}
+
+		mineList, _, err := p.Client.GetPullRequests(project.OrganizationName, project.ProjectName, "", &serializers.PullRequestSearchCriteria{CreatorID: azureDevopsUserID}, mattermostUserID)
+		if err != nil {
+			p.API.LogError(constants.ErrorFetchPullRequests, "Project", project.ProjectName, "Error", err.Error())
+			continue
+		}
+
+		counts.Mine += len(mineList.Value)
+	}
+
+	return counts, nil
+}
+
+func getReviewerVote(pullRequest *serializers.PullRequest, reviewerID string) int {
+	for _, reviewer := range pullRequest.Reviewers {
+		if reviewer.ID == reviewerID {
+			return reviewer.Vote
+		}
+	}
+
+	return 0
+}
+
+// getPullRequestPolicyStatus returns the names of the blocking policies which have not passed yet, or if all the policies of the pull request pass
+func (p *Plugin) getPullRequestPolicyStatus(organization string, pullRequest *serializers.PullRequest, mattermostUserID string) string {
+	policyEvaluations, _, err := p.Client.GetPolicyEvaluations(organization, pullRequest.Repository.Project.ID, pullRequest.PullRequestID, mattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchPolicyEvaluations, "Error", err.Error())
+		return "Unknown"
+	}
+
+	if len(policyEvaluations.Value) == 0 {
+		return "None"
+	}
+
+	var blockingPolicies []string
+	for _, evaluation := range policyEvaluations.Value {
+		if evaluation.IsBlocking() {
+			blockingPolicies = append(blockingPolicies, evaluation.Configuration.Type.DisplayName)
+		}
+	}
+
+	if len(blockingPolicies) == 0 {
+		return "Passing"
+	}
+
+	return "Waiting for " + strings.Join(blockingPolicies, ", ")
+}
+
+// getPullRequestPolicyStatuses returns the policy status of each pull request mapped by its ID.
+// The policy evaluations are fetched for several pull requests at the same time, at most constants.MaxConcurrentPolicyEvaluationRequests at once.
+func (p *Plugin) getPullRequestPolicyStatuses(organization string, pullRequests []*serializers.PullRequest, mattermostUserID string) map[int]string {
+	policyStatuses := make(map[int]string, len(pullRequests))
+	var policyStatusesLock sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, constants.MaxConcurrentPolicyEvaluationRequests)
+	for _, pullRequest := range pullRequests {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(pullRequest *serializers.PullRequest) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			policyStatus := p.getPullRequestPolicyStatus(organization, pullRequest, mattermostUserID)
+			policyStatusesLock.Lock()
+			policyStatuses[pullRequest.PullRequestID] = policyStatus
+			policyStatusesLock.Unlock()
+		}(pullRequest)
+	}
+
+	wg.Wait()
+	return policyStatuses
+}
+
+func getPullRequestMergeStatus(mergeStatus string) string {
+	switch mergeStatus {
+	case constants.PullRequestMergeStatusConflicts:
+		return ":warning: Conflicts"
+	case constants.PullRequestMergeStatusSucceeded:
+		return "No conflicts"
+	default:
+		return "-"
+	}
+}
+
+// formatPullRequestAge returns the time since the pull request was created in minutes, hours or days
+func formatPullRequestAge(creationDate *time.Time, now time.Time) string {
+	if creationDate == nil {
+		return "-"
+	}
+
+	return formatDuration(now.Sub(*creationDate))
+}
+
+// formatDuration returns a duration in whole minutes, hours or days
+func formatDuration(duration time.Duration) string {
+	switch {
+	case duration < time.Hour:
+		return fmt.Sprintf("%d minute(s)", int(duration.Minutes()))
+	case duration < 24*time.Hour:
+		return fmt.Sprintf("%d hour(s)", int(duration.Hours()))
+	default:
+		return fmt.Sprintf("%d day(s)", int(duration.Hours()/24))
+	}
+}
+
+// ParsePullRequestsToCommandResponse formats the active pull requests as a markdown table for each repository
+func (p *Plugin) ParsePullRequestsToCommandResponse(mattermostUserID, organization, project, filter string, pullRequests []*serializers.PullRequest) string {
+	pullRequestsByRepository := map[string][]*serializers.PullRequest{}
+	var repositories []string
+	for _, pullRequest := range pullRequests {
+		repository := pullRequest.Repository.Name
+		if _, ok := pullRequestsByRepository[repository]; !ok {
+			repositories = append(repositories, repository)
+		}
+		pullRequestsByRepository[repository] = append(pullRequestsByRepository[repository], pullRequest)
+	}
+
+	sort.Slice(repositories, func(i, j int) bool {
+		return strings.ToLower(repositories[i]) < strings.ToLower(repositories[j])
+	})
+
+	policyStatuses := p.getPullRequestPolicyStatuses(organization, pullRequests, mattermostUserID)
+
+	var sb strings.Builder
+	now := time.Now().UTC()
+	sb.WriteString(fmt.Sprintf(constants.PullRequestsTitle, filter, project))
+	for _, repository := range repositories {
+		sb.WriteString(fmt.Sprintf(constants.PullRequestsRepositoryTitle, repository))
+		for _, pullRequest := range pullRequestsByRepository[repository] {
+			title := fmt.Sprintf(
+				constants.PullRequestTitle,
+				pullRequest.PullRequestID,
+				pullRequest.Title,
+				fmt.Sprintf(constants.PullRequestWebURL, p.getConfiguration().AzureDevopsAPIBaseURL, organization, project, repository, pullRequest.PullRequestID),
+			)
+			if pullRequest.IsDraft {
+				title += " (draft)"
+			}
+
+			createdBy := "-"
+			if pullRequest.CreatedBy != nil {
+				createdBy = p.getAzureIdentityMention(pullRequest.CreatedBy)
+			}
+
+			sb.WriteString(fmt.Sprintf(
+				constants.PullRequestsItem,
+				title,
+				formatPullRequestAge(pullRequest.CreationDate, now),
+				createdBy,
+				p.getReviewersListString(pullRequest.Reviewers),
+				getPullRequestMergeStatus(pullRequest.MergeStatus),
+				policyStatuses[pullRequest.PullRequestID],
+			))
+		}
+	}
+
+	if len(pullRequests) >= constants.MaxActivePullRequests {
+		sb.WriteString(fmt.Sprintf(constants.PullRequestsLimitReached, constants.MaxActivePullRequests))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,277 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/config"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestFormatPullRequestAge(t *testing.T) {
+	now := time.Date(2022, time.January, 10, 12, 0, 0, 0, time.UTC)
+	for _, testCase := range []struct {
+		description  string
+		creationDate *time.Time
+		expectedAge  string
+	}{
+		{
+			description: "FormatPullRequestAge: creation date is not set",
+			expectedAge: "-",
+		},
+		{
+			description:  "FormatPullRequestAge: created minutes ago",
+			creationDate: func() *time.Time { t := now.Add(-5 * time.Minute); return &t }(),
+			expectedAge:  "5 minute(s)",
+		},
+		{
+			description:  "FormatPullRequestAge: created hours ago",
+			creationDate: func() *time.Time { t := now.Add(-90 * time.Minute); return &t }(),
+			expectedAge:  "1 hour(s)",
+		},
+		{
+			description:  "FormatPullRequestAge: created days ago",
+			creationDate: func() *time.Time { t := now.Add(-50 * time.Hour); return &t }(),
+			expectedAge:  "2 day(s)",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedAge, formatPullRequestAge(testCase.creationDate, now))
+		})
+	}
+}
+
+func TestGetActivePullRequests(t *testing.T) {
+	for _, testCase := range []struct {
+		description            string
+		filter                 string
+		expectedSearchCriteria *serializers.PullRequestSearchCriteria
+		err                    error
+		statusCode             int
+	}{
+		{
+			description: "GetActivePullRequests: all the pull requests",
+			filter:      constants.PullRequestFilterAll,
+			statusCode:  http.StatusOK,
+		},
+		{
+			description:            "GetActivePullRequests: pull requests created by the user",
+			filter:                 constants.PullRequestFilterMine,
+			expectedSearchCriteria: &serializers.PullRequestSearchCriteria{CreatorID: testutils.MockAzureDevopsUserID},
+			statusCode:             http.StatusOK,
+		},
+		{
+			description:            "GetActivePullRequests: pull requests the user is a reviewer on",
+			filter:                 constants.PullRequestFilterReview,
+			expectedSearchCriteria: &serializers.PullRequestSearchCriteria{ReviewerID: testutils.MockAzureDevopsUserID},
+			statusCode:             http.StatusOK,
+		},
+		{
+			description: "GetActivePullRequests: failed to get the pull requests",
+			filter:      constants.PullRequestFilterAll,
+			err:         errors.New("failed to get the pull requests"),
+			statusCode:  http.StatusForbidden,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(&plugintest.API{}, mockedStore, mockedClient)
+
+			if testCase.expectedSearchCriteria != nil {
+				mockedStore.EXPECT().LoadAzureDevopsUserIDFromMattermostUser(testutils.MockMattermostUserID).Return(testutils.MockAzureDevopsUserID, nil)
+			}
+			mockedClient.EXPECT().GetPullRequests(testutils.MockOrganization, testutils.MockProjectName, "mockRepository", testCase.expectedSearchCriteria, testutils.MockMattermostUserID).Return(&serializers.PullRequestList{
+				Count: 1,
+				Value: []*serializers.PullRequest{testutils.GetMockActivePullRequest(1, "mockRepository", false, nil)},
+			}, testCase.statusCode, testCase.err)
+
+			pullRequests, statusCode, err := p.GetActivePullRequests(testutils.MockMattermostUserID, testutils.MockOrganization, testutils.MockProjectName, "mockRepository", testCase.filter)
+
+			assert.Equal(t, testCase.statusCode, statusCode)
+			if testCase.err != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, pullRequests)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, pullRequests, 1)
+		})
+	}
+}
+
+func TestGetPullRequestCounts(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	mockCtrl := gomock.NewController(t)
+	mockedStore := mocks.NewMockKVStore(mockCtrl)
+	mockedClient := mocks.NewMockClient(mockCtrl)
+	p := setupMockPlugin(mockAPI, mockedStore, mockedClient)
+	mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 5)...)
+
+	otherProject := serializers.ProjectDetails{OrganizationName: testutils.MockOrganization, ProjectName: "mockOtherProject"}
+	mockedStore.EXPECT().GetAllProjects(testutils.MockMattermostUserID).Return(append(testutils.GetProjectDetailsPayload(), otherProject), nil)
+	mockedStore.EXPECT().LoadAzureDevopsUserIDFromMattermostUser(testutils.MockMattermostUserID).Return(testutils.MockAzureDevopsUserID, nil)
+
+	reviewSearchCriteria := &serializers.PullRequestSearchCriteria{ReviewerID: testutils.MockAzureDevopsUserID}
+	mineSearchCriteria := &serializers.PullRequestSearchCriteria{CreatorID: testutils.MockAzureDevopsUserID}
+	mockedClient.EXPECT().GetPullRequests(testutils.MockOrganization, testutils.MockProjectName, "", reviewSearchCriteria, testutils.MockMattermostUserID).Return(&serializers.PullRequestList{
+		Value: []*serializers.PullRequest{
+			testutils.GetMockActivePullRequest(1, "mockRepository", false, []serializers.Reviewer{{ID: testutils.MockAzureDevopsUserID}}),
+			testutils.GetMockActivePullRequest(2, "mockRepository", false, []serializers.Reviewer{{ID: testutils.MockAzureDevopsUserID, Vote: constants.PullRequestVoteApproved}}),
+			testutils.GetMockActivePullRequest(3, "mockRepository", true, []serializers.Reviewer{{ID: testutils.MockAzureDevopsUserID}}),
+		},
+	}, http.StatusOK, nil)
+	mockedClient.EXPECT().GetPullRequests(testutils.MockOrganization, testutils.MockProjectName, "", mineSearchCriteria, testutils.MockMattermostUserID).Return(&serializers.PullRequestList{
+		Value: []*serializers.PullRequest{testutils.GetMockActivePullRequest(4, "mockRepository", false, nil)},
+	}, http.StatusOK, nil)
+	mockedClient.EXPECT().GetPullRequests(testutils.MockOrganization, "mockOtherProject", "", reviewSearchCriteria, testutils.MockMattermostUserID).Return(nil, http.StatusNotFound, errors.New("failed to get the pull requests"))
+
+	counts, err := p.GetPullRequestCounts(testutils.MockMattermostUserID)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &serializers.PullRequestCounts{WaitingForReview: 1, Review: 3, Mine: 1}, counts)
+}
+
+func TestParsePullRequestsToCommandResponse(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	mockCtrl := gomock.NewController(t)
+	mockedClient := mocks.NewMockClient(mockCtrl)
+	p := setupMockPlugin(mockAPI, nil, mockedClient)
+	p.setConfiguration(&config.Configuration{AzureDevopsAPIBaseURL: "https://dev.azure.com"})
+	mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+
+	creationDate := time.Now().UTC().Add(-50 * time.Hour)
+	conflicting := testutils.GetMockActivePullRequest(1, "mockRepository", false, []serializers.Reviewer{{DisplayName: "mockReviewer", Vote: constants.PullRequestVoteApproved}})
+	conflicting.MergeStatus = constants.PullRequestMergeStatusConflicts
+	conflicting.CreationDate = &creationDate
+	conflicting.CreatedBy = &serializers.UserID{DisplayName: "mockCreator"}
+	draft := testutils.GetMockActivePullRequest(2, "mockRepository", true, nil)
+	draft.MergeStatus = constants.PullRequestMergeStatusSucceeded
+	other := testutils.GetMockActivePullRequest(3, "anotherRepository", false, nil)
+
+	mockedClient.EXPECT().GetPolicyEvaluations(testutils.MockOrganization, testutils.MockProjectID, 1, testutils.MockMattermostUserID).Return(&serializers.PolicyEvaluationList{
+		Value: []*serializers.PolicyEvaluation{
+			{Status: "queued", Configuration: serializers.PolicyConfiguration{IsEnabled: true, IsBlocking: true, Type: serializers.PolicyType{DisplayName: "Build"}}},
+			{Status: constants.PolicyEvaluationStatusApproved, Configuration: serializers.PolicyConfiguration{IsEnabled: true, IsBlocking: true, Type: serializers.PolicyType{DisplayName: "Minimum number of reviewers"}}},
+		},
+	}, http.StatusOK, nil)
+	mockedClient.EXPECT().GetPolicyEvaluations(testutils.MockOrganization, testutils.MockProjectID, 2, testutils.MockMattermostUserID).Return(&serializers.PolicyEvaluationList{
+		Value: []*serializers.PolicyEvaluation{
+			{Status: constants.PolicyEvaluationStatusApproved, Configuration: serializers.PolicyConfiguration{IsEnabled: true, IsBlocking: true, Type: serializers.PolicyType{DisplayName: "Build"}}},
+		},
+	}, http.StatusOK, nil)
+	mockedClient.EXPECT().GetPolicyEvaluations(testutils.MockOrganization, testutils.MockProjectID, 3, testutils.MockMattermostUserID).Return(nil, http.StatusForbidden, errors.New("failed to get the policy evaluations of the pull request"))
+
+	expected := "###### Active pull requests (all) | mockProjectName\n" +
+		"\n##### anotherRepository\n" +
+		"| Pull request | Age | Created by | Reviewer(s) | Merge | Policies |\n" +
+		"| :----------- | :-- | :--------- | :---------- | :---- | :------- |\n" +
+		"| [#3: mockTitle](https://dev.azure.com/mockOrganization/mockProjectName/_git/anotherRepository/pullrequest/3) | - | - | None | - | Unknown |\n" +
+		"\n##### mockRepository\n" +
+		"| Pull request | Age | Created by | Reviewer(s) | Merge | Policies |\n" +
+		"| :----------- | :-- | :--------- | :---------- | :---- | :------- |\n" +
+		"| [#1: mockTitle](https://dev.azure.com/mockOrganization/mockProjectName/_git/mockRepository/pullrequest/1) | 2 day(s) | mockCreator | &#9989; mockReviewer | :warning: Conflicts | Waiting for Build |\n" +
+		"| [#2: mockTitle](https://dev.azure.com/mockOrganization/mockProjectName/_git/mockRepository/pullrequest/2) (draft) | - | - | None | No conflicts | Passing |\n"
+	assert.Equal(t, expected, p.ParsePullRequestsToCommandResponse(testutils.MockMattermostUserID, testutils.MockOrganization, testutils.MockProjectName, constants.PullRequestFilterAll, []*serializers.PullRequest{conflicting, draft, other}))
+}
+
+func TestAzureDevopsPullRequestsCommand(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	mockCtrl := gomock.NewController(t)
+	mockedStore := mocks.NewMockKVStore(mockCtrl)
+	p := setupMockPlugin(mockAPI, mockedStore, nil)
+	mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+	for _, testCase := range []struct {
+		description        string
+		command            string
+		linkedProjects     []serializers.ProjectDetails
+		expectedRepository string
+		expectedFilter     string
+		pullRequests       []*serializers.PullRequest
+		err                error
+		expectedMessage    string
+	}{
+		{
+			description:     "PullRequestsCommand: project is not linked",
+			command:         "/azuredevops repos prs mockOtherProject mine",
+			linkedProjects:  testutils.GetProjectDetailsPayload(),
+			expectedMessage: constants.PullRequestsProjectRequired,
+		},
+		{
+			description:     "PullRequestsCommand: no active pull requests of the single linked project",
+			command:         "/azuredevops repos prs",
+			linkedProjects:  testutils.GetProjectDetailsPayload(),
+			expectedFilter:  constants.PullRequestFilterAll,
+			pullRequests:    []*serializers.PullRequest{},
+			expectedMessage: fmt.Sprintf(constants.NoActivePullRequests, testutils.MockProjectName),
+		},
+		{
+			description:        "PullRequestsCommand: no active pull requests of the repository to review",
+			command:            "/azuredevops repos prs mockProjectName mockRepository review",
+			linkedProjects:     testutils.GetProjectDetailsPayload(),
+			expectedRepository: "mockRepository",
+			expectedFilter:     constants.PullRequestFilterReview,
+			pullRequests:       []*serializers.PullRequest{},
+			expectedMessage:    fmt.Sprintf(constants.NoActivePullRequests, "mockProjectName / mockRepository"),
+		},
+		{
+			description:     "PullRequestsCommand: active pull requests created by the user",
+			command:         "/azuredevops repos prs mine",
+			linkedProjects:  testutils.GetProjectDetailsPayload(),
+			expectedFilter:  constants.PullRequestFilterMine,
+			pullRequests:    []*serializers.PullRequest{testutils.GetMockActivePullRequest(1, "mockRepository", false, nil)},
+			expectedMessage: "mockPullRequests",
+		},
+		{
+			description:     "PullRequestsCommand: failed to get the pull requests",
+			command:         "/azuredevops repos prs",
+			linkedProjects:  testutils.GetProjectDetailsPayload(),
+			expectedFilter:  constants.PullRequestFilterAll,
+			err:             errors.New("failed to get the pull requests"),
+			expectedMessage: constants.GenericErrorMessage,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				assert.Equal(t, testCase.expectedMessage, args.Get(1).(*model.Post).Message)
+			}).Once().Return(&model.Post{})
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return true
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetActivePullRequests", func(_ *Plugin, _, organization, project, repository, filter string) ([]*serializers.PullRequest, int, error) {
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, testutils.MockProjectName, project)
+				assert.Equal(t, testCase.expectedRepository, repository)
+				assert.Equal(t, testCase.expectedFilter, filter)
+				return testCase.pullRequests, http.StatusOK, testCase.err
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "ParsePullRequestsToCommandResponse", func(_ *Plugin, _, _, _, _ string, _ []*serializers.PullRequest) string {
+				return "mockPullRequests"
+			})
+
+			mockedStore.EXPECT().GetAllProjects(testutils.MockMattermostUserID).Return(testCase.linkedProjects, nil)
+
+			_, err := p.ExecuteCommand(&plugin.Context{}, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID})
+			assert.Nil(t, err)
+		})
+	}
+}
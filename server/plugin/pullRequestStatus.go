@@ -0,0 +1,126 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// UpdatePullRequestStatus completes, abandons, reactivates or sets auto-complete on a pull request as the user.
+// When the completion of the pull request is blocked by branch policies, the names of the blocking policies are returned with http.StatusConflict.
+func (p *Plugin) UpdatePullRequestStatus(mattermostUserID, organization, project, pullRequestID, action string, options *serializers.PullRequestCompletionOptions) (*serializers.PullRequest, []string, int, error) {
+	pullRequest, statusCode, err := p.Client.GetPullRequest(organization, pullRequestID, project, mattermostUserID)
+	if err != nil {
+		return nil, nil, statusCode, err
+	}
+
+	payload := &serializers.UpdatePullRequestRequest{}
+	switch action {
+	case constants.CommandComplete:
+		if pullRequest.Status != constants.PullRequestStatusActive {
+			return pullRequest, nil, http.StatusBadRequest, fmt.Errorf(constants.PullRequestNotActive, pullRequest.PullRequestID)
+		}
+
+		blockingPolicies, statusCode, err := p.getBlockingPolicies(organization, pullRequest, mattermostUserID)
+		if err != nil {
+			return pullRequest, nil, statusCode, err
+		}
+
+		if len(blockingPolicies) > 0 {
+			return pullRequest, blockingPolicies, http.StatusConflict, errors.New(constants.ErrorPullRequestBlockedByPolicies)
+		}
+
+		payload.Status = constants.PullRequestStatusCompleted
+		payload.CompletionOptions = options
+		if pullRequest.LastMergeSourceCommit != nil {
+			payload.LastMergeSourceCommit = &serializers.PullRequestCommitRef{CommitID: pullRequest.LastMergeSourceCommit.CommitID}
+		}
+	case constants.CommandAutoComplete:
+		if pullRequest.Status != constants.PullRequestStatusActive {
+			return pullRequest, nil, http.StatusBadRequest, fmt.Errorf(constants.PullRequestNotActive, pullRequest.PullRequestID)
+		}
+
+		azureDevopsUserID, err := p.Store.LoadAzureDevopsUserIDFromMattermostUser(mattermostUserID)
+		if err != nil {
+			return pullRequest, nil, http.StatusInternalServerError, err
+		}
+
+		payload.AutoCompleteSetBy = &serializers.PullRequestIdentityRef{ID: azureDevopsUserID}
+		payload.CompletionOptions = options
+	case constants.CommandAbandon:
+		if pullRequest.Status != constants.PullRequestStatusActive {
+			return pullRequest, nil, http.StatusBadRequest, fmt.Errorf(constants.PullRequestNotActive, pullRequest.PullRequestID)
+		}
+
+		payload.Status = constants.PullRequestStatusAbandoned
+	case constants.CommandReactivate:
+		if pullRequest.Status != constants.PullRequestStatusAbandoned {
+			return pullRequest, nil, http.StatusBadRequest, fmt.Errorf(constants.PullRequestNotAbandoned, pullRequest.PullRequestID)
+		}
+
+		payload.Status = constants.PullRequestStatusActive
+	default:
+		return pullRequest, nil, http.StatusBadRequest, fmt.Errorf(constants.InvalidCommandArguments, action)
+	}
+
+	updatedPullRequest, statusCode, err := p.Client.UpdatePullRequest(organization, pullRequest.Repository.Project.ID, pullRequest.Repository.ID, pullRequest.PullRequestID, payload, mattermostUserID)
+	if err != nil {
+		return pullRequest, nil, statusCode, err
+	}
+
+	return updatedPullRequest, nil, statusCode, nil
+}
+
+// getBlockingPolicies returns the names of the enabled blocking policies which have not passed yet e.g. the minimum number of reviewers or a build
+func (p *Plugin) getBlockingPolicies(organization string, pullRequest *serializers.PullRequest, mattermostUserID string) ([]string, int, error) {
+	policyEvaluations, statusCode, err := p.Client.GetPolicyEvaluations(organization, pullRequest.Repository.Project.ID, pullRequest.PullRequestID, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	var blockingPolicies []string
+	for _, evaluation := range policyEvaluations.Value {
+		if evaluation.IsBlocking() {
+			blockingPolicies = append(blockingPolicies, evaluation.Configuration.Type.DisplayName)
+		}
+	}
+
+	return blockingPolicies, statusCode, nil
+}
+
+// getPullRequestStatusMessage returns the message shown to the user after the status of a pull request is updated
+func (p *Plugin) getPullRequestStatusMessage(organization, action string, pullRequest *serializers.PullRequest, blockingPolicies []string) string {
+	pullRequestLink := fmt.Sprintf(
+		constants.PullRequestTitle,
+		pullRequest.PullRequestID,
+		pullRequest.Title,
+		fmt.Sprintf(constants.PullRequestWebURL, p.getConfiguration().AzureDevopsAPIBaseURL, organization, pullRequest.Repository.Project.Name, pullRequest.Repository.Name, pullRequest.PullRequestID),
+	)
+
+	if len(blockingPolicies) > 0 {
+		var sb strings.Builder
+		for _, policy := range blockingPolicies {
+			sb.WriteString(fmt.Sprintf(constants.PullRequestBlockingPolicy, policy))
+		}
+		return fmt.Sprintf(constants.PullRequestBlockedByPolicies, pullRequestLink, sb.String(), pullRequest.PullRequestID)
+	}
+
+	switch action {
+	case constants.CommandComplete:
+		if pullRequest.Status == constants.PullRequestStatusCompleted {
+			return fmt.Sprintf(constants.PullRequestCompleted, pullRequestLink)
+		}
+		return fmt.Sprintf(constants.PullRequestCompletionQueued, pullRequestLink)
+	case constants.CommandAutoComplete:
+		return fmt.Sprintf(constants.PullRequestAutoCompleteSet, pullRequestLink)
+	case constants.CommandAbandon:
+		return fmt.Sprintf(constants.PullRequestAbandoned, pullRequestLink)
+	default:
+		return fmt.Sprintf(constants.PullRequestReactivated, pullRequestLink)
+	}
+}
@@ -0,0 +1,387 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/config"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestUpdatePullRequestStatus(t *testing.T) {
+	for _, testCase := range []struct {
+		description              string
+		action                   string
+		status                   string
+		options                  *serializers.PullRequestCompletionOptions
+		policyEvaluations        []*serializers.PolicyEvaluation
+		updateStatusCode         int
+		updateErr                error
+		expectedPayload          *serializers.UpdatePullRequestRequest
+		expectedBlockingPolicies []string
+		expectedStatusCode       int
+		expectedErr              string
+	}{
+		{
+			description: "UpdatePullRequestStatus: complete",
+			action:      constants.CommandComplete,
+			status:      constants.PullRequestStatusActive,
+			options:     &serializers.PullRequestCompletionOptions{MergeStrategy: constants.PullRequestMergeStrategySquash, DeleteSourceBranch: true},
+			policyEvaluations: []*serializers.PolicyEvaluation{
+				{Status: constants.PolicyEvaluationStatusApproved, Configuration: serializers.PolicyConfiguration{IsEnabled: true, IsBlocking: true, Type: serializers.PolicyType{DisplayName: "Minimum number of reviewers"}}},
+				{Status: "rejected", Configuration: serializers.PolicyConfiguration{IsEnabled: true, Type: serializers.PolicyType{DisplayName: "Comment requirements"}}},
+			},
+			expectedPayload: &serializers.UpdatePullRequestRequest{
+				Status:                constants.PullRequestStatusCompleted,
+				LastMergeSourceCommit: &serializers.PullRequestCommitRef{CommitID: "mockCommitID"},
+				CompletionOptions:     &serializers.PullRequestCompletionOptions{MergeStrategy: constants.PullRequestMergeStrategySquash, DeleteSourceBranch: true},
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description: "UpdatePullRequestStatus: complete is blocked by policies",
+			action:      constants.CommandComplete,
+			status:      constants.PullRequestStatusActive,
+			policyEvaluations: []*serializers.PolicyEvaluation{
+				{Status: "queued", Configuration: serializers.PolicyConfiguration{IsEnabled: true, IsBlocking: true, Type: serializers.PolicyType{DisplayName: "Build"}}},
+				{Status: constants.PolicyEvaluationStatusNotApplicable, Configuration: serializers.PolicyConfiguration{IsEnabled: true, IsBlocking: true, Type: serializers.PolicyType{DisplayName: "Work item linking"}}},
+				{Status: "rejected", Configuration: serializers.PolicyConfiguration{IsEnabled: true, IsBlocking: true, Type: serializers.PolicyType{DisplayName: "Minimum number of reviewers"}}},
+			},
+			expectedBlockingPolicies: []string{"Build", "Minimum number of reviewers"},
+			expectedStatusCode:       http.StatusConflict,
+			expectedErr:              constants.ErrorPullRequestBlockedByPolicies,
+		},
+		{
+			description:        "UpdatePullRequestStatus: complete a pull request which is not active",
+			action:             constants.CommandComplete,
+			status:             constants.PullRequestStatusCompleted,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "Pull request #1 is not active",
+		},
+		{
+			description: "UpdatePullRequestStatus: autocomplete",
+			action:      constants.CommandAutoComplete,
+			status:      constants.PullRequestStatusActive,
+			options:     &serializers.PullRequestCompletionOptions{TransitionWorkItems: true},
+			expectedPayload: &serializers.UpdatePullRequestRequest{
+				AutoCompleteSetBy: &serializers.PullRequestIdentityRef{ID: "mockAzureDevopsUserID"},
+				CompletionOptions: &serializers.PullRequestCompletionOptions{TransitionWorkItems: true},
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "UpdatePullRequestStatus: abandon",
+			action:             constants.CommandAbandon,
+			status:             constants.PullRequestStatusActive,
+			expectedPayload:    &serializers.UpdatePullRequestRequest{Status: constants.PullRequestStatusAbandoned},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "UpdatePullRequestStatus: abandon without permission",
+			action:             constants.CommandAbandon,
+			status:             constants.PullRequestStatusActive,
+			expectedPayload:    &serializers.UpdatePullRequestRequest{Status: constants.PullRequestStatusAbandoned},
+			updateStatusCode:   http.StatusForbidden,
+			updateErr:          errors.New("failed to update the pull request"),
+			expectedStatusCode: http.StatusForbidden,
+			expectedErr:        "failed to update the pull request",
+		},
+		{
+			description:        "UpdatePullRequestStatus: reactivate",
+			action:             constants.CommandReactivate,
+			status:             constants.PullRequestStatusAbandoned,
+			expectedPayload:    &serializers.UpdatePullRequestRequest{Status: constants.PullRequestStatusActive},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "UpdatePullRequestStatus: reactivate a pull request which is not abandoned",
+			action:             constants.CommandReactivate,
+			status:             constants.PullRequestStatusActive,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedErr:        "Pull request #1 is not abandoned",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(&plugintest.API{}, mockedStore, mockedClient)
+
+			mockedClient.EXPECT().GetPullRequest(testutils.MockOrganization, "1", testutils.MockProjectName, testutils.MockMattermostUserID).Return(testutils.GetMockPullRequest(testCase.status), http.StatusOK, nil)
+			if testCase.policyEvaluations != nil {
+				mockedClient.EXPECT().GetPolicyEvaluations(testutils.MockOrganization, testutils.MockProjectID, 1, testutils.MockMattermostUserID).Return(&serializers.PolicyEvaluationList{Value: testCase.policyEvaluations}, http.StatusOK, nil)
+			}
+			if testCase.action == constants.CommandAutoComplete {
+				mockedStore.EXPECT().LoadAzureDevopsUserIDFromMattermostUser(testutils.MockMattermostUserID).Return("mockAzureDevopsUserID", nil)
+			}
+			if testCase.expectedPayload != nil {
+				statusCode := testCase.updateStatusCode
+				if statusCode == 0 {
+					statusCode = http.StatusOK
+				}
+				mockedClient.EXPECT().UpdatePullRequest(testutils.MockOrganization, testutils.MockProjectID, "mockRepositoryID", 1, testCase.expectedPayload, testutils.MockMattermostUserID).Return(testutils.GetMockPullRequest(testCase.expectedPayload.Status), statusCode, testCase.updateErr)
+			}
+
+			pullRequest, blockingPolicies, statusCode, err := p.UpdatePullRequestStatus(testutils.MockMattermostUserID, testutils.MockOrganization, testutils.MockProjectName, "1", testCase.action, testCase.options)
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			assert.Equal(t, testCase.expectedBlockingPolicies, blockingPolicies)
+			if testCase.expectedErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErr, err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, pullRequest)
+		})
+	}
+}
+
+func TestGetPullRequestStatusMessage(t *testing.T) {
+	p := setupMockPlugin(&plugintest.API{}, nil, nil)
+	p.setConfiguration(&config.Configuration{AzureDevopsAPIBaseURL: "https://dev.azure.com"})
+	pullRequestLink := "[#1: mockTitle](https://dev.azure.com/mockOrganization/mockProjectName/_git/mockRepository/pullrequest/1)"
+
+	assert.Equal(t, "Pull request "+pullRequestLink+" is completed.", p.getPullRequestStatusMessage(testutils.MockOrganization, constants.CommandComplete, testutils.GetMockPullRequest(constants.PullRequestStatusCompleted), nil))
+	assert.Equal(t, "Pull request "+pullRequestLink+" is queued for completion.", p.getPullRequestStatusMessage(testutils.MockOrganization, constants.CommandComplete, testutils.GetMockPullRequest(constants.PullRequestStatusActive), nil))
+	assert.Equal(t, "Pull request "+pullRequestLink+" is abandoned.", p.getPullRequestStatusMessage(testutils.MockOrganization, constants.CommandAbandon, testutils.GetMockPullRequest(constants.PullRequestStatusAbandoned), nil))
+	assert.Equal(t,
+		"Pull request "+pullRequestLink+" can not be completed until the following policies pass:\n* Build\n* Minimum number of reviewers\nUse `/azuredevops repos pr autocomplete 1` to complete it automatically once they pass.",
+		p.getPullRequestStatusMessage(testutils.MockOrganization, constants.CommandComplete, testutils.GetMockPullRequest(constants.PullRequestStatusActive), []string{"Build", "Minimum number of reviewers"}),
+	)
+}
+
+func TestGetPullRequestActions(t *testing.T) {
+	p := setupMockPlugin(&plugintest.API{}, nil, nil)
+
+	actions := p.getPullRequestActions("mockOrganization", "mockProjectID", "mockRepositoryID", 1, constants.PullRequestStatusActive)
+	require.Len(t, actions, 5)
+	assert.Equal(t, model.POST_ACTION_TYPE_SELECT, actions[4].Type)
+	assert.Len(t, actions[4].Options, 3)
+
+	actions = p.getPullRequestActions("mockOrganization", "mockProjectID", "mockRepositoryID", 1, constants.PullRequestStatusAbandoned)
+	require.Len(t, actions, 1)
+	assert.Equal(t, constants.CommandReactivate, actions[0].Integration.Context[constants.PullRequestContextAction])
+
+	assert.Nil(t, p.getPullRequestActions("mockOrganization", "mockProjectID", "mockRepositoryID", 1, constants.PullRequestStatusCompleted))
+}
+
+func TestHandlePullRequestAction(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description           string
+		context               map[string]interface{}
+		openDialogErr         error
+		updateStatusCode      int
+		updateErr             error
+		expectedStatusCode    int
+		expectedEphemeralText string
+	}{
+		{
+			description:        "HandlePullRequestAction: complete opens the dialog",
+			context:            map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "pullRequestId": 1, "selected_option": constants.CommandComplete},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:           "HandlePullRequestAction: error in opening the dialog",
+			context:               map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "pullRequestId": 1, "selected_option": constants.CommandAutoComplete},
+			openDialogErr:         errors.New("failed to open the dialog"),
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: constants.GenericErrorMessage,
+		},
+		{
+			description:           "HandlePullRequestAction: abandon",
+			context:               map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "pullRequestId": 1, "selected_option": constants.CommandAbandon},
+			updateStatusCode:      http.StatusOK,
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: "Pull request [#1: mockTitle](https://dev.azure.com/mockOrganization/mockProjectName/_git/mockRepository/pullrequest/1) is abandoned.",
+		},
+		{
+			description:           "HandlePullRequestAction: reactivate without permission",
+			context:               map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "pullRequestId": 1, "action": constants.CommandReactivate},
+			updateStatusCode:      http.StatusForbidden,
+			updateErr:             errors.New("failed to update the pull request"),
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: "Looks like you do not have permission to update the pull request #1.",
+		},
+		{
+			description:           "HandlePullRequestAction: pull request is not in the required status",
+			context:               map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "pullRequestId": 1, "action": constants.CommandReactivate},
+			updateStatusCode:      http.StatusBadRequest,
+			updateErr:             errors.New("Pull request #1 is not abandoned"),
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: "Pull request #1 is not abandoned",
+		},
+		{
+			description:        "HandlePullRequestAction: missing action",
+			context:            map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "pullRequestId": 1},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+			p.setConfiguration(&config.Configuration{AzureDevopsAPIBaseURL: "https://dev.azure.com"})
+
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			mockedClient.EXPECT().OpenDialogRequest(gomock.Any(), testutils.MockMattermostUserID).Return(http.StatusOK, testCase.openDialogErr).AnyTimes()
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdatePullRequestStatus", func(_ *Plugin, _, _, _, _, _ string, _ *serializers.PullRequestCompletionOptions) (*serializers.PullRequest, []string, int, error) {
+				return testutils.GetMockPullRequest(constants.PullRequestStatusActive), nil, testCase.updateStatusCode, testCase.updateErr
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdatePullRequestReviewersPost", func(_ *Plugin, _, _ string, _ int, postID, _ string) error {
+				assert.Equal(t, "mockPostID", postID)
+				return nil
+			})
+
+			body, err := json.Marshal(&model.PostActionIntegrationRequest{PostId: "mockPostID", Context: testCase.context})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, constants.PathPullRequestAction, bytes.NewBuffer(body))
+			req.Header.Add(constants.HeaderMattermostUserID, testutils.MockMattermostUserID)
+
+			w := httptest.NewRecorder()
+			p.handlePullRequestAction(w, req)
+			resp := w.Result()
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			if testCase.expectedStatusCode == http.StatusOK {
+				response := model.PostActionIntegrationResponseFromJson(resp.Body)
+				require.NotNil(t, response)
+				assert.Equal(t, testCase.expectedEphemeralText, response.EphemeralText)
+			}
+		})
+	}
+}
+
+func TestAzureDevopsUpdatePullRequestStatusCommand(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description       string
+		command           string
+		linkedProjects    []serializers.ProjectDetails
+		expectedProject   string
+		expectedOptions   *serializers.PullRequestCompletionOptions
+		updateStatusCode  int
+		updateErr         error
+		blockingPolicies  []string
+		expectedMessage   string
+		expectUpdateCalls bool
+	}{
+		{
+			description:     "PullRequestStatusCommand: missing pull request",
+			command:         "/azuredevops repos pr abandon",
+			expectedMessage: constants.PullRequestRequired,
+		},
+		{
+			description:     "PullRequestStatusCommand: invalid pull request",
+			command:         "/azuredevops repos pr abandon abc",
+			expectedMessage: constants.PullRequestRequired,
+		},
+		{
+			description:     "PullRequestStatusCommand: invalid completion option",
+			command:         "/azuredevops repos pr complete 1 --merge-strategy fastForward",
+			expectedMessage: fmt.Sprintf(constants.InvalidCommandArguments, `invalid merge strategy "fastForward", merge strategy must be one of noFastForward, squash, rebase or rebaseMerge`),
+		},
+		{
+			description:     "PullRequestStatusCommand: pull request ID without a single linked project",
+			command:         "/azuredevops repos pr abandon 1",
+			linkedProjects:  []serializers.ProjectDetails{},
+			expectedMessage: constants.PullRequestProjectRequired,
+		},
+		{
+			description:       "PullRequestStatusCommand: complete pull request ID of the linked project",
+			command:           "/azuredevops repos pr complete 1 --merge-strategy squash --delete-source-branch",
+			linkedProjects:    testutils.GetProjectDetailsPayload(),
+			expectedProject:   testutils.MockProjectName,
+			expectedOptions:   &serializers.PullRequestCompletionOptions{MergeStrategy: constants.PullRequestMergeStrategySquash, DeleteSourceBranch: true},
+			updateStatusCode:  http.StatusOK,
+			expectedMessage:   "Pull request [#1: mockTitle](https://dev.azure.com/mockOrganization/mockProjectName/_git/mockRepository/pullrequest/1) is queued for completion.",
+			expectUpdateCalls: true,
+		},
+		{
+			description:       "PullRequestStatusCommand: complete is blocked by policies",
+			command:           "/azuredevops repos pr complete https://dev.azure.com/mockOrganization/mockProject/_git/mockRepository/pullrequest/1",
+			expectedProject:   "mockProject",
+			expectedOptions:   &serializers.PullRequestCompletionOptions{},
+			updateStatusCode:  http.StatusConflict,
+			updateErr:         errors.New(constants.ErrorPullRequestBlockedByPolicies),
+			blockingPolicies:  []string{"Build"},
+			expectedMessage:   "Pull request [#1: mockTitle](https://dev.azure.com/mockOrganization/mockProjectName/_git/mockRepository/pullrequest/1) can not be completed until the following policies pass:\n* Build\nUse `/azuredevops repos pr autocomplete 1` to complete it automatically once they pass.",
+			expectUpdateCalls: true,
+		},
+		{
+			description:       "PullRequestStatusCommand: abandon without permission",
+			command:           "/azuredevops repos pr abandon https://dev.azure.com/mockOrganization/mockProject/_git/mockRepository/pullrequest/1",
+			expectedProject:   "mockProject",
+			updateStatusCode:  http.StatusForbidden,
+			updateErr:         errors.New("failed to update the pull request"),
+			expectedMessage:   "Looks like you do not have permission to update the pull request #1.",
+			expectUpdateCalls: true,
+		},
+		{
+			description:       "PullRequestStatusCommand: error in updating the pull request",
+			command:           "/azuredevops repos pr reactivate https://dev.azure.com/mockOrganization/mockProject/_git/mockRepository/pullrequest/1",
+			expectedProject:   "mockProject",
+			updateStatusCode:  http.StatusInternalServerError,
+			updateErr:         errors.New("failed to update the pull request"),
+			expectedMessage:   constants.GenericErrorMessage,
+			expectUpdateCalls: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+			p.setConfiguration(&config.Configuration{AzureDevopsAPIBaseURL: "https://dev.azure.com"})
+
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				assert.Equal(t, testCase.expectedMessage, args.Get(1).(*model.Post).Message)
+			}).Once().Return(&model.Post{})
+
+			if testCase.linkedProjects != nil {
+				mockedStore.EXPECT().GetAllProjects(testutils.MockMattermostUserID).Return(testCase.linkedProjects, nil)
+			}
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return true
+			})
+			updateCalls := 0
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdatePullRequestStatus", func(_ *Plugin, _, organization, project, pullRequestID, _ string, options *serializers.PullRequestCompletionOptions) (*serializers.PullRequest, []string, int, error) {
+				updateCalls++
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, testCase.expectedProject, project)
+				assert.Equal(t, "1", pullRequestID)
+				assert.Equal(t, testCase.expectedOptions, options)
+				return testutils.GetMockPullRequest(constants.PullRequestStatusActive), testCase.blockingPolicies, testCase.updateStatusCode, testCase.updateErr
+			})
+
+			res, err := p.ExecuteCommand(&plugin.Context{}, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID, ChannelId: testutils.MockChannelID})
+			assert.Nil(t, err)
+			assert.NotNil(t, res)
+			assert.Equal(t, testCase.expectUpdateCalls, updateCalls == 1)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// getPullRequestThreadForComment returns the pull request thread of a comment notification, or nil if the thread can not be identified
+func getPullRequestThreadForComment(pullRequest serializers.PullRequest, comment *serializers.Comment) *serializers.PullRequestThreadReference {
+	// The URL of the pull request is of the form "https://dev.azure.com/{organization}/{projectID}/_apis/git/repositories/{repositoryID}/pullRequests/{pullRequestID}"
+	urlPaths := strings.Split(pullRequest.URL, "/")
+	threadID := comment.GetThreadID()
+	if len(urlPaths) < 4 || threadID == 0 || pullRequest.Repository.ID == "" {
+		return nil
+	}
+
+	return &serializers.PullRequestThreadReference{
+		Organization:  urlPaths[3],
+		ProjectID:     pullRequest.Repository.Project.ID,
+		RepositoryID:  pullRequest.Repository.ID,
+		PullRequestID: pullRequest.PullRequestID,
+		ThreadID:      threadID,
+		CommentID:     comment.ID,
+	}
+}
+
+// setPullRequestThreadProps stores the pull request thread of a comment notification in the props of its post
+func setPullRequestThreadProps(post *model.Post, thread *serializers.PullRequestThreadReference) {
+	post.AddProp(constants.PostPropPullRequestOrganization, thread.Organization)
+	post.AddProp(constants.PostPropPullRequestProjectID, thread.ProjectID)
+	post.AddProp(constants.PostPropPullRequestRepositoryID, thread.RepositoryID)
+	post.AddProp(constants.PostPropPullRequestID, strconv.Itoa(thread.PullRequestID))
+	post.AddProp(constants.PostPropPullRequestThreadID, strconv.Itoa(thread.ThreadID))
+	post.AddProp(constants.PostPropPullRequestCommentID, strconv.Itoa(thread.CommentID))
+}
+
+// getPullRequestThreadFromProps returns the pull request thread stored in the props of a comment notification post, or nil if the post is not a comment notification
+func getPullRequestThreadFromProps(post *model.Post) *serializers.PullRequestThreadReference {
+	organization, _ := post.GetProp(constants.PostPropPullRequestOrganization).(string)
+	projectID, _ := post.GetProp(constants.PostPropPullRequestProjectID).(string)
+	repositoryID, _ := post.GetProp(constants.PostPropPullRequestRepositoryID).(string)
+	pullRequestID, _ := post.GetProp(constants.PostPropPullRequestID).(string)
+	threadID, _ := post.GetProp(constants.PostPropPullRequestThreadID).(string)
+	commentID, _ := post.GetProp(constants.PostPropPullRequestCommentID).(string)
+	if organization == "" || projectID == "" || repositoryID == "" {
+		return nil
+	}
+
+	thread := &serializers.PullRequestThreadReference{
+		Organization: organization,
+		ProjectID:    projectID,
+		RepositoryID: repositoryID,
+	}
+
+	var err error
+	if thread.PullRequestID, err = strconv.Atoi(pullRequestID); err != nil {
+		return nil
+	}
+	if thread.ThreadID, err = strconv.Atoi(threadID); err != nil {
+		return nil
+	}
+	// Replies are added to the thread without a parent comment if the ID of the comment is missing
+	thread.CommentID, _ = strconv.Atoi(commentID)
+
+	return thread
+}
+
+// getPullRequestThreadActions returns the buttons to change the status of a pull request thread.
+// Active threads can be resolved or marked as won't fix and the other threads can be reactivated.
+func (p *Plugin) getPullRequestThreadActions(thread *serializers.PullRequestThreadReference, status string) []*model.PostAction {
+	getAction := func(id, name, style, threadStatus string) *model.PostAction {
+		return &model.PostAction{
+			Id:    id,
+			Type:  model.POST_ACTION_TYPE_BUTTON,
+			Name:  name,
+			Style: style,
+			Integration: &model.PostActionIntegration{
+				URL: fmt.Sprintf("%s%s", p.GetPluginURL(), constants.PathPullRequestThreadStatus),
+				Context: map[string]interface{}{
+					constants.PullRequestContextOrganization:  thread.Organization,
+					constants.PullRequestContextProjectID:     thread.ProjectID,
+					constants.PullRequestContextRepositoryID:  thread.RepositoryID,
+					constants.PullRequestContextPullRequestID: thread.PullRequestID,
+					constants.PullRequestContextThreadID:      thread.ThreadID,
+					constants.PullRequestContextThreadStatus:  threadStatus,
+				},
+			},
+		}
+	}
+
+	if status == constants.PullRequestThreadStatusActive || status == constants.PullRequestThreadStatusPending {
+		return []*model.PostAction{
+			getAction("resolveThread", "Resolve", "primary", constants.PullRequestThreadStatusFixed),
+			getAction("wontFixThread", "Won't fix", "default", constants.PullRequestThreadStatusWontFix),
+		}
+	}
+
+	return []*model.PostAction{
+		getAction("reactivateThread", "Reactivate", "default", constants.PullRequestThreadStatusActive),
+	}
+}
+
+// GetPullRequestThreadRootPost returns the root post of the notification of a pull request thread in the channel, or an empty string if there is none
+func (p *Plugin) GetPullRequestThreadRootPost(thread *serializers.PullRequestThreadReference, channelID string) string {
+	postList, err := p.Store.GetPullRequestThreadPosts(thread.Organization, thread.PullRequestID, thread.ThreadID)
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadPullRequestThreadPosts, "Error", err.Error())
+		return ""
+	}
+
+	return postList[channelID]
+}
+
+// MirrorPullRequestThreadReply adds a reply in the thread of a pull request comment notification as a reply in the pull request thread.
+// The reply is added using the Azure DevOps account of the author of the reply.
+func (p *Plugin) MirrorPullRequestThreadReply(post *model.Post) {
+	if post.RootId == "" || post.UserId == p.botUserID || post.IsSystemMessage() || strings.TrimSpace(post.Message) == "" {
+		return
+	}
+
+	rootPost, appErr := p.API.GetPost(post.RootId)
+	if appErr != nil {
+		p.API.LogError("Error in getting the root post", "Error", appErr.Error())
+		return
+	}
+
+	thread := getPullRequestThreadFromProps(rootPost)
+	if rootPost.UserId != p.botUserID || thread == nil {
+		return
+	}
+
+	if isConnected := p.MattermostUserAlreadyConnected(post.UserId); !isConnected {
+		p.API.SendEphemeralPost(post.UserId, &model.Post{
+			UserId:    p.botUserID,
+			ChannelId: post.ChannelId,
+			RootId:    post.RootId,
+			Message:   constants.PullRequestThreadReplyNotMirrored,
+		})
+		return
+	}
+
+	payload := &serializers.PullRequestThreadCommentRequest{
+		ParentCommentID: thread.CommentID,
+		Content:         fmt.Sprintf(constants.MirroredPullRequestCommentFormat, post.Message),
+		CommentType:     constants.PullRequestCommentTypeText,
+	}
+	comment, _, err := p.Client.AddPullRequestThreadComment(thread.Organization, thread.ProjectID, thread.RepositoryID, thread.PullRequestID, thread.ThreadID, payload, post.UserId)
+	if err != nil {
+		p.API.LogError(constants.ErrorMirrorPullRequestThreadReply, "Error", err.Error())
+		return
+	}
+
+	if comment == nil {
+		return
+	}
+
+	if err := p.Store.MarkPullRequestCommentMirrored(thread.Organization, thread.PullRequestID, thread.ThreadID, comment.ID); err != nil {
+		p.API.LogError(constants.ErrorStoreMirroredPullRequestComment, "Error", err.Error())
+	}
+}
+
+// isMirroredPullRequestComment returns whether a comment of a pull request thread was added for a reply in the thread of its notification
+func (p *Plugin) isMirroredPullRequestComment(thread *serializers.PullRequestThreadReference) bool {
+	isMirrored, err := p.Store.IsPullRequestCommentMirrored(thread.Organization, thread.PullRequestID, thread.ThreadID, thread.CommentID)
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadMirroredPullRequestComment, "Error", err.Error())
+		return false
+	}
+
+	return isMirrored
+}
+
+// UpdatePullRequestThreadPost shows the status of a pull request thread on its notification post and updates the buttons of the post for the status
+func (p *Plugin) UpdatePullRequestThreadPost(postID, status string) error {
+	post, appErr := p.API.GetPost(postID)
+	if appErr != nil {
+		return appErr
+	}
+
+	attachments := post.Attachments()
+	thread := getPullRequestThreadFromProps(post)
+	if len(attachments) == 0 || thread == nil {
+		return nil
+	}
+
+	statusName := getPullRequestThreadStatusName(status)
+	slackAttachment := attachments[0]
+	isStatusFieldPresent := false
+	for _, field := range slackAttachment.Fields {
+		if field.Title == constants.PullRequestThreadStatusFieldTitle {
+			field.Value = statusName
+			isStatusFieldPresent = true
+		}
+	}
+
+	if !isStatusFieldPresent {
+		slackAttachment.Fields = append(slackAttachment.Fields, &model.SlackAttachmentField{
+			Title: constants.PullRequestThreadStatusFieldTitle,
+			Value: statusName,
+		})
+	}
+
+	slackAttachment.Actions = p.getPullRequestThreadActions(thread, status)
+
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{slackAttachment})
+	if _, appErr := p.API.UpdatePost(post); appErr != nil {
+		return appErr
+	}
+
+	return nil
+}
+
+// getPullRequestThreadStatusName returns the name of a pull request thread status shown in the Azure DevOps UI e.g. "Resolved" for "fixed"
+func getPullRequestThreadStatusName(status string) string {
+	if statusName, ok := constants.PullRequestThreadStatusNames[status]; ok {
+		return statusName
+	}
+
+	return status
+}
@@ -0,0 +1,264 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/store"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func getPullRequestThreadPost(p *Plugin) *model.Post {
+	post := &model.Post{Id: "mockRootID", UserId: "mockBotID"}
+	setPullRequestThreadProps(post, testutils.GetMockPullRequestThread())
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{
+		{
+			Fields:  []*model.SlackAttachmentField{{Title: "Comment", Value: "mockComment"}},
+			Actions: p.getPullRequestThreadActions(testutils.GetMockPullRequestThread(), constants.PullRequestThreadStatusActive),
+		},
+	})
+
+	return post
+}
+
+func TestGetPullRequestThreadForComment(t *testing.T) {
+	pullRequest := serializers.PullRequest{
+		PullRequestID: 1,
+		URL:           "https://dev.azure.com/mockOrganization/mockProjectID/_apis/git/repositories/mockRepositoryID/pullRequests/1",
+		Repository:    serializers.Repository{ID: "mockRepositoryID", Project: serializers.Project{ID: testutils.MockProjectID}},
+	}
+	comment := &serializers.Comment{ID: 1}
+	comment.Links.Threads.Href = "https://dev.azure.com/mockOrganization/mockProjectID/_apis/git/repositories/mockRepositoryID/pullRequests/1/threads/5"
+
+	assert.Equal(t, testutils.GetMockPullRequestThread(), getPullRequestThreadForComment(pullRequest, comment))
+	assert.Nil(t, getPullRequestThreadForComment(pullRequest, &serializers.Comment{ID: 1}))
+	assert.Nil(t, getPullRequestThreadForComment(serializers.PullRequest{}, comment))
+}
+
+func TestGetPullRequestThreadFromProps(t *testing.T) {
+	post := &model.Post{}
+	assert.Nil(t, getPullRequestThreadFromProps(post))
+
+	setPullRequestThreadProps(post, testutils.GetMockPullRequestThread())
+	assert.Equal(t, testutils.GetMockPullRequestThread(), getPullRequestThreadFromProps(post))
+
+	post.AddProp(constants.PostPropPullRequestThreadID, "invalid")
+	assert.Nil(t, getPullRequestThreadFromProps(post))
+}
+
+func TestGetPullRequestThreadActions(t *testing.T) {
+	p := setupMockPlugin(&plugintest.API{}, nil, nil)
+
+	actions := p.getPullRequestThreadActions(testutils.GetMockPullRequestThread(), constants.PullRequestThreadStatusActive)
+	require.Len(t, actions, 2)
+	assert.Equal(t, constants.PullRequestThreadStatusFixed, actions[0].Integration.Context[constants.PullRequestContextThreadStatus])
+	assert.Equal(t, constants.PullRequestThreadStatusWontFix, actions[1].Integration.Context[constants.PullRequestContextThreadStatus])
+	assert.Equal(t, 5, actions[0].Integration.Context[constants.PullRequestContextThreadID])
+
+	actions = p.getPullRequestThreadActions(testutils.GetMockPullRequestThread(), constants.PullRequestThreadStatusFixed)
+	require.Len(t, actions, 1)
+	assert.Equal(t, "Reactivate", actions[0].Name)
+	assert.Equal(t, constants.PullRequestThreadStatusActive, actions[0].Integration.Context[constants.PullRequestContextThreadStatus])
+}
+
+func TestGetPullRequestThreadRootPost(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	mockCtrl := gomock.NewController(t)
+	mockedStore := mocks.NewMockKVStore(mockCtrl)
+	p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+	mockedStore.EXPECT().GetPullRequestThreadPosts(testutils.MockOrganization, 1, 5).Return(store.PullRequestThreadPostList{testutils.MockChannelID: "mockRootID"}, nil)
+	assert.Equal(t, "mockRootID", p.GetPullRequestThreadRootPost(testutils.GetMockPullRequestThread(), testutils.MockChannelID))
+
+	mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+	mockedStore.EXPECT().GetPullRequestThreadPosts(testutils.MockOrganization, 1, 5).Return(nil, errors.New("failed to load the posts"))
+	assert.Equal(t, "", p.GetPullRequestThreadRootPost(testutils.GetMockPullRequestThread(), testutils.MockChannelID))
+}
+
+func TestMirrorPullRequestThreadReply(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description     string
+		post            *model.Post
+		rootPost        *model.Post
+		isConnected     bool
+		expectedComment string
+	}{
+		{
+			description: "MirrorPullRequestThreadReply: post is not a reply",
+			post:        &model.Post{UserId: testutils.MockMattermostUserID, Message: "mockMessage"},
+		},
+		{
+			description: "MirrorPullRequestThreadReply: post is created by the bot",
+			post:        &model.Post{UserId: "mockBotID", RootId: "mockRootID", Message: "mockMessage"},
+		},
+		{
+			description: "MirrorPullRequestThreadReply: root post is not a pull request comment notification",
+			post:        &model.Post{UserId: testutils.MockMattermostUserID, RootId: "mockRootID", Message: "mockMessage"},
+			rootPost:    &model.Post{Id: "mockRootID", UserId: "mockBotID"},
+		},
+		{
+			description: "MirrorPullRequestThreadReply: author of the reply is not connected",
+			post:        &model.Post{UserId: testutils.MockMattermostUserID, RootId: "mockRootID", Message: "mockMessage"},
+			rootPost:    getPullRequestThreadPost(setupMockPlugin(&plugintest.API{}, nil, nil)),
+		},
+		{
+			description:     "MirrorPullRequestThreadReply: reply is added to the pull request thread",
+			post:            &model.Post{UserId: testutils.MockMattermostUserID, RootId: "mockRootID", Message: "Fixed in the `next` commit"},
+			rootPost:        getPullRequestThreadPost(setupMockPlugin(&plugintest.API{}, nil, nil)),
+			isConnected:     true,
+			expectedComment: "Fixed in the `next` commit\n\n_Mirrored from Mattermost_",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, mockedClient)
+			p.botUserID = "mockBotID"
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return testCase.isConnected
+			})
+
+			if testCase.rootPost != nil {
+				mockAPI.On("GetPost", "mockRootID").Return(testCase.rootPost, nil)
+			}
+
+			if testCase.rootPost != nil && getPullRequestThreadFromProps(testCase.rootPost) != nil && !testCase.isConnected {
+				mockAPI.On("SendEphemeralPost", testutils.MockMattermostUserID, mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+					assert.Equal(t, constants.PullRequestThreadReplyNotMirrored, args.Get(1).(*model.Post).Message)
+				}).Return(&model.Post{})
+			}
+
+			if testCase.expectedComment != "" {
+				mockedClient.EXPECT().AddPullRequestThreadComment(testutils.MockOrganization, testutils.MockProjectID, "mockRepositoryID", 1, 5, &serializers.PullRequestThreadCommentRequest{
+					ParentCommentID: 1,
+					Content:         testCase.expectedComment,
+					CommentType:     constants.PullRequestCommentTypeText,
+				}, testutils.MockMattermostUserID).Return(&serializers.Comment{ID: 2}, http.StatusOK, nil)
+				mockedStore.EXPECT().MarkPullRequestCommentMirrored(testutils.MockOrganization, 1, 5, 2).Return(nil)
+			}
+
+			p.MirrorPullRequestThreadReply(testCase.post)
+			mockAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdatePullRequestThreadPost(t *testing.T) {
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+
+	var updatedPost *model.Post
+	mockAPI.On("GetPost", "mockRootID").Return(getPullRequestThreadPost(p), nil)
+	mockAPI.On("UpdatePost", mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+		updatedPost = args.Get(0).(*model.Post)
+	}).Return(&model.Post{}, nil)
+
+	require.NoError(t, p.UpdatePullRequestThreadPost("mockRootID", constants.PullRequestThreadStatusWontFix))
+	require.NotNil(t, updatedPost)
+	attachment := updatedPost.Attachments()[0]
+	require.Len(t, attachment.Fields, 2)
+	assert.Equal(t, constants.PullRequestThreadStatusFieldTitle, attachment.Fields[1].Title)
+	assert.Equal(t, "Won't fix", attachment.Fields[1].Value)
+	require.Len(t, attachment.Actions, 1)
+	assert.Equal(t, "Reactivate", attachment.Actions[0].Name)
+}
+
+func TestHandlePullRequestThreadStatus(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description           string
+		context               map[string]interface{}
+		updateStatusCode      int
+		updateErr             error
+		expectedStatusCode    int
+		expectedEphemeralText string
+	}{
+		{
+			description:           "HandlePullRequestThreadStatus: resolve",
+			context:               map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "repositoryId": "mockRepositoryID", "pullRequestId": 1, "threadId": 5, "threadStatus": constants.PullRequestThreadStatusFixed},
+			updateStatusCode:      http.StatusOK,
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: `Status of the comment thread on the pull request #1 is changed to "Resolved".`,
+		},
+		{
+			description:        "HandlePullRequestThreadStatus: invalid status",
+			context:            map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "repositoryId": "mockRepositoryID", "pullRequestId": 1, "threadId": 5, "threadStatus": "invalid"},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:        "HandlePullRequestThreadStatus: missing thread",
+			context:            map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "repositoryId": "mockRepositoryID", "pullRequestId": 1, "threadStatus": constants.PullRequestThreadStatusFixed},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:           "HandlePullRequestThreadStatus: user is not permitted to update the thread",
+			context:               map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "repositoryId": "mockRepositoryID", "pullRequestId": 1, "threadId": 5, "threadStatus": constants.PullRequestThreadStatusWontFix},
+			updateStatusCode:      http.StatusForbidden,
+			updateErr:             errors.New("failed to update the status of the pull request thread"),
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: "Looks like you do not have permission to update the comment thread on the pull request #1.",
+		},
+		{
+			description:           "HandlePullRequestThreadStatus: error in updating the thread",
+			context:               map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "repositoryId": "mockRepositoryID", "pullRequestId": 1, "threadId": 5, "threadStatus": constants.PullRequestThreadStatusActive},
+			updateStatusCode:      http.StatusInternalServerError,
+			updateErr:             errors.New("failed to update the status of the pull request thread"),
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: constants.GenericErrorMessage,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			status, _ := testCase.context["threadStatus"].(string)
+			mockedClient.EXPECT().UpdatePullRequestThreadStatus("mockOrganization", "mockProjectID", "mockRepositoryID", 1, 5, status, testutils.MockMattermostUserID).Return(&serializers.PullRequestCommentThread{ID: 5, Status: status}, testCase.updateStatusCode, testCase.updateErr).AnyTimes()
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdatePullRequestThreadPost", func(_ *Plugin, postID, threadStatus string) error {
+				assert.Equal(t, "mockRootID", postID)
+				assert.Equal(t, status, threadStatus)
+				return nil
+			})
+
+			body, err := json.Marshal(&model.PostActionIntegrationRequest{PostId: "mockRootID", Context: testCase.context})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, constants.PathPullRequestThreadStatus, bytes.NewBuffer(body))
+			req.Header.Add(constants.HeaderMattermostUserID, testutils.MockMattermostUserID)
+
+			w := httptest.NewRecorder()
+			p.handlePullRequestThreadStatus(w, req)
+			resp := w.Result()
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			if testCase.expectedEphemeralText != "" {
+				response := model.PostActionIntegrationResponseFromJson(resp.Body)
+				require.NotNil(t, response)
+				assert.Equal(t, testCase.expectedEphemeralText, response.EphemeralText)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+// getPullRequestActions returns the actions available on a pull request post for the status of the pull request.
+// Active pull requests can be voted on, completed or abandoned and abandoned ones can be reactivated.
+func (p *Plugin) getPullRequestActions(organization, projectID, repositoryID string, pullRequestID int, status string) []*model.PostAction {
+	context := map[string]interface{}{
+		constants.PullRequestContextOrganization:  organization,
+		constants.PullRequestContextProjectID:     projectID,
+		constants.PullRequestContextRepositoryID:  repositoryID,
+		constants.PullRequestContextPullRequestID: pullRequestID,
+	}
+
+	switch status {
+	case constants.PullRequestStatusActive:
+		return append(p.getPullRequestVoteActions(organization, projectID, repositoryID, pullRequestID), &model.PostAction{
+			Id:   "managePullRequest",
+			Type: model.POST_ACTION_TYPE_SELECT,
+			Name: "Complete or abandon",
+			Options: []*model.PostActionOptions{
+				{Text: "Complete", Value: constants.CommandComplete},
+				{Text: "Set auto-complete", Value: constants.CommandAutoComplete},
+				{Text: "Abandon", Value: constants.CommandAbandon},
+			},
+			Integration: &model.PostActionIntegration{
+				URL:     fmt.Sprintf("%s%s", p.GetPluginURL(), constants.PathPullRequestAction),
+				Context: context,
+			},
+		})
+	case constants.PullRequestStatusAbandoned:
+		context[constants.PullRequestContextAction] = constants.CommandReactivate
+		return []*model.PostAction{
+			{
+				Id:   "reactivatePullRequest",
+				Type: model.POST_ACTION_TYPE_BUTTON,
+				Name: "Reactivate",
+				Integration: &model.PostActionIntegration{
+					URL:     fmt.Sprintf("%s%s", p.GetPluginURL(), constants.PathPullRequestAction),
+					Context: context,
+				},
+			},
+		}
+	}
+
+	return nil
+}
+
+// getPullRequestVoteActions returns the buttons to vote on an active pull request
+func (p *Plugin) getPullRequestVoteActions(organization, projectID, repositoryID string, pullRequestID int) []*model.PostAction {
+	actions := []*model.PostAction{}
+	for _, vote := range []struct {
+		id    string
+		value int
+		style string
+	}{
+		{id: "approve", value: constants.PullRequestVoteApproved, style: "primary"},
+		{id: "approveWithSuggestions", value: constants.PullRequestVoteApprovedWithSuggestions, style: "default"},
+		{id: "waitForAuthor", value: constants.PullRequestVoteWaitingForAuthor, style: "default"},
+		{id: "reject", value: constants.PullRequestVoteRejected, style: "danger"},
+	} {
+		actions = append(actions, &model.PostAction{
+			Id:    vote.id,
+			Type:  model.POST_ACTION_TYPE_BUTTON,
+			Name:  constants.PullRequestVoteNames[vote.value],
+			Style: vote.style,
+			Integration: &model.PostActionIntegration{
+				URL: fmt.Sprintf("%s%s", p.GetPluginURL(), constants.PathPullRequestVote),
+				Context: map[string]interface{}{
+					constants.PullRequestContextOrganization:  organization,
+					constants.PullRequestContextProjectID:     projectID,
+					constants.PullRequestContextRepositoryID:  repositoryID,
+					constants.PullRequestContextPullRequestID: pullRequestID,
+					constants.PullRequestContextVote:          vote.value,
+				},
+			},
+		})
+	}
+
+	return actions
+}
+
+// UpdatePullRequestReviewersPost refreshes the reviewers and their votes on a pull request post.
+// The actions of the post are updated for the current status of the pull request.
+func (p *Plugin) UpdatePullRequestReviewersPost(organization, projectID string, pullRequestID int, postID, mattermostUserID string) error {
+	pullRequest, _, err := p.Client.GetPullRequest(organization, strconv.Itoa(pullRequestID), projectID, mattermostUserID)
+	if err != nil {
+		return err
+	}
+
+	post, appErr := p.API.GetPost(postID)
+	if appErr != nil {
+		return appErr
+	}
+
+	attachments := post.Attachments()
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	slackAttachment := attachments[0]
+	for _, field := range slackAttachment.Fields {
+		if field.Title == constants.PullRequestReviewersFieldTitle {
+			field.Value = p.getReviewersListString(pullRequest.Reviewers)
+		}
+	}
+
+	slackAttachment.Actions = p.getPullRequestActions(organization, projectID, pullRequest.Repository.ID, pullRequestID, pullRequest.Status)
+
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{slackAttachment})
+	if _, appErr := p.API.UpdatePost(post); appErr != nil {
+		return appErr
+	}
+
+	return nil
+}
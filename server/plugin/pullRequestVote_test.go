@@ -0,0 +1,220 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func getPullRequestPost(actions []*model.PostAction) *model.Post {
+	post := &model.Post{Id: "mockPostID"}
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{
+		{
+			Fields: []*model.SlackAttachmentField{
+				{Title: "Target Branch", Value: "main", Short: true},
+				{Title: "Source Branch", Value: "feature", Short: true},
+				{Title: constants.PullRequestReviewersFieldTitle, Value: "Jane Doe, John Doe"},
+			},
+			Actions: actions,
+		},
+	})
+
+	return post
+}
+
+func TestGetReviewersListString(t *testing.T) {
+	p := setupMockPlugin(&plugintest.API{}, nil, nil)
+	assert.Equal(t, "None", p.getReviewersListString(nil))
+	assert.Equal(t, "&#9989; Jane Doe, John Doe, &#9203; Jim Doe", p.getReviewersListString([]serializers.Reviewer{
+		{DisplayName: "Jane Doe", Vote: constants.PullRequestVoteApproved},
+		{DisplayName: "John Doe"},
+		{DisplayName: "Jim Doe", Vote: constants.PullRequestVoteWaitingForAuthor},
+	}))
+}
+
+func TestGetPullRequestVoteActions(t *testing.T) {
+	p := setupMockPlugin(&plugintest.API{}, nil, nil)
+	actions := p.getPullRequestVoteActions("mockOrganization", "mockProjectID", "mockRepositoryID", 1)
+	require.Len(t, actions, 4)
+	for index, name := range []string{"Approve", "Approve with suggestions", "Wait for author", "Reject"} {
+		assert.Equal(t, name, actions[index].Name)
+		assert.Equal(t, "mockRepositoryID", actions[index].Integration.Context[constants.PullRequestContextRepositoryID])
+	}
+	assert.Equal(t, constants.PullRequestVoteRejected, actions[3].Integration.Context[constants.PullRequestContextVote])
+}
+
+func TestUpdatePullRequestReviewersPost(t *testing.T) {
+	for _, testCase := range []struct {
+		description       string
+		status            string
+		getPullRequestErr error
+		getPostErr        *model.AppError
+		expectedActions   int
+		expectedErr       bool
+	}{
+		{
+			description:     "UpdatePullRequestReviewersPost: active pull request",
+			status:          constants.PullRequestStatusActive,
+			expectedActions: 5,
+		},
+		{
+			description:     "UpdatePullRequestReviewersPost: abandoned pull request",
+			status:          constants.PullRequestStatusAbandoned,
+			expectedActions: 1,
+		},
+		{
+			description: "UpdatePullRequestReviewersPost: completed pull request",
+			status:      "completed",
+		},
+		{
+			description:       "UpdatePullRequestReviewersPost: error in fetching the pull request",
+			getPullRequestErr: errors.New("failed to get the pull request"),
+			expectedErr:       true,
+		},
+		{
+			description: "UpdatePullRequestReviewersPost: error in fetching the post",
+			status:      constants.PullRequestStatusActive,
+			getPostErr:  &model.AppError{Message: "error in fetching the post"},
+			expectedErr: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+
+			mockedClient.EXPECT().GetPullRequest("mockOrganization", "1", "mockProjectID", testutils.MockMattermostUserID).Return(&serializers.PullRequest{
+				Status:    testCase.status,
+				Reviewers: []serializers.Reviewer{{DisplayName: "Jane Doe", Vote: constants.PullRequestVoteApproved}, {DisplayName: "John Doe", Vote: constants.PullRequestVoteRejected}},
+			}, http.StatusOK, testCase.getPullRequestErr)
+
+			var updatedPost *model.Post
+			if testCase.getPostErr != nil {
+				mockAPI.On("GetPost", "mockPostID").Return(nil, testCase.getPostErr)
+			} else {
+				mockAPI.On("GetPost", "mockPostID").Return(getPullRequestPost(p.getPullRequestVoteActions("mockOrganization", "mockProjectID", "mockRepositoryID", 1)), nil)
+			}
+			mockAPI.On("UpdatePost", mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				updatedPost = args.Get(0).(*model.Post)
+			}).Return(&model.Post{}, nil)
+
+			err := p.UpdatePullRequestReviewersPost("mockOrganization", "mockProjectID", 1, "mockPostID", testutils.MockMattermostUserID)
+			if testCase.expectedErr {
+				assert.Error(t, err)
+				assert.Nil(t, updatedPost)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, updatedPost)
+			attachment := updatedPost.Attachments()[0]
+			assert.Equal(t, "&#9989; Jane Doe, &#10060; John Doe", attachment.Fields[2].Value)
+			assert.Len(t, attachment.Actions, testCase.expectedActions)
+		})
+	}
+}
+
+func TestHandlePullRequestVote(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description           string
+		context               map[string]interface{}
+		voteStatusCode        int
+		voteErr               error
+		loadUserErr           error
+		expectedStatusCode    int
+		expectedEphemeralText string
+	}{
+		{
+			description:           "HandlePullRequestVote: valid",
+			context:               map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "repositoryId": "mockRepositoryID", "pullRequestId": 1, "vote": constants.PullRequestVoteApprovedWithSuggestions},
+			voteStatusCode:        http.StatusOK,
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: `Your vote "Approve with suggestions" is recorded on the pull request #1.`,
+		},
+		{
+			description:        "HandlePullRequestVote: invalid vote",
+			context:            map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "repositoryId": "mockRepositoryID", "pullRequestId": 1, "vote": 3},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:        "HandlePullRequestVote: missing repository",
+			context:            map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "pullRequestId": 1, "vote": constants.PullRequestVoteApproved},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:        "HandlePullRequestVote: error in loading the user",
+			context:            map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "repositoryId": "mockRepositoryID", "pullRequestId": 1, "vote": constants.PullRequestVoteApproved},
+			loadUserErr:        errors.New("error in loading the user"),
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			description:           "HandlePullRequestVote: user is not permitted to vote",
+			context:               map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "repositoryId": "mockRepositoryID", "pullRequestId": 1, "vote": constants.PullRequestVoteRejected},
+			voteStatusCode:        http.StatusForbidden,
+			voteErr:               errors.New("failed to update the vote on the pull request"),
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: "Looks like you do not have permission to vote on the pull request #1.",
+		},
+		{
+			description:           "HandlePullRequestVote: error in voting",
+			context:               map[string]interface{}{"organization": "mockOrganization", "projectId": "mockProjectID", "repositoryId": "mockRepositoryID", "pullRequestId": 1, "vote": constants.PullRequestVoteRejected},
+			voteStatusCode:        http.StatusInternalServerError,
+			voteErr:               errors.New("failed to update the vote on the pull request"),
+			expectedStatusCode:    http.StatusOK,
+			expectedEphemeralText: constants.GenericErrorMessage,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, mockedClient)
+
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			mockedStore.EXPECT().LoadAzureDevopsUserIDFromMattermostUser(testutils.MockMattermostUserID).Return("mockReviewerID", testCase.loadUserErr).AnyTimes()
+			mockedClient.EXPECT().UpdatePullRequestVote("mockOrganization", "mockProjectID", "mockRepositoryID", "mockReviewerID", 1, gomock.Any(), testutils.MockMattermostUserID).Return(&serializers.Reviewer{}, testCase.voteStatusCode, testCase.voteErr).AnyTimes()
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdatePullRequestReviewersPost", func(_ *Plugin, _, _ string, _ int, postID, _ string) error {
+				assert.Equal(t, "mockPostID", postID)
+				return nil
+			})
+
+			body, err := json.Marshal(&model.PostActionIntegrationRequest{PostId: "mockPostID", Context: testCase.context})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, constants.PathPullRequestVote, bytes.NewBuffer(body))
+			req.Header.Add(constants.HeaderMattermostUserID, testutils.MockMattermostUserID)
+
+			w := httptest.NewRecorder()
+			p.handlePullRequestVote(w, req)
+			resp := w.Result()
+			assert.Equal(t, testCase.expectedStatusCode, resp.StatusCode)
+
+			if testCase.expectedEphemeralText != "" {
+				response := model.PostActionIntegrationResponseFromJson(resp.Body)
+				require.NotNil(t, response)
+				assert.Equal(t, testCase.expectedEphemeralText, response.EphemeralText)
+			}
+		})
+	}
+}
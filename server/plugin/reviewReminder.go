@@ -0,0 +1,158 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// startReviewReminderJob runs the due review reminders every minute on one server of the cluster at a time.
+// The occurrences of the reminders are still marked as sent, so that they are not sent again if a run is repeated within the reminder window.
+func (p *Plugin) startReviewReminderJob() error {
+	job, err := p.scheduleClusterJob(constants.ReviewReminderJobKey, constants.ReviewReminderJobInterval, func() {
+		p.RunReviewReminders(time.Now())
+	})
+	if err != nil {
+		return err
+	}
+
+	p.reviewReminderJob = job
+	return nil
+}
+
+func (p *Plugin) stopReviewReminderJob() {
+	if p.reviewReminderJob != nil {
+		if err := p.reviewReminderJob.Close(); err != nil {
+			p.API.LogError(constants.ErrorStopReviewReminderJob, "Error", err.Error())
+		}
+		p.reviewReminderJob = nil
+	}
+}
+
+// RunReviewReminders sends the occurrences of the review reminders which are due and are not sent yet
+func (p *Plugin) RunReviewReminders(now time.Time) {
+	reminders, err := p.Store.GetReviewReminders()
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadReviewReminders, "Error", err.Error())
+		return
+	}
+
+	for _, reminder := range reminders {
+		occurrence := reminder.GetDueOccurrence(now)
+		if occurrence == "" {
+			continue
+		}
+
+		isNotSent, err := p.Store.MarkReviewReminderSent(reminder.ID, occurrence)
+		if err != nil {
+			p.API.LogError(constants.ErrorSendReviewReminder, "ReminderID", reminder.ID, "Error", err.Error())
+			continue
+		}
+
+		if !isNotSent {
+			continue
+		}
+
+		if err := p.SendReviewReminder(reminder, now); err != nil {
+			p.API.LogError(constants.ErrorSendReviewReminder, "ReminderID", reminder.ID, "Error", err.Error())
+		}
+	}
+}
+
+// SendReviewReminder posts the pull requests waiting for review in the channel of the reminder using the Azure DevOps account of the user who added it.
+// Nothing is posted if no pull request is waiting for review.
+func (p *Plugin) SendReviewReminder(reminder *serializers.ReviewReminder, now time.Time) error {
+	pullRequestList, _, err := p.Client.GetPullRequests(reminder.OrganizationName, reminder.ProjectName, reminder.Repository, nil, reminder.CreatedBy)
+	if err != nil {
+		return err
+	}
+
+	message := p.getReviewReminderMessage(reminder, pullRequestList.Value, now)
+	if message == "" {
+		return nil
+	}
+
+	if _, appErr := p.API.CreatePost(&model.Post{
+		UserId:    p.botUserID,
+		ChannelId: reminder.ChannelID,
+		Message:   message,
+	}); appErr != nil {
+		return appErr
+	}
+
+	return nil
+}
+
+// getReviewReminderMessage lists the non-draft pull requests older than the minimum age of the reminder which have no reviewers or have reviewers who have not voted yet.
+// The reviewers who have not voted are mentioned if they are mapped to Mattermost users.
+func (p *Plugin) getReviewReminderMessage(reminder *serializers.ReviewReminder, pullRequests []*serializers.PullRequest, now time.Time) string {
+	minAge := reminder.GetMinAge()
+	var sb strings.Builder
+	count := 0
+	for _, pullRequest := range pullRequests {
+		if pullRequest.IsDraft || (pullRequest.CreationDate != nil && now.Sub(*pullRequest.CreationDate) < minAge) {
+			continue
+		}
+
+		var pendingReviewers []string
+		for _, reviewer := range pullRequest.Reviewers {
+			if reviewer.Vote == 0 {
+				pendingReviewers = append(pendingReviewers, p.getAzureIdentityMention(reviewer.GetIdentity()))
+			}
+		}
+
+		if len(pullRequest.Reviewers) > 0 && len(pendingReviewers) == 0 {
+			continue
+		}
+
+		waitingFor := "reviewers to be added"
+		if len(pendingReviewers) > 0 {
+			waitingFor = strings.Join(pendingReviewers, ", ")
+		}
+
+		title := fmt.Sprintf(
+			constants.PullRequestTitle,
+			pullRequest.PullRequestID,
+			pullRequest.Title,
+			fmt.Sprintf(constants.PullRequestWebURL, p.getConfiguration().AzureDevopsAPIBaseURL, reminder.OrganizationName, reminder.ProjectName, pullRequest.Repository.Name, pullRequest.PullRequestID),
+		)
+		sb.WriteString(fmt.Sprintf(constants.ReviewReminderItem, title, formatPullRequestAge(pullRequest.CreationDate, now), waitingFor))
+		count++
+	}
+
+	if count == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(constants.ReviewReminderTitle, count, reminder.GetScopeString()) + sb.String()
+}
+
+// ParseReviewRemindersToCommandResponse formats the review reminders of a channel as a markdown table
+func (p *Plugin) ParseReviewRemindersToCommandResponse(reminders []*serializers.ReviewReminder) string {
+	if len(reminders) == 0 {
+		return constants.NoReviewReminders
+	}
+
+	var sb strings.Builder
+	sb.WriteString(constants.ReviewRemindersListHeader)
+	for _, reminder := range reminders {
+		minAge := reminder.MinAge
+		if minAge == "" {
+			minAge = "-"
+		}
+
+		createdBy := reminder.CreatedBy
+		if user, appErr := p.API.GetUser(reminder.CreatedBy); appErr == nil {
+			createdBy = fmt.Sprintf("@%s", user.Username)
+		}
+
+		sb.WriteString(fmt.Sprintf(constants.ReviewRemindersListItem, reminder.ID, reminder.GetScheduleString(), reminder.GetScopeString(), minAge, createdBy))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,284 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/config"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestRunReviewReminders(t *testing.T) {
+	defer monkey.UnpatchAll()
+	// Monday, 10 January 2022 09:35 in Asia/Kolkata
+	monday := time.Date(2022, time.January, 10, 4, 5, 0, 0, time.UTC)
+	for _, testCase := range []struct {
+		description        string
+		now                time.Time
+		expectedOccurrence string
+		isNotSent          bool
+		expectSend         bool
+	}{
+		{
+			description:        "RunReviewReminders: reminder is due in its timezone",
+			now:                monday,
+			expectedOccurrence: "2022-01-10",
+			isNotSent:          true,
+			expectSend:         true,
+		},
+		{
+			description:        "RunReviewReminders: reminder is already sent by another server",
+			now:                monday,
+			expectedOccurrence: "2022-01-10",
+		},
+		{
+			description: "RunReviewReminders: reminder is not due after its window",
+			now:         monday.Add(constants.ReviewReminderWindow),
+		},
+		{
+			description: "RunReviewReminders: reminder is not due before its time",
+			now:         monday.Add(-10 * time.Minute),
+		},
+		{
+			description: "RunReviewReminders: reminder is not due on the weekend",
+			now:         monday.AddDate(0, 0, -1),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+			mockedStore.EXPECT().GetReviewReminders().Return([]*serializers.ReviewReminder{testutils.GetMockReviewReminder()}, nil)
+			if testCase.expectedOccurrence != "" {
+				mockedStore.EXPECT().MarkReviewReminderSent("mockReminderID", testCase.expectedOccurrence).Return(testCase.isNotSent, nil)
+			}
+
+			isSent := false
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "SendReviewReminder", func(_ *Plugin, reminder *serializers.ReviewReminder, _ time.Time) error {
+				assert.Equal(t, "mockReminderID", reminder.ID)
+				isSent = true
+				return nil
+			})
+
+			p.RunReviewReminders(testCase.now)
+
+			assert.Equal(t, testCase.expectSend, isSent)
+		})
+	}
+}
+
+func TestSendReviewReminder(t *testing.T) {
+	now := time.Date(2022, time.January, 10, 4, 5, 0, 0, time.UTC)
+	createdAt := func(age time.Duration) *time.Time {
+		creationDate := now.Add(-age)
+		return &creationDate
+	}
+
+	pending := testutils.GetMockActivePullRequest(1, "mockRepository", false, []serializers.Reviewer{
+		{DisplayName: "mockApprover", Vote: constants.PullRequestVoteApproved},
+		{DisplayName: "mockReviewer"},
+	})
+	pending.CreationDate = createdAt(50 * time.Hour)
+	withoutReviewers := testutils.GetMockActivePullRequest(2, "mockRepository", false, nil)
+	withoutReviewers.CreationDate = createdAt(30 * time.Hour)
+	recent := testutils.GetMockActivePullRequest(3, "mockRepository", false, []serializers.Reviewer{{DisplayName: "mockReviewer"}})
+	recent.CreationDate = createdAt(time.Hour)
+	draft := testutils.GetMockActivePullRequest(4, "mockRepository", true, []serializers.Reviewer{{DisplayName: "mockReviewer"}})
+	draft.CreationDate = createdAt(50 * time.Hour)
+	voted := testutils.GetMockActivePullRequest(5, "mockRepository", false, []serializers.Reviewer{{DisplayName: "mockReviewer", Vote: constants.PullRequestVoteWaitingForAuthor}})
+	voted.CreationDate = createdAt(50 * time.Hour)
+
+	for _, testCase := range []struct {
+		description     string
+		pullRequests    []*serializers.PullRequest
+		expectedMessage string
+	}{
+		{
+			description:  "SendReviewReminder: pull requests waiting for review are posted",
+			pullRequests: []*serializers.PullRequest{pending, withoutReviewers, recent, draft, voted},
+			expectedMessage: "#### 2 pull request(s) of mockProjectName waiting for review\n" +
+				"* [#1: mockTitle](https://dev.azure.com/mockOrganization/mockProjectName/_git/mockRepository/pullrequest/1) | opened 2 day(s) ago | waiting for mockReviewer\n" +
+				"* [#2: mockTitle](https://dev.azure.com/mockOrganization/mockProjectName/_git/mockRepository/pullrequest/2) | opened 1 day(s) ago | waiting for reviewers to be added\n",
+		},
+		{
+			description:  "SendReviewReminder: nothing is posted if no pull request is waiting for review",
+			pullRequests: []*serializers.PullRequest{recent, draft, voted},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+			p.setConfiguration(&config.Configuration{AzureDevopsAPIBaseURL: "https://dev.azure.com"})
+
+			mockedClient.EXPECT().GetPullRequests(testutils.MockOrganization, testutils.MockProjectName, "", nil, testutils.MockMattermostUserID).Return(&serializers.PullRequestList{Value: testCase.pullRequests}, http.StatusOK, nil)
+			if testCase.expectedMessage != "" {
+				mockAPI.On("CreatePost", mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+					post := args.Get(0).(*model.Post)
+					assert.Equal(t, testutils.MockChannelID, post.ChannelId)
+					assert.Equal(t, testCase.expectedMessage, post.Message)
+				}).Once().Return(&model.Post{}, nil)
+			}
+
+			err := p.SendReviewReminder(testutils.GetMockReviewReminder(), now)
+
+			assert.Nil(t, err)
+			mockAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAzureDevopsReviewRemindersCommand(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description      string
+		command          string
+		setupStore       func(mockedStore *mocks.MockKVStore)
+		expectedReminder *serializers.ReviewReminder
+		expectedMessage  string
+	}{
+		{
+			description:     "ReviewRemindersCommand: days and time are not provided",
+			command:         "/azuredevops repos reminders add weekdays",
+			expectedMessage: fmt.Sprintf(constants.InvalidCommandArguments, constants.ReviewReminderUsage),
+		},
+		{
+			description:     "ReviewRemindersCommand: invalid days",
+			command:         "/azuredevops repos reminders add mon,someday 09:30",
+			expectedMessage: fmt.Sprintf(constants.InvalidCommandArguments, fmt.Sprintf(constants.InvalidReminderDays, "mon,someday")),
+		},
+		{
+			description:     "ReviewRemindersCommand: invalid time",
+			command:         "/azuredevops repos reminders add weekdays 9.30",
+			expectedMessage: fmt.Sprintf(constants.InvalidCommandArguments, fmt.Sprintf(constants.InvalidReminderTime, "9.30")),
+		},
+		{
+			description:     "ReviewRemindersCommand: invalid minimum age",
+			command:         "/azuredevops repos reminders add weekdays 09:30 min-age=soon",
+			expectedMessage: fmt.Sprintf(constants.InvalidCommandArguments, fmt.Sprintf(constants.InvalidReminderMinAge, "soon")),
+		},
+		{
+			description:     "ReviewRemindersCommand: invalid argument",
+			command:         "/azuredevops repos reminders add weekdays 09:30 team=web",
+			expectedMessage: fmt.Sprintf(constants.InvalidCommandArguments, fmt.Sprintf(constants.InvalidReminderArgument, "team=web")),
+		},
+		{
+			description: "ReviewRemindersCommand: project is not linked",
+			command:     "/azuredevops repos reminders add daily 09:30 project=mockOtherProject",
+			setupStore: func(mockedStore *mocks.MockKVStore) {
+				mockedStore.EXPECT().GetAllProjects(testutils.MockMattermostUserID).Return(testutils.GetProjectDetailsPayload(), nil)
+			},
+			expectedMessage: constants.ReviewReminderProjectRequired,
+		},
+		{
+			description: "ReviewRemindersCommand: reminder is added",
+			command:     "/azuredevops repos reminders add fri,Monday 09:30 repo=mockRepository min-age=3d",
+			setupStore: func(mockedStore *mocks.MockKVStore) {
+				mockedStore.EXPECT().GetAllProjects(testutils.MockMattermostUserID).Return(testutils.GetProjectDetailsPayload(), nil)
+			},
+			expectedReminder: &serializers.ReviewReminder{
+				ChannelID:        testutils.MockChannelID,
+				OrganizationName: testutils.MockOrganization,
+				ProjectName:      testutils.MockProjectName,
+				Repository:       "mockRepository",
+				Days:             []string{"mon", "fri"},
+				Time:             "09:30",
+				Timezone:         "Asia/Kolkata",
+				MinAge:           "3d",
+				CreatedBy:        testutils.MockMattermostUserID,
+			},
+			expectedMessage: `Review reminder with ID: "mockRemi" is added to this channel. Pull requests of mockProjectName / mockRepository waiting for review will be listed on mon, fri at 09:30 (Asia/Kolkata).`,
+		},
+		{
+			description: "ReviewRemindersCommand: reminders of the channel are listed",
+			command:     "/azuredevops repos reminders list",
+			setupStore: func(mockedStore *mocks.MockKVStore) {
+				otherReminder := testutils.GetMockReviewReminder()
+				otherReminder.ID = "mockOtherReminderID"
+				otherReminder.ChannelID = "mockOtherChannelID"
+				mockedStore.EXPECT().GetReviewReminders().Return([]*serializers.ReviewReminder{testutils.GetMockReviewReminder(), otherReminder}, nil)
+			},
+			expectedMessage: constants.ReviewRemindersListHeader +
+				"| mockReminderID | on mon, tue, wed, thu, fri at 09:30 (Asia/Kolkata) | mockProjectName | 24h | @mockUsername |\n",
+		},
+		{
+			description: "ReviewRemindersCommand: channel has no reminders",
+			command:     "/azuredevops repos reminders list",
+			setupStore: func(mockedStore *mocks.MockKVStore) {
+				mockedStore.EXPECT().GetReviewReminders().Return([]*serializers.ReviewReminder{}, nil)
+			},
+			expectedMessage: constants.NoReviewReminders,
+		},
+		{
+			description:     "ReviewRemindersCommand: reminder ID is not provided",
+			command:         "/azuredevops repos reminders delete",
+			expectedMessage: constants.ReviewReminderIDRequired,
+		},
+		{
+			description: "ReviewRemindersCommand: reminder does not exist in the channel",
+			command:     "/azuredevops repos reminders delete mockReminderID",
+			setupStore: func(mockedStore *mocks.MockKVStore) {
+				mockedStore.EXPECT().DeleteReviewReminder(testutils.MockChannelID, "mockReminderID").Return(false, nil)
+			},
+			expectedMessage: fmt.Sprintf(constants.ReviewReminderNotFound, "mockReminderID"),
+		},
+		{
+			description: "ReviewRemindersCommand: reminder is deleted",
+			command:     "/azuredevops repos reminders delete mockReminderID",
+			setupStore: func(mockedStore *mocks.MockKVStore) {
+				mockedStore.EXPECT().DeleteReviewReminder(testutils.MockChannelID, "mockReminderID").Return(true, nil)
+			},
+			expectedMessage: fmt.Sprintf(constants.ReviewReminderDeleted, "mockReminderID"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+			mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				assert.Equal(t, testCase.expectedMessage, args.Get(1).(*model.Post).Message)
+			}).Once().Return(&model.Post{})
+			mockAPI.On("GetUser", testutils.MockMattermostUserID).Return(&model.User{
+				Username: "mockUsername",
+				Timezone: model.StringMap{"useAutomaticTimezone": "false", "manualTimezone": "Asia/Kolkata"},
+			}, nil)
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return true
+			})
+			monkey.Patch(model.NewId, func() string {
+				return "mockReminderIDWhichIsLonger"
+			})
+
+			if testCase.setupStore != nil {
+				testCase.setupStore(mockedStore)
+			}
+			if testCase.expectedReminder != nil {
+				testCase.expectedReminder.ID = "mockRemi"
+				mockedStore.EXPECT().StoreReviewReminder(testCase.expectedReminder).Return(nil)
+			}
+
+			_, err := p.ExecuteCommand(&plugin.Context{}, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID, ChannelId: testutils.MockChannelID})
+			assert.Nil(t, err)
+		})
+	}
+}
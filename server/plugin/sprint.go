@@ -0,0 +1,307 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// sprintWorkItemFields are the fields of the work items of an iteration needed for the sprint summary
+var sprintWorkItemFields = []string{
+	constants.FieldReferenceNameTitle,
+	constants.FieldReferenceNameType,
+	constants.FieldReferenceNameState,
+	constants.FieldReferenceNameAssignedTo,
+	constants.FieldReferenceNameChangedDate,
+	constants.FieldReferenceNameRemainingWork,
+}
+
+// GetSprintSummary summarises the current iteration of the team.
+// If the team is not provided, the default team of the project is used.
+// A nil summary is returned if the team has no current iteration.
+func (p *Plugin) GetSprintSummary(mattermostUserID, organization, projectName, teamName string, staleDays int) (*serializers.SprintSummary, int, error) {
+	if teamName == "" {
+		teamName = fmt.Sprintf(constants.DefaultTeamNameFormat, projectName)
+	}
+
+	iterationList, statusCode, err := p.Client.GetCurrentIteration(organization, projectName, teamName, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	if len(iterationList.Value) == 0 {
+		return nil, http.StatusOK, nil
+	}
+
+	iteration := iterationList.Value[0]
+	iterationWorkItems, statusCode, err := p.Client.GetIterationWorkItems(organization, projectName, teamName, iteration.ID, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	capacity, statusCode, err := p.Client.GetIterationCapacities(organization, projectName, teamName, iteration.ID, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	teamSettings, statusCode, err := p.Client.GetTeamSettings(organization, projectName, teamName, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	var workItemIDs []string
+	isAdded := map[int]bool{}
+	for _, relation := range iterationWorkItems.WorkItemRelations {
+		if relation.Target != nil && !isAdded[relation.Target.ID] {
+			isAdded[relation.Target.ID] = true
+			workItemIDs = append(workItemIDs, strconv.Itoa(relation.Target.ID))
+		}
+	}
+
+	var workItems []*serializers.TaskValue
+	for start := 0; start < len(workItemIDs); start += constants.MaxWorkItemsPerRequest {
+		end := start + constants.MaxWorkItemsPerRequest
+		if end > len(workItemIDs) {
+			end = len(workItemIDs)
+		}
+
+		taskList, statusCode, err := p.Client.GetTasks(organization, projectName, workItemIDs[start:end], sprintWorkItemFields, mattermostUserID)
+		if err != nil {
+			return nil, statusCode, err
+		}
+
+		workItems = append(workItems, taskList.Value...)
+	}
+
+	summary := buildSprintSummary(iteration, workItems, capacity, teamSettings.WorkingDays, staleDays, time.Now().UTC())
+	summary.Organization = organization
+	summary.Project = projectName
+	summary.Team = teamName
+	for _, workItem := range summary.StaleWorkItems {
+		workItem.URL = fmt.Sprintf(constants.WorkItemWebURL, p.getConfiguration().AzureDevopsAPIBaseURL, organization, projectName, workItem.ID)
+	}
+
+	return summary, http.StatusOK, nil
+}
+
+// buildSprintSummary summarises the work items of the iteration by state and compares the remaining work assigned to the team members with their capacity for the rest of the iteration
+func buildSprintSummary(iteration *serializers.Iteration, workItems []*serializers.TaskValue, capacity *serializers.IterationCapacity, workingDays []string, staleDays int, now time.Time) *serializers.SprintSummary {
+	summary := &serializers.SprintSummary{
+		Iteration: &serializers.SprintIteration{
+			ID:         iteration.ID,
+			Name:       iteration.Name,
+			Path:       iteration.Path,
+			StartDate:  iteration.Attributes.StartDate,
+			FinishDate: iteration.Attributes.FinishDate,
+		},
+		States:         []*serializers.SprintStateSummary{},
+		Members:        []*serializers.SprintMemberSummary{},
+		StaleDays:      staleDays,
+		StaleWorkItems: []*serializers.SprintWorkItem{},
+	}
+
+	remainingDays := getRemainingWorkingDays(iteration.Attributes.StartDate, iteration.Attributes.FinishDate, workingDays, now)
+	summary.RemainingWorkingDays = len(remainingDays)
+
+	memberByID := map[string]*serializers.SprintMemberSummary{}
+	for _, teamMember := range capacity.TeamMembers {
+		var capacityPerDay float64
+		for _, activity := range teamMember.Activities {
+			capacityPerDay += activity.CapacityPerDay
+		}
+
+		var availableDays int
+		for _, day := range remainingDays {
+			if !isDayOff(day, teamMember.DaysOff) {
+				availableDays++
+			}
+		}
+
+		member := &serializers.SprintMemberSummary{
+			DisplayName: teamMember.TeamMember.DisplayName,
+			UniqueName:  teamMember.TeamMember.UniqueName,
+			Capacity:    capacityPerDay * float64(availableDays),
+		}
+		memberByID[teamMember.TeamMember.ID] = member
+		summary.Members = append(summary.Members, member)
+		summary.Capacity += member.Capacity
+	}
+
+	stateByName := map[string]*serializers.SprintStateSummary{}
+	var unassigned *serializers.SprintMemberSummary
+	staleBefore := now.AddDate(0, 0, -staleDays)
+	for _, workItem := range workItems {
+		// Work items which the user cannot access are returned as null
+		if workItem == nil || strings.EqualFold(workItem.Fields.State, constants.WorkItemStateRemoved) {
+			continue
+		}
+
+		summary.WorkItemCount++
+		summary.RemainingWork += workItem.Fields.RemainingWork
+
+		state, ok := stateByName[workItem.Fields.State]
+		if !ok {
+			state = &serializers.SprintStateSummary{State: workItem.Fields.State}
+			stateByName[workItem.Fields.State] = state
+			summary.States = append(summary.States, state)
+		}
+		state.WorkItemCount++
+		state.RemainingWork += workItem.Fields.RemainingWork
+
+		assignedTo := workItem.Fields.AssignedTo
+		member, ok := memberByID[assignedTo.ID]
+		if !ok {
+			if assignedTo.ID == "" {
+				if unassigned == nil {
+					unassigned = &serializers.SprintMemberSummary{DisplayName: constants.UnassignedMember}
+				}
+				member = unassigned
+			} else {
+				// The work item is assigned to someone who has no capacity set for the iteration
+				member = &serializers.SprintMemberSummary{
+					DisplayName: assignedTo.DisplayName,
+					UniqueName:  assignedTo.UniqueName,
+				}
+				memberByID[assignedTo.ID] = member
+				summary.Members = append(summary.Members, member)
+			}
+		}
+		member.WorkItemCount++
+		member.AssignedWork += workItem.Fields.RemainingWork
+
+		if !serializers.IsCompletedWorkItemState(workItem.Fields.State) && workItem.Fields.UpdatedAt.Before(staleBefore) {
+			summary.StaleWorkItems = append(summary.StaleWorkItems, &serializers.SprintWorkItem{
+				ID:              workItem.ID,
+				Title:           workItem.Fields.Title,
+				Type:            workItem.Fields.Type,
+				State:           workItem.Fields.State,
+				AssignedTo:      assignedTo.DisplayName,
+				UpdatedAt:       workItem.Fields.UpdatedAt,
+				DaysSinceUpdate: int(now.Sub(workItem.Fields.UpdatedAt).Hours() / 24),
+			})
+		}
+	}
+
+	sort.Slice(summary.States, func(i, j int) bool {
+		return summary.States[i].State < summary.States[j].State
+	})
+	sort.Slice(summary.Members, func(i, j int) bool {
+		return strings.ToLower(summary.Members[i].DisplayName) < strings.ToLower(summary.Members[j].DisplayName)
+	})
+	sort.Slice(summary.StaleWorkItems, func(i, j int) bool {
+		return summary.StaleWorkItems[i].UpdatedAt.Before(summary.StaleWorkItems[j].UpdatedAt)
+	})
+
+	// Unassigned work is shown after the team members
+	if unassigned != nil {
+		summary.Members = append(summary.Members, unassigned)
+	}
+
+	return summary
+}
+
+// getRemainingWorkingDays returns the working days of the iteration from the current day till the end of the iteration
+func getRemainingWorkingDays(startDate, finishDate *time.Time, workingDays []string, now time.Time) []time.Time {
+	if startDate == nil || finishDate == nil {
+		return nil
+	}
+
+	isWorkingDay := map[string]bool{}
+	for _, workingDay := range workingDays {
+		isWorkingDay[strings.ToLower(workingDay)] = true
+	}
+
+	day := truncateToDay(now)
+	if start := truncateToDay(*startDate); day.Before(start) {
+		day = start
+	}
+
+	var remainingDays []time.Time
+	for finish := truncateToDay(*finishDate); !day.After(finish); day = day.AddDate(0, 0, 1) {
+		if isWorkingDay[strings.ToLower(day.Weekday().String())] {
+			remainingDays = append(remainingDays, day)
+		}
+	}
+
+	return remainingDays
+}
+
+func isDayOff(day time.Time, daysOff []*serializers.DateRange) bool {
+	for _, dayOff := range daysOff {
+		if !day.Before(truncateToDay(dayOff.Start)) && !day.After(truncateToDay(dayOff.End)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// truncateToDay returns the start of the day of the time in UTC, as the dates of iterations are stored in UTC
+func truncateToDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// ParseSprintSummaryToCommandResponse formats the sprint summary as markdown tables
+func (p *Plugin) ParseSprintSummaryToCommandResponse(summary *serializers.SprintSummary) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(constants.SprintTitle, summary.Iteration.Name, formatIterationDate(summary.Iteration.StartDate), formatIterationDate(summary.Iteration.FinishDate), summary.Project, summary.Team))
+	sb.WriteString(fmt.Sprintf(constants.SprintOverview, summary.WorkItemCount, formatHours(summary.RemainingWork), formatHours(summary.Capacity), summary.RemainingWorkingDays))
+
+	if len(summary.States) > 0 {
+		sb.WriteString("\n| State | Work items | Remaining work |\n")
+		sb.WriteString("| :---- | :--------- | :------------- |\n")
+		for _, state := range summary.States {
+			sb.WriteString(fmt.Sprintf("| %s | %d | %s |\n", state.State, state.WorkItemCount, formatHours(state.RemainingWork)))
+		}
+	}
+
+	if len(summary.Members) > 0 {
+		sb.WriteString("\n| Member | Work items | Remaining work | Capacity |\n")
+		sb.WriteString("| :----- | :--------- | :------------- | :------- |\n")
+		for _, member := range summary.Members {
+			capacity := formatHours(member.Capacity)
+			if member.DisplayName == constants.UnassignedMember && member.UniqueName == "" {
+				capacity = "-"
+			} else if member.IsOverallocated() {
+				capacity += " :warning:"
+			}
+			sb.WriteString(fmt.Sprintf("| %s | %d | %s | %s |\n", member.DisplayName, member.WorkItemCount, formatHours(member.AssignedWork), capacity))
+		}
+	}
+
+	sb.WriteString("\n")
+	if len(summary.StaleWorkItems) == 0 {
+		sb.WriteString(fmt.Sprintf(constants.SprintNoStaleWorkItems, summary.StaleDays))
+		return sb.String()
+	}
+
+	sb.WriteString(fmt.Sprintf(constants.SprintStaleWorkItemsTitle, summary.StaleDays))
+	for _, workItem := range summary.StaleWorkItems {
+		assignedTo := workItem.AssignedTo
+		if assignedTo == "" {
+			assignedTo = constants.UnassignedMember
+		}
+		sb.WriteString(fmt.Sprintf(constants.SprintStaleWorkItem, workItem.Type, workItem.ID, workItem.Title, workItem.URL, workItem.State, assignedTo, workItem.DaysSinceUpdate))
+	}
+
+	return sb.String()
+}
+
+func formatIterationDate(date *time.Time) string {
+	if date == nil {
+		return "not set"
+	}
+
+	return date.UTC().Format("Jan 2")
+}
+
+func formatHours(hours float64) string {
+	return strconv.FormatFloat(hours, 'f', -1, 64) + "h"
+}
@@ -0,0 +1,317 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/config"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+var mockWorkingDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday"}
+
+func TestGetRemainingWorkingDays(t *testing.T) {
+	iteration := testutils.GetMockIteration()
+	for _, testCase := range []struct {
+		description  string
+		now          time.Time
+		expectedDays int
+	}{
+		{
+			description:  "GetRemainingWorkingDays: iteration has not started",
+			now:          time.Date(2022, time.December, 30, 10, 0, 0, 0, time.UTC),
+			expectedDays: 10,
+		},
+		{
+			description:  "GetRemainingWorkingDays: iteration is in progress",
+			now:          time.Date(2023, time.January, 10, 18, 30, 0, 0, time.UTC),
+			expectedDays: 4,
+		},
+		{
+			description:  "GetRemainingWorkingDays: last day of the iteration",
+			now:          time.Date(2023, time.January, 13, 23, 0, 0, 0, time.UTC),
+			expectedDays: 1,
+		},
+		{
+			description: "GetRemainingWorkingDays: iteration has ended",
+			now:         time.Date(2023, time.January, 14, 9, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			days := getRemainingWorkingDays(iteration.Attributes.StartDate, iteration.Attributes.FinishDate, mockWorkingDays, testCase.now)
+			assert.Len(t, days, testCase.expectedDays)
+		})
+	}
+
+	t.Run("GetRemainingWorkingDays: iteration dates are not set", func(t *testing.T) {
+		assert.Nil(t, getRemainingWorkingDays(nil, nil, mockWorkingDays, time.Now()))
+	})
+}
+
+func TestBuildSprintSummary(t *testing.T) {
+	now := time.Date(2023, time.January, 10, 12, 0, 0, 0, time.UTC)
+	workItems := []*serializers.TaskValue{
+		testutils.GetMockSprintWorkItem(1, "Active", "mockUser1", 8, now.AddDate(0, 0, -1)),
+		testutils.GetMockSprintWorkItem(2, "Active", "mockUser1", 16.5, now.AddDate(0, 0, -5)),
+		testutils.GetMockSprintWorkItem(3, "New", "mockUser2", 4, now.AddDate(0, 0, -4)),
+		testutils.GetMockSprintWorkItem(4, "New", "", 2, now.AddDate(0, 0, -1)),
+		testutils.GetMockSprintWorkItem(5, constants.WorkItemStateClosed, "mockUser2", 0, now.AddDate(0, 0, -10)),
+		testutils.GetMockSprintWorkItem(6, constants.WorkItemStateRemoved, "mockUser2", 3, now.AddDate(0, 0, -10)),
+		testutils.GetMockSprintWorkItem(7, "Active", "mockUser3", 1, now),
+		nil,
+	}
+	capacity := &serializers.IterationCapacity{
+		TeamMembers: []*serializers.TeamMemberCapacity{
+			{
+				TeamMember: serializers.TaskUserDetails{ID: "mockUser2", DisplayName: "mockUser2"},
+				Activities: []*serializers.ActivityCapacity{{Name: "Development", CapacityPerDay: 4}, {Name: "Testing", CapacityPerDay: 2}},
+			},
+			{
+				TeamMember: serializers.TaskUserDetails{ID: "mockUser1", DisplayName: "mockUser1"},
+				Activities: []*serializers.ActivityCapacity{{Name: "Development", CapacityPerDay: 6}},
+				DaysOff:    []*serializers.DateRange{{Start: time.Date(2023, time.January, 12, 0, 0, 0, 0, time.UTC), End: time.Date(2023, time.January, 13, 0, 0, 0, 0, time.UTC)}},
+			},
+		},
+	}
+
+	summary := buildSprintSummary(testutils.GetMockIteration(), workItems, capacity, mockWorkingDays, 3, now)
+
+	assert.Equal(t, "Sprint 1", summary.Iteration.Name)
+	assert.Equal(t, 4, summary.RemainingWorkingDays)
+	assert.Equal(t, 6, summary.WorkItemCount)
+	assert.Equal(t, 31.5, summary.RemainingWork)
+	assert.Equal(t, float64(36), summary.Capacity)
+	assert.Equal(t, []*serializers.SprintStateSummary{
+		{State: "Active", WorkItemCount: 3, RemainingWork: 25.5},
+		{State: constants.WorkItemStateClosed, WorkItemCount: 1},
+		{State: "New", WorkItemCount: 2, RemainingWork: 6},
+	}, summary.States)
+	assert.Equal(t, []*serializers.SprintMemberSummary{
+		{DisplayName: "mockUser1", Capacity: 12, AssignedWork: 24.5, WorkItemCount: 2},
+		{DisplayName: "mockUser2", Capacity: 24, AssignedWork: 4, WorkItemCount: 2},
+		{DisplayName: "mockUser3", AssignedWork: 1, WorkItemCount: 1},
+		{DisplayName: constants.UnassignedMember, AssignedWork: 2, WorkItemCount: 1},
+	}, summary.Members)
+	assert.True(t, summary.Members[0].IsOverallocated())
+	assert.False(t, summary.Members[1].IsOverallocated())
+
+	if assert.Len(t, summary.StaleWorkItems, 2) {
+		assert.Equal(t, 2, summary.StaleWorkItems[0].ID)
+		assert.Equal(t, 5, summary.StaleWorkItems[0].DaysSinceUpdate)
+		assert.Equal(t, 3, summary.StaleWorkItems[1].ID)
+	}
+}
+
+func TestGetSprintSummary(t *testing.T) {
+	for _, testCase := range []struct {
+		description        string
+		iterations         []*serializers.Iteration
+		iterationErr       error
+		workItemRelations  []*serializers.IterationWorkItemRelation
+		expectedIDs        []string
+		expectSummary      bool
+		expectedStatusCode int
+	}{
+		{
+			description: "GetSprintSummary: summary is fetched",
+			iterations:  []*serializers.Iteration{testutils.GetMockIteration()},
+			workItemRelations: []*serializers.IterationWorkItemRelation{
+				{Target: &serializers.WorkItemReference{ID: 1}},
+				{Rel: constants.RelationTypeChild, Source: &serializers.WorkItemReference{ID: 1}, Target: &serializers.WorkItemReference{ID: 2}},
+				{Target: &serializers.WorkItemReference{ID: 2}},
+			},
+			expectedIDs:        []string{"1", "2"},
+			expectSummary:      true,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "GetSprintSummary: team has no current iteration",
+			iterations:         []*serializers.Iteration{},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "GetSprintSummary: failed to get the current iteration",
+			iterationErr:       errors.New("failed to get the current iteration"),
+			expectedStatusCode: http.StatusNotFound,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(&plugintest.API{}, nil, mockedClient)
+			p.setConfiguration(
+				&config.Configuration{
+					AzureDevopsAPIBaseURL: "https://dev.azure.com",
+				})
+			team := "mockProjectName Team"
+
+			mockedClient.EXPECT().GetCurrentIteration(testutils.MockOrganization, testutils.MockProjectName, team, testutils.MockMattermostUserID).Return(&serializers.IterationList{Value: testCase.iterations}, testCase.expectedStatusCode, testCase.iterationErr)
+
+			if testCase.expectSummary {
+				mockedClient.EXPECT().GetIterationWorkItems(testutils.MockOrganization, testutils.MockProjectName, team, "mockIterationID", testutils.MockMattermostUserID).Return(&serializers.IterationWorkItems{WorkItemRelations: testCase.workItemRelations}, http.StatusOK, nil)
+				mockedClient.EXPECT().GetIterationCapacities(testutils.MockOrganization, testutils.MockProjectName, team, "mockIterationID", testutils.MockMattermostUserID).Return(&serializers.IterationCapacity{}, http.StatusOK, nil)
+				mockedClient.EXPECT().GetTeamSettings(testutils.MockOrganization, testutils.MockProjectName, team, testutils.MockMattermostUserID).Return(&serializers.TeamSettings{WorkingDays: mockWorkingDays}, http.StatusOK, nil)
+				mockedClient.EXPECT().GetTasks(testutils.MockOrganization, testutils.MockProjectName, testCase.expectedIDs, sprintWorkItemFields, testutils.MockMattermostUserID).Return(&serializers.TaskList{
+					Value: []*serializers.TaskValue{
+						testutils.GetMockSprintWorkItem(1, "Active", "", 0, time.Time{}),
+						testutils.GetMockSprintWorkItem(2, "Active", "", 0, time.Now()),
+					},
+				}, http.StatusOK, nil)
+			}
+
+			summary, statusCode, err := p.GetSprintSummary(testutils.MockMattermostUserID, testutils.MockOrganization, testutils.MockProjectName, "", 3)
+
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			if testCase.iterationErr != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, summary)
+				return
+			}
+
+			assert.Nil(t, err)
+			if !testCase.expectSummary {
+				assert.Nil(t, summary)
+				return
+			}
+
+			assert.Equal(t, team, summary.Team)
+			assert.Equal(t, 2, summary.WorkItemCount)
+			if assert.Len(t, summary.StaleWorkItems, 1) {
+				assert.Equal(t, "https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/1", summary.StaleWorkItems[0].URL)
+			}
+		})
+	}
+}
+
+func TestParseSprintSummaryToCommandResponse(t *testing.T) {
+	p := setupMockPlugin(nil, nil, nil)
+	iteration := testutils.GetMockIteration()
+	summary := &serializers.SprintSummary{
+		Project: testutils.MockProjectName,
+		Team:    "mockProjectName Team",
+		Iteration: &serializers.SprintIteration{
+			Name:       iteration.Name,
+			StartDate:  iteration.Attributes.StartDate,
+			FinishDate: iteration.Attributes.FinishDate,
+		},
+		WorkItemCount:        2,
+		RemainingWork:        10.5,
+		Capacity:             8,
+		RemainingWorkingDays: 2,
+		States:               []*serializers.SprintStateSummary{{State: "Active", WorkItemCount: 2, RemainingWork: 10.5}},
+		Members: []*serializers.SprintMemberSummary{
+			{DisplayName: "mockUser", Capacity: 8, AssignedWork: 8.5, WorkItemCount: 1},
+			{DisplayName: constants.UnassignedMember, AssignedWork: 2, WorkItemCount: 1},
+		},
+		StaleDays:      3,
+		StaleWorkItems: []*serializers.SprintWorkItem{{ID: 1, Title: "mockTitle", Type: "Task", State: "Active", URL: "mockLink", DaysSinceUpdate: 4}},
+	}
+
+	expected := "###### Sprint 1 (Jan 2 - Jan 13) | mockProjectName / mockProjectName Team\n" +
+		"**2** work item(s) | **10.5h** remaining work | **8h** capacity over the remaining 2 working day(s)\n" +
+		"\n| State | Work items | Remaining work |\n" +
+		"| :---- | :--------- | :------------- |\n" +
+		"| Active | 2 | 10.5h |\n" +
+		"\n| Member | Work items | Remaining work | Capacity |\n" +
+		"| :----- | :--------- | :------------- | :------- |\n" +
+		"| mockUser | 1 | 8.5h | 8h :warning: |\n" +
+		"| Unassigned | 1 | 2h | - |\n" +
+		"\n###### Not updated in the last 3 day(s)\n" +
+		"* [Task #1: mockTitle](mockLink) | Active | Unassigned | updated 4 day(s) ago\n"
+	assert.Equal(t, expected, p.ParseSprintSummaryToCommandResponse(summary))
+
+	summary.StaleWorkItems = nil
+	assert.Contains(t, p.ParseSprintSummaryToCommandResponse(summary), "All work items were updated in the last 3 day(s).")
+}
+
+func TestExecuteSprintCommand(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	mockCtrl := gomock.NewController(t)
+	mockedStore := mocks.NewMockKVStore(mockCtrl)
+	p := setupMockPlugin(mockAPI, mockedStore, nil)
+	for _, testCase := range []struct {
+		description       string
+		command           string
+		linkedProjects    []serializers.ProjectDetails
+		summary           *serializers.SprintSummary
+		expectedTeam      string
+		expectedStaleDays int
+		ephemeralMessage  string
+	}{
+		{
+			description:      "SprintCommand: invalid number of days",
+			command:          "/azuredevops boards sprint --stale-days=none",
+			ephemeralMessage: constants.InvalidStaleDays,
+		},
+		{
+			description:      "SprintCommand: number of days is not provided",
+			command:          "/azuredevops boards sprint --stale-days",
+			ephemeralMessage: constants.InvalidStaleDays,
+		},
+		{
+			description:      "SprintCommand: project is not linked",
+			command:          "/azuredevops boards sprint mockOtherProject",
+			linkedProjects:   testutils.GetProjectDetailsPayload(),
+			ephemeralMessage: constants.SprintProjectRequired,
+		},
+		{
+			description:       "SprintCommand: team has no current iteration",
+			command:           "/azuredevops boards sprint",
+			linkedProjects:    testutils.GetProjectDetailsPayload(),
+			expectedStaleDays: constants.DefaultStaleDays,
+			ephemeralMessage:  fmt.Sprintf(constants.NoCurrentIteration, "mockProjectName Team", testutils.MockProjectName),
+		},
+		{
+			description:       "SprintCommand: summary of the current iteration",
+			command:           `/azuredevops boards sprint mockProjectName "Web Team" --stale-days 5`,
+			linkedProjects:    testutils.GetProjectDetailsPayload(),
+			summary:           &serializers.SprintSummary{Iteration: &serializers.SprintIteration{Name: "Sprint 1"}, Project: testutils.MockProjectName, Team: "Web Team", StaleDays: 5},
+			expectedTeam:      "Web Team",
+			expectedStaleDays: 5,
+			ephemeralMessage: "###### Sprint 1 (not set - not set) | mockProjectName / Web Team\n" +
+				"**0** work item(s) | **0h** remaining work | **0h** capacity over the remaining 0 working day(s)\n" +
+				"\nAll work items were updated in the last 5 day(s).",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				post := args.Get(1).(*model.Post)
+				assert.Equal(t, testCase.ephemeralMessage, post.Message)
+			}).Once().Return(&model.Post{})
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return true
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetSprintSummary", func(_ *Plugin, _, organization, project, team string, staleDays int) (*serializers.SprintSummary, int, error) {
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, testutils.MockProjectName, project)
+				assert.Equal(t, testCase.expectedTeam, team)
+				assert.Equal(t, testCase.expectedStaleDays, staleDays)
+				return testCase.summary, http.StatusOK, nil
+			})
+
+			if testCase.linkedProjects != nil {
+				mockedStore.EXPECT().GetAllProjects(testutils.MockMattermostUserID).Return(testCase.linkedProjects, nil)
+			}
+
+			_, err := p.ExecuteCommand(&plugin.Context{}, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID})
+			assert.Nil(t, err)
+		})
+	}
+}
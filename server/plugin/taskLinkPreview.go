@@ -7,6 +7,7 @@ import (
 	"github.com/mattermost/mattermost-server/v5/model"
 
 	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
 )
 
 // postTaskPreview function returns the new post containing the preview of the work item.
@@ -18,7 +19,7 @@ func (p *Plugin) PostTaskPreview(linkData []string, userID, channelID string) (*
 		return nil, ""
 	}
 
-	assignedTo := task.Fields.AssignedTo.DisplayName
+	assignedTo := p.getAzureIdentityMention(task.Fields.AssignedTo.GetIdentity())
 	if assignedTo == "" {
 		assignedTo = "None"
 	}
@@ -28,6 +29,24 @@ func (p *Plugin) PostTaskPreview(linkData []string, userID, channelID string) (*
 		description = "No description"
 	}
 
+	fields := []*model.SlackAttachmentField{
+		{
+			Title: "State",
+			Value: task.Fields.State,
+			Short: true,
+		},
+		{
+			Title: "Assigned To",
+			Value: assignedTo,
+			Short: true,
+		},
+	}
+	fields = append(fields, p.getWorkItemHierarchyFields(task, linkData[3], linkData[4], userID)...)
+	fields = append(fields, &model.SlackAttachmentField{
+		Title: "Description",
+		Value: description,
+	})
+
 	post := &model.Post{
 		UserId:    userID,
 		ChannelId: channelID,
@@ -37,22 +56,7 @@ func (p *Plugin) PostTaskPreview(linkData []string, userID, channelID string) (*
 		AuthorIcon: fmt.Sprintf(constants.PublicFiles, p.GetSiteURL(), constants.PluginID, constants.FileNameBoardsIcon),
 		Title:      fmt.Sprintf(constants.TaskTitle, task.Fields.Type, task.ID, task.Fields.Title, task.Link.HTML.Href),
 		Color:      constants.IconColorBoards,
-		Fields: []*model.SlackAttachmentField{
-			{
-				Title: "State",
-				Value: task.Fields.State,
-				Short: true,
-			},
-			{
-				Title: "Assigned To",
-				Value: assignedTo,
-				Short: true,
-			},
-			{
-				Title: "Description",
-				Value: description,
-			},
-		},
+		Fields:     fields,
 		Footer:     linkData[4],
 		FooterIcon: fmt.Sprintf(constants.PublicFiles, p.GetSiteURL(), constants.PluginID, constants.FileNameProjectIcon),
 	}
@@ -67,6 +71,17 @@ func (p *Plugin) PostPullRequestPreview(linkData []string, link, userID, channel
 		return nil, ""
 	}
 
+	post := &model.Post{
+		UserId:    userID,
+		ChannelId: channelID,
+	}
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{p.getPullRequestPreviewAttachment(linkData[3], linkData[6], link, pullRequest)})
+
+	return post, ""
+}
+
+// getPullRequestPreviewAttachment returns the attachment showing the branches and reviewers of a pull request along with its actions
+func (p *Plugin) getPullRequestPreviewAttachment(organization, footer, link string, pullRequest *serializers.PullRequest) *model.SlackAttachment {
 	var targetBranchName, sourceBranchName string
 	if len(strings.Split(pullRequest.TargetRefName, "/")) == 3 {
 		targetBranchName = strings.Split(pullRequest.TargetRefName, "/")[2]
@@ -76,10 +91,6 @@ func (p *Plugin) PostPullRequestPreview(linkData []string, link, userID, channel
 		sourceBranchName = strings.Split(pullRequest.SourceRefName, "/")[2]
 	}
 
-	post := &model.Post{
-		UserId:    userID,
-		ChannelId: channelID,
-	}
 	reviewers := p.getReviewersListString(pullRequest.Reviewers)
 	attachment := &model.SlackAttachment{
 		AuthorName: "Azure Repos",
@@ -98,16 +109,16 @@ func (p *Plugin) PostPullRequestPreview(linkData []string, link, userID, channel
 				Short: true,
 			},
 			{
-				Title: "Reviewer(s)",
+				Title: constants.PullRequestReviewersFieldTitle,
 				Value: reviewers,
 			},
 		},
-		Footer:     linkData[6],
+		Footer:     footer,
 		FooterIcon: fmt.Sprintf(constants.PublicFiles, p.GetSiteURL(), constants.PluginID, constants.FileNameProjectIcon),
 	}
-	model.ParseSlackAttachment(post, []*model.SlackAttachment{attachment})
 
-	return post, ""
+	attachment.Actions = p.getPullRequestActions(organization, pullRequest.Repository.Project.ID, pullRequest.Repository.ID, pullRequest.PullRequestID, pullRequest.Status)
+	return attachment
 }
 
 func (p *Plugin) PostBuildDetailsPreview(linkData []string, link, userID, channelID string) (*model.Post, string) {
@@ -154,6 +165,7 @@ func (p *Plugin) PostBuildDetailsPreview(linkData []string, link, userID, channe
 		},
 		Footer:     project,
 		FooterIcon: fmt.Sprintf(constants.PublicFiles, p.GetSiteURL(), constants.PluginID, constants.FileNameProjectIcon),
+		Actions:    p.getPipelineRunActions(organization, project, buildDetails.ID, buildDetails.Status, buildDetails.Result, ""),
 	}
 
 	model.ParseSlackAttachment(post, []*model.SlackAttachment{attachment})
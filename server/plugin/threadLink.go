@@ -0,0 +1,224 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// LinkThreadToWorkItem links the thread of the post to a work item.
+// A hyperlink relation pointing at the thread is added to the work item and the link is stored in the KV store.
+func (p *Plugin) LinkThreadToWorkItem(mattermostUserID, postID, organization, projectName, workItemID string, mirrorReplies bool) (*serializers.ThreadLink, int, error) {
+	rootPost, statusCode, err := p.getRootPostForUser(mattermostUserID, postID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	task, statusCode, err := p.Client.GetTask(organization, workItemID, projectName, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	existingThreadLink, err := p.Store.GetThreadLink(rootPost.Id)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	isAlreadyLinked := existingThreadLink != nil && strings.EqualFold(existingThreadLink.OrganizationName, organization) && existingThreadLink.WorkItemID == workItemID
+	if existingThreadLink != nil && !isAlreadyLinked {
+		if err = p.Store.DeleteThreadLink(existingThreadLink); err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+	}
+
+	if !isAlreadyLinked {
+		permalink, permalinkErr := p.getPostPermalink(mattermostUserID, rootPost)
+		if permalinkErr != nil {
+			return nil, http.StatusInternalServerError, permalinkErr
+		}
+
+		if statusCode, err = p.Client.AddWorkItemHyperlink(organization, projectName, workItemID, permalink, constants.ThreadLinkRelationComment, mattermostUserID); err != nil {
+			return nil, statusCode, err
+		}
+	}
+
+	threadLink := &serializers.ThreadLink{
+		PostID:           rootPost.Id,
+		ChannelID:        rootPost.ChannelId,
+		OrganizationName: organization,
+		ProjectName:      projectName,
+		WorkItemID:       workItemID,
+		WorkItemURL:      task.Link.HTML.Href,
+		MirrorReplies:    mirrorReplies,
+		MattermostUserID: mattermostUserID,
+	}
+
+	if err = p.Store.StoreThreadLink(threadLink); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	message := fmt.Sprintf(constants.ThreadLinked, workItemID, threadLink.WorkItemURL)
+	if mirrorReplies {
+		message = fmt.Sprintf(constants.ThreadLinkedWithMirroring, workItemID, threadLink.WorkItemURL)
+	}
+
+	if _, appErr := p.API.CreatePost(&model.Post{
+		UserId:    p.botUserID,
+		ChannelId: rootPost.ChannelId,
+		RootId:    rootPost.Id,
+		Message:   message,
+	}); appErr != nil {
+		p.API.LogError("Error in creating post", "Error", appErr.Error())
+	}
+
+	return threadLink, http.StatusOK, nil
+}
+
+// UnlinkThread removes the link between the thread of the post and its work item.
+// The hyperlink relation on the work item is kept as the thread still contains the earlier discussion.
+func (p *Plugin) UnlinkThread(mattermostUserID, postID string) (*serializers.ThreadLink, int, error) {
+	rootPost, statusCode, err := p.getRootPostForUser(mattermostUserID, postID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	threadLink, err := p.Store.GetThreadLink(rootPost.Id)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	if threadLink == nil {
+		return nil, http.StatusNotFound, errors.New(constants.ThreadNotLinked)
+	}
+
+	if err := p.Store.DeleteThreadLink(threadLink); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return threadLink, http.StatusOK, nil
+}
+
+// MirrorThreadReply adds a reply in a thread linked to a work item as a comment on the work item, if mirroring is enabled for the thread.
+// The comment is added using the Azure DevOps account of the author of the reply.
+func (p *Plugin) MirrorThreadReply(post *model.Post) {
+	if post.RootId == "" || post.UserId == p.botUserID || post.IsSystemMessage() || strings.TrimSpace(post.Message) == "" {
+		return
+	}
+
+	threadLink, err := p.Store.GetThreadLink(post.RootId)
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadThreadLink, "Error", err.Error())
+		return
+	}
+
+	if threadLink == nil || !threadLink.MirrorReplies {
+		return
+	}
+
+	if isConnected := p.MattermostUserAlreadyConnected(post.UserId); !isConnected {
+		p.API.SendEphemeralPost(post.UserId, &model.Post{
+			UserId:    p.botUserID,
+			ChannelId: post.ChannelId,
+			RootId:    post.RootId,
+			Message:   constants.ThreadReplyNotMirrored,
+		})
+		return
+	}
+
+	text := fmt.Sprintf(constants.MirroredCommentFormat, strings.ReplaceAll(html.EscapeString(post.Message), "\n", "<br>"))
+	if _, _, err := p.Client.AddWorkItemComment(threadLink.OrganizationName, threadLink.ProjectName, threadLink.WorkItemID, text, post.UserId); err != nil {
+		p.API.LogError(constants.ErrorMirrorThreadReply, "Error", err.Error())
+	}
+}
+
+// GetLinkedThreadForWorkItemComment returns the root post ID of the thread in the channel linked to the work item of a comment notification.
+// It also returns if the comment was mirrored from the linked thread, in which case the comment is already present in the thread.
+func (p *Plugin) GetLinkedThreadForWorkItemComment(body *serializers.SubscriptionNotification, channelID string) (string, bool) {
+	// The URL of the work item is of the form "https://dev.azure.com/{organization}/{projectID}/_apis/wit/workItems/{workItemID}"
+	urlPaths := strings.Split(body.Resource.URL, "/")
+	if len(urlPaths) < 4 || body.Resource.ID == nil {
+		return "", false
+	}
+
+	threadLinks, err := p.Store.GetThreadLinksForWorkItem(urlPaths[3], fmt.Sprint(body.Resource.ID))
+	if err != nil {
+		p.API.LogError(constants.ErrorLoadThreadLink, "Error", err.Error())
+		return "", false
+	}
+
+	for _, threadLink := range threadLinks {
+		if threadLink.ChannelID == channelID {
+			return threadLink.PostID, threadLink.MirrorReplies && strings.Contains(body.DetailedMessage.Markdown, constants.MirroredCommentMarker)
+		}
+	}
+
+	return "", false
+}
+
+// getPostForUser returns the post, if the user has access to the channel of the post
+func (p *Plugin) getPostForUser(mattermostUserID, postID string) (*model.Post, int, error) {
+	post, appErr := p.API.GetPost(postID)
+	if appErr != nil {
+		return nil, appErr.StatusCode, appErr
+	}
+
+	if _, appErr = p.API.GetChannelMember(post.ChannelId, mattermostUserID); appErr != nil {
+		return nil, http.StatusForbidden, errors.New(constants.NotAuthorized)
+	}
+
+	return post, http.StatusOK, nil
+}
+
+// getRootPostForUser returns the root post of the thread of the post, if the user has access to the channel of the post
+func (p *Plugin) getRootPostForUser(mattermostUserID, postID string) (*model.Post, int, error) {
+	post, statusCode, err := p.getPostForUser(mattermostUserID, postID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	if post.RootId == "" {
+		return post, http.StatusOK, nil
+	}
+
+	rootPost, appErr := p.API.GetPost(post.RootId)
+	if appErr != nil {
+		return nil, appErr.StatusCode, appErr
+	}
+
+	return rootPost, http.StatusOK, nil
+}
+
+// getPostPermalink returns the permalink of the post.
+// Posts in direct and group messages do not belong to a team, so any team of the user is used for their permalink.
+func (p *Plugin) getPostPermalink(mattermostUserID string, post *model.Post) (string, error) {
+	channel, appErr := p.API.GetChannel(post.ChannelId)
+	if appErr != nil {
+		return "", appErr
+	}
+
+	teamID := channel.TeamId
+	if teamID == "" {
+		teams, appErr := p.API.GetTeamsForUser(mattermostUserID)
+		if appErr != nil {
+			return "", appErr
+		}
+		if len(teams) == 0 {
+			return "", errors.New("unable to find a team of the user for the permalink")
+		}
+		teamID = teams[0].Id
+	}
+
+	team, appErr := p.API.GetTeam(teamID)
+	if appErr != nil {
+		return "", appErr
+	}
+
+	return fmt.Sprintf(constants.PathPostPermalink, strings.TrimRight(p.GetSiteURL(), "/"), team.Name, post.Id), nil
+}
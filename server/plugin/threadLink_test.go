@@ -0,0 +1,269 @@
+package plugin
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/config"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestLinkThreadToWorkItem(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description        string
+		existingThreadLink *serializers.ThreadLink
+		getTaskError       error
+		hyperlinkError     error
+		expectHyperlink    bool
+		expectDelete       bool
+		expectedStatusCode int
+	}{
+		{
+			description:        "LinkThreadToWorkItem: thread is linked",
+			expectHyperlink:    true,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "LinkThreadToWorkItem: thread is already linked to the work item",
+			existingThreadLink: &serializers.ThreadLink{PostID: "mockRootID", OrganizationName: "MockOrganization", WorkItemID: "1"},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "LinkThreadToWorkItem: thread is linked to another work item",
+			existingThreadLink: &serializers.ThreadLink{PostID: "mockRootID", OrganizationName: testutils.MockOrganization, WorkItemID: "2"},
+			expectDelete:       true,
+			expectHyperlink:    true,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "LinkThreadToWorkItem: failed to get the work item",
+			getTaskError:       errors.New("failed to get the work item"),
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			description:        "LinkThreadToWorkItem: failed to add the hyperlink",
+			expectHyperlink:    true,
+			hyperlinkError:     errors.New("failed to add the hyperlink"),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, mockedClient)
+			p.botUserID = "mockBotID"
+			p.setConfiguration(
+				&config.Configuration{
+					MattermostSiteURL: "https://mattermost.example.com/",
+				})
+
+			mockAPI.On("GetPost", "mockPostID").Return(&model.Post{Id: "mockPostID", RootId: "mockRootID", ChannelId: testutils.MockChannelID}, nil)
+			mockAPI.On("GetPost", "mockRootID").Return(&model.Post{Id: "mockRootID", ChannelId: testutils.MockChannelID}, nil)
+			mockAPI.On("GetChannelMember", testutils.MockChannelID, testutils.MockMattermostUserID).Return(&model.ChannelMember{}, nil)
+			mockAPI.On("GetChannel", testutils.MockChannelID).Return(&model.Channel{TeamId: testutils.MockTeamID}, nil)
+			mockAPI.On("GetTeam", testutils.MockTeamID).Return(&model.Team{Name: "mockTeam"}, nil)
+			mockAPI.On("CreatePost", mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				post := args.Get(0).(*model.Post)
+				assert.Equal(t, "mockRootID", post.RootId)
+				assert.Equal(t, "mockBotID", post.UserId)
+			}).Return(&model.Post{}, nil)
+
+			task := &serializers.TaskValue{}
+			task.Link.HTML.Href = "mockWorkItemURL"
+			mockedClient.EXPECT().GetTask(testutils.MockOrganization, "1", testutils.MockProjectName, testutils.MockMattermostUserID).Return(task, testCase.expectedStatusCode, testCase.getTaskError)
+
+			if testCase.getTaskError == nil {
+				mockedStore.EXPECT().GetThreadLink("mockRootID").Return(testCase.existingThreadLink, nil)
+			}
+
+			if testCase.expectDelete {
+				mockedStore.EXPECT().DeleteThreadLink(testCase.existingThreadLink).Return(nil)
+			}
+
+			if testCase.expectHyperlink {
+				mockedClient.EXPECT().AddWorkItemHyperlink(testutils.MockOrganization, testutils.MockProjectName, "1", "https://mattermost.example.com/mockTeam/pl/mockRootID", constants.ThreadLinkRelationComment, testutils.MockMattermostUserID).Return(testCase.expectedStatusCode, testCase.hyperlinkError)
+			}
+
+			if testCase.getTaskError == nil && testCase.hyperlinkError == nil {
+				mockedStore.EXPECT().StoreThreadLink(&serializers.ThreadLink{
+					PostID:           "mockRootID",
+					ChannelID:        testutils.MockChannelID,
+					OrganizationName: testutils.MockOrganization,
+					ProjectName:      testutils.MockProjectName,
+					WorkItemID:       "1",
+					WorkItemURL:      "mockWorkItemURL",
+					MirrorReplies:    true,
+					MattermostUserID: testutils.MockMattermostUserID,
+				}).Return(nil)
+			}
+
+			threadLink, statusCode, err := p.LinkThreadToWorkItem(testutils.MockMattermostUserID, "mockPostID", testutils.MockOrganization, testutils.MockProjectName, "1", true)
+
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			if testCase.getTaskError != nil || testCase.hyperlinkError != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, threadLink)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "mockRootID", threadLink.PostID)
+		})
+	}
+}
+
+func TestMirrorThreadReply(t *testing.T) {
+	defer monkey.UnpatchAll()
+	threadLink := &serializers.ThreadLink{
+		PostID:           "mockRootID",
+		OrganizationName: testutils.MockOrganization,
+		ProjectName:      testutils.MockProjectName,
+		WorkItemID:       "1",
+		MirrorReplies:    true,
+	}
+	for _, testCase := range []struct {
+		description     string
+		post            *model.Post
+		threadLink      *serializers.ThreadLink
+		isConnected     bool
+		expectLoad      bool
+		expectedComment string
+	}{
+		{
+			description: "MirrorThreadReply: post is not a reply",
+			post:        &model.Post{UserId: testutils.MockMattermostUserID, Message: "mockMessage"},
+		},
+		{
+			description: "MirrorThreadReply: post is created by the bot",
+			post:        &model.Post{UserId: "mockBotID", RootId: "mockRootID", Message: "mockMessage"},
+		},
+		{
+			description: "MirrorThreadReply: thread is not linked",
+			post:        &model.Post{UserId: testutils.MockMattermostUserID, RootId: "mockRootID", Message: "mockMessage"},
+			expectLoad:  true,
+		},
+		{
+			description: "MirrorThreadReply: mirroring is not enabled for the thread",
+			post:        &model.Post{UserId: testutils.MockMattermostUserID, RootId: "mockRootID", Message: "mockMessage"},
+			threadLink:  &serializers.ThreadLink{PostID: "mockRootID"},
+			expectLoad:  true,
+		},
+		{
+			description: "MirrorThreadReply: author of the reply is not connected",
+			post:        &model.Post{UserId: testutils.MockMattermostUserID, RootId: "mockRootID", Message: "mockMessage"},
+			threadLink:  threadLink,
+			expectLoad:  true,
+		},
+		{
+			description:     "MirrorThreadReply: reply is added as a comment",
+			post:            &model.Post{UserId: testutils.MockMattermostUserID, RootId: "mockRootID", Message: "Steps to <repro>\n1. Login"},
+			threadLink:      threadLink,
+			isConnected:     true,
+			expectLoad:      true,
+			expectedComment: "Steps to &lt;repro&gt;<br>1. Login<br><br><em>Mirrored from Mattermost</em>",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, mockedClient)
+			p.botUserID = "mockBotID"
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return testCase.isConnected
+			})
+
+			if testCase.expectLoad {
+				mockedStore.EXPECT().GetThreadLink("mockRootID").Return(testCase.threadLink, nil)
+			}
+
+			if testCase.threadLink != nil && testCase.threadLink.MirrorReplies && !testCase.isConnected {
+				mockAPI.On("SendEphemeralPost", testutils.MockMattermostUserID, mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+					assert.Equal(t, constants.ThreadReplyNotMirrored, args.Get(1).(*model.Post).Message)
+				}).Return(&model.Post{})
+			}
+
+			if testCase.expectedComment != "" {
+				mockedClient.EXPECT().AddWorkItemComment(testutils.MockOrganization, testutils.MockProjectName, "1", testCase.expectedComment, testutils.MockMattermostUserID).Return(&serializers.WorkItemComment{}, http.StatusOK, nil)
+			}
+
+			p.MirrorThreadReply(testCase.post)
+			mockAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetLinkedThreadForWorkItemComment(t *testing.T) {
+	defer monkey.UnpatchAll()
+	threadLinks := []*serializers.ThreadLink{
+		{PostID: "mockOtherRootID", ChannelID: "mockOtherChannelID", MirrorReplies: true},
+		{PostID: "mockRootID", ChannelID: testutils.MockChannelID, MirrorReplies: true},
+	}
+	for _, testCase := range []struct {
+		description               string
+		resourceURL               string
+		detailedMessage           string
+		expectLoad                bool
+		expectedRootID            string
+		expectedIsMirroredComment bool
+	}{
+		{
+			description: "GetLinkedThreadForWorkItemComment: resource URL is missing",
+		},
+		{
+			description:     "GetLinkedThreadForWorkItemComment: comment is posted in the linked thread",
+			resourceURL:     "https://dev.azure.com/mockOrganization/mockProjectID/_apis/wit/workItems/1",
+			detailedMessage: "Bug #1 commented on by mockUser",
+			expectLoad:      true,
+			expectedRootID:  "mockRootID",
+		},
+		{
+			description:               "GetLinkedThreadForWorkItemComment: comment is mirrored from the linked thread",
+			resourceURL:               "https://dev.azure.com/mockOrganization/mockProjectID/_apis/wit/workItems/1",
+			detailedMessage:           "Bug #1 commented on by mockUser\nmockComment\n\n_Mirrored from Mattermost_",
+			expectLoad:                true,
+			expectedRootID:            "mockRootID",
+			expectedIsMirroredComment: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(&plugintest.API{}, mockedStore, nil)
+
+			if testCase.expectLoad {
+				mockedStore.EXPECT().GetThreadLinksForWorkItem(testutils.MockOrganization, "1").Return(threadLinks, nil)
+			}
+
+			body := &serializers.SubscriptionNotification{
+				EventType:       constants.SubscriptionEventWorkItemCommented,
+				DetailedMessage: serializers.DetailedMessage{Markdown: testCase.detailedMessage},
+				Resource: serializers.Resource{
+					ID:  float64(1),
+					URL: testCase.resourceURL,
+				},
+			}
+
+			rootID, isMirroredComment := p.GetLinkedThreadForWorkItemComment(body, testutils.MockChannelID)
+			assert.Equal(t, testCase.expectedRootID, rootID)
+			assert.Equal(t, testCase.expectedIsMirroredComment, isMirroredComment)
+		})
+	}
+}
@@ -261,8 +261,8 @@ func (p *Plugin) ParseSubscriptionsToCommandResponse(subscriptionsList []*serial
 	}
 
 	sb.WriteString(fmt.Sprintf("###### %s subscription(s)\n", cases.Title(language.Und).String(command)))
-	sb.WriteString("| Subscription ID | Organization | Project | Event Type | Created By | Channel |\n")
-	sb.WriteString("| :-------------- | :----------- | :------ | :--------- | :--------- | :------ |\n")
+	sb.WriteString("| Subscription ID | Organization | Project | Event Type | Created By | Channel | Filters |\n")
+	sb.WriteString("| :-------------- | :----------- | :------ | :--------- | :--------- | :------ | :------ |\n")
 
 	displayEventType := map[string]string{
 		constants.SubscriptionEventWorkItemCreated:                    "Work Item Created",
@@ -294,12 +294,12 @@ func (p *Plugin) ParseSubscriptionsToCommandResponse(subscriptionsList []*serial
 			case constants.FilterCreatedByMe:
 				if subscription.MattermostUserID == userID && subscription.ServiceType == command {
 					noSubscriptionFound = false
-					sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s | %s |\n", subscription.SubscriptionID, subscription.OrganizationName, subscription.ProjectName, displayEventType[subscription.EventType], subscription.CreatedBy, subscription.ChannelName))
+					sb.WriteString(getSubscriptionRow(subscription, displayEventType[subscription.EventType], command))
 				}
 			case constants.FilterCreatedByAnyone:
 				if subscription.ServiceType == command {
 					noSubscriptionFound = false
-					sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s | %s |\n", subscription.SubscriptionID, subscription.OrganizationName, subscription.ProjectName, displayEventType[subscription.EventType], subscription.CreatedBy, subscription.ChannelName))
+					sb.WriteString(getSubscriptionRow(subscription, displayEventType[subscription.EventType], command))
 				}
 			}
 		}
@@ -313,6 +313,25 @@ func (p *Plugin) ParseSubscriptionsToCommandResponse(subscriptionsList []*serial
 	return sb.String()
 }
 
+func getSubscriptionRow(subscription *serializers.SubscriptionDetails, eventType, command string) string {
+	row := fmt.Sprintf("| %s | %s | %s | %s | %s | %s |", subscription.SubscriptionID, subscription.OrganizationName, subscription.ProjectName, eventType, subscription.CreatedBy, subscription.ChannelName)
+	var filters string
+	switch command {
+	case constants.CommandBoards:
+		filters = subscription.WorkItemFilters.String()
+	case constants.CommandRepos:
+		filters = subscription.CodePushFilters.String()
+	case constants.CommandPipelines:
+		filters = subscription.PipelineFilters.String()
+	}
+
+	if filters == "" {
+		filters = "-"
+	}
+
+	return fmt.Sprintf("%s %s |\n", row, filters)
+}
+
 func (p *Plugin) GetOffsetAndLimitFromQueryParams(r *http.Request) (offset, limit int) {
 	query := r.URL.Query()
 	var page int
@@ -377,6 +396,19 @@ func (p *Plugin) updateBaseURLForReleaseEventTypes(url, eventType string) string
 func (p *Plugin) UpdatePipelineReleaseApprovalPost(requestType, postID, mattermostUserID string) error {
 	post, _ := p.API.GetPost(postID)
 	slackAttachment := post.Attachments()[0]
+	setPipelineReleaseApprovalStatus(slackAttachment, requestType)
+
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{slackAttachment})
+	if _, err := p.API.UpdatePost(post); err != nil {
+		p.handlePipelineApprovalRequestUpdateError("Error in updating post", mattermostUserID, err)
+		return err
+	}
+
+	return nil
+}
+
+// setPipelineReleaseApprovalStatus marks the approver of the notification of a release deployment waiting for approval as approved or rejected, removing the actions
+func setPipelineReleaseApprovalStatus(slackAttachment *model.SlackAttachment, requestType string) {
 	slackAttachment.Actions = nil
 	slackAttachment.Fields = []*model.SlackAttachmentField{
 		slackAttachment.Fields[0],
@@ -386,14 +418,6 @@ func (p *Plugin) UpdatePipelineReleaseApprovalPost(requestType, postID, mattermo
 			Value: fmt.Sprintf("%s %s", constants.PipelineRequestUpdateEmoji[requestType], slackAttachment.Fields[2].Value),
 		},
 	}
-
-	model.ParseSlackAttachment(post, []*model.SlackAttachment{slackAttachment})
-	if _, err := p.API.UpdatePost(post); err != nil {
-		p.handlePipelineApprovalRequestUpdateError("Error in updating post", mattermostUserID, err)
-		return err
-	}
-
-	return nil
 }
 
 func (p *Plugin) handlePipelineApprovalRequestUpdateError(errorMessage, mattermostUserID string, err error) {
@@ -411,40 +435,72 @@ func (p *Plugin) UpdatePipelineRunApprovalPost(approvalSteps []*serializers.Appr
 	}
 
 	slackAttachment := post.Attachments()[0]
+	p.setPipelineRunApprovalStatus(slackAttachment, approvalSteps, minRequiredApprovers, status)
+
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{slackAttachment})
+	if _, err := p.API.UpdatePost(post); err != nil {
+		p.handlePipelineApprovalRequestUpdateError(fmt.Sprintf("Error in fetching post: %s", postID), mattermostUserID, err)
+		return err
+	}
+
+	return nil
+}
+
+// setPipelineRunApprovalStatus updates the approvers of the notification of a run stage waiting for approval with their statuses.
+// The actions are removed once the approval is completed, along with its expiry unless it timed out.
+func (p *Plugin) setPipelineRunApprovalStatus(slackAttachment *model.SlackAttachment, approvalSteps []*serializers.ApprovalStep, minRequiredApprovers int, status string) {
 	numOfApprovalsReached := 0
 
 	approvers := ""
 	for _, step := range approvalSteps {
-		if step.Status != "pending" {
-			approvers += fmt.Sprintf("%s %s \n", constants.PipelineRequestUpdateEmoji[step.Status], step.AssignedApprover.DisplayName)
+		if step.Status != constants.ApprovalStatusPending {
+			approvers += fmt.Sprintf("%s %s \n", constants.PipelineRequestUpdateEmoji[step.Status], p.getAzureIdentityMention(step.AssignedApprover.GetIdentity()))
 			if step.Status == "approved" {
 				numOfApprovalsReached++
 			}
 		} else {
-			approvers += step.AssignedApprover.DisplayName + "\n"
+			approvers += p.getAzureIdentityMention(step.AssignedApprover.GetIdentity()) + "\n"
 		}
 	}
 
-	slackAttachment.Fields = []*model.SlackAttachmentField{
-		slackAttachment.Fields[0],
-		slackAttachment.Fields[1],
-		{
-			Title: slackAttachment.Fields[2].Title,
+	if len(slackAttachment.Fields) > constants.ApprovalApproversFieldIndex {
+		slackAttachment.Fields[constants.ApprovalApproversFieldIndex] = &model.SlackAttachmentField{
+			Title: slackAttachment.Fields[constants.ApprovalApproversFieldIndex].Title,
 			Value: approvers,
-		},
+		}
 	}
 
-	if status != "pending" || numOfApprovalsReached == minRequiredApprovers {
+	if status != constants.ApprovalStatusPending || numOfApprovalsReached == minRequiredApprovers {
 		slackAttachment.Actions = nil
 	}
 
-	model.ParseSlackAttachment(post, []*model.SlackAttachment{slackAttachment})
-	if _, err := p.API.UpdatePost(post); err != nil {
-		p.handlePipelineApprovalRequestUpdateError(fmt.Sprintf("Error in fetching post: %s", postID), mattermostUserID, err)
-		return err
+	switch status {
+	case constants.ApprovalStatusPending:
+	case constants.ApprovalStatusTimedOut:
+		setPipelineRunApprovalExpiry(slackAttachment, constants.ApprovalExpired)
+	default:
+		setPipelineRunApprovalExpiry(slackAttachment, "")
 	}
+}
 
-	return nil
+// setPipelineRunApprovalExpiry sets the expiry of the notification of a run stage waiting for approval, the expiry is removed if it is empty
+func setPipelineRunApprovalExpiry(slackAttachment *model.SlackAttachment, expiry string) {
+	fields := make([]*model.SlackAttachmentField, 0, len(slackAttachment.Fields))
+	for _, field := range slackAttachment.Fields {
+		if field.Title != constants.ApprovalExpiresFieldTitle {
+			fields = append(fields, field)
+		}
+	}
+
+	if expiry != "" {
+		fields = append(fields, &model.SlackAttachmentField{
+			Title: constants.ApprovalExpiresFieldTitle,
+			Value: expiry,
+			Short: true,
+		})
+	}
+
+	slackAttachment.Fields = fields
 }
 
 func (p *Plugin) deleteSubscription(subscription *serializers.SubscriptionDetails, mattermostUserID string) (int, error) {
@@ -554,3 +610,143 @@ func (p *Plugin) SanitizeURLPaths(organization, project, otherPathInput string)
 
 	return 0, nil
 }
+
+// GetWorkItemFieldDefinitions returns the fields of a work item type along with their data types
+func (p *Plugin) GetWorkItemFieldDefinitions(organization, projectName, workItemType, mattermostUserID string) ([]*serializers.WorkItemFieldDefinition, int, error) {
+	workItemTypeFields, statusCode, err := p.Client.GetWorkItemTypeFields(organization, projectName, workItemType, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	workItemFields, statusCode, err := p.Client.GetWorkItemFields(organization, projectName, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	fieldByReferenceName := make(map[string]*serializers.WorkItemField, len(workItemFields.Value))
+	for _, field := range workItemFields.Value {
+		fieldByReferenceName[field.ReferenceName] = field
+	}
+
+	definitions := make([]*serializers.WorkItemFieldDefinition, 0, len(workItemTypeFields.Value))
+	for _, workItemTypeField := range workItemTypeFields.Value {
+		definition := &serializers.WorkItemFieldDefinition{
+			Name:           workItemTypeField.Name,
+			ReferenceName:  workItemTypeField.ReferenceName,
+			Type:           constants.FieldTypeString,
+			AlwaysRequired: workItemTypeField.AlwaysRequired,
+			DefaultValue:   workItemTypeField.DefaultValue,
+			AllowedValues:  workItemTypeField.AllowedValues,
+			HelpText:       workItemTypeField.HelpText,
+		}
+
+		if field, ok := fieldByReferenceName[workItemTypeField.ReferenceName]; ok {
+			definition.Type = field.Type
+			definition.ReadOnly = field.ReadOnly
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, http.StatusOK, nil
+}
+
+// CreateWorkItem validates the field values of the payload against the field definitions of the work item type and creates the work item
+func (p *Plugin) CreateWorkItem(body *serializers.CreateTaskRequestPayload, mattermostUserID string) (*serializers.TaskValue, int, error) {
+	fieldDefinitions, statusCode, err := p.GetWorkItemFieldDefinitions(body.Organization, body.Project, body.Type, mattermostUserID)
+	if err != nil {
+		p.API.LogError(constants.ErrorFetchWorkItemFields, "Error", err.Error())
+		return nil, statusCode, err
+	}
+
+	if validationErr := body.ValidateFields(fieldDefinitions); validationErr != nil {
+		return nil, http.StatusBadRequest, validationErr
+	}
+
+	if statusCode, err := p.resolveIdentityFieldValues(body, fieldDefinitions, mattermostUserID); err != nil {
+		return nil, statusCode, err
+	}
+
+	return p.Client.CreateTask(body, mattermostUserID)
+}
+
+// resolveIdentityFieldValues replaces the values of the identity fields of the payload with the unique names of the identities they refer to,
+// an error is returned if a value does not refer to an identity of the organization
+func (p *Plugin) resolveIdentityFieldValues(body *serializers.CreateTaskRequestPayload, fieldDefinitions []*serializers.WorkItemFieldDefinition, mattermostUserID string) (int, error) {
+	for _, definition := range fieldDefinitions {
+		if definition.Type != constants.FieldTypeIdentity {
+			continue
+		}
+
+		value, ok := body.Fields.AdditionalFields[definition.ReferenceName].(string)
+		if !ok || strings.TrimSpace(value) == "" {
+			continue
+		}
+
+		identity, err := p.ResolveAzureIdentity(body.Organization, value, mattermostUserID)
+		if err != nil {
+			p.API.LogError(constants.ErrorResolveAzureIdentity, "Error", err.Error())
+			return http.StatusInternalServerError, err
+		}
+
+		if identity == nil {
+			return http.StatusBadRequest, fmt.Errorf(constants.ErrorUnknownIdentity, value, definition.Name)
+		}
+
+		body.Fields.AdditionalFields[definition.ReferenceName] = identity.UniqueName
+	}
+
+	return http.StatusOK, nil
+}
+
+// GetLinkedProjectFromArguments returns the linked project of the user matching the organization and project arguments of a command.
+// If the arguments are not provided, the only linked project of the user is returned.
+func (p *Plugin) GetLinkedProjectFromArguments(mattermostUserID string, arguments map[string]string) (*serializers.ProjectDetails, error) {
+	projectList, err := p.Store.GetAllProjects(mattermostUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchingProjects []serializers.ProjectDetails
+	for _, project := range projectList {
+		if organization, ok := arguments[constants.PresetArgumentOrganization]; ok && !strings.EqualFold(organization, project.OrganizationName) {
+			continue
+		}
+		if projectName, ok := arguments[constants.PresetArgumentProject]; ok && !strings.EqualFold(projectName, project.ProjectName) {
+			continue
+		}
+		matchingProjects = append(matchingProjects, project)
+	}
+
+	if len(matchingProjects) != 1 {
+		return nil, nil
+	}
+
+	return &matchingProjects[0], nil
+}
+
+func (p *Plugin) ParsePresetsToCommandResponse(presets []*serializers.WorkItemPreset) string {
+	if len(presets) == 0 {
+		return constants.NoPresetsFound
+	}
+
+	var sb strings.Builder
+	sb.WriteString("###### Work item preset(s)\n")
+	sb.WriteString("| Name | Project | Type | Fields | Created By |\n")
+	sb.WriteString("| :--- | :------ | :--- | :----- | :--------- |\n")
+	for _, preset := range presets {
+		sb.WriteString(preset.ToCommandResponse())
+	}
+
+	return sb.String()
+}
+
+// truncateText shortens the value to maxLength characters and marks the cut with an ellipsis.
+func truncateText(value string, maxLength int) string {
+	runes := []rune(value)
+	if len(runes) <= maxLength {
+		return value
+	}
+
+	return strings.TrimSpace(string(runes[:maxLength])) + "…"
+}
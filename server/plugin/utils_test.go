@@ -767,7 +767,7 @@ func TestParseSubscriptionsToCommandResponse(t *testing.T) {
 			command:           constants.CommandBoards,
 			subscriptionsList: testutils.GetSuscriptionDetailsPayload(testutils.MockMattermostUserID, constants.CommandBoards, constants.SubscriptionEventWorkItemCreated),
 			createdBy:         constants.FilterCreatedByMe,
-			expectedMessage:   fmt.Sprintf("###### %s subscription(s)\n| Subscription ID | Organization | Project | Event Type | Created By | Channel |\n| :-------------- | :----------- | :------ | :--------- | :--------- | :------ |\n| mockSubscriptionID | mockOrganization | mockProjectName | Work Item Created | mockCreatedBy | mockChannelName |\n", cases.Title(language.Und).String(constants.CommandBoards)),
+			expectedMessage:   fmt.Sprintf("###### %s subscription(s)\n| Subscription ID | Organization | Project | Event Type | Created By | Channel | Filters |\n| :-------------- | :----------- | :------ | :--------- | :--------- | :------ | :------ |\n| mockSubscriptionID | mockOrganization | mockProjectName | Work Item Created | mockCreatedBy | mockChannelName | - |\n", cases.Title(language.Und).String(constants.CommandBoards)),
 		},
 		{
 			description:       "ParseSubscriptionsToCommandResponse: subscriptions created by anyone",
@@ -775,7 +775,28 @@ func TestParseSubscriptionsToCommandResponse(t *testing.T) {
 			subscriptionsList: testutils.GetSuscriptionDetailsPayload(testutils.MockMattermostUserID, constants.CommandBoards, constants.SubscriptionEventWorkItemCreated),
 
 			createdBy:       constants.FilterCreatedByAnyone,
-			expectedMessage: fmt.Sprintf("###### %s subscription(s)\n| Subscription ID | Organization | Project | Event Type | Created By | Channel |\n| :-------------- | :----------- | :------ | :--------- | :--------- | :------ |\n| mockSubscriptionID | mockOrganization | mockProjectName | Work Item Created | mockCreatedBy | mockChannelName |\n", cases.Title(language.Und).String(constants.CommandBoards)),
+			expectedMessage: fmt.Sprintf("###### %s subscription(s)\n| Subscription ID | Organization | Project | Event Type | Created By | Channel | Filters |\n| :-------------- | :----------- | :------ | :--------- | :--------- | :------ | :------ |\n| mockSubscriptionID | mockOrganization | mockProjectName | Work Item Created | mockCreatedBy | mockChannelName | - |\n", cases.Title(language.Und).String(constants.CommandBoards)),
+		},
+		{
+			description:       "ParseSubscriptionsToCommandResponse: subscriptions with work item filters",
+			command:           constants.CommandBoards,
+			subscriptionsList: []*serializers.SubscriptionDetails{{ChannelID: testutils.MockChannelID, SubscriptionID: "mockSubscriptionID", OrganizationName: "mockOrganization", ProjectName: "mockProjectName", EventType: constants.SubscriptionEventWorkItemUpdated, ServiceType: constants.CommandBoards, CreatedBy: "mockCreatedBy", ChannelName: "mockChannelName", WorkItemFilters: &serializers.WorkItemFilters{WorkItemTypes: []string{"Bug", "Incident"}, MaxPriority: 2}}},
+			createdBy:         constants.FilterCreatedByAnyone,
+			expectedMessage:   fmt.Sprintf("###### %s subscription(s)\n| Subscription ID | Organization | Project | Event Type | Created By | Channel | Filters |\n| :-------------- | :----------- | :------ | :--------- | :--------- | :------ | :------ |\n| mockSubscriptionID | mockOrganization | mockProjectName | Work Item Updated | mockCreatedBy | mockChannelName | type in (Bug, Incident), priority <= 2 |\n", cases.Title(language.Und).String(constants.CommandBoards)),
+		},
+		{
+			description:       "ParseSubscriptionsToCommandResponse: repos subscriptions are listed without filters",
+			command:           constants.CommandRepos,
+			subscriptionsList: testutils.GetSuscriptionDetailsPayload(testutils.MockMattermostUserID, constants.CommandRepos, constants.SubscriptionEventPullRequestCreated),
+			createdBy:         constants.FilterCreatedByAnyone,
+			expectedMessage:   fmt.Sprintf("###### %s subscription(s)\n| Subscription ID | Organization | Project | Event Type | Created By | Channel | Filters |\n| :-------------- | :----------- | :------ | :--------- | :--------- | :------ | :------ |\n| mockSubscriptionID | mockOrganization | mockProjectName | Pull Request Created | mockCreatedBy | mockChannelName | - |\n", cases.Title(language.Und).String(constants.CommandRepos)),
+		},
+		{
+			description:       "ParseSubscriptionsToCommandResponse: subscriptions with pipeline filters",
+			command:           constants.CommandPipelines,
+			subscriptionsList: []*serializers.SubscriptionDetails{{ChannelID: testutils.MockChannelID, SubscriptionID: "mockSubscriptionID", OrganizationName: "mockOrganization", ProjectName: "mockProjectName", EventType: constants.SubscriptionEventBuildCompleted, ServiceType: constants.CommandPipelines, CreatedBy: "mockCreatedBy", ChannelName: "mockChannelName", PipelineFilters: &serializers.PipelineFilters{NotifyRequester: true}}},
+			createdBy:         constants.FilterCreatedByAnyone,
+			expectedMessage:   fmt.Sprintf("###### %s subscription(s)\n| Subscription ID | Organization | Project | Event Type | Created By | Channel | Filters |\n| :-------------- | :----------- | :------ | :--------- | :--------- | :------ | :------ |\n| mockSubscriptionID | mockOrganization | mockProjectName | Build Completed | mockCreatedBy | mockChannelName | requester of failed builds notified |\n", cases.Title(language.Und).String(constants.CommandPipelines)),
 		},
 		{
 			description:       "ParseSubscriptionsToCommandResponse: no subscriptions created by the user is present",
@@ -0,0 +1,147 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// CreateWorkItemBranch creates the branch "users/<alias>/<ID>-<title>" to start working on a work item and links it to the work item.
+// The repository is optional if the project has a single repository or a repository named after the project, and the default branch of the repository is used if the base branch is not provided.
+// If the work item is to be activated, failing to do so does not fail the creation of the branch and is reported in the returned branch instead.
+func (p *Plugin) CreateWorkItemBranch(mattermostUserID, organization, projectName, workItemID, repositoryName, baseBranch string, activate bool) (*serializers.WorkItemBranch, int, error) {
+	workItem, statusCode, err := p.Client.GetTask(organization, workItemID, projectName, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	repositoryList, statusCode, err := p.Client.GetGitRepositories(organization, projectName, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	repository, err := getGitRepository(repositoryList, projectName, repositoryName)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	if baseBranch == "" {
+		if repository.DefaultBranch == "" {
+			return nil, http.StatusBadRequest, fmt.Errorf(constants.RepositoryIsEmpty, repository.Name)
+		}
+		baseBranch = repository.DefaultBranch
+	}
+	baseBranch = strings.TrimPrefix(baseBranch, constants.RefNamePrefixBranch)
+
+	branchList, statusCode, err := p.Client.GetGitRepositoryBranches(organization, projectName, repository.ID, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	baseObjectID := ""
+	for _, branch := range branchList.Value {
+		if branch.Name == constants.RefNamePrefixBranch+baseBranch {
+			baseObjectID = branch.ObjectID
+			break
+		}
+	}
+
+	if baseObjectID == "" {
+		return nil, http.StatusBadRequest, fmt.Errorf(constants.BranchNotFound, baseBranch, repository.Name)
+	}
+
+	branchName := fmt.Sprintf(constants.WorkItemBranchNameFormat, p.getWorkItemBranchAlias(mattermostUserID), workItem.ID)
+	if slug := serializers.GetBranchSlug(workItem.Fields.Title); slug != "" {
+		branchName = fmt.Sprintf(constants.WorkItemBranchSlugFormat, branchName, slug)
+	}
+	refUpdateResult, statusCode, err := p.Client.CreateGitBranch(organization, projectName, repository.ID, branchName, baseObjectID, mattermostUserID)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	if !refUpdateResult.Success {
+		if refUpdateResult.UpdateStatus == constants.GitRefUpdateStatusStaleOldObjectID {
+			return nil, http.StatusBadRequest, fmt.Errorf(constants.BranchAlreadyExists, branchName, repository.Name)
+		}
+		return nil, http.StatusInternalServerError, fmt.Errorf("failed to create the git branch: %s %s", refUpdateResult.UpdateStatus, refUpdateResult.CustomMessage)
+	}
+
+	relations := []*serializers.WorkItemRelation{
+		{
+			Rel: constants.RelationTypeArtifactLink,
+			URL: fmt.Sprintf(constants.BranchArtifactLinkURL, repository.Project.ID, repository.ID, url.QueryEscape(branchName)),
+			Attributes: map[string]interface{}{
+				"name": constants.BranchArtifactLinkName,
+			},
+		},
+	}
+
+	if statusCode, err = p.Client.AddWorkItemRelations(organization, projectName, workItemID, relations, mattermostUserID); err != nil {
+		return nil, statusCode, err
+	}
+
+	workItemBranch := &serializers.WorkItemBranch{
+		Name:       branchName,
+		WebURL:     fmt.Sprintf(constants.BranchWebURL, repository.WebURL, url.QueryEscape(branchName)),
+		BaseBranch: baseBranch,
+		Repository: repository,
+		WorkItem:   workItem,
+	}
+
+	if activate && workItem.Fields.State != constants.WorkItemStateActive {
+		if _, err = p.Client.UpdateWorkItemState(organization, projectName, workItemID, constants.WorkItemStateActive, mattermostUserID); err != nil {
+			p.API.LogError(constants.ErrorActivateWorkItem, "WorkItemID", workItemID, "Error", err.Error())
+			workItemBranch.ActivateError = err.Error()
+		}
+	}
+
+	return workItemBranch, http.StatusOK, nil
+}
+
+// getGitRepository returns the repository with the given name.
+// If the name is not provided, the only repository of the project or the repository named after the project is returned.
+func getGitRepository(repositoryList *serializers.GitRepositoryList, projectName, repositoryName string) (*serializers.GitRepository, error) {
+	if repositoryList == nil || len(repositoryList.Value) == 0 {
+		return nil, fmt.Errorf(constants.NoRepositories, projectName)
+	}
+
+	isRepositoryProvided := repositoryName != ""
+	if !isRepositoryProvided {
+		if len(repositoryList.Value) == 1 {
+			return repositoryList.Value[0], nil
+		}
+		repositoryName = projectName
+	}
+
+	for _, repository := range repositoryList.Value {
+		if strings.EqualFold(repository.Name, repositoryName) {
+			return repository, nil
+		}
+	}
+
+	if !isRepositoryProvided {
+		return nil, fmt.Errorf(constants.RepositoryRequired, repositoryList.GetRepositoryNames())
+	}
+
+	return nil, fmt.Errorf(constants.RepositoryNotFound, repositoryName, repositoryList.GetRepositoryNames())
+}
+
+// getWorkItemBranchAlias returns the alias of the user used in the names of their branches.
+// The alias is the part of the email of the Azure DevOps account before "@", or the Mattermost username if the email is not present.
+func (p *Plugin) getWorkItemBranchAlias(mattermostUserID string) string {
+	if azureDevopsUserID, err := p.Store.LoadAzureDevopsUserIDFromMattermostUser(mattermostUserID); err == nil {
+		if user, err := p.Store.LoadAzureDevopsUserDetails(azureDevopsUserID); err == nil && user.Email != "" {
+			return strings.ToLower(strings.Split(user.Email, "@")[0])
+		}
+	}
+
+	if user, appErr := p.API.GetUser(mattermostUserID); appErr == nil {
+		return user.Username
+	}
+
+	return mattermostUserID
+}
@@ -0,0 +1,295 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestCreateWorkItemBranch(t *testing.T) {
+	mockBranchList := &serializers.GitRefList{Value: []*serializers.GitRef{
+		{Name: "refs/heads/main", ObjectID: "mockMainObjectID"},
+		{Name: "refs/heads/release/1.0", ObjectID: "mockReleaseObjectID"},
+	}}
+	for _, testCase := range []struct {
+		description           string
+		title                 string
+		repositoryName        string
+		baseBranch            string
+		activate              bool
+		repositoryList        *serializers.GitRepositoryList
+		expectedRepositoryID  string
+		expectedBaseObjectID  string
+		refUpdateResult       *serializers.GitRefUpdateResult
+		activateErr           error
+		expectedErr           error
+		expectedStatusCode    int
+		expectedBranchName    string
+		expectedActivateError string
+	}{
+		{
+			description:          "CreateWorkItemBranch: only repo of the project from its default branch",
+			activate:             true,
+			repositoryList:       testutils.GetMockGitRepositoryList("mockRepo"),
+			expectedRepositoryID: "mockRepoID",
+			expectedBaseObjectID: "mockMainObjectID",
+			refUpdateResult:      &serializers.GitRefUpdateResult{Success: true},
+			expectedStatusCode:   http.StatusOK,
+		},
+		{
+			description:          "CreateWorkItemBranch: title without any letter or digit",
+			title:                "¿¡!?",
+			repositoryList:       testutils.GetMockGitRepositoryList("mockRepo"),
+			expectedRepositoryID: "mockRepoID",
+			expectedBaseObjectID: "mockMainObjectID",
+			refUpdateResult:      &serializers.GitRefUpdateResult{Success: true},
+			expectedStatusCode:   http.StatusOK,
+			expectedBranchName:   "users/mockuser/42",
+		},
+		{
+			description:          "CreateWorkItemBranch: repo named after the project",
+			repositoryList:       testutils.GetMockGitRepositoryList("mockRepo", "mockProjectName"),
+			expectedRepositoryID: "mockProjectNameID",
+			expectedBaseObjectID: "mockMainObjectID",
+			refUpdateResult:      &serializers.GitRefUpdateResult{Success: true},
+			expectedStatusCode:   http.StatusOK,
+		},
+		{
+			description:          "CreateWorkItemBranch: given repo and base branch",
+			repositoryName:       "mockrepo",
+			baseBranch:           "release/1.0",
+			repositoryList:       testutils.GetMockGitRepositoryList("mockRepo", "mockProjectName"),
+			expectedRepositoryID: "mockRepoID",
+			expectedBaseObjectID: "mockReleaseObjectID",
+			refUpdateResult:      &serializers.GitRefUpdateResult{Success: true},
+			expectedStatusCode:   http.StatusOK,
+		},
+		{
+			description:           "CreateWorkItemBranch: work item could not be activated",
+			activate:              true,
+			repositoryList:        testutils.GetMockGitRepositoryList("mockRepo"),
+			expectedRepositoryID:  "mockRepoID",
+			expectedBaseObjectID:  "mockMainObjectID",
+			refUpdateResult:       &serializers.GitRefUpdateResult{Success: true},
+			activateErr:           errors.New("invalid state"),
+			expectedStatusCode:    http.StatusOK,
+			expectedActivateError: "invalid state",
+		},
+		{
+			description:        "CreateWorkItemBranch: repo is not provided",
+			repositoryList:     testutils.GetMockGitRepositoryList("mockRepo", "mockOtherRepo"),
+			expectedErr:        fmt.Errorf(constants.RepositoryRequired, "mockRepo, mockOtherRepo"),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:        "CreateWorkItemBranch: repo does not exist",
+			repositoryName:     "mockUnknownRepo",
+			repositoryList:     testutils.GetMockGitRepositoryList("mockRepo"),
+			expectedErr:        fmt.Errorf(constants.RepositoryNotFound, "mockUnknownRepo", "mockRepo"),
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:          "CreateWorkItemBranch: base branch does not exist",
+			baseBranch:           "develop",
+			repositoryList:       testutils.GetMockGitRepositoryList("mockRepo"),
+			expectedRepositoryID: "mockRepoID",
+			expectedErr:          fmt.Errorf(constants.BranchNotFound, "develop", "mockRepo"),
+			expectedStatusCode:   http.StatusBadRequest,
+		},
+		{
+			description:          "CreateWorkItemBranch: branch already exists",
+			repositoryList:       testutils.GetMockGitRepositoryList("mockRepo"),
+			expectedRepositoryID: "mockRepoID",
+			expectedBaseObjectID: "mockMainObjectID",
+			refUpdateResult:      &serializers.GitRefUpdateResult{UpdateStatus: constants.GitRefUpdateStatusStaleOldObjectID},
+			expectedErr:          fmt.Errorf(constants.BranchAlreadyExists, "users/mockuser/42-fix-the-login-page-on-safari", "mockRepo"),
+			expectedStatusCode:   http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, mockedClient)
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 5)...)
+
+			if testCase.title == "" {
+				testCase.title = "Fix the login page on Safari!"
+			}
+			if testCase.expectedBranchName == "" {
+				testCase.expectedBranchName = "users/mockuser/42-fix-the-login-page-on-safari"
+			}
+
+			workItem := &serializers.TaskValue{ID: 42, Fields: serializers.TaskFieldValue{Title: testCase.title, Type: "Bug", State: "New"}}
+			mockedClient.EXPECT().GetTask(testutils.MockOrganization, "42", testutils.MockProjectName, testutils.MockMattermostUserID).Return(workItem, http.StatusOK, nil)
+			mockedClient.EXPECT().GetGitRepositories(testutils.MockOrganization, testutils.MockProjectName, testutils.MockMattermostUserID).Return(testCase.repositoryList, http.StatusOK, nil)
+
+			if testCase.expectedRepositoryID != "" {
+				mockedClient.EXPECT().GetGitRepositoryBranches(testutils.MockOrganization, testutils.MockProjectName, testCase.expectedRepositoryID, testutils.MockMattermostUserID).Return(mockBranchList, http.StatusOK, nil)
+			}
+
+			if testCase.refUpdateResult != nil {
+				mockedStore.EXPECT().LoadAzureDevopsUserIDFromMattermostUser(testutils.MockMattermostUserID).Return("mockAzureDevopsUserID", nil)
+				mockedStore.EXPECT().LoadAzureDevopsUserDetails("mockAzureDevopsUserID").Return(&serializers.User{UserProfile: serializers.UserProfile{Email: "MockUser@example.com"}}, nil)
+				mockedClient.EXPECT().CreateGitBranch(testutils.MockOrganization, testutils.MockProjectName, testCase.expectedRepositoryID, testCase.expectedBranchName, testCase.expectedBaseObjectID, testutils.MockMattermostUserID).Return(testCase.refUpdateResult, http.StatusOK, nil)
+			}
+
+			if testCase.expectedErr == nil {
+				mockedClient.EXPECT().AddWorkItemRelations(testutils.MockOrganization, testutils.MockProjectName, "42", gomock.Any(), testutils.MockMattermostUserID).DoAndReturn(func(_, _, _ string, relations []*serializers.WorkItemRelation, _ string) (int, error) {
+					assert.Equal(t, constants.RelationTypeArtifactLink, relations[0].Rel)
+					assert.Equal(t, fmt.Sprintf("vstfs:///Git/Ref/mockProjectID%%2F%s%%2FGB%s", testCase.expectedRepositoryID, strings.ReplaceAll(testCase.expectedBranchName, "/", "%2F")), relations[0].URL)
+					return http.StatusOK, nil
+				})
+			}
+
+			if testCase.activate {
+				mockedClient.EXPECT().UpdateWorkItemState(testutils.MockOrganization, testutils.MockProjectName, "42", constants.WorkItemStateActive, testutils.MockMattermostUserID).Return(http.StatusOK, testCase.activateErr)
+			}
+
+			branch, statusCode, err := p.CreateWorkItemBranch(testutils.MockMattermostUserID, testutils.MockOrganization, testutils.MockProjectName, "42", testCase.repositoryName, testCase.baseBranch, testCase.activate)
+
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			if testCase.expectedErr != nil {
+				assert.EqualError(t, err, testCase.expectedErr.Error())
+				assert.Nil(t, branch)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedBranchName, branch.Name)
+			assert.Equal(t, testCase.expectedActivateError, branch.ActivateError)
+		})
+	}
+}
+
+func TestExecuteCreateWorkItemBranchCommand(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	mockBranch := &serializers.WorkItemBranch{
+		Name:       "users/mockuser/42-mock-title",
+		WebURL:     "mockBranchURL",
+		BaseBranch: "main",
+		Repository: &serializers.GitRepository{Name: "mockRepo"},
+		WorkItem:   &serializers.TaskValue{ID: 42, Fields: serializers.TaskFieldValue{Title: "mockTitle", Type: "Bug"}},
+	}
+	mockBranch.WorkItem.Link.HTML.Href = "mockWorkItemURL"
+	for _, testCase := range []struct {
+		description            string
+		command                string
+		linkedProject          *serializers.ProjectDetails
+		expectCreate           bool
+		expectedRepositoryName string
+		expectedBaseBranch     string
+		expectedActivate       bool
+		activateError          string
+		statusCode             int
+		err                    error
+		ephemeralMessage       string
+	}{
+		{
+			description:      "CreateWorkItemBranchCommand: work item is not provided",
+			command:          "/azuredevops repos branch create",
+			ephemeralMessage: constants.WorkItemBranchUsage,
+		},
+		{
+			description:      "CreateWorkItemBranchCommand: invalid work item",
+			command:          "/azuredevops repos branch create mockWorkItem",
+			ephemeralMessage: constants.WorkItemBranchUsage,
+		},
+		{
+			description:      "CreateWorkItemBranchCommand: project of the work item is not found",
+			command:          "/azuredevops repos branch create 42",
+			ephemeralMessage: constants.WorkItemBranchProjectRequired,
+		},
+		{
+			description:            "CreateWorkItemBranchCommand: branch is created and the work item is activated",
+			command:                "/azuredevops repos branch create 42 mockRepo main --activate",
+			linkedProject:          &serializers.ProjectDetails{OrganizationName: testutils.MockOrganization, ProjectName: testutils.MockProjectName},
+			expectCreate:           true,
+			expectedRepositoryName: "mockRepo",
+			expectedBaseBranch:     "main",
+			expectedActivate:       true,
+			statusCode:             http.StatusOK,
+			ephemeralMessage:       "Created the branch [users/mockuser/42-mock-title](mockBranchURL) from `main` in the repo mockRepo and linked it to [Bug #42: mockTitle](mockWorkItemURL).\nThe work item is moved to Active.",
+		},
+		{
+			description:      "CreateWorkItemBranchCommand: work item could not be activated",
+			command:          "/azuredevops repos branch create https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/42 --activate",
+			expectCreate:     true,
+			expectedActivate: true,
+			activateError:    "invalid state",
+			statusCode:       http.StatusOK,
+			ephemeralMessage: "Created the branch [users/mockuser/42-mock-title](mockBranchURL) from `main` in the repo mockRepo and linked it to [Bug #42: mockTitle](mockWorkItemURL).\nUnable to move the work item to Active: invalid state",
+		},
+		{
+			description:      "CreateWorkItemBranchCommand: invalid repo",
+			command:          "/azuredevops repos branch create https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/42",
+			expectCreate:     true,
+			statusCode:       http.StatusBadRequest,
+			err:              fmt.Errorf(constants.RepositoryRequired, "mockRepo, mockOtherRepo"),
+			ephemeralMessage: fmt.Sprintf(constants.RepositoryRequired, "mockRepo, mockOtherRepo"),
+		},
+		{
+			description:      "CreateWorkItemBranchCommand: error while creating the branch",
+			command:          "/azuredevops repos branch create https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/42",
+			expectCreate:     true,
+			statusCode:       http.StatusInternalServerError,
+			err:              errors.New("failed to create the git branch"),
+			ephemeralMessage: constants.GenericErrorMessage,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				post := args.Get(1).(*model.Post)
+				assert.Equal(t, testCase.ephemeralMessage, post.Message)
+			}).Once().Return(&model.Post{})
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return true
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetLinkedProjectFromArguments", func(_ *Plugin, _ string, _ map[string]string) (*serializers.ProjectDetails, error) {
+				return testCase.linkedProject, nil
+			})
+
+			isCreated := false
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "CreateWorkItemBranch", func(_ *Plugin, _, organization, projectName, workItemID, repositoryName, baseBranch string, activate bool) (*serializers.WorkItemBranch, int, error) {
+				isCreated = true
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, testutils.MockProjectName, projectName)
+				assert.Equal(t, "42", workItemID)
+				assert.Equal(t, testCase.expectedRepositoryName, repositoryName)
+				assert.Equal(t, testCase.expectedBaseBranch, baseBranch)
+				assert.Equal(t, testCase.expectedActivate, activate)
+				if testCase.err != nil {
+					return nil, testCase.statusCode, testCase.err
+				}
+
+				branch := *mockBranch
+				branch.ActivateError = testCase.activateError
+				return &branch, testCase.statusCode, nil
+			})
+
+			_, err := p.ExecuteCommand(nil, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID})
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectCreate, isCreated)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package plugin
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+var (
+	htmlTagRegex           = regexp.MustCompile(`(?i)</?(p|div|span|br|b|strong|i|em|u|s|a|ul|ol|li|code|pre|h[1-6]|img|table|tbody|thead|tr|td|th|blockquote|font)\b[^>]*>`)
+	htmlLineBreakRegex     = regexp.MustCompile(`(?i)<br\s*/?>|</(p|div|h[1-6]|tr|ul|ol|blockquote|pre)>`)
+	htmlListItemRegex      = regexp.MustCompile(`(?i)<li\b[^>]*>`)
+	htmlBoldRegex          = regexp.MustCompile(`(?is)<(?:b|strong)\b[^>]*>(.*?)</(?:b|strong)>`)
+	htmlItalicRegex        = regexp.MustCompile(`(?is)<(?:i|em)\b[^>]*>(.*?)</(?:i|em)>`)
+	htmlCodeRegex          = regexp.MustCompile(`(?is)<code\b[^>]*>(.*?)</code>`)
+	htmlLinkRegex          = regexp.MustCompile(`(?is)<a\b[^>]*href="([^"]*)"[^>]*>(.*?)</a>`)
+	htmlAnyTagRegex        = regexp.MustCompile(`<[^>]+>`)
+	whitespaceRegex        = regexp.MustCompile(`[ \t\r\n]+`)
+	blankLinesRegex        = regexp.MustCompile(`\n{3,}`)
+	identityRegex          = regexp.MustCompile(`^(.*\S)\s+<[^<>\s]+>$`)
+	camelCaseBoundaryRegex = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+)
+
+// getWorkItemFieldChanges returns the changed fields of a work item updated event as markdown, one field per line.
+// Noise fields and the fields ignored in the plugin configuration are skipped.
+func (p *Plugin) getWorkItemFieldChanges(changes map[string]*serializers.WorkItemFieldChange) string {
+	ignoredFields := p.getConfiguration().GetIgnoredWorkItemFields()
+
+	fieldNames := make([]string, 0, len(changes))
+	for name, change := range changes {
+		if change == nil || constants.NoiseWorkItemFields[name] || ignoredFields[name] {
+			continue
+		}
+		fieldNames = append(fieldNames, name)
+	}
+
+	sort.Slice(fieldNames, func(i, j int) bool {
+		return getWorkItemFieldDisplayName(fieldNames[i]) < getWorkItemFieldDisplayName(fieldNames[j])
+	})
+
+	lines := make([]string, 0, len(fieldNames))
+	for _, name := range fieldNames {
+		if line := formatWorkItemFieldChange(getWorkItemFieldDisplayName(name), changes[name]); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func formatWorkItemFieldChange(displayName string, change *serializers.WorkItemFieldChange) string {
+	oldValue := formatWorkItemFieldValue(change.OldValue)
+	newValue := formatWorkItemFieldValue(change.NewValue)
+	if oldValue == newValue {
+		return ""
+	}
+
+	if isInlineWorkItemFieldValue(newValue) {
+		return fmt.Sprintf(constants.WorkItemFieldChangeFormat, displayName, getInlineWorkItemFieldValue(oldValue), getInlineWorkItemFieldValue(newValue))
+	}
+
+	return fmt.Sprintf(constants.WorkItemFieldBlockChangeFormat, displayName, "> "+strings.ReplaceAll(newValue, "\n", "\n> "))
+}
+
+func isInlineWorkItemFieldValue(value string) bool {
+	return !strings.Contains(value, "\n") && len([]rune(value)) <= constants.MaxInlineWorkItemFieldValueLength
+}
+
+// getInlineWorkItemFieldValue shortens a value to its first line so that it fits in "old → new"
+func getInlineWorkItemFieldValue(value string) string {
+	if value == "" {
+		return constants.WorkItemFieldEmptyValue
+	}
+
+	if isInlineWorkItemFieldValue(value) {
+		return value
+	}
+
+	if index := strings.Index(value, "\n"); index != -1 {
+		value = value[:index]
+	}
+	return truncateText(value, constants.MaxInlineWorkItemFieldValueLength)
+}
+
+func formatWorkItemFieldValue(value interface{}) string {
+	var formattedValue string
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		formattedValue = v
+		if htmlTagRegex.MatchString(v) {
+			formattedValue = convertHTMLToMarkdown(v)
+		} else if match := identityRegex.FindStringSubmatch(v); match != nil {
+			// Identity fields are sent as "Display Name <unique name>"
+			formattedValue = match[1]
+		}
+	case float64:
+		formattedValue = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		formattedValue = strconv.FormatBool(v)
+	case map[string]interface{}:
+		// Identity fields are sent as objects in the newer versions of the payload
+		if displayName, ok := v["displayName"].(string); ok {
+			formattedValue = displayName
+		} else if uniqueName, ok := v["uniqueName"].(string); ok {
+			formattedValue = uniqueName
+		}
+	default:
+		formattedValue = fmt.Sprint(v)
+	}
+
+	return truncateText(strings.TrimSpace(formattedValue), constants.MaxWorkItemFieldValueLength)
+}
+
+// convertHTMLToMarkdown converts the HTML of rich text fields like the description to markdown.
+// Formatting which has no markdown equivalent is dropped.
+func convertHTMLToMarkdown(value string) string {
+	value = whitespaceRegex.ReplaceAllString(value, " ")
+	value = htmlLineBreakRegex.ReplaceAllString(value, "\n")
+	value = htmlListItemRegex.ReplaceAllString(value, "\n* ")
+	value = htmlLinkRegex.ReplaceAllString(value, "[$2]($1)")
+	value = htmlBoldRegex.ReplaceAllString(value, "**$1**")
+	value = htmlItalicRegex.ReplaceAllString(value, "_${1}_")
+	value = htmlCodeRegex.ReplaceAllString(value, "`$1`")
+	value = htmlAnyTagRegex.ReplaceAllString(value, "")
+	value = strings.ReplaceAll(html.UnescapeString(value), "\u00a0", " ")
+
+	lines := strings.Split(value, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	return strings.TrimSpace(blankLinesRegex.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+// getWorkItemFieldDisplayName converts the reference name of a field like "System.AssignedTo" to "Assigned To"
+func getWorkItemFieldDisplayName(referenceName string) string {
+	if referenceName == constants.FieldReferenceNameHistory {
+		return constants.FieldDisplayNameHistory
+	}
+
+	name := referenceName[strings.LastIndex(referenceName, ".")+1:]
+	name = strings.ReplaceAll(name, "_", " ")
+	return camelCaseBoundaryRegex.ReplaceAllString(name, "$1 $2")
+}
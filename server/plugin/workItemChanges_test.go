@@ -0,0 +1,131 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/config"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+func TestGetWorkItemFieldChanges(t *testing.T) {
+	for _, testCase := range []struct {
+		description           string
+		body                  string
+		ignoredWorkItemFields string
+		expectedChanges       string
+	}{
+		{
+			description: "GetWorkItemFieldChanges: changed fields",
+			body: `{"resource": {"fields": {
+				"System.State": {"oldValue": "New", "newValue": "Active"},
+				"System.AssignedTo": {"newValue": "Jane Doe <jane@contoso.com>"},
+				"Microsoft.VSTS.Common.Priority": {"oldValue": 3, "newValue": 1}
+			}}}`,
+			expectedChanges: "**Assigned To:** — → Jane Doe\n**Priority:** 3 → 1\n**State:** New → Active",
+		},
+		{
+			description: "GetWorkItemFieldChanges: noise fields are suppressed",
+			body: `{"resource": {"fields": {
+				"System.Rev": {"oldValue": 4, "newValue": 5},
+				"System.ChangedDate": {"oldValue": "2023-01-01T10:00:00Z", "newValue": "2023-01-02T10:00:00Z"},
+				"System.Title": {"oldValue": "Old title", "newValue": "New title"}
+			}}}`,
+			expectedChanges: "**Title:** Old title → New title",
+		},
+		{
+			description: "GetWorkItemFieldChanges: fields ignored in the configuration are suppressed",
+			body: `{"resource": {"fields": {
+				"System.Tags": {"oldValue": "ui", "newValue": "ui; backend"},
+				"System.Title": {"oldValue": "Old title", "newValue": "New title"}
+			}}}`,
+			ignoredWorkItemFields: "System.Tags, Custom.Unused",
+			expectedChanges:       "**Title:** Old title → New title",
+		},
+		{
+			description: "GetWorkItemFieldChanges: HTML fields are converted to markdown",
+			body: `{"resource": {"fields": {
+				"System.Description": {"oldValue": "<div>Old</div>", "newValue": "<div>Steps to <b>reproduce</b>:</div><ul><li>Open the <a href=\"https://example.com\">page</a></li><li>Click &quot;Save&quot;</li></ul>"},
+				"System.History": {"newValue": "<div>Looks good&nbsp;to me</div>"}
+			}}}`,
+			expectedChanges: "**Comment:** — → Looks good to me\n**Description:**\n> Steps to **reproduce**:\n> \n> * Open the [page](https://example.com)\n> * Click \"Save\"",
+		},
+		{
+			description: "GetWorkItemFieldChanges: identity objects",
+			body: `{"resource": {"fields": {
+				"System.AssignedTo": {"oldValue": {"displayName": "Jane Doe", "uniqueName": "jane@contoso.com"}, "newValue": {"displayName": "John Doe", "uniqueName": "john@contoso.com"}}
+			}}}`,
+			expectedChanges: "**Assigned To:** Jane Doe → John Doe",
+		},
+		{
+			description: "GetWorkItemFieldChanges: fields without a change are skipped",
+			body: `{"resource": {"fields": {
+				"System.AssignedTo": {"oldValue": "Jane Doe <jane@contoso.com>", "newValue": {"displayName": "Jane Doe"}},
+				"System.Title": "Plain value"
+			}}}`,
+		},
+		{
+			description: "GetWorkItemFieldChanges: no changed fields",
+			body:        `{"resource": {}}`,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			p := setupMockPlugin(&plugintest.API{}, nil, nil)
+			p.setConfiguration(&config.Configuration{IgnoredWorkItemFields: testCase.ignoredWorkItemFields})
+
+			body, err := serializers.SubscriptionNotificationFromJSON(strings.NewReader(testCase.body))
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectedChanges, p.getWorkItemFieldChanges(body.Resource.Fields.Changes))
+		})
+	}
+}
+
+func TestFormatWorkItemFieldChange(t *testing.T) {
+	longValue := strings.Repeat("a", 100)
+	for _, testCase := range []struct {
+		description    string
+		change         *serializers.WorkItemFieldChange
+		expectedChange string
+	}{
+		{
+			description:    "FormatWorkItemFieldChange: value is removed",
+			change:         &serializers.WorkItemFieldChange{OldValue: "Sprint 1"},
+			expectedChange: "**Iteration Path:** Sprint 1 → —",
+		},
+		{
+			description:    "FormatWorkItemFieldChange: long new value is quoted",
+			change:         &serializers.WorkItemFieldChange{OldValue: "Short", NewValue: longValue},
+			expectedChange: "**Iteration Path:**\n> " + longValue,
+		},
+		{
+			description:    "FormatWorkItemFieldChange: long old value is shortened",
+			change:         &serializers.WorkItemFieldChange{OldValue: longValue + "\nsecond line", NewValue: "Short"},
+			expectedChange: "**Iteration Path:** " + strings.Repeat("a", 80) + "… → Short",
+		},
+		{
+			description:    "FormatWorkItemFieldChange: boolean value",
+			change:         &serializers.WorkItemFieldChange{OldValue: false, NewValue: true},
+			expectedChange: "**Iteration Path:** false → true",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedChange, formatWorkItemFieldChange("Iteration Path", testCase.change))
+		})
+	}
+}
+
+func TestGetWorkItemFieldDisplayName(t *testing.T) {
+	for referenceName, expectedDisplayName := range map[string]string{
+		"System.AssignedTo":                       "Assigned To",
+		"Microsoft.VSTS.Scheduling.RemainingWork": "Remaining Work",
+		"System.History":                          "Comment",
+		"Custom.Customer_Name":                    "Customer Name",
+	} {
+		assert.Equal(t, expectedDisplayName, getWorkItemFieldDisplayName(referenceName))
+	}
+}
@@ -0,0 +1,159 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// ShouldPostWorkItemNotification returns false if the work item of the notification does not match the work item filters of its subscription.
+// The notification is posted if the subscription cannot be loaded, so that notifications are not lost.
+func (p *Plugin) ShouldPostWorkItemNotification(body *serializers.SubscriptionNotification) bool {
+	switch body.EventType {
+	case constants.SubscriptionEventWorkItemCreated, constants.SubscriptionEventWorkItemUpdated, constants.SubscriptionEventWorkItemDeleted, constants.SubscriptionEventWorkItemCommented:
+	default:
+		return true
+	}
+
+	subscription, err := p.getSubscriptionDetails(body.SubscriptionID)
+	if err != nil {
+		p.API.LogError(constants.FetchSubscriptionListError, "Error", err.Error())
+		return true
+	}
+
+	if subscription == nil {
+		return true
+	}
+
+	return matchesWorkItemFilters(subscription.WorkItemFilters, body)
+}
+
+// UpdateWorkItemFilters sets the work item filters of a subscription, the filters are removed if they are empty.
+func (p *Plugin) UpdateWorkItemFilters(mattermostUserID, subscriptionID string, filters *serializers.WorkItemFilters) (*serializers.SubscriptionDetails, int, error) {
+	subscription, statusCode, err := p.getSubscriptionToFilter(mattermostUserID, subscriptionID, constants.CommandBoards)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	if err = filters.IsValid(subscription.EventType); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	subscription.WorkItemFilters = nil
+	if !filters.IsEmpty() {
+		subscription.WorkItemFilters = filters
+	}
+
+	if err = p.Store.StoreSubscription(subscription); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return subscription, http.StatusOK, nil
+}
+
+// getSubscriptionToFilter returns the subscription of the service type whose filters are to be updated.
+// The filters can be updated by the members of the channel of the subscription.
+func (p *Plugin) getSubscriptionToFilter(mattermostUserID, subscriptionID, serviceType string) (*serializers.SubscriptionDetails, int, error) {
+	subscription, err := p.getSubscriptionDetails(subscriptionID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	if subscription == nil || subscription.ServiceType != serviceType {
+		return nil, http.StatusNotFound, errors.New(constants.SubscriptionNotFound)
+	}
+
+	if _, appErr := p.API.GetChannelMember(subscription.ChannelID, mattermostUserID); appErr != nil {
+		return nil, http.StatusForbidden, errors.New(constants.NotAuthorized)
+	}
+
+	return subscription, http.StatusOK, nil
+}
+
+// getSubscriptionDetails returns the stored details of the subscription, or nil if it does not exist
+func (p *Plugin) getSubscriptionDetails(subscriptionID string) (*serializers.SubscriptionDetails, error) {
+	subscriptionList, err := p.Store.GetAllSubscriptions("")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, subscription := range subscriptionList {
+		if subscription.SubscriptionID == subscriptionID {
+			return subscription, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// matchesWorkItemFilters returns true if the work item of the notification matches all the filters.
+// For work item updated events the fields of the work item are present in the revision while the fields of the resource contain the changes.
+func matchesWorkItemFilters(filters *serializers.WorkItemFilters, body *serializers.SubscriptionNotification) bool {
+	if filters.IsEmpty() {
+		return true
+	}
+
+	fields := body.Resource.Fields
+	if body.EventType == constants.SubscriptionEventWorkItemUpdated {
+		fields = body.Resource.Revision.Fields
+	}
+
+	if len(filters.WorkItemTypes) > 0 {
+		workItemType := fmt.Sprint(fields.WorkItemType)
+		isMatchingType := false
+		for _, filterType := range filters.WorkItemTypes {
+			if strings.EqualFold(filterType, workItemType) {
+				isMatchingType = true
+				break
+			}
+		}
+
+		if !isMatchingType {
+			return false
+		}
+	}
+
+	if filters.Tag != "" {
+		tags, _ := fields.Tags.(string)
+		isMatchingTag := false
+		for _, tag := range strings.Split(tags, ";") {
+			if strings.EqualFold(strings.TrimSpace(tag), filters.Tag) {
+				isMatchingTag = true
+				break
+			}
+		}
+
+		if !isMatchingTag {
+			return false
+		}
+	}
+
+	if filters.StateFrom != "" || filters.StateTo != "" {
+		// The state is only present in the changes if it was changed
+		stateChange, ok := body.Resource.Fields.State.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		if filters.StateFrom != "" && !strings.EqualFold(fmt.Sprint(stateChange["oldValue"]), filters.StateFrom) {
+			return false
+		}
+
+		if filters.StateTo != "" && !strings.EqualFold(fmt.Sprint(stateChange["newValue"]), filters.StateTo) {
+			return false
+		}
+	}
+
+	if filters.MaxPriority > 0 {
+		priority, ok := fields.Priority.(float64)
+		if !ok || int(priority) > filters.MaxPriority {
+			return false
+		}
+	}
+
+	return true
+}
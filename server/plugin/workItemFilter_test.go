@@ -0,0 +1,342 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestMatchesWorkItemFilters(t *testing.T) {
+	for _, testCase := range []struct {
+		description   string
+		filters       *serializers.WorkItemFilters
+		body          *serializers.SubscriptionNotification
+		expectedMatch bool
+	}{
+		{
+			description:   "MatchesWorkItemFilters: no filters",
+			body:          testutils.GetMockWorkItemUpdatedNotification("Task", "", 3, nil),
+			expectedMatch: true,
+		},
+		{
+			description:   "MatchesWorkItemFilters: matching type",
+			filters:       &serializers.WorkItemFilters{WorkItemTypes: []string{"Bug", "Incident"}},
+			body:          testutils.GetMockWorkItemUpdatedNotification("incident", "", 3, nil),
+			expectedMatch: true,
+		},
+		{
+			description: "MatchesWorkItemFilters: other type",
+			filters:     &serializers.WorkItemFilters{WorkItemTypes: []string{"Bug", "Incident"}},
+			body:        testutils.GetMockWorkItemUpdatedNotification("Task", "", 3, nil),
+		},
+		{
+			description:   "MatchesWorkItemFilters: matching tag",
+			filters:       &serializers.WorkItemFilters{Tag: "Customer"},
+			body:          testutils.GetMockWorkItemUpdatedNotification("Bug", "triage; customer", 3, nil),
+			expectedMatch: true,
+		},
+		{
+			description: "MatchesWorkItemFilters: tag is only a part of another tag",
+			filters:     &serializers.WorkItemFilters{Tag: "customer"},
+			body:        testutils.GetMockWorkItemUpdatedNotification("Bug", "customer-facing", 3, nil),
+		},
+		{
+			description:   "MatchesWorkItemFilters: matching state change",
+			filters:       &serializers.WorkItemFilters{StateFrom: "Active", StateTo: "Resolved"},
+			body:          testutils.GetMockWorkItemUpdatedNotification("Bug", "", 3, map[string]interface{}{"oldValue": "Active", "newValue": "Resolved"}),
+			expectedMatch: true,
+		},
+		{
+			description:   "MatchesWorkItemFilters: matching new state",
+			filters:       &serializers.WorkItemFilters{StateTo: "Closed"},
+			body:          testutils.GetMockWorkItemUpdatedNotification("Bug", "", 3, map[string]interface{}{"oldValue": "Resolved", "newValue": "Closed"}),
+			expectedMatch: true,
+		},
+		{
+			description: "MatchesWorkItemFilters: other state change",
+			filters:     &serializers.WorkItemFilters{StateFrom: "Active", StateTo: "Resolved"},
+			body:        testutils.GetMockWorkItemUpdatedNotification("Bug", "", 3, map[string]interface{}{"oldValue": "New", "newValue": "Resolved"}),
+		},
+		{
+			description: "MatchesWorkItemFilters: state is not changed",
+			filters:     &serializers.WorkItemFilters{StateTo: "Resolved"},
+			body:        testutils.GetMockWorkItemUpdatedNotification("Bug", "", 3, nil),
+		},
+		{
+			description:   "MatchesWorkItemFilters: matching priority",
+			filters:       &serializers.WorkItemFilters{MaxPriority: 2},
+			body:          testutils.GetMockWorkItemUpdatedNotification("Bug", "", 2, nil),
+			expectedMatch: true,
+		},
+		{
+			description: "MatchesWorkItemFilters: lower priority",
+			filters:     &serializers.WorkItemFilters{MaxPriority: 2},
+			body:        testutils.GetMockWorkItemUpdatedNotification("Bug", "", 3, nil),
+		},
+		{
+			description: "MatchesWorkItemFilters: one of the filters does not match",
+			filters:     &serializers.WorkItemFilters{WorkItemTypes: []string{"Bug"}, MaxPriority: 2},
+			body:        testutils.GetMockWorkItemUpdatedNotification("Bug", "", 4, nil),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedMatch, matchesWorkItemFilters(testCase.filters, testCase.body))
+		})
+	}
+}
+
+func TestShouldPostWorkItemNotification(t *testing.T) {
+	for _, testCase := range []struct {
+		description        string
+		eventType          string
+		subscriptions      []*serializers.SubscriptionDetails
+		err                error
+		expectLoad         bool
+		expectedPostResult bool
+	}{
+		{
+			description:        "ShouldPostWorkItemNotification: not a work item event",
+			eventType:          constants.SubscriptionEventPullRequestCreated,
+			expectedPostResult: true,
+		},
+		{
+			description:        "ShouldPostWorkItemNotification: matching work item",
+			eventType:          constants.SubscriptionEventWorkItemCreated,
+			subscriptions:      []*serializers.SubscriptionDetails{{SubscriptionID: "mockSubscriptionID", WorkItemFilters: &serializers.WorkItemFilters{WorkItemTypes: []string{"Bug"}}}},
+			expectLoad:         true,
+			expectedPostResult: true,
+		},
+		{
+			description:   "ShouldPostWorkItemNotification: work item does not match",
+			eventType:     constants.SubscriptionEventWorkItemCreated,
+			subscriptions: []*serializers.SubscriptionDetails{{SubscriptionID: "mockSubscriptionID", WorkItemFilters: &serializers.WorkItemFilters{WorkItemTypes: []string{"Incident"}}}},
+			expectLoad:    true,
+		},
+		{
+			description:        "ShouldPostWorkItemNotification: subscription does not exist",
+			eventType:          constants.SubscriptionEventWorkItemCreated,
+			expectLoad:         true,
+			expectedPostResult: true,
+		},
+		{
+			description:        "ShouldPostWorkItemNotification: error while loading the subscriptions",
+			eventType:          constants.SubscriptionEventWorkItemCreated,
+			err:                errors.New("failed to load the subscriptions"),
+			expectLoad:         true,
+			expectedPostResult: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+
+			if testCase.expectLoad {
+				mockedStore.EXPECT().GetAllSubscriptions("").Return(testCase.subscriptions, testCase.err)
+			}
+
+			body := testutils.GetMockSubscriptionNotification(testCase.eventType)
+			body.Resource.Fields.WorkItemType = "Bug"
+
+			assert.Equal(t, testCase.expectedPostResult, p.ShouldPostWorkItemNotification(body))
+		})
+	}
+}
+
+func TestUpdateWorkItemFilters(t *testing.T) {
+	for _, testCase := range []struct {
+		description        string
+		subscription       *serializers.SubscriptionDetails
+		filters            *serializers.WorkItemFilters
+		isChannelMember    bool
+		expectStore        bool
+		expectedFilters    *serializers.WorkItemFilters
+		expectedStatusCode int
+	}{
+		{
+			description:        "UpdateWorkItemFilters: filters are updated",
+			subscription:       &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", ServiceType: constants.CommandBoards, EventType: constants.SubscriptionEventWorkItemUpdated, ChannelID: testutils.MockChannelID},
+			filters:            &serializers.WorkItemFilters{StateTo: "Resolved"},
+			isChannelMember:    true,
+			expectStore:        true,
+			expectedFilters:    &serializers.WorkItemFilters{StateTo: "Resolved"},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "UpdateWorkItemFilters: filters are removed",
+			subscription:       &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", ServiceType: constants.CommandBoards, EventType: constants.SubscriptionEventWorkItemUpdated, ChannelID: testutils.MockChannelID, WorkItemFilters: &serializers.WorkItemFilters{Tag: "customer"}},
+			filters:            &serializers.WorkItemFilters{},
+			isChannelMember:    true,
+			expectStore:        true,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "UpdateWorkItemFilters: subscription does not exist",
+			filters:            &serializers.WorkItemFilters{Tag: "customer"},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			description:        "UpdateWorkItemFilters: not a Boards subscription",
+			subscription:       &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", ServiceType: constants.CommandRepos, EventType: constants.SubscriptionEventPullRequestCreated, ChannelID: testutils.MockChannelID},
+			filters:            &serializers.WorkItemFilters{Tag: "customer"},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			description:        "UpdateWorkItemFilters: user is not a member of the channel",
+			subscription:       &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", ServiceType: constants.CommandBoards, EventType: constants.SubscriptionEventWorkItemUpdated, ChannelID: testutils.MockChannelID},
+			filters:            &serializers.WorkItemFilters{Tag: "customer"},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			description:        "UpdateWorkItemFilters: state filters for a work item created subscription",
+			subscription:       &serializers.SubscriptionDetails{SubscriptionID: "mockSubscriptionID", ServiceType: constants.CommandBoards, EventType: constants.SubscriptionEventWorkItemCreated, ChannelID: testutils.MockChannelID},
+			filters:            &serializers.WorkItemFilters{StateFrom: "New"},
+			isChannelMember:    true,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedStore := mocks.NewMockKVStore(mockCtrl)
+			p := setupMockPlugin(mockAPI, mockedStore, nil)
+
+			var subscriptions []*serializers.SubscriptionDetails
+			if testCase.subscription != nil {
+				subscriptions = append(subscriptions, testCase.subscription)
+			}
+			mockedStore.EXPECT().GetAllSubscriptions("").Return(subscriptions, nil)
+
+			if testCase.isChannelMember {
+				mockAPI.On("GetChannelMember", testutils.MockChannelID, testutils.MockMattermostUserID).Return(&model.ChannelMember{}, nil)
+			} else {
+				mockAPI.On("GetChannelMember", testutils.MockChannelID, testutils.MockMattermostUserID).Return(nil, &model.AppError{})
+			}
+
+			if testCase.expectStore {
+				mockedStore.EXPECT().StoreSubscription(gomock.Any()).Return(nil)
+			}
+
+			subscription, statusCode, err := p.UpdateWorkItemFilters(testutils.MockMattermostUserID, "mockSubscriptionID", testCase.filters)
+
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			if testCase.expectedStatusCode != http.StatusOK {
+				assert.NotNil(t, err)
+				assert.Nil(t, subscription)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedFilters, subscription.WorkItemFilters)
+		})
+	}
+}
+
+func TestExecuteWorkItemFiltersCommand(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	p := setupMockPlugin(mockAPI, nil, nil)
+	for _, testCase := range []struct {
+		description      string
+		command          string
+		expectedFilters  *serializers.WorkItemFilters
+		statusCode       int
+		err              error
+		ephemeralMessage string
+	}{
+		{
+			description:      "WorkItemFiltersCommand: subscription ID is not provided",
+			command:          "/azuredevops boards subscription filter",
+			ephemeralMessage: constants.SubscriptionIDNotProvided,
+		},
+		{
+			description:      "WorkItemFiltersCommand: filters are not provided",
+			command:          "/azuredevops boards subscription filter mockSubscriptionID",
+			ephemeralMessage: constants.WorkItemFiltersRequired,
+		},
+		{
+			description:      "WorkItemFiltersCommand: invalid filter",
+			command:          "/azuredevops boards subscription filter mockSubscriptionID area=Web",
+			ephemeralMessage: fmt.Sprintf(constants.InvalidWorkItemFilterArgument, "area=Web"),
+		},
+		{
+			description:      "WorkItemFiltersCommand: invalid priority",
+			command:          "/azuredevops boards subscription filter mockSubscriptionID priority<=high",
+			ephemeralMessage: fmt.Sprintf(constants.InvalidWorkItemFilterPriority, "high"),
+		},
+		{
+			description:      "WorkItemFiltersCommand: filters are updated",
+			command:          `/azuredevops boards subscription filter mockSubscriptionID "type=Bug, User Story" tag=customer from=Active to=Resolved priority<=2`,
+			expectedFilters:  &serializers.WorkItemFilters{WorkItemTypes: []string{"Bug", "User Story"}, Tag: "customer", StateFrom: "Active", StateTo: "Resolved", MaxPriority: 2},
+			statusCode:       http.StatusOK,
+			ephemeralMessage: fmt.Sprintf(constants.WorkItemFiltersUpdated, "mockSubscriptionID", "type in (Bug, User Story), tag contains customer, state changed from Active, state changed to Resolved, priority <= 2"),
+		},
+		{
+			description:      "WorkItemFiltersCommand: filters are removed",
+			command:          "/azuredevops boards subscription filter mockSubscriptionID clear",
+			expectedFilters:  &serializers.WorkItemFilters{},
+			statusCode:       http.StatusOK,
+			ephemeralMessage: fmt.Sprintf(constants.WorkItemFiltersCleared, "mockSubscriptionID"),
+		},
+		{
+			description:      "WorkItemFiltersCommand: subscription does not exist",
+			command:          "/azuredevops boards subscription filter mockSubscriptionID tag=customer",
+			expectedFilters:  &serializers.WorkItemFilters{Tag: "customer"},
+			statusCode:       http.StatusNotFound,
+			err:              errors.New(constants.SubscriptionNotFound),
+			ephemeralMessage: "Boards subscription with ID: \"mockSubscriptionID\" does not exist",
+		},
+		{
+			description:      "WorkItemFiltersCommand: filters are not supported for the subscription",
+			command:          "/azuredevops boards subscription filter mockSubscriptionID from=New",
+			expectedFilters:  &serializers.WorkItemFilters{StateFrom: "New"},
+			statusCode:       http.StatusBadRequest,
+			err:              errors.New(constants.WorkItemStateFiltersNotSupported),
+			ephemeralMessage: constants.WorkItemStateFiltersNotSupported,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...)
+			mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				post := args.Get(1).(*model.Post)
+				assert.Equal(t, testCase.ephemeralMessage, post.Message)
+			}).Once().Return(&model.Post{})
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return true
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "UpdateWorkItemFilters", func(_ *Plugin, _, subscriptionID string, filters *serializers.WorkItemFilters) (*serializers.SubscriptionDetails, int, error) {
+				assert.Equal(t, "mockSubscriptionID", subscriptionID)
+				assert.Equal(t, testCase.expectedFilters, filters)
+				if testCase.err != nil {
+					return nil, testCase.statusCode, testCase.err
+				}
+
+				subscription := &serializers.SubscriptionDetails{SubscriptionID: subscriptionID}
+				if !filters.IsEmpty() {
+					subscription.WorkItemFilters = filters
+				}
+				return subscription, testCase.statusCode, nil
+			})
+
+			_, err := p.ExecuteCommand(nil, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID})
+			assert.Nil(t, err)
+		})
+	}
+}
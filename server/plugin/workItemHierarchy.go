@@ -0,0 +1,148 @@
+package plugin
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// workItemHierarchyFields are the fields of the parent and children of a work item needed for its preview
+var workItemHierarchyFields = []string{
+	constants.FieldReferenceNameTitle,
+	constants.FieldReferenceNameProject,
+	constants.FieldReferenceNameType,
+	constants.FieldReferenceNameState,
+}
+
+// BreakdownWorkItem creates a child task of the parent work item for each of the titles, in the area and iteration of the parent.
+// If creating a child fails, the children created before the failure are returned along with the error.
+func (p *Plugin) BreakdownWorkItem(mattermostUserID, organization, projectName, parentID string, titles []string) (*serializers.TaskValue, []*serializers.TaskValue, int, error) {
+	parent, statusCode, err := p.Client.GetTask(organization, parentID, projectName, mattermostUserID)
+	if err != nil {
+		return nil, nil, statusCode, err
+	}
+
+	fieldDefinitions, statusCode, err := p.GetWorkItemFieldDefinitions(organization, projectName, constants.DefaultChildWorkItemType, mattermostUserID)
+	if err != nil {
+		return parent, nil, statusCode, err
+	}
+
+	// Validate all the children before creating any of them
+	bodies := make([]*serializers.CreateTaskRequestPayload, 0, len(titles))
+	for _, title := range titles {
+		body := &serializers.CreateTaskRequestPayload{
+			Organization: organization,
+			Project:      projectName,
+			Type:         constants.DefaultChildWorkItemType,
+			Fields: serializers.CreateTaskFieldValue{
+				Title:            title,
+				AreaPath:         parent.Fields.AreaPath,
+				AdditionalFields: map[string]interface{}{},
+			},
+			Relations: []*serializers.WorkItemRelation{
+				{
+					Rel: constants.RelationTypeParent,
+					URL: parent.URL,
+				},
+			},
+		}
+
+		if parent.Fields.Iteration != "" {
+			body.Fields.AdditionalFields[constants.FieldReferenceNameIteration] = parent.Fields.Iteration
+		}
+
+		if validationErr := body.ValidateFields(fieldDefinitions); validationErr != nil {
+			return parent, nil, http.StatusBadRequest, validationErr
+		}
+
+		bodies = append(bodies, body)
+	}
+
+	children := make([]*serializers.TaskValue, 0, len(bodies))
+	for _, body := range bodies {
+		child, statusCode, err := p.Client.CreateTask(body, mattermostUserID)
+		if err != nil {
+			return parent, children, statusCode, err
+		}
+
+		children = append(children, child)
+	}
+
+	return parent, children, http.StatusOK, nil
+}
+
+// getWorkItemHierarchyFields returns the attachment fields showing the parent of the work item and the progress of its children.
+// No fields are returned if the work item has no parent or children.
+func (p *Plugin) getWorkItemHierarchyFields(task *serializers.TaskValue, organization, projectName, mattermostUserID string) []*model.SlackAttachmentField {
+	parentIDs := task.GetRelatedWorkItemIDs(constants.RelationTypeParent)
+	childIDs := task.GetRelatedWorkItemIDs(constants.RelationTypeChild)
+	if len(parentIDs) == 0 && len(childIDs) == 0 {
+		return nil
+	}
+
+	workItemIDs := append(parentIDs, childIDs...)
+	if len(workItemIDs) > constants.MaxWorkItemsPerRequest {
+		workItemIDs = workItemIDs[:constants.MaxWorkItemsPerRequest]
+	}
+
+	taskList, _, err := p.Client.GetTasks(organization, projectName, workItemIDs, workItemHierarchyFields, mattermostUserID)
+	if err != nil {
+		p.API.LogDebug(constants.ErrorFetchWorkItemHierarchy, "Error", err.Error())
+		return nil
+	}
+
+	relatedTasks := make(map[string]*serializers.TaskValue, len(taskList.Value))
+	for _, relatedTask := range taskList.Value {
+		// Work items which the user cannot access are returned as null
+		if relatedTask != nil {
+			relatedTasks[fmt.Sprint(relatedTask.ID)] = relatedTask
+		}
+	}
+
+	var fields []*model.SlackAttachmentField
+	if len(parentIDs) > 0 {
+		parentValue := fmt.Sprintf("#%s", parentIDs[0])
+		if parent, ok := relatedTasks[parentIDs[0]]; ok {
+			parentProject := parent.Fields.Project
+			if parentProject == "" {
+				parentProject = projectName
+			}
+			parentURL := fmt.Sprintf(constants.WorkItemWebURL, p.getConfiguration().AzureDevopsAPIBaseURL, organization, parentProject, parent.ID)
+			parentValue = fmt.Sprintf(constants.TaskTitle, parent.Fields.Type, parent.ID, parent.Fields.Title, parentURL)
+		}
+
+		fields = append(fields, &model.SlackAttachmentField{
+			Title: "Parent",
+			Value: parentValue,
+			Short: true,
+		})
+	}
+
+	if len(childIDs) > 0 {
+		var total, done int
+		for _, childID := range childIDs {
+			child, ok := relatedTasks[childID]
+			if !ok || strings.EqualFold(child.Fields.State, constants.WorkItemStateRemoved) {
+				continue
+			}
+
+			total++
+			if serializers.IsCompletedWorkItemState(child.Fields.State) {
+				done++
+			}
+		}
+
+		fields = append(fields, &model.SlackAttachmentField{
+			Title: fmt.Sprintf("Children (%d)", total),
+			Value: fmt.Sprintf(constants.WorkItemChildrenProgress, done, total),
+			Short: true,
+		})
+	}
+
+	return fields
+}
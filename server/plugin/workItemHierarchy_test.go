@@ -0,0 +1,313 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v5/plugin"
+	"github.com/mattermost/mattermost-server/v5/plugin/plugintest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/mocks"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/config"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestBreakdownWorkItem(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description        string
+		titles             []string
+		getTaskError       error
+		createTaskError    error
+		expectCreate       bool
+		expectedChildren   int
+		expectedStatusCode int
+	}{
+		{
+			description:        "BreakdownWorkItem: children are created",
+			titles:             []string{"Write tests", "Update docs"},
+			expectCreate:       true,
+			expectedChildren:   2,
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			description:        "BreakdownWorkItem: failed to get the parent",
+			titles:             []string{"Write tests"},
+			getTaskError:       errors.New("failed to get the Task"),
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			description:        "BreakdownWorkItem: title is not valid",
+			titles:             []string{"Write tests", " "},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			description:        "BreakdownWorkItem: failed to create a child",
+			titles:             []string{"Write tests", "Update docs"},
+			createTaskError:    errors.New("failed to create task"),
+			expectCreate:       true,
+			expectedChildren:   1,
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(&plugintest.API{}, nil, mockedClient)
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "GetWorkItemFieldDefinitions", func(_ *Plugin, _, _, workItemType, _ string) ([]*serializers.WorkItemFieldDefinition, int, error) {
+				assert.Equal(t, constants.DefaultChildWorkItemType, workItemType)
+				return []*serializers.WorkItemFieldDefinition{
+					{Name: "Title", ReferenceName: constants.FieldReferenceNameTitle, AlwaysRequired: true},
+					{Name: "Area Path", ReferenceName: constants.FieldReferenceNameAreaPath, Type: "treePath"},
+					{Name: "Iteration Path", ReferenceName: constants.FieldReferenceNameIteration, Type: "treePath"},
+				}, http.StatusOK, nil
+			})
+
+			parent := testutils.GetMockParentTask()
+			mockedClient.EXPECT().GetTask(testutils.MockOrganization, "42", testutils.MockProjectName, testutils.MockMattermostUserID).Return(parent, testCase.expectedStatusCode, testCase.getTaskError)
+
+			createCount := 0
+			if testCase.expectCreate {
+				mockedClient.EXPECT().CreateTask(gomock.Any(), testutils.MockMattermostUserID).DoAndReturn(func(body *serializers.CreateTaskRequestPayload, _ string) (*serializers.TaskValue, int, error) {
+					createCount++
+					assert.Equal(t, constants.DefaultChildWorkItemType, body.Type)
+					assert.Equal(t, parent.Fields.AreaPath, body.Fields.AreaPath)
+					assert.Equal(t, parent.Fields.Iteration, body.Fields.AdditionalFields[constants.FieldReferenceNameIteration])
+					assert.Equal(t, []*serializers.WorkItemRelation{{Rel: constants.RelationTypeParent, URL: parent.URL}}, body.Relations)
+					if testCase.createTaskError != nil && createCount == 2 {
+						return nil, testCase.expectedStatusCode, testCase.createTaskError
+					}
+					return &serializers.TaskValue{ID: createCount, Fields: serializers.TaskFieldValue{Title: body.Fields.Title}}, http.StatusOK, nil
+				}).Times(len(testCase.titles))
+			}
+
+			resp, children, statusCode, err := p.BreakdownWorkItem(testutils.MockMattermostUserID, testutils.MockOrganization, testutils.MockProjectName, "42", testCase.titles)
+
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+			assert.Len(t, children, testCase.expectedChildren)
+			if testCase.getTaskError != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, resp)
+				return
+			}
+
+			assert.Equal(t, parent, resp)
+			if testCase.expectedStatusCode != http.StatusOK {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, "Update docs", children[1].Fields.Title)
+		})
+	}
+}
+
+func TestGetWorkItemHierarchyFields(t *testing.T) {
+	for _, testCase := range []struct {
+		description    string
+		relations      []*serializers.WorkItemRelation
+		relatedTasks   []*serializers.TaskValue
+		getTasksError  error
+		expectedIDs    []string
+		expectedFields []*model.SlackAttachmentField
+	}{
+		{
+			description: "GetWorkItemHierarchyFields: work item has no parent or children",
+			relations:   []*serializers.WorkItemRelation{{Rel: constants.RelationTypeHyperlink, URL: "https://mattermost.example.com"}},
+		},
+		{
+			description: "GetWorkItemHierarchyFields: work item has a parent and children",
+			relations: []*serializers.WorkItemRelation{
+				{Rel: constants.RelationTypeParent, URL: "https://dev.azure.com/mockOrganization/mockProjectID/_apis/wit/workItems/1"},
+				{Rel: constants.RelationTypeChild, URL: "https://dev.azure.com/mockOrganization/mockProjectID/_apis/wit/workItems/3"},
+				{Rel: constants.RelationTypeChild, URL: "https://dev.azure.com/mockOrganization/mockProjectID/_apis/wit/workItems/4"},
+				{Rel: constants.RelationTypeChild, URL: "https://dev.azure.com/mockOrganization/mockProjectID/_apis/wit/workItems/5"},
+			},
+			relatedTasks: []*serializers.TaskValue{
+				{ID: 1, Fields: serializers.TaskFieldValue{Title: "mockParentTitle", Type: "Epic", Project: "mockOtherProject"}},
+				{ID: 3, Fields: serializers.TaskFieldValue{State: constants.WorkItemStateDone}},
+				{ID: 4, Fields: serializers.TaskFieldValue{State: "In Progress"}},
+				{ID: 5, Fields: serializers.TaskFieldValue{State: constants.WorkItemStateRemoved}},
+				nil,
+			},
+			expectedIDs: []string{"1", "3", "4", "5"},
+			expectedFields: []*model.SlackAttachmentField{
+				{Title: "Parent", Value: "[Epic #1: mockParentTitle](https://dev.azure.com/mockOrganization/mockOtherProject/_workitems/edit/1)", Short: true},
+				{Title: "Children (2)", Value: "1/2 done", Short: true},
+			},
+		},
+		{
+			description: "GetWorkItemHierarchyFields: failed to get the related work items",
+			relations: []*serializers.WorkItemRelation{
+				{Rel: constants.RelationTypeChild, URL: "https://dev.azure.com/mockOrganization/mockProjectID/_apis/wit/workItems/3"},
+			},
+			getTasksError: errors.New("failed to get the Tasks"),
+			expectedIDs:   []string{"3"},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI := &plugintest.API{}
+			mockCtrl := gomock.NewController(t)
+			mockedClient := mocks.NewMockClient(mockCtrl)
+			p := setupMockPlugin(mockAPI, nil, mockedClient)
+			p.setConfiguration(
+				&config.Configuration{
+					AzureDevopsAPIBaseURL: "https://dev.azure.com",
+				})
+
+			if testCase.expectedIDs != nil {
+				mockedClient.EXPECT().GetTasks(testutils.MockOrganization, testutils.MockProjectName, testCase.expectedIDs, workItemHierarchyFields, testutils.MockMattermostUserID).Return(&serializers.TaskList{Value: testCase.relatedTasks}, http.StatusOK, testCase.getTasksError)
+			}
+
+			if testCase.getTasksError != nil {
+				mockAPI.On("LogDebug", testutils.GetMockArgumentsWithType("string", 3)...)
+			}
+
+			fields := p.getWorkItemHierarchyFields(&serializers.TaskValue{Relations: testCase.relations}, testutils.MockOrganization, testutils.MockProjectName, testutils.MockMattermostUserID)
+			assert.Equal(t, testCase.expectedFields, fields)
+		})
+	}
+}
+
+func TestExecuteBreakdownCommand(t *testing.T) {
+	defer monkey.UnpatchAll()
+	mockAPI := &plugintest.API{}
+	mockCtrl := gomock.NewController(t)
+	mockedStore := mocks.NewMockKVStore(mockCtrl)
+	p := setupMockPlugin(mockAPI, mockedStore, nil)
+	parent := testutils.GetMockParentTask()
+	children := []*serializers.TaskValue{
+		{ID: 43, Fields: serializers.TaskFieldValue{Title: "Write tests", Type: "Task"}},
+		{ID: 44, Fields: serializers.TaskFieldValue{Title: "Update docs", Type: "Task"}},
+	}
+	children[0].Link.HTML.Href = "mockLink43"
+	children[1].Link.HTML.Href = "mockLink44"
+	for _, testCase := range []struct {
+		description        string
+		command            string
+		linkedProjects     []serializers.ProjectDetails
+		expectedProject    string
+		expectedTitles     []string
+		children           []*serializers.TaskValue
+		statusCode         int
+		err                error
+		expectLogError     bool
+		ephemeralMessage   string
+		expectedParentPath []string
+	}{
+		{
+			description:      "BreakdownCommand: parent is not provided",
+			command:          "/azuredevops boards workitem breakdown",
+			ephemeralMessage: constants.ParentWorkItemRequired,
+		},
+		{
+			description:      "BreakdownCommand: titles are not provided",
+			command:          `/azuredevops boards workitem breakdown 42 " "`,
+			ephemeralMessage: constants.ChildWorkItemTitlesRequired,
+		},
+		{
+			description:      "BreakdownCommand: parent is invalid",
+			command:          `/azuredevops boards workitem breakdown mockParent "Write tests"`,
+			ephemeralMessage: constants.ParentWorkItemRequired,
+		},
+		{
+			description:      "BreakdownCommand: project of the parent cannot be found",
+			command:          `/azuredevops boards workitem breakdown 42 "Write tests"`,
+			linkedProjects:   []serializers.ProjectDetails{},
+			ephemeralMessage: constants.ParentWorkItemProjectRequired,
+		},
+		{
+			description:      "BreakdownCommand: children are created using the ID of the parent",
+			command:          `/azuredevops boards workitem breakdown 42 "Write tests" "Update docs"`,
+			linkedProjects:   testutils.GetProjectDetailsPayload(),
+			expectedProject:  testutils.MockProjectName,
+			expectedTitles:   []string{"Write tests", "Update docs"},
+			children:         children,
+			statusCode:       http.StatusOK,
+			ephemeralMessage: fmt.Sprintf(constants.ChildWorkItemsCreated, 2, "User Story", 42, "mockParentTitle", "mockParentLink") + "* [Task #43: Write tests](mockLink43)\n* [Task #44: Update docs](mockLink44)\n",
+		},
+		{
+			description:      "BreakdownCommand: children are created using the link of the parent",
+			command:          `/azuredevops boards workitem breakdown https://dev.azure.com/mockOrganization/mockOtherProject/_workitems/edit/42 "Write tests"`,
+			expectedProject:  "mockOtherProject",
+			expectedTitles:   []string{"Write tests"},
+			children:         children[:1],
+			statusCode:       http.StatusOK,
+			ephemeralMessage: fmt.Sprintf(constants.ChildWorkItemsCreated, 1, "User Story", 42, "mockParentTitle", "mockParentLink") + "* [Task #43: Write tests](mockLink43)\n",
+		},
+		{
+			description:      "BreakdownCommand: some of the children are created",
+			command:          `/azuredevops boards workitem breakdown https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/42 "Write tests" "Update docs"`,
+			expectedProject:  testutils.MockProjectName,
+			expectedTitles:   []string{"Write tests", "Update docs"},
+			children:         children[:1],
+			statusCode:       http.StatusInternalServerError,
+			err:              errors.New("failed to create task"),
+			expectLogError:   true,
+			ephemeralMessage: fmt.Sprintf(constants.ChildWorkItemsPartiallyCreated, 1, 2, "User Story", 42, "mockParentTitle", "mockParentLink", constants.GenericErrorMessage) + "* [Task #43: Write tests](mockLink43)\n",
+		},
+		{
+			description:      "BreakdownCommand: children are not valid",
+			command:          `/azuredevops boards workitem breakdown https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/42 "Write tests"`,
+			expectedProject:  testutils.MockProjectName,
+			expectedTitles:   []string{"Write tests"},
+			statusCode:       http.StatusBadRequest,
+			err:              errors.New("mockValidationError"),
+			ephemeralMessage: "mockValidationError",
+		},
+		{
+			description:      "BreakdownCommand: parent does not exist",
+			command:          `/azuredevops boards workitem breakdown https://dev.azure.com/mockOrganization/mockProjectName/_workitems/edit/42 "Write tests"`,
+			expectedProject:  testutils.MockProjectName,
+			expectedTitles:   []string{"Write tests"},
+			statusCode:       http.StatusNotFound,
+			err:              errors.New("failed to get the Task"),
+			expectLogError:   true,
+			ephemeralMessage: constants.GenericErrorMessage,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			mockAPI.On("SendEphemeralPost", mock.AnythingOfType("string"), mock.AnythingOfType("*model.Post")).Run(func(args mock.Arguments) {
+				post := args.Get(1).(*model.Post)
+				assert.Equal(t, testCase.ephemeralMessage, post.Message)
+			}).Once().Return(&model.Post{})
+
+			if testCase.expectLogError {
+				mockAPI.On("LogError", testutils.GetMockArgumentsWithType("string", 3)...).Once()
+			}
+
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "MattermostUserAlreadyConnected", func(_ *Plugin, _ string) bool {
+				return true
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(p), "BreakdownWorkItem", func(_ *Plugin, mattermostUserID, organization, projectName, parentID string, titles []string) (*serializers.TaskValue, []*serializers.TaskValue, int, error) {
+				assert.Equal(t, testutils.MockOrganization, organization)
+				assert.Equal(t, testCase.expectedProject, projectName)
+				assert.Equal(t, "42", parentID)
+				assert.Equal(t, testCase.expectedTitles, titles)
+				if testCase.statusCode == http.StatusNotFound {
+					return nil, nil, testCase.statusCode, testCase.err
+				}
+				return parent, testCase.children, testCase.statusCode, testCase.err
+			})
+
+			if testCase.linkedProjects != nil {
+				mockedStore.EXPECT().GetAllProjects(testutils.MockMattermostUserID).Return(testCase.linkedProjects, nil)
+			}
+
+			_, err := p.ExecuteCommand(&plugin.Context{}, &model.CommandArgs{Command: testCase.command, UserId: testutils.MockMattermostUserID})
+			assert.Nil(t, err)
+		})
+	}
+}
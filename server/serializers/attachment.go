@@ -0,0 +1,54 @@
+package serializers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+type AttachFilesRequestPayload struct {
+	PostID       string `json:"postID"`
+	Organization string `json:"organization"`
+	Project      string `json:"project"`
+	WorkItemID   string `json:"workItemID"`
+}
+
+// WorkItemAttachment is a file uploaded to Azure DevOps which can be added to work items
+type WorkItemAttachment struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+// AttachedFile is a file of a post attached to a work item
+type AttachedFile struct {
+	FileName string `json:"fileName"`
+	Size     int64  `json:"size"`
+	URL      string `json:"url"`
+}
+
+// IsValid function to validate request payload.
+func (t *AttachFilesRequestPayload) IsValid() error {
+	if t.PostID == "" {
+		return errors.New(constants.PostIDRequired)
+	}
+	if t.Organization == "" {
+		return errors.New(constants.OrganizationRequired)
+	}
+	if t.Project == "" {
+		return errors.New(constants.ProjectRequired)
+	}
+	if t.WorkItemID == "" {
+		return errors.New(constants.WorkItemIDRequired)
+	}
+	return nil
+}
+
+func AttachFilesRequestPayloadFromJSON(data io.Reader) (*AttachFilesRequestPayload, error) {
+	var body *AttachFilesRequestPayload
+	if err := json.NewDecoder(data).Decode(&body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
@@ -0,0 +1,150 @@
+package serializers
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+var mergedPullRequestRegex = regexp.MustCompile(constants.MergedPullRequestRegex)
+
+// CodePushFilters are the filters of a code pushed subscription which collapse the commits in its notifications,
+// the number of collapsed commits is shown instead of the commits
+type CodePushFilters struct {
+	CollapseMergeCommits bool `json:"collapseMergeCommits,omitempty"`
+	CollapseBotCommits   bool `json:"collapseBotCommits,omitempty"`
+}
+
+// CommitDiffs are the changes between a base and a target commit
+type CommitDiffs struct {
+	// AheadCount is the number of commits of the target which are not present in the base
+	AheadCount int `json:"aheadCount"`
+	// BehindCount is the number of commits of the base which are not present in the target
+	BehindCount int `json:"behindCount"`
+	// ChangeCounts are the number of changed files by change type e.g. "Add", "Edit" or "Delete"
+	ChangeCounts map[string]int `json:"changeCounts"`
+}
+
+// ParseCodePushFilterArguments parses arguments of the form "collapse=merges,bots" into code push filters.
+func ParseCodePushFilterArguments(args []string) (*CodePushFilters, error) {
+	filters := &CodePushFilters{}
+	for _, arg := range args {
+		key, value, found := cut(arg, constants.FilterArgumentSeparator)
+		if !found || key != constants.CodePushFilterArgumentCollapse || strings.TrimSpace(value) == "" {
+			return nil, fmt.Errorf(constants.InvalidCodePushFilterArgument, arg)
+		}
+
+		for _, kind := range strings.Split(value, ",") {
+			switch strings.TrimSpace(kind) {
+			case constants.CodePushFilterCollapseMerges:
+				filters.CollapseMergeCommits = true
+			case constants.CodePushFilterCollapseBots:
+				filters.CollapseBotCommits = true
+			default:
+				return nil, fmt.Errorf(constants.InvalidCodePushFilterArgument, arg)
+			}
+		}
+	}
+
+	return filters, nil
+}
+
+// IsEmpty returns true if none of the filters are set
+func (f *CodePushFilters) IsEmpty() bool {
+	return f == nil || (!f.CollapseMergeCommits && !f.CollapseBotCommits)
+}
+
+// IsValid validates the filters for a subscription of the event type
+func (f *CodePushFilters) IsValid(eventType string) error {
+	if !f.IsEmpty() && eventType != constants.SubscriptionEventCodePushed {
+		return errors.New(constants.CodePushFiltersNotSupported)
+	}
+
+	return nil
+}
+
+// String returns the filters in the form they are shown in the subscription lists
+func (f *CodePushFilters) String() string {
+	if f.IsEmpty() {
+		return ""
+	}
+
+	var filters []string
+	if f.CollapseMergeCommits {
+		filters = append(filters, "merge commits collapsed")
+	}
+	if f.CollapseBotCommits {
+		filters = append(filters, "bot commits collapsed")
+	}
+
+	return strings.Join(filters, ", ")
+}
+
+// IsMergeCommit returns true if the message of the commit is the default message of a merge or of a merged pull request
+func (c *Commit) IsMergeCommit() bool {
+	return strings.HasPrefix(c.Comment, constants.MergeCommitPrefix) || mergedPullRequestRegex.MatchString(c.Comment)
+}
+
+// IsBotCommit returns true if the commit is authored by a bot or a build service account
+func (c *Commit) IsBotCommit() bool {
+	if c.Author == nil {
+		return false
+	}
+
+	for _, marker := range constants.BotCommitAuthorMarkers {
+		if strings.Contains(c.Author.Name, marker) || strings.Contains(c.Author.Email, marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetAuthorName returns the name of the author of the commit, or its email if the name is not present
+func (c *Commit) GetAuthorName() string {
+	if c.Author == nil {
+		return ""
+	}
+
+	if c.Author.Name != "" {
+		return c.Author.Name
+	}
+
+	return c.Author.Email
+}
+
+// GetTitle returns the first line of the message of the commit
+func (c *Commit) GetTitle() string {
+	return strings.TrimSpace(strings.SplitN(c.Comment, "\n", 2)[0])
+}
+
+// GetShortObjectID returns the abbreviated form of a git object ID
+func GetShortObjectID(objectID string) string {
+	if len(objectID) > constants.ShortCommitIDLength {
+		return objectID[:constants.ShortCommitIDLength]
+	}
+
+	return objectID
+}
+
+// IsCreated returns true if the ref did not exist before the push
+func (r *RefUpdates) IsCreated() bool {
+	return r.OldObjectID == constants.ZeroObjectID
+}
+
+// IsDeleted returns true if the ref was deleted by the push
+func (r *RefUpdates) IsDeleted() bool {
+	return r.NewObjectID == constants.ZeroObjectID
+}
+
+// GetKindAndName returns whether the ref is a branch or a tag along with its short name e.g. "Branch" and "feature/login" for "refs/heads/feature/login"
+func (r *RefUpdates) GetKindAndName() (kind, name string) {
+	if strings.HasPrefix(r.Name, constants.RefNamePrefixTag) {
+		return "Tag", strings.TrimPrefix(r.Name, constants.RefNamePrefixTag)
+	}
+
+	return "Branch", strings.TrimPrefix(r.Name, constants.RefNamePrefixBranch)
+}
@@ -0,0 +1,98 @@
+package serializers
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+type GitRepository struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	DefaultBranch string  `json:"defaultBranch"`
+	RemoteURL     string  `json:"remoteUrl"`
+	WebURL        string  `json:"webUrl"`
+	Project       Project `json:"project"`
+}
+
+type GitRepositoryList struct {
+	Count int              `json:"count"`
+	Value []*GitRepository `json:"value"`
+}
+
+type GitRef struct {
+	Name     string `json:"name"`
+	ObjectID string `json:"objectId"`
+}
+
+type GitRefList struct {
+	Count int       `json:"count"`
+	Value []*GitRef `json:"value"`
+}
+
+type GitRefUpdate struct {
+	Name        string `json:"name"`
+	OldObjectID string `json:"oldObjectId"`
+	NewObjectID string `json:"newObjectId"`
+}
+
+type GitRefUpdateResult struct {
+	Name          string `json:"name"`
+	NewObjectID   string `json:"newObjectId"`
+	Success       bool   `json:"success"`
+	UpdateStatus  string `json:"updateStatus"`
+	CustomMessage string `json:"customMessage"`
+}
+
+type GitRefUpdateResultList struct {
+	Count int                   `json:"count"`
+	Value []*GitRefUpdateResult `json:"value"`
+}
+
+// WorkItemBranch is a branch created to start working on a work item
+type WorkItemBranch struct {
+	Name       string
+	WebURL     string
+	BaseBranch string
+	Repository *GitRepository
+	WorkItem   *TaskValue
+	// ActivateError is the reason the work item could not be moved to the active state, if it was to be moved
+	ActivateError string
+}
+
+// GetRepositoryNames returns the names of the repositories separated by commas
+func (l *GitRepositoryList) GetRepositoryNames() string {
+	names := make([]string, 0, len(l.Value))
+	for _, repository := range l.Value {
+		names = append(names, repository.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// GetBranchSlug returns the title of a work item in the form used in branch names e.g. "fix-the-login-page" for "Fix the login page!".
+// The slug is limited to constants.MaxWorkItemBranchSlugLength characters without ending in a hyphen.
+func GetBranchSlug(title string) string {
+	var sb strings.Builder
+	isHyphenPending := false
+	for _, character := range strings.ToLower(title) {
+		if character > unicode.MaxASCII || !(unicode.IsLetter(character) || unicode.IsDigit(character)) {
+			isHyphenPending = sb.Len() > 0
+			continue
+		}
+
+		if isHyphenPending {
+			sb.WriteRune('-')
+			isHyphenPending = false
+		}
+		sb.WriteRune(character)
+	}
+
+	slug := sb.String()
+	if len(slug) > constants.MaxWorkItemBranchSlugLength {
+		slug = strings.TrimRight(slug[:constants.MaxWorkItemBranchSlugLength], "-")
+	}
+
+	return slug
+}
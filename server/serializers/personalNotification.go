@@ -0,0 +1,85 @@
+package serializers
+
+import (
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+// PersonalNotificationSettings are the kinds of events for which a user receives direct messages
+type PersonalNotificationSettings struct {
+	Reviews     bool `json:"reviews"`
+	Mentions    bool `json:"mentions"`
+	Assignments bool `json:"assignments"`
+}
+
+// Update turns the notifications of a kind on or off, it returns false if the kind is not valid
+func (s *PersonalNotificationSettings) Update(kind string, isEnabled bool) bool {
+	switch kind {
+	case constants.PersonalNotificationReviews:
+		s.Reviews = isEnabled
+	case constants.PersonalNotificationMentions:
+		s.Mentions = isEnabled
+	case constants.PersonalNotificationAssignments:
+		s.Assignments = isEnabled
+	case constants.PersonalNotificationAll:
+		s.Reviews = isEnabled
+		s.Mentions = isEnabled
+		s.Assignments = isEnabled
+	default:
+		return false
+	}
+
+	return true
+}
+
+// IsEnabled returns true if the notifications of a kind are turned on
+func (s *PersonalNotificationSettings) IsEnabled(kind string) bool {
+	switch kind {
+	case constants.PersonalNotificationReviews:
+		return s.Reviews
+	case constants.PersonalNotificationMentions:
+		return s.Mentions
+	case constants.PersonalNotificationAssignments:
+		return s.Assignments
+	}
+
+	return false
+}
+
+// ParseIdentity returns the identity of an identity field of a work item.
+// The field is an identity reference in the newer versions of the events and a string of the form "Display Name <email>" in the older ones.
+func ParseIdentity(value interface{}) *UserID {
+	switch identity := value.(type) {
+	case map[string]interface{}:
+		id, _ := identity["id"].(string)
+		displayName, _ := identity["displayName"].(string)
+		uniqueName, _ := identity["uniqueName"].(string)
+		if id == "" && uniqueName == "" {
+			return nil
+		}
+
+		return &UserID{
+			ID:          id,
+			DisplayName: displayName,
+			UniqueName:  uniqueName,
+		}
+	case string:
+		identity = strings.TrimSpace(identity)
+		if identity == "" {
+			return nil
+		}
+
+		startIndex := strings.LastIndex(identity, "<")
+		if startIndex == -1 || !strings.HasSuffix(identity, ">") {
+			return &UserID{DisplayName: identity}
+		}
+
+		return &UserID{
+			DisplayName: strings.TrimSpace(identity[:startIndex]),
+			UniqueName:  identity[startIndex+1 : len(identity)-1],
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package serializers
+
+import (
+	"sort"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+// PipelineApproval is a pending approval of a run stage whose notification post is synced with Azure DevOps until the approval is completed.
+// The approvals are fetched using the Azure DevOps account of the user who added the subscription of the post.
+type PipelineApproval struct {
+	ApprovalID       string    `json:"approvalID"`
+	OrganizationName string    `json:"organizationName"`
+	ProjectID        string    `json:"projectID"`
+	ChannelID        string    `json:"channelID"`
+	PostID           string    `json:"postID"`
+	MattermostUserID string    `json:"mattermostUserID"`
+	PipelineName     string    `json:"pipelineName"`
+	PipelineURL      string    `json:"pipelineURL"`
+	StageName        string    `json:"stageName"`
+	StageURL         string    `json:"stageURL"`
+	CreatedAt        time.Time `json:"createdAt"`
+	ExpiresAt        time.Time `json:"expiresAt"`
+}
+
+// GetDueReminder returns the number of the latest reminder of the approval which is due, reminders are due every interval after the approval is created.
+// It returns 0 if no reminder is due yet or the reminders are disabled.
+func (a *PipelineApproval) GetDueReminder(now time.Time, interval time.Duration) int {
+	if interval <= 0 || now.Before(a.CreatedAt) {
+		return 0
+	}
+
+	return int(now.Sub(a.CreatedAt) / interval)
+}
+
+// CheckConfiguration is the configuration of a check of a protected resource e.g. the approval check of an environment.
+type CheckConfiguration struct {
+	ID int `json:"id"`
+	// Timeout of the check in minutes
+	Timeout int `json:"timeout"`
+}
+
+// GetTimeout returns the time after which the check times out, it is 0 if the timeout is not set
+func (c *CheckConfiguration) GetTimeout() time.Duration {
+	return time.Duration(c.Timeout) * time.Minute
+}
+
+// GetCreatedOn returns the time when the approval was created, it is the zero time if it is missing or invalid
+func (a *Approval) GetCreatedOn() time.Time {
+	createdOn, err := time.Parse(time.RFC3339, a.CreatedOn)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return createdOn
+}
+
+// GetPendingApprovers returns the approvers of the approval who have not approved or rejected it yet.
+// For approvals in sequence it is only the next approver as the others can not approve it yet.
+func (d *PipelineRunApprovalDetails) GetPendingApprovers() []*Approver {
+	var pendingSteps []*ApprovalStep
+	for _, step := range d.ApprovalSteps {
+		if step.Status == constants.ApprovalStatusPending {
+			pendingSteps = append(pendingSteps, step)
+		}
+	}
+
+	sort.SliceStable(pendingSteps, func(i, j int) bool {
+		return pendingSteps[i].Order < pendingSteps[j].Order
+	})
+
+	if d.ExecutionOrder == constants.ApprovalExecutionOrderSequence && len(pendingSteps) > 1 {
+		pendingSteps = pendingSteps[:1]
+	}
+
+	approvers := make([]*Approver, 0, len(pendingSteps))
+	for _, step := range pendingSteps {
+		approvers = append(approvers, &step.AssignedApprover)
+	}
+
+	return approvers
+}
@@ -0,0 +1,53 @@
+package serializers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+// PipelineFilters are the filters of a build completed or run state changed subscription which notify the user who triggered a failed build.
+// The user is mentioned in the notification if they are a member of its channel, and sent a direct message otherwise.
+type PipelineFilters struct {
+	NotifyRequester bool `json:"notifyRequester,omitempty"`
+}
+
+// ParsePipelineFilterArguments parses arguments of the form "notify=requester" into pipeline filters.
+func ParsePipelineFilterArguments(args []string) (*PipelineFilters, error) {
+	filters := &PipelineFilters{}
+	for _, arg := range args {
+		key, value, found := cut(arg, constants.FilterArgumentSeparator)
+		if !found || key != constants.PipelineFilterArgumentNotify || strings.TrimSpace(value) != constants.PipelineFilterNotifyRequester {
+			return nil, fmt.Errorf(constants.InvalidPipelineFilterArgument, arg)
+		}
+
+		filters.NotifyRequester = true
+	}
+
+	return filters, nil
+}
+
+// IsEmpty returns true if none of the filters are set
+func (f *PipelineFilters) IsEmpty() bool {
+	return f == nil || !f.NotifyRequester
+}
+
+// IsValid validates the filters for a subscription of the event type
+func (f *PipelineFilters) IsValid(eventType string) error {
+	if !f.IsEmpty() && eventType != constants.SubscriptionEventBuildCompleted && eventType != constants.SubscriptionEventRunStateChanged {
+		return errors.New(constants.PipelineFiltersNotSupported)
+	}
+
+	return nil
+}
+
+// String returns the filters in the form they are shown in the subscription lists
+func (f *PipelineFilters) String() string {
+	if f.IsEmpty() {
+		return ""
+	}
+
+	return "requester of failed builds notified"
+}
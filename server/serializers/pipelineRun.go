@@ -0,0 +1,251 @@
+package serializers
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+type Pipeline struct {
+	ID     int           `json:"id"`
+	Name   string        `json:"name"`
+	Folder string        `json:"folder"`
+	Links  PipelineLinks `json:"_links"`
+}
+
+type PipelineLinks struct {
+	Web Href `json:"web"`
+}
+
+type PipelineList struct {
+	Count int         `json:"count"`
+	Value []*Pipeline `json:"value"`
+}
+
+// PipelineRunResources are the resources of a pipeline run e.g. the branch of the repository of the pipeline
+type PipelineRunResources struct {
+	Repositories map[string]*PipelineRepositoryResource `json:"repositories,omitempty"`
+}
+
+type PipelineRepositoryResource struct {
+	RefName string `json:"refName"`
+}
+
+type PipelineVariable struct {
+	Value string `json:"value"`
+}
+
+type RunPipelineRequest struct {
+	PreviewRun         bool                         `json:"previewRun,omitempty"`
+	Resources          *PipelineRunResources        `json:"resources,omitempty"`
+	TemplateParameters map[string]string            `json:"templateParameters,omitempty"`
+	Variables          map[string]*PipelineVariable `json:"variables,omitempty"`
+}
+
+type PipelineRun struct {
+	ID        int                   `json:"id"`
+	Name      string                `json:"name"`
+	State     string                `json:"state"`
+	Links     PipelineLinks         `json:"_links"`
+	Pipeline  *Pipeline             `json:"pipeline"`
+	Resources *PipelineRunResources `json:"resources"`
+}
+
+// PipelinePreview is the YAML of a pipeline with its templates expanded, returned by a preview run
+type PipelinePreview struct {
+	FinalYAML string `json:"finalYaml"`
+}
+
+type UpdateBuildRequest struct {
+	Status string `json:"status"`
+}
+
+type UpdateBuildStageRequest struct {
+	ForceRetryAllJobs bool   `json:"forceRetryAllJobs"`
+	State             string `json:"state"`
+}
+
+// BuildTimeline holds the stages, jobs and tasks of a build as records linked to their parents
+type BuildTimeline struct {
+	Records []*TimelineRecord `json:"records"`
+}
+
+type TimelineRecord struct {
+	ID         string             `json:"id"`
+	ParentID   string             `json:"parentId"`
+	Type       string             `json:"type"`
+	Name       string             `json:"name"`
+	Identifier string             `json:"identifier"`
+	State      string             `json:"state"`
+	Result     string             `json:"result"`
+	Order      int                `json:"order"`
+	Log        *TimelineRecordLog `json:"log"`
+	Issues     []*TimelineIssue   `json:"issues"`
+}
+
+type TimelineRecordLog struct {
+	ID int `json:"id"`
+}
+
+type TimelineIssue struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+type BuildLog struct {
+	ID        int `json:"id"`
+	LineCount int `json:"lineCount"`
+}
+
+type BuildLogList struct {
+	Count int         `json:"count"`
+	Value []*BuildLog `json:"value"`
+}
+
+type BuildLogLines struct {
+	Count int      `json:"count"`
+	Value []string `json:"value"`
+}
+
+type TestRun struct {
+	ID            int                 `json:"id"`
+	Name          string              `json:"name"`
+	TotalTests    int                 `json:"totalTests"`
+	RunStatistics []*TestRunStatistic `json:"runStatistics"`
+}
+
+// TestRunStatistic is the count of the results of a test run with the same outcome and metadata e.g. the flaky tests
+type TestRunStatistic struct {
+	State          string `json:"state"`
+	Outcome        string `json:"outcome"`
+	Count          int    `json:"count"`
+	ResultMetadata string `json:"resultMetadata"`
+}
+
+type TestRunList struct {
+	Count int        `json:"count"`
+	Value []*TestRun `json:"value"`
+}
+
+type TestResult struct {
+	ID                int    `json:"id"`
+	TestCaseTitle     string `json:"testCaseTitle"`
+	AutomatedTestName string `json:"automatedTestName"`
+	Outcome           string `json:"outcome"`
+}
+
+type TestResultList struct {
+	Count int           `json:"count"`
+	Value []*TestResult `json:"value"`
+}
+
+// PipelineParameter is a runtime parameter declared in the YAML of a pipeline
+type PipelineParameter struct {
+	Name        string        `yaml:"name"`
+	DisplayName string        `yaml:"displayName"`
+	Type        string        `yaml:"type"`
+	Default     interface{}   `yaml:"default"`
+	Values      []interface{} `yaml:"values"`
+}
+
+// ParsePipelineParameters returns the runtime parameters declared at the top of the YAML of a pipeline
+func ParsePipelineParameters(pipelineYAML string) ([]*PipelineParameter, error) {
+	pipeline := struct {
+		Parameters []*PipelineParameter `yaml:"parameters"`
+	}{}
+	if err := yaml.Unmarshal([]byte(pipelineYAML), &pipeline); err != nil {
+		return nil, err
+	}
+
+	return pipeline.Parameters, nil
+}
+
+// GetDefault returns the default value of the parameter as it is set in the template parameters of a run
+func (p *PipelineParameter) GetDefault() string {
+	if p.Default == nil {
+		return ""
+	}
+
+	return fmt.Sprint(p.Default)
+}
+
+// GetValues returns the allowed values of the parameter, if any
+func (p *PipelineParameter) GetValues() []string {
+	values := make([]string, 0, len(p.Values))
+	for _, value := range p.Values {
+		values = append(values, fmt.Sprint(value))
+	}
+
+	return values
+}
+
+// GetDisplayName returns the display name of the parameter or its name if the display name is not set
+func (p *PipelineParameter) GetDisplayName() string {
+	if p.DisplayName != "" {
+		return p.DisplayName
+	}
+
+	return p.Name
+}
+
+// GetBranchName returns the branch of the repository of the pipeline the run is queued for
+func (r *PipelineRun) GetBranchName() string {
+	if r.Resources == nil || r.Resources.Repositories[constants.PipelineRepositorySelf] == nil {
+		return ""
+	}
+
+	return strings.TrimPrefix(r.Resources.Repositories[constants.PipelineRepositorySelf].RefName, constants.RefNamePrefixBranch)
+}
+
+// GetState returns the result of the build once it is completed and its status otherwise
+func (b *BuildDetails) GetState() string {
+	if b.Status == constants.BuildStatusCompleted && b.Result != "" {
+		return b.Result
+	}
+
+	return b.Status
+}
+
+// GetRequester returns the identity of the user the build was requested for, or of the user who requested it if it is not present
+func (b *BuildDetails) GetRequester() *UserID {
+	if b.RequestedFor.ID != "" || b.RequestedFor.UniqueName != "" {
+		return b.RequestedFor.GetIdentity()
+	}
+
+	return b.RequestedBy.GetIdentity()
+}
+
+// GetFirstError returns the message of the first error issue of the record, if any
+func (r *TimelineRecord) GetFirstError() string {
+	for _, issue := range r.Issues {
+		if issue.Type == constants.TimelineIssueTypeError {
+			return issue.Message
+		}
+	}
+
+	return ""
+}
+
+// GetCount returns the number of results of the test run with the given outcome and, if it is not empty, the given metadata
+func (r *TestRun) GetCount(outcome, resultMetadata string) int {
+	count := 0
+	for _, statistic := range r.RunStatistics {
+		if (outcome == "" || statistic.Outcome == outcome) && (resultMetadata == "" || statistic.ResultMetadata == resultMetadata) {
+			count += statistic.Count
+		}
+	}
+
+	return count
+}
+
+// GetName returns the title of the test case or the name of the automated test if the title is not set
+func (r *TestResult) GetName() string {
+	if r.TestCaseTitle != "" {
+		return r.TestCaseTitle
+	}
+
+	return r.AutomatedTestName
+}
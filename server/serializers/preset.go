@@ -0,0 +1,173 @@
+package serializers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+// WorkItemPreset is a named set of work item field values stored per channel
+type WorkItemPreset struct {
+	Name             string `json:"name"`
+	ChannelID        string `json:"channelID"`
+	OrganizationName string `json:"organizationName"`
+	ProjectName      string `json:"projectName"`
+	Type             string `json:"type"`
+	// Fields holds the values of the fields keyed by the field reference name e.g. "System.AreaPath"
+	Fields    map[string]string `json:"fields"`
+	CreatedBy string            `json:"createdBy"`
+}
+
+type WorkItemTemplateReference struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	WorkItemTypeName string `json:"workItemTypeName"`
+}
+
+type WorkItemTemplateList struct {
+	Count int                          `json:"count"`
+	Value []*WorkItemTemplateReference `json:"value"`
+}
+
+type WorkItemTemplate struct {
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description"`
+	WorkItemTypeName string            `json:"workItemTypeName"`
+	Fields           map[string]string `json:"fields"`
+}
+
+// presetArgumentFields maps the short preset argument keys to the field reference names
+var presetArgumentFields = map[string]string{
+	constants.PresetArgumentArea:      constants.FieldReferenceNameAreaPath,
+	constants.PresetArgumentIteration: constants.FieldReferenceNameIteration,
+	constants.PresetArgumentTags:      constants.FieldReferenceNameTags,
+	constants.PresetArgumentAssignee:  constants.FieldReferenceNameAssignedTo,
+	constants.PresetArgumentPriority:  constants.FieldReferenceNamePriority,
+}
+
+// ParsePresetArguments parses arguments of the form "key=value" into a map keyed by the argument key.
+// Keys other than the short preset argument keys are expected to be field reference names and are kept as they are.
+func ParsePresetArguments(args []string) (map[string]string, error) {
+	arguments := make(map[string]string, len(args))
+	for _, arg := range args {
+		key, value, found := cut(arg, constants.PresetArgumentSeparator)
+		if !found || key == "" || value == "" {
+			return nil, fmt.Errorf(constants.PresetInvalidArgument, arg)
+		}
+
+		arguments[key] = value
+	}
+
+	return arguments, nil
+}
+
+// SetFieldsFromArguments sets the work item type and the field values of the preset from the parsed preset arguments.
+// The organization, project, team and template arguments are ignored as they are not field values.
+func (p *WorkItemPreset) SetFieldsFromArguments(arguments map[string]string) {
+	if p.Fields == nil {
+		p.Fields = map[string]string{}
+	}
+
+	for key, value := range arguments {
+		switch key {
+		case constants.PresetArgumentType:
+			p.Type = value
+		case constants.PresetArgumentOrganization, constants.PresetArgumentProject, constants.PresetArgumentTeam, constants.PresetArgumentTemplate:
+			continue
+		case constants.PresetArgumentTags:
+			tags := strings.Split(value, ",")
+			for index, tag := range tags {
+				tags[index] = strings.TrimSpace(tag)
+			}
+			p.Fields[constants.FieldReferenceNameTags] = strings.Join(tags, constants.FieldTagsSeparator)
+		default:
+			if referenceName, ok := presetArgumentFields[key]; ok {
+				key = referenceName
+			}
+			p.Fields[key] = value
+		}
+	}
+}
+
+// IsValid function to validate the preset.
+func (p *WorkItemPreset) IsValid() error {
+	if p.Name == "" {
+		return errors.New(constants.PresetNameRequired)
+	}
+	if p.ChannelID == "" {
+		return errors.New(constants.ChannelIDRequired)
+	}
+	if p.OrganizationName == "" {
+		return errors.New(constants.OrganizationRequired)
+	}
+	if p.ProjectName == "" {
+		return errors.New(constants.ProjectRequired)
+	}
+	if p.Type == "" {
+		return errors.New(constants.PresetTypeRequired)
+	}
+	return nil
+}
+
+// ValidateFields validates the field values of the preset against the field definitions of the work item type.
+// Unlike the validation of the create payload, required fields are not checked as the title is only provided while creating a work item.
+func (p *WorkItemPreset) ValidateFields(definitions []*WorkItemFieldDefinition) error {
+	fieldValues := make(map[string]interface{}, len(p.Fields))
+	for referenceName, value := range p.Fields {
+		fieldValues[referenceName] = value
+	}
+
+	return validateFieldValues(fieldValues, definitions, p.Type)
+}
+
+// ToCreateTaskRequestPayload creates the payload for creating a work item with the given title from the preset
+func (p *WorkItemPreset) ToCreateTaskRequestPayload(title string) *CreateTaskRequestPayload {
+	payload := &CreateTaskRequestPayload{
+		Organization: p.OrganizationName,
+		Project:      p.ProjectName,
+		Type:         p.Type,
+		Fields: CreateTaskFieldValue{
+			Title:            title,
+			AdditionalFields: map[string]interface{}{},
+		},
+	}
+
+	for referenceName, value := range p.Fields {
+		switch referenceName {
+		case constants.FieldReferenceNameTitle:
+			continue
+		case constants.FieldReferenceNameDescription:
+			payload.Fields.Description = value
+		case constants.FieldReferenceNameAreaPath:
+			payload.Fields.AreaPath = value
+		default:
+			payload.Fields.AdditionalFields[referenceName] = value
+		}
+	}
+
+	return payload
+}
+
+// ToCommandResponse returns a markdown summary of the preset
+func (p *WorkItemPreset) ToCommandResponse() string {
+	fields := make([]string, 0, len(p.Fields))
+	for referenceName, value := range p.Fields {
+		fields = append(fields, fmt.Sprintf("%s=%s", referenceName, value))
+	}
+	sort.Strings(fields)
+
+	return fmt.Sprintf("| %s | %s/%s | %s | %s | @%s |\n", p.Name, p.OrganizationName, p.ProjectName, p.Type, strings.Join(fields, ", "), p.CreatedBy)
+}
+
+// cut slices s around the first instance of sep, this can be replaced by strings.Cut once the Go version is updated
+func cut(s, sep string) (before, after string, found bool) {
+	if index := strings.Index(s, sep); index >= 0 {
+		return s[:index], s[index+len(sep):], true
+	}
+	return s, "", false
+}
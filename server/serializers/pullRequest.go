@@ -0,0 +1,164 @@
+package serializers
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+type PullRequestVoteRequest struct {
+	Vote int `json:"vote"`
+}
+
+type PullRequestCompletionOptions struct {
+	MergeStrategy       string `json:"mergeStrategy,omitempty"`
+	DeleteSourceBranch  bool   `json:"deleteSourceBranch"`
+	TransitionWorkItems bool   `json:"transitionWorkItems"`
+}
+
+type PullRequestCommitRef struct {
+	CommitID string `json:"commitId"`
+}
+
+type PullRequestIdentityRef struct {
+	ID string `json:"id"`
+}
+
+type UpdatePullRequestRequest struct {
+	Status                string                        `json:"status,omitempty"`
+	LastMergeSourceCommit *PullRequestCommitRef         `json:"lastMergeSourceCommit,omitempty"`
+	CompletionOptions     *PullRequestCompletionOptions `json:"completionOptions,omitempty"`
+	AutoCompleteSetBy     *PullRequestIdentityRef       `json:"autoCompleteSetBy,omitempty"`
+}
+
+type PullRequestWorkItemRef struct {
+	ID string `json:"id"`
+}
+
+type CreatePullRequestRequest struct {
+	SourceRefName string                    `json:"sourceRefName"`
+	TargetRefName string                    `json:"targetRefName"`
+	Title         string                    `json:"title"`
+	Description   string                    `json:"description,omitempty"`
+	IsDraft       bool                      `json:"isDraft"`
+	Reviewers     []*PullRequestIdentityRef `json:"reviewers,omitempty"`
+	WorkItemRefs  []*PullRequestWorkItemRef `json:"workItemRefs,omitempty"`
+}
+
+type PullRequestList struct {
+	Count int            `json:"count"`
+	Value []*PullRequest `json:"value"`
+}
+
+// PullRequestSearchCriteria filters the active pull requests by their creator or one of their reviewers
+type PullRequestSearchCriteria struct {
+	CreatorID  string
+	ReviewerID string
+}
+
+// ToQueryParams returns the search criteria as the query params of the Azure DevOps API e.g. "&searchCriteria.creatorId=..."
+func (c *PullRequestSearchCriteria) ToQueryParams() string {
+	if c == nil {
+		return ""
+	}
+
+	queryParams := ""
+	if c.CreatorID != "" {
+		queryParams += "&searchCriteria.creatorId=" + url.QueryEscape(c.CreatorID)
+	}
+	if c.ReviewerID != "" {
+		queryParams += "&searchCriteria.reviewerId=" + url.QueryEscape(c.ReviewerID)
+	}
+
+	return queryParams
+}
+
+// PullRequestCounts are the numbers of active pull requests of a user across their linked projects
+type PullRequestCounts struct {
+	WaitingForReview int `json:"waitingForReview"`
+	Review           int `json:"review"`
+	Mine             int `json:"mine"`
+}
+
+type PolicyEvaluationList struct {
+	Value []*PolicyEvaluation `json:"value"`
+}
+
+type PolicyEvaluation struct {
+	Status        string              `json:"status"`
+	Configuration PolicyConfiguration `json:"configuration"`
+}
+
+type PolicyConfiguration struct {
+	IsEnabled  bool       `json:"isEnabled"`
+	IsBlocking bool       `json:"isBlocking"`
+	Type       PolicyType `json:"type"`
+}
+
+type PolicyType struct {
+	DisplayName string `json:"displayName"`
+}
+
+// IsBlocking returns true if the policy prevents the completion of the pull request
+func (e *PolicyEvaluation) IsBlocking() bool {
+	if !e.Configuration.IsEnabled || !e.Configuration.IsBlocking {
+		return false
+	}
+
+	return e.Status != constants.PolicyEvaluationStatusApproved && e.Status != constants.PolicyEvaluationStatusNotApplicable
+}
+
+// ParsePullRequestCompletionOptions parses the flags of the "repos pr complete" and "repos pr autocomplete" commands
+// e.g. "--merge-strategy squash --delete-source-branch --transition-work-items"
+func ParsePullRequestCompletionOptions(args []string) (*PullRequestCompletionOptions, error) {
+	options := &PullRequestCompletionOptions{}
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case constants.FlagDeleteSourceBranch:
+			options.DeleteSourceBranch = true
+		case constants.FlagTransitionWorkItems:
+			options.TransitionWorkItems = true
+		case constants.FlagMergeStrategy:
+			if i+1 >= len(args) {
+				return nil, errors.New(constants.MergeStrategyRequired)
+			}
+			i++
+
+			if !constants.PullRequestMergeStrategies[args[i]] {
+				return nil, fmt.Errorf(constants.InvalidMergeStrategy, args[i])
+			}
+			options.MergeStrategy = args[i]
+		default:
+			return nil, fmt.Errorf(constants.InvalidPullRequestCompletionOption, args[i])
+		}
+	}
+
+	return options, nil
+}
+
+type PullRequestCommentThread struct {
+	ID     int    `json:"id"`
+	Status string `json:"status"`
+}
+
+type PullRequestThreadCommentRequest struct {
+	ParentCommentID int    `json:"parentCommentId"`
+	Content         string `json:"content"`
+	CommentType     int    `json:"commentType"`
+}
+
+type PullRequestThreadStatusRequest struct {
+	Status string `json:"status"`
+}
+
+// PullRequestThreadReference identifies the pull request thread of a comment notification, it is stored in the props of the notification post
+type PullRequestThreadReference struct {
+	Organization  string
+	ProjectID     string
+	RepositoryID  string
+	PullRequestID int
+	ThreadID      int
+	CommentID     int
+}
@@ -0,0 +1,213 @@
+package serializers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+// reminderWeekdays are the days of the week in the order of time.Weekday
+var reminderWeekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// ReviewReminder is a schedule to list the active pull requests waiting for review in a channel
+type ReviewReminder struct {
+	ID               string `json:"id"`
+	ChannelID        string `json:"channelID"`
+	OrganizationName string `json:"organizationName"`
+	ProjectName      string `json:"projectName"`
+	Repository       string `json:"repository"`
+	// Days are the short names of the days of the week e.g. "mon"
+	Days []string `json:"days"`
+	// Time is the time of the day in the timezone of the reminder e.g. "09:30"
+	Time     string `json:"time"`
+	Timezone string `json:"timezone"`
+	// MinAge is the minimum age of the pull requests to be listed e.g. "24h"
+	MinAge    string `json:"minAge"`
+	CreatedBy string `json:"createdBy"`
+}
+
+// ParseReviewReminderArguments parses the arguments of the "repos reminders add" command
+// e.g. ["weekdays", "09:30", "repo=web", "min-age=24h"]. The organization and project arguments are returned to select the linked project.
+func ParseReviewReminderArguments(args []string) (*ReviewReminder, map[string]string, error) {
+	if len(args) < 2 {
+		return nil, nil, errors.New(constants.ReviewReminderUsage)
+	}
+
+	days, err := parseReminderDays(args[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err = time.Parse(constants.ReminderTimeLayout, args[1]); err != nil {
+		return nil, nil, fmt.Errorf(constants.InvalidReminderTime, args[1])
+	}
+
+	reminder := &ReviewReminder{
+		Days: days,
+		Time: args[1],
+	}
+
+	projectArguments := map[string]string{}
+	for _, arg := range args[2:] {
+		key, value, found := cut(arg, constants.PresetArgumentSeparator)
+		if !found || value == "" {
+			return nil, nil, fmt.Errorf(constants.InvalidReminderArgument, arg)
+		}
+
+		switch key {
+		case constants.ReminderArgumentRepository:
+			reminder.Repository = value
+		case constants.ReminderArgumentMinAge:
+			if _, err = ParseReminderMinAge(value); err != nil {
+				return nil, nil, err
+			}
+			reminder.MinAge = value
+		case constants.PresetArgumentOrganization, constants.PresetArgumentProject:
+			projectArguments[key] = value
+		default:
+			return nil, nil, fmt.Errorf(constants.InvalidReminderArgument, arg)
+		}
+	}
+
+	return reminder, projectArguments, nil
+}
+
+func parseReminderDays(value string) ([]string, error) {
+	switch strings.ToLower(value) {
+	case constants.ReminderDaysDaily:
+		return append([]string{}, reminderWeekdays...), nil
+	case constants.ReminderDaysWeekdays:
+		return append([]string{}, reminderWeekdays[1:6]...), nil
+	}
+
+	isSelected := map[string]bool{}
+	for _, day := range strings.Split(strings.ToLower(value), ",") {
+		day = strings.TrimSpace(day)
+		if len(day) > 3 {
+			day = day[:3]
+		}
+
+		if getWeekday(day) < 0 {
+			return nil, fmt.Errorf(constants.InvalidReminderDays, value)
+		}
+		isSelected[day] = true
+	}
+
+	// The days are stored in the order of the week irrespective of the order they are provided in
+	var days []string
+	for _, day := range reminderWeekdays {
+		if isSelected[day] {
+			days = append(days, day)
+		}
+	}
+
+	return days, nil
+}
+
+func getWeekday(day string) time.Weekday {
+	for weekday, name := range reminderWeekdays {
+		if name == day {
+			return time.Weekday(weekday)
+		}
+	}
+
+	return -1
+}
+
+// ParseReminderMinAge parses a duration such as "30m" or "24h", days are supported as well e.g. "3d"
+func ParseReminderMinAge(value string) (time.Duration, error) {
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err != nil || days < 0 {
+			return 0, fmt.Errorf(constants.InvalidReminderMinAge, value)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+
+	minAge, err := time.ParseDuration(value)
+	if err != nil || minAge < 0 {
+		return 0, fmt.Errorf(constants.InvalidReminderMinAge, value)
+	}
+
+	return minAge, nil
+}
+
+// GetMinAge returns the minimum age of the pull requests to be listed, pull requests of any age are listed if it is not set
+func (r *ReviewReminder) GetMinAge() time.Duration {
+	if r.MinAge == "" {
+		return 0
+	}
+
+	minAge, err := ParseReminderMinAge(r.MinAge)
+	if err != nil {
+		return 0
+	}
+
+	return minAge
+}
+
+// GetLocation returns the timezone of the reminder, UTC is used if the timezone is not set or is unknown
+func (r *ReviewReminder) GetLocation() *time.Location {
+	if r.Timezone == "" {
+		return time.UTC
+	}
+
+	location, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// GetDueOccurrence returns the date of the occurrence of the reminder which is due at the given time, or an empty string if none is due.
+// An occurrence is due from its scheduled time till the end of constants.ReviewReminderWindow.
+func (r *ReviewReminder) GetDueOccurrence(now time.Time) string {
+	scheduledTime, err := time.Parse(constants.ReminderTimeLayout, r.Time)
+	if err != nil {
+		return ""
+	}
+
+	localNow := now.In(r.GetLocation())
+	// The occurrence of the previous day can still be due shortly after midnight
+	for _, day := range []time.Time{localNow, localNow.AddDate(0, 0, -1)} {
+		scheduledAt := time.Date(day.Year(), day.Month(), day.Day(), scheduledTime.Hour(), scheduledTime.Minute(), 0, 0, day.Location())
+		if !r.isScheduledOn(scheduledAt.Weekday()) {
+			continue
+		}
+
+		if !localNow.Before(scheduledAt) && localNow.Before(scheduledAt.Add(constants.ReviewReminderWindow)) {
+			return scheduledAt.Format(constants.ReminderOccurrenceLayout)
+		}
+	}
+
+	return ""
+}
+
+func (r *ReviewReminder) isScheduledOn(weekday time.Weekday) bool {
+	for _, day := range r.Days {
+		if getWeekday(day) == weekday {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetScheduleString returns the days and time of the reminder e.g. "on mon, tue at 09:30 (Europe/Berlin)"
+func (r *ReviewReminder) GetScheduleString() string {
+	return fmt.Sprintf("on %s at %s (%s)", strings.Join(r.Days, ", "), r.Time, r.GetLocation().String())
+}
+
+// GetScopeString returns the project and the repository of the pull requests to be listed e.g. "Project / Repo"
+func (r *ReviewReminder) GetScopeString() string {
+	if r.Repository == "" {
+		return r.ProjectName
+	}
+
+	return fmt.Sprintf("%s / %s", r.ProjectName, r.Repository)
+}
@@ -0,0 +1,119 @@
+package serializers
+
+import (
+	"time"
+)
+
+type Iteration struct {
+	ID         string              `json:"id"`
+	Name       string              `json:"name"`
+	Path       string              `json:"path"`
+	Attributes IterationAttributes `json:"attributes"`
+}
+
+type IterationAttributes struct {
+	StartDate  *time.Time `json:"startDate"`
+	FinishDate *time.Time `json:"finishDate"`
+	TimeFrame  string     `json:"timeFrame"`
+}
+
+type IterationList struct {
+	Count int          `json:"count"`
+	Value []*Iteration `json:"value"`
+}
+
+// IterationWorkItems contains the backlog items of an iteration along with their children
+type IterationWorkItems struct {
+	WorkItemRelations []*IterationWorkItemRelation `json:"workItemRelations"`
+}
+
+type IterationWorkItemRelation struct {
+	Rel    string             `json:"rel"`
+	Source *WorkItemReference `json:"source"`
+	Target *WorkItemReference `json:"target"`
+}
+
+type WorkItemReference struct {
+	ID  int    `json:"id"`
+	URL string `json:"url"`
+}
+
+type IterationCapacity struct {
+	TeamMembers         []*TeamMemberCapacity `json:"teamMembers"`
+	TotalCapacityPerDay float64               `json:"totalCapacityPerDay"`
+}
+
+type TeamMemberCapacity struct {
+	TeamMember TaskUserDetails     `json:"teamMember"`
+	Activities []*ActivityCapacity `json:"activities"`
+	DaysOff    []*DateRange        `json:"daysOff"`
+}
+
+type ActivityCapacity struct {
+	Name           string  `json:"name"`
+	CapacityPerDay float64 `json:"capacityPerDay"`
+}
+
+type DateRange struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+type TeamSettings struct {
+	WorkingDays []string `json:"workingDays"`
+}
+
+// SprintSummary summarises the work items, remaining work and capacity of the current iteration of a team
+type SprintSummary struct {
+	Organization         string                 `json:"organization"`
+	Project              string                 `json:"project"`
+	Team                 string                 `json:"team"`
+	Iteration            *SprintIteration       `json:"iteration"`
+	WorkItemCount        int                    `json:"workItemCount"`
+	RemainingWork        float64                `json:"remainingWork"`
+	Capacity             float64                `json:"capacity"`
+	RemainingWorkingDays int                    `json:"remainingWorkingDays"`
+	States               []*SprintStateSummary  `json:"states"`
+	Members              []*SprintMemberSummary `json:"members"`
+	StaleDays            int                    `json:"staleDays"`
+	StaleWorkItems       []*SprintWorkItem      `json:"staleWorkItems"`
+}
+
+type SprintIteration struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Path       string     `json:"path"`
+	StartDate  *time.Time `json:"startDate"`
+	FinishDate *time.Time `json:"finishDate"`
+}
+
+type SprintStateSummary struct {
+	State         string  `json:"state"`
+	WorkItemCount int     `json:"workItemCount"`
+	RemainingWork float64 `json:"remainingWork"`
+}
+
+// SprintMemberSummary compares the capacity of a team member for the rest of the iteration with the remaining work assigned to them
+type SprintMemberSummary struct {
+	DisplayName   string  `json:"displayName"`
+	UniqueName    string  `json:"uniqueName"`
+	Capacity      float64 `json:"capacity"`
+	AssignedWork  float64 `json:"assignedWork"`
+	WorkItemCount int     `json:"workItemCount"`
+}
+
+type SprintWorkItem struct {
+	ID              int       `json:"id"`
+	Title           string    `json:"title"`
+	Type            string    `json:"type"`
+	State           string    `json:"state"`
+	AssignedTo      string    `json:"assignedTo"`
+	URL             string    `json:"url"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+	DaysSinceUpdate int       `json:"daysSinceUpdate"`
+}
+
+// IsOverallocated checks if the remaining work assigned to the member exceeds their capacity
+func (m *SprintMemberSummary) IsOverallocated() bool {
+	return m.AssignedWork > m.Capacity
+}
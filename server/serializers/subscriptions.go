@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
@@ -13,6 +15,7 @@ type UserID struct {
 	ID          string `json:"id"`
 	DisplayName string `json:"displayName"`
 	UniqueName  string `json:"uniqueName"`
+	Descriptor  string `json:"descriptor"`
 }
 
 type PublisherInputsGeneric struct {
@@ -107,6 +110,8 @@ type CreateSubscriptionRequestPayload struct {
 	RunStateID                       string `json:"runStateId"`
 	RunStateIDName                   string `json:"runStateIdName"`
 	RunResultID                      string `json:"runResultId"`
+	// Filters evaluated by the plugin on the notifications of work item subscriptions
+	WorkItemFilters *WorkItemFilters `json:"workItemFilters,omitempty"`
 }
 
 type GetSubscriptionFilterPossibleValuesRequestPayload struct {
@@ -204,6 +209,12 @@ type SubscriptionDetails struct {
 	RunStateID                       string `json:"runStateId"`
 	RunStateIDName                   string `json:"runStateIdName"`
 	RunResultID                      string `json:"runResultId"`
+	// Filters evaluated by the plugin on the notifications of work item subscriptions
+	WorkItemFilters *WorkItemFilters `json:"workItemFilters,omitempty"`
+	// Filters collapsing the commits in the notifications of code pushed subscriptions
+	CodePushFilters *CodePushFilters `json:"codePushFilters,omitempty"`
+	// Filters notifying the user who triggered a failed build of build completed and run state changed subscriptions
+	PipelineFilters *PipelineFilters `json:"pipelineFilters,omitempty"`
 }
 
 type DetailedMessage struct {
@@ -211,6 +222,8 @@ type DetailedMessage struct {
 }
 
 type SubscriptionNotification struct {
+	// ID of the event, it is the same for the notifications of the event sent to different subscriptions
+	ID              string          `json:"id"`
 	SubscriptionID  string          `json:"subscriptionID"`
 	DetailedMessage DetailedMessage `json:"detailedMessage"`
 	Message         DetailedMessage `json:"message"`
@@ -224,6 +237,9 @@ type Approval struct {
 	Steps                []*ApprovalStep `json:"steps"`
 	MinRequiredApprovers int             `json:"minRequiredApprovers"`
 	ExecutionOrder       string          `json:"executionOrder"`
+	CreatedOn            string          `json:"createdOn"`
+	// Reference to the configuration of the approval check, only its ID is present
+	CheckConfiguration CheckConfiguration `json:"checkConfiguration"`
 }
 
 type ApprovalStep struct {
@@ -237,14 +253,23 @@ type ApprovalStep struct {
 type Approver struct {
 	DisplayName string `json:"displayName"`
 	ID          string `json:"id"`
+	UniqueName  string `json:"uniqueName"`
+}
+
+func (a *Approver) GetIdentity() *UserID {
+	return &UserID{ID: a.ID, DisplayName: a.DisplayName, UniqueName: a.UniqueName}
 }
 
 type Resource struct {
+	ID            interface{}  `json:"id"`
+	URL           string       `json:"url"`
 	PullRequestID int          `json:"pullRequestId"`
 	Reviewers     []Reviewer   `json:"reviewers"`
 	SourceRefName string       `json:"sourceRefName"`
 	TargetRefName string       `json:"targetRefName"`
 	MergeStatus   string       `json:"mergeStatus"`
+	Status        string       `json:"status"`
+	Result        string       `json:"result"`
 	Title         string       `json:"title"`
 	Description   string       `json:"description"`
 	Repository    Repository   `json:"repository"`
@@ -268,11 +293,32 @@ type Resource struct {
 	ProjectID     string       `json:"projectId"`
 	Fields        Fields       `json:"fields"`
 	Revision      Revision     `json:"revision"`
+	CreatedBy     UserID       `json:"createdBy"`
+	RevisedBy     UserID       `json:"revisedBy"`
+}
+
+// GetComment returns the comment of a pull request commented event
+func (r *Resource) GetComment() (*Comment, error) {
+	// Convert map to json string
+	jsonBytes, err := json.Marshal(r.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert json string to struct
+	comment := &Comment{}
+	if err := json.Unmarshal(jsonBytes, comment); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
 }
 
 type Stage struct {
-	Name  string      `json:"name"`
-	Links ProjectLink `json:"_links"`
+	Name   string      `json:"name"`
+	State  string      `json:"state"`
+	Result string      `json:"result"`
+	Links  ProjectLink `json:"_links"`
 }
 
 type Release struct {
@@ -291,7 +337,13 @@ type Artifact struct {
 }
 
 type RequestedFor struct {
-	Name string `json:"displayName"`
+	ID         string `json:"id"`
+	Name       string `json:"displayName"`
+	UniqueName string `json:"uniqueName"`
+}
+
+func (r *RequestedFor) GetIdentity() *UserID {
+	return &UserID{ID: r.ID, DisplayName: r.Name, UniqueName: r.UniqueName}
 }
 
 type Definition struct {
@@ -310,39 +362,131 @@ type Fields struct {
 	State        interface{} `json:"System.State"`
 	WorkItemType interface{} `json:"System.WorkItemType"`
 	Title        interface{} `json:"System.Title"`
+	Tags         interface{} `json:"System.Tags"`
+	Priority     interface{} `json:"Microsoft.VSTS.Common.Priority"`
+	AssignedTo   interface{} `json:"System.AssignedTo"`
+	History      interface{} `json:"System.History"`
+	ChangedBy    interface{} `json:"System.ChangedBy"`
+	// Changes holds the fields of a work item updated event which carry an old and a new value, keyed by their reference names
+	Changes map[string]*WorkItemFieldChange `json:"-"`
+}
+
+type WorkItemFieldChange struct {
+	OldValue interface{} `json:"oldValue"`
+	NewValue interface{} `json:"newValue"`
+}
+
+func (f *Fields) UnmarshalJSON(data []byte) error {
+	// The alias type prevents the recursive call of UnmarshalJSON
+	type fields Fields
+	if err := json.Unmarshal(data, (*fields)(f)); err != nil {
+		return err
+	}
+
+	var rawFields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawFields); err != nil {
+		return err
+	}
+
+	for name, rawValue := range rawFields {
+		var value map[string]interface{}
+		if err := json.Unmarshal(rawValue, &value); err != nil {
+			continue
+		}
+
+		oldValue, hasOldValue := value["oldValue"]
+		newValue, hasNewValue := value["newValue"]
+		if !hasOldValue && !hasNewValue {
+			continue
+		}
+
+		if f.Changes == nil {
+			f.Changes = make(map[string]*WorkItemFieldChange)
+		}
+		f.Changes[name] = &WorkItemFieldChange{OldValue: oldValue, NewValue: newValue}
+	}
+
+	return nil
 }
 
 type RefUpdates struct {
 	Name string `json:"name"`
+	// The object IDs are all zeros when the ref is created or deleted
+	OldObjectID string `json:"oldObjectId"`
+	NewObjectID string `json:"newObjectId"`
 }
 
 type Commit struct {
-	CommitID string `json:"commitId"`
-	Comment  string `json:"comment"`
-	URL      string `json:"url"`
+	CommitID string        `json:"commitId"`
+	Comment  string        `json:"comment"`
+	URL      string        `json:"url"`
+	Author   *CommitAuthor `json:"author"`
+}
+
+type CommitAuthor struct {
+	Name  string     `json:"name"`
+	Email string     `json:"email"`
+	Date  *time.Time `json:"date"`
 }
 
 type Repository struct {
-	Name string `json:"name"`
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Project   Project `json:"project"`
+	RemoteURL string  `json:"remoteUrl"`
 }
 
 type PullRequest struct {
-	PullRequestID int        `json:"pullRequestId"`
-	Reviewers     []Reviewer `json:"reviewers"`
-	SourceRefName string     `json:"sourceRefName"`
-	TargetRefName string     `json:"targetRefName"`
-	MergeStatus   string     `json:"mergeStatus"`
-	Title         string     `json:"title"`
-	Description   string     `json:"description"`
-	Repository    Repository `json:"repository"`
+	PullRequestID         int        `json:"pullRequestId"`
+	Reviewers             []Reviewer `json:"reviewers"`
+	SourceRefName         string     `json:"sourceRefName"`
+	TargetRefName         string     `json:"targetRefName"`
+	MergeStatus           string     `json:"mergeStatus"`
+	Status                string     `json:"status"`
+	Title                 string     `json:"title"`
+	Description           string     `json:"description"`
+	Repository            Repository `json:"repository"`
+	LastMergeSourceCommit *Commit    `json:"lastMergeSourceCommit"`
+	AutoCompleteSetBy     *Reviewer  `json:"autoCompleteSetBy"`
+	URL                   string     `json:"url"`
+	CreatedBy             *UserID    `json:"createdBy"`
+	CreationDate          *time.Time `json:"creationDate"`
+	IsDraft               bool       `json:"isDraft"`
 }
 
 type Comment struct {
-	Content string `json:"content"`
+	ID              int          `json:"id"`
+	ParentCommentID int          `json:"parentCommentId"`
+	Content         string       `json:"content"`
+	Author          UserID       `json:"author"`
+	Links           CommentLinks `json:"_links"`
+}
+
+type CommentLinks struct {
+	Threads Href `json:"threads"`
+}
+
+// GetThreadID returns the ID of the pull request thread of the comment from the link of the thread
+// e.g. "https://dev.azure.com/{organization}/{projectID}/_apis/git/repositories/{repositoryID}/pullRequests/{pullRequestID}/threads/{threadID}"
+func (c *Comment) GetThreadID() int {
+	urlPaths := strings.Split(c.Links.Threads.Href, "/")
+	threadID, err := strconv.Atoi(urlPaths[len(urlPaths)-1])
+	if err != nil {
+		return 0
+	}
+
+	return threadID
 }
 
 type Reviewer struct {
+	ID          string `json:"id"`
 	DisplayName string `json:"displayName"`
+	UniqueName  string `json:"uniqueName"`
+	Vote        int    `json:"vote"`
+}
+
+func (r *Reviewer) GetIdentity() *UserID {
+	return &UserID{ID: r.ID, DisplayName: r.DisplayName, UniqueName: r.UniqueName}
 }
 
 type DeleteSubscriptionRequestPayload struct {
@@ -382,6 +526,7 @@ type PipelineRunApprovalDetails struct {
 	Status               string          `json:"status"`
 	ApprovalSteps        []*ApprovalStep `json:"steps"`
 	MinRequiredApprovers int             `json:"minRequiredApprovers"`
+	ExecutionOrder       string          `json:"executionOrder"`
 }
 
 type PipelineRunApproveResponse struct {
@@ -408,18 +553,27 @@ type PipelineApprovalDetails struct {
 }
 
 type BuildDetails struct {
-	BuildNumber  string      `json:"buildNumber"`
-	SourceBranch string      `json:"sourceBranch"`
-	Repository   Repository  `json:"repository"`
-	Status       string      `json:"status"`
-	RequestedBy  RequestedBy `json:"requestedBy"`
-	Project      Project     `json:"project"`
-	Link         Link        `json:"_links"`
-	Definition   Definition  `json:"definition"`
+	ID           int          `json:"id"`
+	BuildNumber  string       `json:"buildNumber"`
+	SourceBranch string       `json:"sourceBranch"`
+	Repository   Repository   `json:"repository"`
+	Status       string       `json:"status"`
+	Result       string       `json:"result"`
+	RequestedBy  RequestedBy  `json:"requestedBy"`
+	RequestedFor RequestedFor `json:"requestedFor"`
+	Project      Project      `json:"project"`
+	Link         Link         `json:"_links"`
+	Definition   Definition   `json:"definition"`
 }
 
 type RequestedBy struct {
+	ID          string `json:"id"`
 	DisplayName string `json:"displayName"`
+	UniqueName  string `json:"uniqueName"`
+}
+
+func (r *RequestedBy) GetIdentity() *UserID {
+	return &UserID{ID: r.ID, DisplayName: r.DisplayName, UniqueName: r.UniqueName}
 }
 
 type ReleaseDetails struct {
@@ -497,7 +651,7 @@ func (t *CreateSubscriptionRequestPayload) IsSubscriptionRequestPayloadValid() e
 	if t.ChannelID == "" {
 		return errors.New(constants.ChannelIDRequired)
 	}
-	return nil
+	return t.WorkItemFilters.IsValid(t.EventType)
 }
 
 func (t *DeleteSubscriptionRequestPayload) IsSubscriptionRequestPayloadValid() error {
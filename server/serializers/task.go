@@ -3,12 +3,31 @@ package serializers
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"html"
 	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
 )
 
+var (
+	htmlTagRegex   = regexp.MustCompile(`<(/?)([a-zA-Z][a-zA-Z0-9]*)\b[^<>]*?(/?)>`)
+	htmlImageRegex = regexp.MustCompile(`(?i)<img\b`)
+
+	htmlVoidElements = map[string]bool{
+		"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+		"input": true, "link": true, "meta": true, "source": true, "track": true, "wbr": true,
+	}
+	htmlOptionalEndTagElements = map[string]bool{
+		"p": true, "li": true, "dt": true, "dd": true, "tr": true, "td": true, "th": true, "option": true,
+	}
+)
+
 // TODO: WIP.
 // type TaskIDList struct {
 // 	TaskList []TaskIDListValue `json:"workItems"`
@@ -18,15 +37,17 @@ import (
 // 	ID int `json:"id"`
 // }
 
-// type TaskList struct {
-// 	Count int         `json:"count"`
-// 	Tasks []TaskValue `json:"value"`
-// }
-
 type TaskValue struct {
-	ID     int            `json:"id"`
-	Fields TaskFieldValue `json:"fields"`
-	Link   Link           `json:"_links"`
+	ID        int                 `json:"id"`
+	URL       string              `json:"url"`
+	Fields    TaskFieldValue      `json:"fields"`
+	Link      Link                `json:"_links"`
+	Relations []*WorkItemRelation `json:"relations"`
+}
+
+type TaskList struct {
+	Count int          `json:"count"`
+	Value []*TaskValue `json:"value"`
 }
 
 type TaskFieldValue struct {
@@ -41,6 +62,10 @@ type TaskFieldValue struct {
 	UpdatedAt   time.Time       `json:"System.ChangedDate"`
 	UpdatedBy   TaskUserDetails `json:"System.ChangedBy"`
 	Description string          `json:"System.Description"`
+	AreaPath    string          `json:"System.AreaPath"`
+	Iteration   string          `json:"System.IterationPath"`
+	// RemainingWork is only present for the work item types which track it e.g. tasks
+	RemainingWork float64 `json:"Microsoft.VSTS.Scheduling.RemainingWork"`
 }
 
 type Link struct {
@@ -58,24 +83,87 @@ type TaskUserDetails struct {
 	UniqueName  string `json:"uniqueName"`
 }
 
+func (t *TaskUserDetails) GetIdentity() *UserID {
+	return &UserID{ID: t.ID, DisplayName: t.DisplayName, UniqueName: t.UniqueName}
+}
+
 type CreateTaskRequestPayload struct {
 	Organization string               `json:"organization"`
 	Project      string               `json:"project"`
 	Type         string               `json:"type"`
 	Fields       CreateTaskFieldValue `json:"fields"`
+	// Relations are added to the work item on creation and are only set by the plugin e.g. for the parent of a child work item
+	Relations []*WorkItemRelation `json:"-"`
 }
 
 type CreateTaskFieldValue struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	AreaPath    string `json:"areaPath"`
+	// AdditionalFields holds values for any other field of the work item type keyed by the field reference name e.g. "Microsoft.VSTS.Common.Severity"
+	AdditionalFields map[string]interface{} `json:"additionalFields"`
 }
 
 type CreateTaskBodyPayload struct {
-	Operation string `json:"op"`
-	Path      string `json:"path"`
-	From      string `json:"from"`
-	Value     string `json:"value"`
+	Operation string      `json:"op"`
+	Path      string      `json:"path"`
+	From      string      `json:"from"`
+	Value     interface{} `json:"value"`
+}
+
+type WorkItemType struct {
+	Name          string `json:"name"`
+	ReferenceName string `json:"referenceName"`
+	Description   string `json:"description"`
+	Color         string `json:"color"`
+	IsDisabled    bool   `json:"isDisabled"`
+}
+
+type WorkItemTypeList struct {
+	Count int             `json:"count"`
+	Value []*WorkItemType `json:"value"`
+}
+
+// WorkItemTypeField is a field as defined on a work item type of a process
+type WorkItemTypeField struct {
+	Name           string        `json:"name"`
+	ReferenceName  string        `json:"referenceName"`
+	AlwaysRequired bool          `json:"alwaysRequired"`
+	DefaultValue   interface{}   `json:"defaultValue"`
+	AllowedValues  []interface{} `json:"allowedValues"`
+	HelpText       string        `json:"helpText"`
+}
+
+type WorkItemTypeFieldList struct {
+	Count int                  `json:"count"`
+	Value []*WorkItemTypeField `json:"value"`
+}
+
+// WorkItemField is a field as defined on the project and contains the data type of the field
+type WorkItemField struct {
+	Name          string `json:"name"`
+	ReferenceName string `json:"referenceName"`
+	Type          string `json:"type"`
+	ReadOnly      bool   `json:"readOnly"`
+	IsPicklist    bool   `json:"isPicklist"`
+	IsIdentity    bool   `json:"isIdentity"`
+}
+
+type WorkItemFieldList struct {
+	Count int              `json:"count"`
+	Value []*WorkItemField `json:"value"`
+}
+
+// WorkItemFieldDefinition combines a work item type field with its data type
+type WorkItemFieldDefinition struct {
+	Name           string        `json:"name"`
+	ReferenceName  string        `json:"referenceName"`
+	Type           string        `json:"type"`
+	AlwaysRequired bool          `json:"alwaysRequired"`
+	ReadOnly       bool          `json:"readOnly"`
+	DefaultValue   interface{}   `json:"defaultValue"`
+	AllowedValues  []interface{} `json:"allowedValues"`
+	HelpText       string        `json:"helpText"`
 }
 
 // IsValid function to validate request payload.
@@ -95,6 +183,242 @@ func (t *CreateTaskRequestPayload) IsValid() error {
 	return nil
 }
 
+// GetFieldValues returns the values of all the fields present in the payload keyed by the field reference name
+func (t *CreateTaskRequestPayload) GetFieldValues() map[string]interface{} {
+	fieldValues := map[string]interface{}{}
+	for referenceName, value := range t.Fields.AdditionalFields {
+		fieldValues[referenceName] = value
+	}
+
+	if t.Fields.Title != "" {
+		fieldValues[constants.FieldReferenceNameTitle] = t.Fields.Title
+	}
+	if t.Fields.Description != "" {
+		fieldValues[constants.FieldReferenceNameDescription] = t.Fields.Description
+	}
+	if t.Fields.AreaPath != "" {
+		fieldValues[constants.FieldReferenceNameAreaPath] = t.Fields.AreaPath
+	}
+
+	return fieldValues
+}
+
+// ValidateFields validates the field values of the payload against the field definitions of the work item type.
+func (t *CreateTaskRequestPayload) ValidateFields(definitions []*WorkItemFieldDefinition) error {
+	fieldValues := t.GetFieldValues()
+	var missingFields []string
+	for _, definition := range definitions {
+		if !definition.AlwaysRequired || definition.ReadOnly || definition.DefaultValue != nil {
+			continue
+		}
+
+		if value, ok := fieldValues[definition.ReferenceName]; !ok || isEmptyFieldValue(value) {
+			missingFields = append(missingFields, definition.Name)
+		}
+	}
+
+	if len(missingFields) > 0 {
+		sort.Strings(missingFields)
+		return fmt.Errorf(constants.ErrorMissingRequiredFields, t.Type, strings.Join(missingFields, ", "))
+	}
+
+	return validateFieldValues(fieldValues, definitions, t.Type)
+}
+
+// validateFieldValues checks if the fields are defined for the work item type, are not read-only and have valid values
+func validateFieldValues(fieldValues map[string]interface{}, definitions []*WorkItemFieldDefinition, workItemType string) error {
+	definitionByReferenceName := make(map[string]*WorkItemFieldDefinition, len(definitions))
+	for _, definition := range definitions {
+		definitionByReferenceName[definition.ReferenceName] = definition
+	}
+
+	for referenceName, value := range fieldValues {
+		definition, ok := definitionByReferenceName[referenceName]
+		if !ok {
+			return fmt.Errorf(constants.ErrorUnknownField, referenceName, workItemType)
+		}
+
+		if definition.ReadOnly {
+			return fmt.Errorf(constants.ErrorReadOnlyField, definition.Name)
+		}
+
+		if err := definition.ValidateValue(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateValue checks if the value is valid for the data type and allowed values of the field
+func (d *WorkItemFieldDefinition) ValidateValue(value interface{}) error {
+	if isEmptyFieldValue(value) {
+		return nil
+	}
+
+	switch d.Type {
+	case constants.FieldTypeInteger, constants.FieldTypePicklistInteger:
+		number, ok := getNumericFieldValue(value)
+		if !ok || number != float64(int64(number)) {
+			return fmt.Errorf(constants.ErrorInvalidFieldValue, d.Name, "an integer")
+		}
+	case constants.FieldTypeDouble, constants.FieldTypePicklistDouble:
+		if _, ok := getNumericFieldValue(value); !ok {
+			return fmt.Errorf(constants.ErrorInvalidFieldValue, d.Name, "a number")
+		}
+	case constants.FieldTypeBoolean:
+		switch v := value.(type) {
+		case bool:
+		case string:
+			if _, err := strconv.ParseBool(v); err != nil {
+				return fmt.Errorf(constants.ErrorInvalidFieldValue, d.Name, "a boolean")
+			}
+		default:
+			return fmt.Errorf(constants.ErrorInvalidFieldValue, d.Name, "a boolean")
+		}
+	case constants.FieldTypeDateTime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf(constants.ErrorInvalidFieldValue, d.Name, "a date")
+		}
+		if _, err := time.Parse(time.RFC3339, v); err != nil {
+			if _, err := time.Parse(constants.DateLayout, v); err != nil {
+				return fmt.Errorf(constants.ErrorInvalidFieldValue, d.Name, "a date")
+			}
+		}
+	case constants.FieldTypeHTML:
+		v, ok := value.(string)
+		if !ok || !isValidHTML(v) {
+			return fmt.Errorf(constants.ErrorInvalidFieldValue, d.Name, "valid HTML")
+		}
+		if isEmptyHTML(v) {
+			return fmt.Errorf(constants.ErrorInvalidFieldValue, d.Name, "HTML with some content")
+		}
+	default:
+		// String, tree path, identity and string picklist fields are all sent as strings.
+		// The values of identity fields are resolved to the identities of the organization by the plugin before the work item is created.
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf(constants.ErrorInvalidFieldValue, d.Name, "a string")
+		}
+	}
+
+	if len(d.AllowedValues) > 0 && d.Type != constants.FieldTypeIdentity {
+		for _, allowedValue := range d.AllowedValues {
+			if fmt.Sprint(allowedValue) == fmt.Sprint(value) {
+				return nil
+			}
+		}
+		return fmt.Errorf(constants.ErrorFieldValueNotAllowed, fmt.Sprint(value), d.Name)
+	}
+
+	return nil
+}
+
+func isEmptyFieldValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	if v, ok := value.(string); ok {
+		return strings.TrimSpace(v) == ""
+	}
+
+	return false
+}
+
+// isValidHTML checks if each closing tag of the HTML closes an open element and if the elements are closed.
+// The elements whose end tags can be omitted e.g. paragraphs and list items are closed implicitly.
+func isValidHTML(value string) bool {
+	var openElements []string
+	for _, tag := range htmlTagRegex.FindAllStringSubmatch(value, -1) {
+		isClosingTag, element, isSelfClosingTag := tag[1] == "/", strings.ToLower(tag[2]), tag[3] == "/"
+		if htmlVoidElements[element] || isSelfClosingTag {
+			continue
+		}
+
+		if !isClosingTag {
+			openElements = append(openElements, element)
+			continue
+		}
+
+		for {
+			if len(openElements) == 0 {
+				return false
+			}
+
+			openElement := openElements[len(openElements)-1]
+			openElements = openElements[:len(openElements)-1]
+			if openElement == element {
+				break
+			}
+
+			if !htmlOptionalEndTagElements[openElement] {
+				return false
+			}
+		}
+	}
+
+	for _, openElement := range openElements {
+		if !htmlOptionalEndTagElements[openElement] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isEmptyHTML checks if the HTML has no text or images
+func isEmptyHTML(value string) bool {
+	if htmlImageRegex.MatchString(value) {
+		return false
+	}
+
+	text := html.UnescapeString(htmlTagRegex.ReplaceAllString(value, ""))
+	return strings.TrimSpace(text) == ""
+}
+
+func getNumericFieldValue(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case string:
+		number, err := strconv.ParseFloat(v, 64)
+		return number, err == nil
+	}
+
+	return 0, false
+}
+
+// GetRelatedWorkItemIDs returns the IDs of the work items related to the work item by the given relation type
+func (t *TaskValue) GetRelatedWorkItemIDs(relationType string) []string {
+	var workItemIDs []string
+	for _, relation := range t.Relations {
+		if relation.Rel != relationType {
+			continue
+		}
+
+		// The URL of a related work item is of the form "https://dev.azure.com/{organization}/{projectID}/_apis/wit/workItems/{workItemID}"
+		workItemID := relation.URL[strings.LastIndex(relation.URL, "/")+1:]
+		if _, err := strconv.Atoi(workItemID); err == nil {
+			workItemIDs = append(workItemIDs, workItemID)
+		}
+	}
+
+	return workItemIDs
+}
+
+// IsCompletedWorkItemState checks if the state belongs to the completed state category of the default processes
+func IsCompletedWorkItemState(state string) bool {
+	switch state {
+	case constants.WorkItemStateDone, constants.WorkItemStateClosed, constants.WorkItemStateCompleted:
+		return true
+	}
+
+	return false
+}
+
 func CreateTaskRequestPayloadFromJSON(data io.Reader) (*CreateTaskRequestPayload, error) {
 	var body *CreateTaskRequestPayload
 	if err := json.NewDecoder(data).Decode(&body); err != nil {
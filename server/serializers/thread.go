@@ -0,0 +1,65 @@
+package serializers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+// ThreadLink links a Mattermost thread to a work item
+type ThreadLink struct {
+	PostID           string `json:"postID"`
+	ChannelID        string `json:"channelID"`
+	OrganizationName string `json:"organizationName"`
+	ProjectName      string `json:"projectName"`
+	WorkItemID       string `json:"workItemID"`
+	WorkItemURL      string `json:"workItemURL"`
+	MirrorReplies    bool   `json:"mirrorReplies"`
+	MattermostUserID string `json:"mattermostUserID"`
+}
+
+type LinkThreadRequestPayload struct {
+	PostID        string `json:"postID"`
+	Organization  string `json:"organization"`
+	Project       string `json:"project"`
+	WorkItemID    string `json:"workItemID"`
+	MirrorReplies bool   `json:"mirrorReplies"`
+}
+
+type WorkItemRelation struct {
+	Rel        string                 `json:"rel"`
+	URL        string                 `json:"url"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+type WorkItemComment struct {
+	ID   int    `json:"id,omitempty"`
+	Text string `json:"text"`
+}
+
+// IsValid function to validate request payload.
+func (t *LinkThreadRequestPayload) IsValid() error {
+	if t.PostID == "" {
+		return errors.New(constants.PostIDRequired)
+	}
+	if t.Organization == "" {
+		return errors.New(constants.OrganizationRequired)
+	}
+	if t.Project == "" {
+		return errors.New(constants.ProjectRequired)
+	}
+	if t.WorkItemID == "" {
+		return errors.New(constants.WorkItemIDRequired)
+	}
+	return nil
+}
+
+func LinkThreadRequestPayloadFromJSON(data io.Reader) (*LinkThreadRequestPayload, error) {
+	var body *LinkThreadRequestPayload
+	if err := json.NewDecoder(data).Decode(&body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
@@ -7,3 +7,37 @@ type User struct {
 	ExpiresAt        int64  `json:"expiresAt"`
 	UserProfile
 }
+
+// Identity is an Azure DevOps user or group of an organization, the members of a group are the descriptors of its members
+type Identity struct {
+	ID                  string             `json:"id"`
+	Descriptor          string             `json:"descriptor"`
+	ProviderDisplayName string             `json:"providerDisplayName"`
+	IsContainer         bool               `json:"isContainer"`
+	IsActive            bool               `json:"isActive"`
+	Members             []string           `json:"members"`
+	Properties          IdentityProperties `json:"properties"`
+}
+
+type IdentityProperties struct {
+	Account IdentityProperty `json:"Account"`
+	Mail    IdentityProperty `json:"Mail"`
+}
+
+type IdentityProperty struct {
+	Value string `json:"$value"`
+}
+
+type IdentityList struct {
+	Value []*Identity `json:"value"`
+}
+
+// GetIdentity returns the identity of a user to resolve its Mattermost user, the email of the user is its unique name
+func (i *Identity) GetIdentity() *UserID {
+	uniqueName := i.Properties.Mail.Value
+	if uniqueName == "" {
+		uniqueName = i.Properties.Account.Value
+	}
+
+	return &UserID{ID: i.ID, DisplayName: i.ProviderDisplayName, UniqueName: uniqueName}
+}
@@ -0,0 +1,141 @@
+package serializers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+// WorkItemFilters are the filters of a work item subscription which are evaluated by the plugin before posting its notifications,
+// as the filters provided by Azure DevOps for work item events are limited to the area path
+type WorkItemFilters struct {
+	WorkItemTypes []string `json:"workItemTypes,omitempty"`
+	Tag           string   `json:"tag,omitempty"`
+	StateFrom     string   `json:"stateFrom,omitempty"`
+	StateTo       string   `json:"stateTo,omitempty"`
+	// MaxPriority is the largest priority value of the work items, the highest priority being 1
+	MaxPriority int `json:"maxPriority,omitempty"`
+}
+
+type UpdateWorkItemFiltersRequestPayload struct {
+	SubscriptionID  string           `json:"subscriptionID"`
+	WorkItemFilters *WorkItemFilters `json:"workItemFilters"`
+}
+
+// ParseWorkItemFilterArguments parses arguments of the form "type=Bug,Incident", "tag=customer", "from=Active", "to=Resolved" and "priority<=2" into work item filters.
+func ParseWorkItemFilterArguments(args []string) (*WorkItemFilters, error) {
+	filters := &WorkItemFilters{}
+	for _, arg := range args {
+		if priorityPrefix := constants.WorkItemFilterArgumentPriority + constants.WorkItemFilterPriorityOperator; strings.HasPrefix(arg, priorityPrefix) {
+			value := strings.TrimPrefix(arg, priorityPrefix)
+			priority, err := strconv.Atoi(value)
+			if err != nil || priority <= 0 {
+				return nil, fmt.Errorf(constants.InvalidWorkItemFilterPriority, value)
+			}
+			filters.MaxPriority = priority
+			continue
+		}
+
+		key, value, found := cut(arg, constants.FilterArgumentSeparator)
+		value = strings.TrimSpace(value)
+		if !found || value == "" {
+			return nil, fmt.Errorf(constants.InvalidWorkItemFilterArgument, arg)
+		}
+
+		switch key {
+		case constants.WorkItemFilterArgumentType:
+			filters.WorkItemTypes = nil
+			for _, workItemType := range strings.Split(value, ",") {
+				if workItemType = strings.TrimSpace(workItemType); workItemType != "" {
+					filters.WorkItemTypes = append(filters.WorkItemTypes, workItemType)
+				}
+			}
+		case constants.WorkItemFilterArgumentTag:
+			filters.Tag = value
+		case constants.WorkItemFilterArgumentStateFrom:
+			filters.StateFrom = value
+		case constants.WorkItemFilterArgumentStateTo:
+			filters.StateTo = value
+		default:
+			return nil, fmt.Errorf(constants.InvalidWorkItemFilterArgument, arg)
+		}
+	}
+
+	return filters, nil
+}
+
+// IsEmpty returns true if none of the filters are set
+func (f *WorkItemFilters) IsEmpty() bool {
+	return f == nil || (len(f.WorkItemTypes) == 0 && f.Tag == "" && f.StateFrom == "" && f.StateTo == "" && f.MaxPriority == 0)
+}
+
+// IsValid validates the filters for a subscription of the event type.
+// The state filters are only supported for work item updated events as the notifications of other events do not contain a state transition.
+func (f *WorkItemFilters) IsValid(eventType string) error {
+	if f.IsEmpty() {
+		return nil
+	}
+
+	switch eventType {
+	case constants.SubscriptionEventWorkItemCreated, constants.SubscriptionEventWorkItemUpdated, constants.SubscriptionEventWorkItemDeleted, constants.SubscriptionEventWorkItemCommented:
+	default:
+		return errors.New(constants.WorkItemFiltersNotSupported)
+	}
+
+	if eventType != constants.SubscriptionEventWorkItemUpdated && (f.StateFrom != "" || f.StateTo != "") {
+		return errors.New(constants.WorkItemStateFiltersNotSupported)
+	}
+
+	if f.MaxPriority < 0 {
+		return fmt.Errorf(constants.InvalidWorkItemFilterPriority, strconv.Itoa(f.MaxPriority))
+	}
+
+	return nil
+}
+
+// String returns the filters in the form they are shown in the subscription lists
+func (f *WorkItemFilters) String() string {
+	if f.IsEmpty() {
+		return ""
+	}
+
+	var filters []string
+	if len(f.WorkItemTypes) > 0 {
+		filters = append(filters, fmt.Sprintf("type in (%s)", strings.Join(f.WorkItemTypes, ", ")))
+	}
+	if f.Tag != "" {
+		filters = append(filters, fmt.Sprintf("tag contains %s", f.Tag))
+	}
+	if f.StateFrom != "" {
+		filters = append(filters, fmt.Sprintf("state changed from %s", f.StateFrom))
+	}
+	if f.StateTo != "" {
+		filters = append(filters, fmt.Sprintf("state changed to %s", f.StateTo))
+	}
+	if f.MaxPriority > 0 {
+		filters = append(filters, fmt.Sprintf("priority <= %d", f.MaxPriority))
+	}
+
+	return strings.Join(filters, ", ")
+}
+
+// IsValid function to validate request payload.
+func (t *UpdateWorkItemFiltersRequestPayload) IsValid() error {
+	if t.SubscriptionID == "" {
+		return errors.New(constants.SubscriptionIDRequired)
+	}
+	return nil
+}
+
+func UpdateWorkItemFiltersRequestPayloadFromJSON(data io.Reader) (*UpdateWorkItemFiltersRequestPayload, error) {
+	var body *UpdateWorkItemFiltersRequestPayload
+	if err := json.NewDecoder(data).Decode(&body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
@@ -0,0 +1,68 @@
+package store
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+type IdentityOverrideStore interface {
+	StoreIdentityOverride(azureIdentity, mattermostUserID string) error
+	DeleteIdentityOverride(azureIdentity string) (bool, error)
+	GetIdentityOverrides() (IdentityOverrideList, error)
+}
+
+// IdentityOverrideList maps the Azure DevOps identities, in lower case, to the IDs of the Mattermost users they are mapped to by the admins
+type IdentityOverrideList map[string]string
+
+// StoreIdentityOverride maps an Azure DevOps identity i.e. its email, unique name, ID or descriptor to a Mattermost user
+func (s *Store) StoreIdentityOverride(azureIdentity, mattermostUserID string) error {
+	return s.AtomicModify(constants.IdentityOverridesKey, func(initialBytes []byte) ([]byte, error) {
+		overrides := IdentityOverrideList{}
+		if len(initialBytes) != 0 {
+			if err := json.Unmarshal(initialBytes, &overrides); err != nil {
+				return nil, err
+			}
+		}
+
+		overrides[strings.ToLower(azureIdentity)] = mattermostUserID
+		return json.Marshal(overrides)
+	})
+}
+
+// DeleteIdentityOverride removes the mapping of an Azure DevOps identity, it returns false if the identity is not mapped
+func (s *Store) DeleteIdentityOverride(azureIdentity string) (bool, error) {
+	isDeleted := false
+	err := s.AtomicModify(constants.IdentityOverridesKey, func(initialBytes []byte) ([]byte, error) {
+		overrides := IdentityOverrideList{}
+		if len(initialBytes) != 0 {
+			if err := json.Unmarshal(initialBytes, &overrides); err != nil {
+				return nil, err
+			}
+		}
+
+		_, isDeleted = overrides[strings.ToLower(azureIdentity)]
+		if !isDeleted {
+			return initialBytes, nil
+		}
+
+		delete(overrides, strings.ToLower(azureIdentity))
+		return json.Marshal(overrides)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return isDeleted, nil
+}
+
+// GetIdentityOverrides returns the Azure DevOps identities mapped to Mattermost users by the admins
+func (s *Store) GetIdentityOverrides() (IdentityOverrideList, error) {
+	overrides := IdentityOverrideList{}
+	if err := s.LoadJSON(constants.IdentityOverridesKey, &overrides); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
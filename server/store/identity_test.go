@@ -0,0 +1,141 @@
+package store
+
+import (
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestStoreIdentityOverride(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description    string
+		initialBytes   []byte
+		expectedResult string
+		expectedError  bool
+	}{
+		{
+			description:    "StoreIdentityOverride: identity is mapped in lower case",
+			expectedResult: `{"john@example.com":"mockMattermostUserID"}`,
+		},
+		{
+			description:    "StoreIdentityOverride: existing mapping is replaced",
+			initialBytes:   []byte(`{"john@example.com":"mockOtherUserID","jane@example.com":"mockOtherUserID"}`),
+			expectedResult: `{"john@example.com":"mockMattermostUserID","jane@example.com":"mockOtherUserID"}`,
+		},
+		{
+			description:   "StoreIdentityOverride: unmarshaling gives error",
+			initialBytes:  []byte("mockInvalidJSON"),
+			expectedError: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "AtomicModify", func(_ *Store, key string, modify func([]byte) ([]byte, error)) error {
+				assert.Equal(t, constants.IdentityOverridesKey, key)
+				resp, err := modify(testCase.initialBytes)
+				if err != nil {
+					return err
+				}
+
+				assert.JSONEq(t, testCase.expectedResult, string(resp))
+				return nil
+			})
+
+			err := s.StoreIdentityOverride("John@example.com", testutils.MockMattermostUserID)
+
+			if testCase.expectedError {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestDeleteIdentityOverride(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description       string
+		initialBytes      []byte
+		expectedResult    string
+		expectedIsDeleted bool
+	}{
+		{
+			description:       "DeleteIdentityOverride: mapping is deleted",
+			initialBytes:      []byte(`{"john@example.com":"mockMattermostUserID","jane@example.com":"mockOtherUserID"}`),
+			expectedResult:    `{"jane@example.com":"mockOtherUserID"}`,
+			expectedIsDeleted: true,
+		},
+		{
+			description:    "DeleteIdentityOverride: identity is not mapped",
+			initialBytes:   []byte(`{"jane@example.com":"mockOtherUserID"}`),
+			expectedResult: `{"jane@example.com":"mockOtherUserID"}`,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "AtomicModify", func(_ *Store, key string, modify func([]byte) ([]byte, error)) error {
+				resp, err := modify(testCase.initialBytes)
+				assert.Nil(t, err)
+				assert.JSONEq(t, testCase.expectedResult, string(resp))
+				return nil
+			})
+
+			isDeleted, err := s.DeleteIdentityOverride("John@example.com")
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedIsDeleted, isDeleted)
+		})
+	}
+}
+
+func TestGetIdentityOverrides(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description       string
+		storedOverrides   []byte
+		err               error
+		expectedOverrides IdentityOverrideList
+	}{
+		{
+			description:       "GetIdentityOverrides: no identities are mapped",
+			expectedOverrides: IdentityOverrideList{},
+		},
+		{
+			description:       "GetIdentityOverrides: mapped identities are fetched successfully",
+			storedOverrides:   []byte(`{"john@example.com":"mockMattermostUserID"}`),
+			expectedOverrides: IdentityOverrideList{"john@example.com": testutils.MockMattermostUserID},
+		},
+		{
+			description: "GetIdentityOverrides: 'Load' gives error",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "Load", func(_ *Store, key string) ([]byte, error) {
+				assert.Equal(t, constants.IdentityOverridesKey, key)
+				return testCase.storedOverrides, testCase.err
+			})
+
+			overrides, err := s.GetIdentityOverrides()
+
+			if testCase.err != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, overrides)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedOverrides, overrides)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package store
+
+import (
+	"encoding/json"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+type PersonalNotificationStore interface {
+	StorePersonalNotificationSettings(mattermostUserID string, settings *serializers.PersonalNotificationSettings) error
+	GetPersonalNotificationSettings(mattermostUserID string) (*serializers.PersonalNotificationSettings, error)
+	AddPullRequestReviewers(repositoryID string, pullRequestID int, reviewerIDs []string) ([]string, bool, error)
+	MarkPersonalNotificationSent(eventID, kind, mattermostUserID string) (bool, error)
+}
+
+// StorePersonalNotificationSettings stores the kinds of events for which a user receives direct messages
+func (s *Store) StorePersonalNotificationSettings(mattermostUserID string, settings *serializers.PersonalNotificationSettings) error {
+	return s.StoreJSON(GetPersonalNotificationSettingsKey(mattermostUserID), settings)
+}
+
+// GetPersonalNotificationSettings returns the personal notification settings of a user, all the notifications are turned off by default
+func (s *Store) GetPersonalNotificationSettings(mattermostUserID string) (*serializers.PersonalNotificationSettings, error) {
+	settings := &serializers.PersonalNotificationSettings{}
+	if err := s.LoadJSON(GetPersonalNotificationSettingsKey(mattermostUserID), settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// AddPullRequestReviewers tracks the current reviewers of a pull request to find the reviewers added by a pull request updated event.
+// It returns the reviewers which were not tracked earlier and whether the pull request was tracked before.
+func (s *Store) AddPullRequestReviewers(repositoryID string, pullRequestID int, reviewerIDs []string) ([]string, bool, error) {
+	var addedReviewerIDs []string
+	wasTracked := false
+	err := s.AtomicModifyWithOptions(GetPullRequestReviewersKey(repositoryID, pullRequestID), func(initialBytes []byte) ([]byte, *model.PluginKVSetOptions, error) {
+		addedReviewerIDs = nil
+		wasTracked = len(initialBytes) != 0
+		trackedReviewerIDs := map[string]bool{}
+		if wasTracked {
+			if err := json.Unmarshal(initialBytes, &trackedReviewerIDs); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		// Reviewers removed from the pull request are forgotten so that they are notified if they are added again
+		currentReviewerIDs := map[string]bool{}
+		for _, reviewerID := range reviewerIDs {
+			if !trackedReviewerIDs[reviewerID] && !currentReviewerIDs[reviewerID] {
+				addedReviewerIDs = append(addedReviewerIDs, reviewerID)
+			}
+			currentReviewerIDs[reviewerID] = true
+		}
+
+		newBytes, err := json.Marshal(currentReviewerIDs)
+		return newBytes, &model.PluginKVSetOptions{ExpireInSeconds: constants.TTLSecondsForPullRequestReviewers}, err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return addedReviewerIDs, wasTracked, nil
+}
+
+// MarkPersonalNotificationSent marks a personal notification of an event as sent to a user.
+// It returns false if the notification was already sent, as the event is delivered once for each subscription of the project.
+func (s *Store) MarkPersonalNotificationSent(eventID, kind, mattermostUserID string) (bool, error) {
+	return s.StoreWithOptions(GetPersonalNotificationSentKey(eventID, kind, mattermostUserID), []byte{1}, model.PluginKVSetOptions{
+		Atomic:          true,
+		OldValue:        nil,
+		ExpireInSeconds: constants.TTLSecondsForPersonalNotificationSent,
+	})
+}
@@ -0,0 +1,148 @@
+package store
+
+import (
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestGetPersonalNotificationSettings(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description      string
+		storedSettings   []byte
+		err              error
+		expectedSettings *serializers.PersonalNotificationSettings
+	}{
+		{
+			description:      "GetPersonalNotificationSettings: notifications are off by default",
+			expectedSettings: &serializers.PersonalNotificationSettings{},
+		},
+		{
+			description:      "GetPersonalNotificationSettings: stored settings are fetched successfully",
+			storedSettings:   []byte(`{"reviews":true,"mentions":false,"assignments":true}`),
+			expectedSettings: &serializers.PersonalNotificationSettings{Reviews: true, Assignments: true},
+		},
+		{
+			description: "GetPersonalNotificationSettings: 'Load' gives error",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "Load", func(_ *Store, key string) ([]byte, error) {
+				assert.Equal(t, GetPersonalNotificationSettingsKey(testutils.MockMattermostUserID), key)
+				return testCase.storedSettings, testCase.err
+			})
+
+			settings, err := s.GetPersonalNotificationSettings(testutils.MockMattermostUserID)
+
+			if testCase.err != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, settings)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedSettings, settings)
+		})
+	}
+}
+
+func TestAddPullRequestReviewers(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description        string
+		initialBytes       []byte
+		reviewerIDs        []string
+		expectedResult     string
+		expectedAdded      []string
+		expectedWasTracked bool
+		expectedError      bool
+	}{
+		{
+			description:    "AddPullRequestReviewers: all the reviewers are added for an untracked pull request",
+			reviewerIDs:    []string{"mockReviewer1", "mockReviewer2"},
+			expectedResult: `{"mockReviewer1":true,"mockReviewer2":true}`,
+			expectedAdded:  []string{"mockReviewer1", "mockReviewer2"},
+		},
+		{
+			description:        "AddPullRequestReviewers: only new reviewers are added and removed reviewers are forgotten",
+			initialBytes:       []byte(`{"mockReviewer1":true,"mockReviewer3":true}`),
+			reviewerIDs:        []string{"mockReviewer1", "mockReviewer2"},
+			expectedResult:     `{"mockReviewer1":true,"mockReviewer2":true}`,
+			expectedAdded:      []string{"mockReviewer2"},
+			expectedWasTracked: true,
+		},
+		{
+			description:   "AddPullRequestReviewers: unmarshaling gives error",
+			initialBytes:  []byte("mockInvalidJSON"),
+			expectedError: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "AtomicModifyWithOptions", func(_ *Store, key string, modify func([]byte) ([]byte, *model.PluginKVSetOptions, error)) error {
+				assert.Equal(t, GetPullRequestReviewersKey("mockRepositoryID", 1), key)
+				resp, opts, err := modify(testCase.initialBytes)
+				if err != nil {
+					return err
+				}
+
+				assert.JSONEq(t, testCase.expectedResult, string(resp))
+				assert.Equal(t, constants.TTLSecondsForPullRequestReviewers, opts.ExpireInSeconds)
+				return nil
+			})
+
+			added, wasTracked, err := s.AddPullRequestReviewers("mockRepositoryID", 1, testCase.reviewerIDs)
+
+			if testCase.expectedError {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedAdded, added)
+			assert.Equal(t, testCase.expectedWasTracked, wasTracked)
+		})
+	}
+}
+
+func TestMarkPersonalNotificationSent(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description string
+		isStored    bool
+	}{
+		{
+			description: "MarkPersonalNotificationSent: notification is not sent yet",
+			isStored:    true,
+		},
+		{
+			description: "MarkPersonalNotificationSent: notification is already sent",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "StoreWithOptions", func(_ *Store, key string, _ []byte, opts model.PluginKVSetOptions) (bool, error) {
+				assert.Equal(t, GetPersonalNotificationSentKey("mockEventID", constants.PersonalNotificationMentions, testutils.MockMattermostUserID), key)
+				assert.True(t, opts.Atomic)
+				assert.Nil(t, opts.OldValue)
+				return testCase.isStored, nil
+			})
+
+			isNotSent, err := s.MarkPersonalNotificationSent("mockEventID", constants.PersonalNotificationMentions, testutils.MockMattermostUserID)
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.isStored, isNotSent)
+		})
+	}
+}
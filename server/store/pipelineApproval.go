@@ -0,0 +1,133 @@
+package store
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+type PipelineApprovalStore interface {
+	StorePipelineApproval(approval *serializers.PipelineApproval) error
+	GetPipelineApprovals() ([]*serializers.PipelineApproval, error)
+	DeletePipelineApproval(postID string) error
+	MarkApprovalReminderSent(approvalID string, reminder int) (bool, error)
+	AddApprovalPost(requestName, organization, approvalID, postID string) error
+	GetApprovalPosts(requestName, organization, approvalID string) ([]string, error)
+}
+
+// PipelineApprovalList maps the IDs of the posts of the pending approvals to the approvals, they are stored under a single key to be synced by the approval job.
+// An approval is tracked once for each of its posts as it is posted once for each subscription.
+type PipelineApprovalList map[string]*serializers.PipelineApproval
+
+func pipelineApprovalListFromJSON(bytes []byte) (PipelineApprovalList, error) {
+	approvalList := PipelineApprovalList{}
+	if len(bytes) != 0 {
+		if err := json.Unmarshal(bytes, &approvalList); err != nil {
+			return nil, err
+		}
+	}
+
+	return approvalList, nil
+}
+
+// StorePipelineApproval adds the approval, replacing any existing approval of the same post
+func (s *Store) StorePipelineApproval(approval *serializers.PipelineApproval) error {
+	return s.AtomicModify(constants.PipelineApprovalsKey, func(initialBytes []byte) ([]byte, error) {
+		approvalList, err := pipelineApprovalListFromJSON(initialBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		approvalList[approval.PostID] = approval
+		return json.Marshal(approvalList)
+	})
+}
+
+// GetPipelineApprovals returns the tracked approvals sorted by the IDs of their posts
+func (s *Store) GetPipelineApprovals() ([]*serializers.PipelineApproval, error) {
+	initialBytes, err := s.Load(constants.PipelineApprovalsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	approvalList, err := pipelineApprovalListFromJSON(initialBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	approvals := make([]*serializers.PipelineApproval, 0, len(approvalList))
+	for _, approval := range approvalList {
+		approvals = append(approvals, approval)
+	}
+
+	sort.Slice(approvals, func(i, j int) bool {
+		return approvals[i].PostID < approvals[j].PostID
+	})
+	return approvals, nil
+}
+
+// DeletePipelineApproval stops tracking the approval of a post
+func (s *Store) DeletePipelineApproval(postID string) error {
+	return s.AtomicModify(constants.PipelineApprovalsKey, func(initialBytes []byte) ([]byte, error) {
+		approvalList, err := pipelineApprovalListFromJSON(initialBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := approvalList[postID]; !ok {
+			return initialBytes, nil
+		}
+
+		delete(approvalList, postID)
+		return json.Marshal(approvalList)
+	})
+}
+
+// MarkApprovalReminderSent marks a reminder of an approval as sent, it returns false if it is already marked as sent for another post of the approval or by another server of the cluster
+func (s *Store) MarkApprovalReminderSent(approvalID string, reminder int) (bool, error) {
+	return s.StoreWithOptions(GetApprovalReminderSentKey(approvalID, reminder), []byte{1}, model.PluginKVSetOptions{
+		Atomic:          true,
+		OldValue:        nil,
+		ExpireInSeconds: constants.TTLSecondsForApprovalReminderSent,
+	})
+}
+
+// AddApprovalPost adds a post to the posts of an approval, i.e. its notifications in the channels and the approval cards sent to its approvers.
+// The posts are kept in sync once the approval is approved or rejected from any of them.
+func (s *Store) AddApprovalPost(requestName, organization, approvalID, postID string) error {
+	return s.AtomicModifyWithOptions(GetApprovalPostsKey(requestName, organization, approvalID), func(initialBytes []byte) ([]byte, *model.PluginKVSetOptions, error) {
+		var postIDs []string
+		if len(initialBytes) != 0 {
+			if err := json.Unmarshal(initialBytes, &postIDs); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		for _, existingPostID := range postIDs {
+			if existingPostID == postID {
+				return initialBytes, nil, nil
+			}
+		}
+
+		newBytes, err := json.Marshal(append(postIDs, postID))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return newBytes, &model.PluginKVSetOptions{ExpireInSeconds: constants.TTLSecondsForApprovalPosts}, nil
+	})
+}
+
+// GetApprovalPosts returns the IDs of the posts of an approval
+func (s *Store) GetApprovalPosts(requestName, organization, approvalID string) ([]string, error) {
+	postIDs := []string{}
+	if err := s.LoadJSON(GetApprovalPostsKey(requestName, organization, approvalID), &postIDs); err != nil {
+		return nil, err
+	}
+
+	return postIDs, nil
+}
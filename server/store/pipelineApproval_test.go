@@ -0,0 +1,259 @@
+package store
+
+import (
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+func TestStorePipelineApproval(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description    string
+		initialBytes   []byte
+		expectedResult string
+		expectedError  bool
+	}{
+		{
+			description:    "StorePipelineApproval: approval is added",
+			initialBytes:   []byte(`{"mockOtherPostID":{"approvalID":"mockApprovalID","postID":"mockOtherPostID"}}`),
+			expectedResult: `{"mockOtherPostID":{"approvalID":"mockApprovalID","organizationName":"","projectID":"","channelID":"","postID":"mockOtherPostID","mattermostUserID":"","pipelineName":"","pipelineURL":"","stageName":"","stageURL":"","createdAt":"0001-01-01T00:00:00Z","expiresAt":"0001-01-01T00:00:00Z"},"mockPostID":{"approvalID":"mockApprovalID","organizationName":"","projectID":"","channelID":"","postID":"mockPostID","mattermostUserID":"","pipelineName":"","pipelineURL":"","stageName":"","stageURL":"","createdAt":"0001-01-01T00:00:00Z","expiresAt":"0001-01-01T00:00:00Z"}}`,
+		},
+		{
+			description:   "StorePipelineApproval: unmarshaling gives error",
+			initialBytes:  []byte("mockInvalidJSON"),
+			expectedError: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "AtomicModify", func(_ *Store, key string, modify func([]byte) ([]byte, error)) error {
+				assert.Equal(t, constants.PipelineApprovalsKey, key)
+				resp, err := modify(testCase.initialBytes)
+				if err != nil {
+					return err
+				}
+
+				assert.JSONEq(t, testCase.expectedResult, string(resp))
+				return nil
+			})
+
+			err := s.StorePipelineApproval(&serializers.PipelineApproval{ApprovalID: "mockApprovalID", PostID: "mockPostID"})
+
+			if testCase.expectedError {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestGetPipelineApprovals(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description     string
+		storedBytes     []byte
+		err             error
+		expectedPostIDs []string
+	}{
+		{
+			description:     "GetPipelineApprovals: no approvals are stored",
+			expectedPostIDs: []string{},
+		},
+		{
+			description:     "GetPipelineApprovals: approvals are sorted by post ID",
+			storedBytes:     []byte(`{"b":{"postID":"b"},"a":{"postID":"a"}}`),
+			expectedPostIDs: []string{"a", "b"},
+		},
+		{
+			description: "GetPipelineApprovals: 'Load' gives error",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "Load", func(_ *Store, key string) ([]byte, error) {
+				assert.Equal(t, constants.PipelineApprovalsKey, key)
+				return testCase.storedBytes, testCase.err
+			})
+
+			approvals, err := s.GetPipelineApprovals()
+
+			if testCase.err != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, approvals)
+				return
+			}
+
+			assert.Nil(t, err)
+			postIDs := []string{}
+			for _, approval := range approvals {
+				postIDs = append(postIDs, approval.PostID)
+			}
+			assert.Equal(t, testCase.expectedPostIDs, postIDs)
+		})
+	}
+}
+
+func TestDeletePipelineApproval(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description    string
+		postID         string
+		expectedResult string
+	}{
+		{
+			description:    "DeletePipelineApproval: approval is deleted",
+			postID:         "mockPostID",
+			expectedResult: `{}`,
+		},
+		{
+			description:    "DeletePipelineApproval: approval of the post is not tracked",
+			postID:         "mockOtherPostID",
+			expectedResult: `{"mockPostID":{"postID":"mockPostID"}}`,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "AtomicModify", func(_ *Store, key string, modify func([]byte) ([]byte, error)) error {
+				assert.Equal(t, constants.PipelineApprovalsKey, key)
+				resp, err := modify([]byte(`{"mockPostID":{"postID":"mockPostID"}}`))
+				if err != nil {
+					return err
+				}
+
+				assert.JSONEq(t, testCase.expectedResult, string(resp))
+				return nil
+			})
+
+			err := s.DeletePipelineApproval(testCase.postID)
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestMarkApprovalReminderSent(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	monkey.PatchInstanceMethod(reflect.TypeOf(&s), "StoreWithOptions", func(_ *Store, key string, _ []byte, opts model.PluginKVSetOptions) (bool, error) {
+		assert.Equal(t, GetApprovalReminderSentKey("mockApprovalID", 2), key)
+		assert.True(t, opts.Atomic)
+		assert.Nil(t, opts.OldValue)
+		assert.Equal(t, constants.TTLSecondsForApprovalReminderSent, opts.ExpireInSeconds)
+		return true, nil
+	})
+
+	isNotSent, err := s.MarkApprovalReminderSent("mockApprovalID", 2)
+
+	assert.Nil(t, err)
+	assert.True(t, isNotSent)
+}
+
+func TestAddApprovalPost(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description    string
+		initialBytes   []byte
+		expectedResult string
+		expectedError  bool
+	}{
+		{
+			description:    "AddApprovalPost: post is added to an approval without posts",
+			expectedResult: `["mockPostID"]`,
+		},
+		{
+			description:    "AddApprovalPost: post is added to the posts of the approval",
+			initialBytes:   []byte(`["mockOtherPostID"]`),
+			expectedResult: `["mockOtherPostID","mockPostID"]`,
+		},
+		{
+			description:    "AddApprovalPost: post is already added",
+			initialBytes:   []byte(`["mockPostID"]`),
+			expectedResult: `["mockPostID"]`,
+		},
+		{
+			description:   "AddApprovalPost: unmarshaling gives error",
+			initialBytes:  []byte("mockInvalidJSON"),
+			expectedError: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "AtomicModifyWithOptions", func(_ *Store, key string, modify func([]byte) ([]byte, *model.PluginKVSetOptions, error)) error {
+				assert.Equal(t, GetApprovalPostsKey(constants.PipelineRequestNameRun, "mockOrganization", "mockApprovalID"), key)
+				resp, opts, err := modify(testCase.initialBytes)
+				if err != nil {
+					return err
+				}
+
+				assert.JSONEq(t, testCase.expectedResult, string(resp))
+				if opts != nil {
+					assert.Equal(t, constants.TTLSecondsForApprovalPosts, opts.ExpireInSeconds)
+				}
+				return nil
+			})
+
+			err := s.AddApprovalPost(constants.PipelineRequestNameRun, "mockOrganization", "mockApprovalID", "mockPostID")
+
+			if testCase.expectedError {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestGetApprovalPosts(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description     string
+		storedBytes     []byte
+		err             error
+		expectedPostIDs []string
+	}{
+		{
+			description:     "GetApprovalPosts: approval has no posts",
+			expectedPostIDs: []string{},
+		},
+		{
+			description:     "GetApprovalPosts: posts of the approval are returned",
+			storedBytes:     []byte(`["mockPostID","mockOtherPostID"]`),
+			expectedPostIDs: []string{"mockPostID", "mockOtherPostID"},
+		},
+		{
+			description: "GetApprovalPosts: 'Load' gives error",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "Load", func(_ *Store, key string) ([]byte, error) {
+				assert.Equal(t, GetApprovalPostsKey(constants.PipelineRequestNameRelease, "MockOrganization", "1"), key)
+				return testCase.storedBytes, testCase.err
+			})
+
+			postIDs, err := s.GetApprovalPosts(constants.PipelineRequestNameRelease, "mockOrganization", "1")
+
+			if testCase.err != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, postIDs)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedPostIDs, postIDs)
+		})
+	}
+}
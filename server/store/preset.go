@@ -0,0 +1,151 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+// ErrPresetAlreadyExists is returned when a preset is stored with the name of an existing preset of the channel
+var ErrPresetAlreadyExists = errors.New("preset already exists")
+
+type PresetStore interface {
+	StorePreset(preset *serializers.WorkItemPreset) error
+	GetPreset(channelID, name string) (*serializers.WorkItemPreset, error)
+	GetAllPresets(channelID string) ([]*serializers.WorkItemPreset, error)
+	DeletePreset(channelID, name string) error
+}
+
+type PresetListMap map[string]serializers.WorkItemPreset
+
+type PresetList struct {
+	ByName PresetListMap
+}
+
+func NewPresetList() *PresetList {
+	return &PresetList{
+		ByName: PresetListMap{},
+	}
+}
+
+func storePresetAtomicModify(preset *serializers.WorkItemPreset, initialBytes []byte) ([]byte, error) {
+	presetList, err := PresetListFromJSON(initialBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := presetList.ByName[GetPresetKey(preset.Name)]; ok {
+		return nil, ErrPresetAlreadyExists
+	}
+
+	presetList.AddPreset(preset)
+	modifiedBytes, marshalErr := json.Marshal(presetList)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	return modifiedBytes, nil
+}
+
+// StorePreset adds the preset to the presets of its channel, ErrPresetAlreadyExists is returned if the channel has a preset with the same name
+func (s *Store) StorePreset(preset *serializers.WorkItemPreset) error {
+	key := GetPresetListMapKey(preset.ChannelID)
+	if err := s.AtomicModify(key, func(initialBytes []byte) ([]byte, error) {
+		return storePresetAtomicModify(preset, initialBytes)
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (presetList *PresetList) AddPreset(preset *serializers.WorkItemPreset) {
+	presetList.ByName[GetPresetKey(preset.Name)] = *preset
+}
+
+func (s *Store) getPresetList(channelID string) (*PresetList, error) {
+	initialBytes, err := s.Load(GetPresetListMapKey(channelID))
+	if err != nil {
+		return nil, err
+	}
+
+	return PresetListFromJSON(initialBytes)
+}
+
+// GetPreset returns the preset of the channel with the given name, or nil if it does not exist
+func (s *Store) GetPreset(channelID, name string) (*serializers.WorkItemPreset, error) {
+	presetList, err := s.getPresetList(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	preset, ok := presetList.ByName[GetPresetKey(name)]
+	if !ok {
+		return nil, nil
+	}
+	return &preset, nil
+}
+
+// GetAllPresets returns the presets of the channel sorted by name
+func (s *Store) GetAllPresets(channelID string) ([]*serializers.WorkItemPreset, error) {
+	presetList, err := s.getPresetList(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	presets := make([]*serializers.WorkItemPreset, 0, len(presetList.ByName))
+	for key := range presetList.ByName {
+		preset := presetList.ByName[key]
+		presets = append(presets, &preset)
+	}
+
+	sort.Slice(presets, func(i, j int) bool {
+		return presets[i].Name < presets[j].Name
+	})
+	return presets, nil
+}
+
+func deletePresetAtomicModify(name string, initialBytes []byte) ([]byte, error) {
+	presetList, err := PresetListFromJSON(initialBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(presetList.ByName, GetPresetKey(name))
+	modifiedBytes, marshalErr := json.Marshal(presetList)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	return modifiedBytes, nil
+}
+
+func (s *Store) DeletePreset(channelID, name string) error {
+	key := GetPresetListMapKey(channelID)
+	if err := s.AtomicModify(key, func(initialBytes []byte) ([]byte, error) {
+		return deletePresetAtomicModify(name, initialBytes)
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetPresetKey returns the key of a preset in the preset list, preset names are case insensitive
+func GetPresetKey(name string) string {
+	return strings.ToLower(name)
+}
+
+func PresetListFromJSON(bytes []byte) (*PresetList, error) {
+	var presetList *PresetList
+	if len(bytes) != 0 {
+		unmarshalErr := json.Unmarshal(bytes, &presetList)
+		if unmarshalErr != nil {
+			return nil, unmarshalErr
+		}
+	} else {
+		presetList = NewPresetList()
+	}
+	return presetList, nil
+}
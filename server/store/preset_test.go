@@ -0,0 +1,239 @@
+package store
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestStorePresetAtomicModify(t *testing.T) {
+	defer monkey.UnpatchAll()
+	for _, testCase := range []struct {
+		description  string
+		initialBytes []byte
+		marshalError error
+	}{
+		{
+			description: "StorePresetAtomicModify: preset is added successfully",
+		},
+		{
+			description:  "StorePresetAtomicModify: preset is added to the existing presets",
+			initialBytes: []byte(`{"ByName":{"triage":{"name":"triage"}}}`),
+		},
+		{
+			description:  "StorePresetAtomicModify: preset with the same name exists",
+			initialBytes: []byte(`{"ByName":{"bug-triage":{"name":"bug-triage"}}}`),
+		},
+		{
+			description:  "StorePresetAtomicModify: unmarshaling gives error",
+			initialBytes: []byte("mockInvalidJSON"),
+		},
+		{
+			description:  "StorePresetAtomicModify: marshaling gives error",
+			marshalError: errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			if testCase.marshalError != nil {
+				monkey.Patch(json.Marshal, func(interface{}) ([]byte, error) {
+					return nil, testCase.marshalError
+				})
+			}
+
+			resp, err := storePresetAtomicModify(&serializers.WorkItemPreset{Name: "Bug-Triage", ChannelID: testutils.MockChannelID}, testCase.initialBytes)
+			monkey.UnpatchAll()
+
+			if strings.Contains(string(testCase.initialBytes), "bug-triage") {
+				assert.Equal(t, ErrPresetAlreadyExists, err)
+				assert.Nil(t, resp)
+				return
+			}
+
+			if testCase.marshalError != nil || string(testCase.initialBytes) == "mockInvalidJSON" {
+				assert.NotNil(t, err)
+				assert.Nil(t, resp)
+				return
+			}
+
+			assert.Nil(t, err)
+			presetList, err := PresetListFromJSON(resp)
+			assert.Nil(t, err)
+			assert.Equal(t, "Bug-Triage", presetList.ByName["bug-triage"].Name)
+			if testCase.initialBytes != nil {
+				assert.Len(t, presetList.ByName, 2)
+			}
+		})
+	}
+}
+
+func TestStorePreset(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description string
+		err         error
+	}{
+		{
+			description: "StorePreset: preset is stored successfully",
+		},
+		{
+			description: "StorePreset: preset is not stored successfully",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "AtomicModify", func(_ *Store, key string, _ func([]byte) ([]byte, error)) error {
+				assert.Equal(t, GetPresetListMapKey(testutils.MockChannelID), key)
+				return testCase.err
+			})
+
+			err := s.StorePreset(&serializers.WorkItemPreset{ChannelID: testutils.MockChannelID})
+
+			if testCase.err != nil {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestGetPreset(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description    string
+		presetName     string
+		err            error
+		expectedPreset bool
+	}{
+		{
+			description:    "GetPreset: preset is fetched successfully",
+			presetName:     "bug-triage",
+			expectedPreset: true,
+		},
+		{
+			description:    "GetPreset: preset name is case insensitive",
+			presetName:     "BUG-TRIAGE",
+			expectedPreset: true,
+		},
+		{
+			description: "GetPreset: preset does not exist",
+			presetName:  "mockPreset",
+		},
+		{
+			description: "GetPreset: 'Load' gives error",
+			presetName:  "bug-triage",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "Load", func(*Store, string) ([]byte, error) {
+				return []byte(`{"ByName":{"bug-triage":{"name":"Bug-Triage","type":"Bug"}}}`), testCase.err
+			})
+
+			preset, err := s.GetPreset(testutils.MockChannelID, testCase.presetName)
+
+			if testCase.err != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, preset)
+				return
+			}
+
+			assert.Nil(t, err)
+			if testCase.expectedPreset {
+				assert.Equal(t, "Bug", preset.Type)
+				return
+			}
+			assert.Nil(t, preset)
+		})
+	}
+}
+
+func TestGetAllPresets(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description string
+		err         error
+	}{
+		{
+			description: "GetAllPresets: presets are fetched successfully",
+		},
+		{
+			description: "GetAllPresets: presets are not fetched successfully",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "Load", func(*Store, string) ([]byte, error) {
+				return []byte(`{"ByName":{"triage":{"name":"triage"},"feature":{"name":"feature"}}}`), testCase.err
+			})
+
+			presets, err := s.GetAllPresets(testutils.MockChannelID)
+
+			if testCase.err != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, presets)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, presets, 2)
+			assert.Equal(t, "feature", presets[0].Name)
+			assert.Equal(t, "triage", presets[1].Name)
+		})
+	}
+}
+
+func TestDeletePresetAtomicModify(t *testing.T) {
+	defer monkey.UnpatchAll()
+	resp, err := deletePresetAtomicModify("Triage", []byte(`{"ByName":{"triage":{"name":"triage"},"feature":{"name":"feature"}}}`))
+	assert.Nil(t, err)
+
+	presetList, err := PresetListFromJSON(resp)
+	assert.Nil(t, err)
+	assert.Len(t, presetList.ByName, 1)
+	assert.Contains(t, presetList.ByName, "feature")
+}
+
+func TestDeletePreset(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description string
+		err         error
+	}{
+		{
+			description: "DeletePreset: preset is deleted successfully",
+		},
+		{
+			description: "DeletePreset: preset is not deleted successfully",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "AtomicModify", func(*Store, string, func([]byte) ([]byte, error)) error {
+				return testCase.err
+			})
+
+			err := s.DeletePreset(testutils.MockChannelID, "mockPreset")
+
+			if testCase.err != nil {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package store
+
+import (
+	"encoding/json"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+)
+
+type PullRequestThreadStore interface {
+	StorePullRequestThreadPost(organization string, pullRequestID, threadID int, channelID, postID string) error
+	GetPullRequestThreadPosts(organization string, pullRequestID, threadID int) (PullRequestThreadPostList, error)
+	MarkPullRequestCommentMirrored(organization string, pullRequestID, threadID, commentID int) error
+	IsPullRequestCommentMirrored(organization string, pullRequestID, threadID, commentID int) (bool, error)
+}
+
+// PullRequestThreadPostList maps the channel IDs to the root post IDs of the notifications of a pull request thread
+type PullRequestThreadPostList map[string]string
+
+// StorePullRequestThreadPost stores the root post of the notification of a pull request thread in a channel.
+// Later comments in the pull request thread are posted as replies to this post.
+func (s *Store) StorePullRequestThreadPost(organization string, pullRequestID, threadID int, channelID, postID string) error {
+	return s.AtomicModify(GetPullRequestThreadKey(organization, pullRequestID, threadID), func(initialBytes []byte) ([]byte, error) {
+		postList := PullRequestThreadPostList{}
+		if len(initialBytes) != 0 {
+			if err := json.Unmarshal(initialBytes, &postList); err != nil {
+				return nil, err
+			}
+		}
+
+		postList[channelID] = postID
+		return json.Marshal(postList)
+	})
+}
+
+// GetPullRequestThreadPosts returns the root posts of the notifications of a pull request thread mapped by their channel IDs
+func (s *Store) GetPullRequestThreadPosts(organization string, pullRequestID, threadID int) (PullRequestThreadPostList, error) {
+	var postList PullRequestThreadPostList
+	if err := s.LoadJSON(GetPullRequestThreadKey(organization, pullRequestID, threadID), &postList); err != nil {
+		return nil, err
+	}
+
+	return postList, nil
+}
+
+// MarkPullRequestCommentMirrored marks a comment added to a pull request thread for a reply in Mattermost,
+// so that the notification of the comment is not posted again in the thread of the reply.
+func (s *Store) MarkPullRequestCommentMirrored(organization string, pullRequestID, threadID, commentID int) error {
+	_, err := s.StoreWithOptions(GetMirroredPullRequestCommentKey(organization, pullRequestID, threadID, commentID), []byte{1}, model.PluginKVSetOptions{
+		ExpireInSeconds: constants.TTLSecondsForMirroredPullRequestComment,
+	})
+	return err
+}
+
+// IsPullRequestCommentMirrored returns whether a comment of a pull request thread was added for a reply in Mattermost
+func (s *Store) IsPullRequestCommentMirrored(organization string, pullRequestID, threadID, commentID int) (bool, error) {
+	data, err := s.Load(GetMirroredPullRequestCommentKey(organization, pullRequestID, threadID, commentID))
+	if err != nil {
+		return false, err
+	}
+
+	return len(data) != 0, nil
+}
@@ -0,0 +1,162 @@
+package store
+
+import (
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestStorePullRequestThreadPost(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description    string
+		initialBytes   []byte
+		expectedResult string
+		expectedError  bool
+	}{
+		{
+			description:    "StorePullRequestThreadPost: post is added to an empty list",
+			expectedResult: `{"mockChannelID":"mockRootID"}`,
+		},
+		{
+			description:    "StorePullRequestThreadPost: post is added to the existing posts",
+			initialBytes:   []byte(`{"mockOtherChannelID":"mockOtherRootID"}`),
+			expectedResult: `{"mockOtherChannelID":"mockOtherRootID","mockChannelID":"mockRootID"}`,
+		},
+		{
+			description:   "StorePullRequestThreadPost: unmarshaling gives error",
+			initialBytes:  []byte("mockInvalidJSON"),
+			expectedError: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "AtomicModify", func(_ *Store, key string, modify func([]byte) ([]byte, error)) error {
+				assert.Equal(t, GetPullRequestThreadKey(testutils.MockOrganization, 1, 5), key)
+				resp, err := modify(testCase.initialBytes)
+				if err != nil {
+					return err
+				}
+
+				assert.JSONEq(t, testCase.expectedResult, string(resp))
+				return nil
+			})
+
+			err := s.StorePullRequestThreadPost(testutils.MockOrganization, 1, 5, testutils.MockChannelID, "mockRootID")
+
+			if testCase.expectedError {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestGetPullRequestThreadPosts(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description string
+		err         error
+	}{
+		{
+			description: "GetPullRequestThreadPosts: posts are fetched successfully",
+		},
+		{
+			description: "GetPullRequestThreadPosts: 'Load' gives error",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "Load", func(_ *Store, key string) ([]byte, error) {
+				assert.Equal(t, GetPullRequestThreadKey(testutils.MockOrganization, 1, 5), key)
+				return []byte(`{"mockChannelID":"mockRootID"}`), testCase.err
+			})
+
+			postList, err := s.GetPullRequestThreadPosts(testutils.MockOrganization, 1, 5)
+
+			if testCase.err != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, postList)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, PullRequestThreadPostList{testutils.MockChannelID: "mockRootID"}, postList)
+		})
+	}
+}
+
+func TestMarkPullRequestCommentMirrored(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description string
+		err         error
+	}{
+		{
+			description: "MarkPullRequestCommentMirrored: comment is marked successfully",
+		},
+		{
+			description: "MarkPullRequestCommentMirrored: 'StoreWithOptions' gives error",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "StoreWithOptions", func(_ *Store, key string, _ []byte, opts model.PluginKVSetOptions) (bool, error) {
+				assert.Equal(t, GetMirroredPullRequestCommentKey(testutils.MockOrganization, 1, 5, 2), key)
+				assert.Equal(t, constants.TTLSecondsForMirroredPullRequestComment, opts.ExpireInSeconds)
+				return testCase.err == nil, testCase.err
+			})
+
+			err := s.MarkPullRequestCommentMirrored(testutils.MockOrganization, 1, 5, 2)
+
+			assert.Equal(t, testCase.err, err)
+		})
+	}
+}
+
+func TestIsPullRequestCommentMirrored(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description        string
+		data               []byte
+		err                error
+		expectedIsMirrored bool
+	}{
+		{
+			description:        "IsPullRequestCommentMirrored: comment is mirrored",
+			data:               []byte{1},
+			expectedIsMirrored: true,
+		},
+		{
+			description: "IsPullRequestCommentMirrored: comment is not mirrored",
+		},
+		{
+			description: "IsPullRequestCommentMirrored: 'Load' gives error",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "Load", func(_ *Store, key string) ([]byte, error) {
+				assert.Equal(t, GetMirroredPullRequestCommentKey(testutils.MockOrganization, 1, 5, 2), key)
+				return testCase.data, testCase.err
+			})
+
+			isMirrored, err := s.IsPullRequestCommentMirrored(testutils.MockOrganization, 1, 5, 2)
+
+			assert.Equal(t, testCase.err, err)
+			assert.Equal(t, testCase.expectedIsMirrored, isMirrored)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package store
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/mattermost/mattermost-server/v5/model"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+type ReviewReminderStore interface {
+	StoreReviewReminder(reminder *serializers.ReviewReminder) error
+	GetReviewReminders() ([]*serializers.ReviewReminder, error)
+	DeleteReviewReminder(channelID, reminderID string) (bool, error)
+	MarkReviewReminderSent(reminderID, occurrence string) (bool, error)
+}
+
+// ReviewReminderList maps the IDs of the review reminders of all the channels to the reminders, they are stored under a single key to be run by the reminder job
+type ReviewReminderList map[string]*serializers.ReviewReminder
+
+func reviewReminderListFromJSON(bytes []byte) (ReviewReminderList, error) {
+	reminderList := ReviewReminderList{}
+	if len(bytes) != 0 {
+		if err := json.Unmarshal(bytes, &reminderList); err != nil {
+			return nil, err
+		}
+	}
+
+	return reminderList, nil
+}
+
+// StoreReviewReminder adds the reminder, replacing any existing reminder with the same ID
+func (s *Store) StoreReviewReminder(reminder *serializers.ReviewReminder) error {
+	return s.AtomicModify(constants.ReviewRemindersKey, func(initialBytes []byte) ([]byte, error) {
+		reminderList, err := reviewReminderListFromJSON(initialBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		reminderList[reminder.ID] = reminder
+		return json.Marshal(reminderList)
+	})
+}
+
+// GetReviewReminders returns the review reminders of all the channels sorted by ID
+func (s *Store) GetReviewReminders() ([]*serializers.ReviewReminder, error) {
+	initialBytes, err := s.Load(constants.ReviewRemindersKey)
+	if err != nil {
+		return nil, err
+	}
+
+	reminderList, err := reviewReminderListFromJSON(initialBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	reminders := make([]*serializers.ReviewReminder, 0, len(reminderList))
+	for _, reminder := range reminderList {
+		reminders = append(reminders, reminder)
+	}
+
+	sort.Slice(reminders, func(i, j int) bool {
+		return reminders[i].ID < reminders[j].ID
+	})
+	return reminders, nil
+}
+
+// DeleteReviewReminder deletes a reminder of the channel, it returns false if the channel has no reminder with the ID
+func (s *Store) DeleteReviewReminder(channelID, reminderID string) (bool, error) {
+	isDeleted := false
+	err := s.AtomicModify(constants.ReviewRemindersKey, func(initialBytes []byte) ([]byte, error) {
+		reminderList, err := reviewReminderListFromJSON(initialBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		reminder, ok := reminderList[reminderID]
+		isDeleted = ok && reminder.ChannelID == channelID
+		if !isDeleted {
+			return initialBytes, nil
+		}
+
+		delete(reminderList, reminderID)
+		return json.Marshal(reminderList)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return isDeleted, nil
+}
+
+// MarkReviewReminderSent marks an occurrence of a reminder as sent, it returns false if it is already marked as sent by another server of the cluster
+func (s *Store) MarkReviewReminderSent(reminderID, occurrence string) (bool, error) {
+	return s.StoreWithOptions(GetReviewReminderSentKey(reminderID, occurrence), []byte{1}, model.PluginKVSetOptions{
+		Atomic:          true,
+		OldValue:        nil,
+		ExpireInSeconds: constants.TTLSecondsForReviewReminderSent,
+	})
+}
@@ -0,0 +1,164 @@
+package store
+
+import (
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestStoreReviewReminder(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description    string
+		initialBytes   []byte
+		expectedResult string
+		expectedError  bool
+	}{
+		{
+			description:    "StoreReviewReminder: reminder is added",
+			initialBytes:   []byte(`{"mockOtherID":{"id":"mockOtherID","channelID":"mockOtherChannelID"}}`),
+			expectedResult: `{"mockOtherID":{"id":"mockOtherID","channelID":"mockOtherChannelID","organizationName":"","projectName":"","repository":"","days":null,"time":"","timezone":"","minAge":"","createdBy":""},"mockReminderID":{"id":"mockReminderID","channelID":"mockChannelID","organizationName":"","projectName":"","repository":"","days":["mon"],"time":"09:30","timezone":"","minAge":"","createdBy":""}}`,
+		},
+		{
+			description:   "StoreReviewReminder: unmarshaling gives error",
+			initialBytes:  []byte("mockInvalidJSON"),
+			expectedError: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "AtomicModify", func(_ *Store, key string, modify func([]byte) ([]byte, error)) error {
+				assert.Equal(t, constants.ReviewRemindersKey, key)
+				resp, err := modify(testCase.initialBytes)
+				if err != nil {
+					return err
+				}
+
+				assert.JSONEq(t, testCase.expectedResult, string(resp))
+				return nil
+			})
+
+			err := s.StoreReviewReminder(&serializers.ReviewReminder{ID: "mockReminderID", ChannelID: testutils.MockChannelID, Days: []string{"mon"}, Time: "09:30"})
+
+			if testCase.expectedError {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestGetReviewReminders(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description string
+		storedBytes []byte
+		err         error
+		expectedIDs []string
+	}{
+		{
+			description: "GetReviewReminders: no reminders are stored",
+			expectedIDs: []string{},
+		},
+		{
+			description: "GetReviewReminders: reminders are sorted by ID",
+			storedBytes: []byte(`{"b":{"id":"b"},"a":{"id":"a"}}`),
+			expectedIDs: []string{"a", "b"},
+		},
+		{
+			description: "GetReviewReminders: 'Load' gives error",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "Load", func(_ *Store, key string) ([]byte, error) {
+				assert.Equal(t, constants.ReviewRemindersKey, key)
+				return testCase.storedBytes, testCase.err
+			})
+
+			reminders, err := s.GetReviewReminders()
+
+			if testCase.err != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, reminders)
+				return
+			}
+
+			assert.Nil(t, err)
+			ids := []string{}
+			for _, reminder := range reminders {
+				ids = append(ids, reminder.ID)
+			}
+			assert.Equal(t, testCase.expectedIDs, ids)
+		})
+	}
+}
+
+func TestDeleteReviewReminder(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description       string
+		channelID         string
+		expectedResult    string
+		expectedIsDeleted bool
+	}{
+		{
+			description:       "DeleteReviewReminder: reminder is deleted",
+			channelID:         testutils.MockChannelID,
+			expectedResult:    `{}`,
+			expectedIsDeleted: true,
+		},
+		{
+			description:    "DeleteReviewReminder: reminder belongs to another channel",
+			channelID:      "mockOtherChannelID",
+			expectedResult: `{"mockReminderID":{"id":"mockReminderID","channelID":"mockChannelID"}}`,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "AtomicModify", func(_ *Store, key string, modify func([]byte) ([]byte, error)) error {
+				assert.Equal(t, constants.ReviewRemindersKey, key)
+				resp, err := modify([]byte(`{"mockReminderID":{"id":"mockReminderID","channelID":"mockChannelID"}}`))
+				if err != nil {
+					return err
+				}
+
+				assert.JSONEq(t, testCase.expectedResult, string(resp))
+				return nil
+			})
+
+			isDeleted, err := s.DeleteReviewReminder(testCase.channelID, "mockReminderID")
+
+			assert.Nil(t, err)
+			assert.Equal(t, testCase.expectedIsDeleted, isDeleted)
+		})
+	}
+}
+
+func TestMarkReviewReminderSent(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	monkey.PatchInstanceMethod(reflect.TypeOf(&s), "StoreWithOptions", func(_ *Store, key string, _ []byte, opts model.PluginKVSetOptions) (bool, error) {
+		assert.Equal(t, GetReviewReminderSentKey("mockReminderID", "2022-01-10"), key)
+		assert.True(t, opts.Atomic)
+		assert.Nil(t, opts.OldValue)
+		assert.Equal(t, constants.TTLSecondsForReviewReminderSent, opts.ExpireInSeconds)
+		return true, nil
+	})
+
+	isNotSent, err := s.MarkReviewReminderSent("mockReminderID", "2022-01-10")
+
+	assert.Nil(t, err)
+	assert.True(t, isNotSent)
+}
@@ -13,6 +13,13 @@ type KVStore interface {
 	UserStore
 	LinkStore
 	SubscriptionStore
+	PresetStore
+	ThreadLinkStore
+	PullRequestThreadStore
+	PersonalNotificationStore
+	IdentityOverrideStore
+	ReviewReminderStore
+	PipelineApprovalStore
 	DeleteUserTokenOnEncryptionSecretChange() error
 }
 
@@ -64,6 +64,12 @@ func (subscriptionList *SubscriptionList) AddSubscription(userID string, subscri
 		subscriptionList.ByMattermostUserID[userID] = make(SubscriptionListMap)
 	}
 
+	// The creation time is kept when a stored subscription is updated
+	createdAt := subscription.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now().UTC()
+	}
+
 	subscriptionListValue := serializers.SubscriptionDetails{
 		SubscriptionID:                   subscription.SubscriptionID,
 		MattermostUserID:                 userID,
@@ -76,7 +82,7 @@ func (subscriptionList *SubscriptionList) AddSubscription(userID string, subscri
 		ChannelName:                      subscription.ChannelName,
 		ChannelType:                      subscription.ChannelType,
 		CreatedBy:                        subscription.CreatedBy,
-		CreatedAt:                        time.Now().UTC(),
+		CreatedAt:                        createdAt,
 		Repository:                       subscription.Repository,
 		TargetBranch:                     subscription.TargetBranch,
 		RepositoryName:                   subscription.RepositoryName,
@@ -115,6 +121,8 @@ func (subscriptionList *SubscriptionList) AddSubscription(userID string, subscri
 		RunStateID:                       subscription.RunStateID,
 		RunStateIDName:                   subscription.RunStateIDName,
 		RunResultID:                      subscription.RunResultID,
+		WorkItemFilters:                  subscription.WorkItemFilters,
+		CodePushFilters:                  subscription.CodePushFilters,
 	}
 	subscriptionList.ByMattermostUserID[userID][subscription.SubscriptionID] = subscriptionListValue
 }
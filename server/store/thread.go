@@ -0,0 +1,84 @@
+package store
+
+import (
+	"encoding/json"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+)
+
+type ThreadLinkStore interface {
+	StoreThreadLink(threadLink *serializers.ThreadLink) error
+	GetThreadLink(postID string) (*serializers.ThreadLink, error)
+	GetThreadLinksForWorkItem(organization, workItemID string) ([]*serializers.ThreadLink, error)
+	DeleteThreadLink(threadLink *serializers.ThreadLink) error
+}
+
+// WorkItemThreadList maps the root post IDs of the threads linked to a work item to their channel IDs
+type WorkItemThreadList map[string]string
+
+func (s *Store) StoreThreadLink(threadLink *serializers.ThreadLink) error {
+	if err := s.StoreJSON(GetThreadLinkKey(threadLink.PostID), threadLink); err != nil {
+		return err
+	}
+
+	return s.AtomicModify(GetWorkItemThreadsKey(threadLink.OrganizationName, threadLink.WorkItemID), func(initialBytes []byte) ([]byte, error) {
+		return modifyWorkItemThreadList(initialBytes, func(threadList WorkItemThreadList) {
+			threadList[threadLink.PostID] = threadLink.ChannelID
+		})
+	})
+}
+
+// GetThreadLink returns the work item link of the thread with the given root post ID, or nil if the thread is not linked
+func (s *Store) GetThreadLink(postID string) (*serializers.ThreadLink, error) {
+	var threadLink *serializers.ThreadLink
+	if err := s.LoadJSON(GetThreadLinkKey(postID), &threadLink); err != nil {
+		return nil, err
+	}
+
+	return threadLink, nil
+}
+
+func (s *Store) GetThreadLinksForWorkItem(organization, workItemID string) ([]*serializers.ThreadLink, error) {
+	var threadList WorkItemThreadList
+	if err := s.LoadJSON(GetWorkItemThreadsKey(organization, workItemID), &threadList); err != nil {
+		return nil, err
+	}
+
+	var threadLinks []*serializers.ThreadLink
+	for postID := range threadList {
+		threadLink, err := s.GetThreadLink(postID)
+		if err != nil {
+			return nil, err
+		}
+
+		if threadLink != nil {
+			threadLinks = append(threadLinks, threadLink)
+		}
+	}
+
+	return threadLinks, nil
+}
+
+func (s *Store) DeleteThreadLink(threadLink *serializers.ThreadLink) error {
+	if err := s.Delete(GetThreadLinkKey(threadLink.PostID)); err != nil {
+		return err
+	}
+
+	return s.AtomicModify(GetWorkItemThreadsKey(threadLink.OrganizationName, threadLink.WorkItemID), func(initialBytes []byte) ([]byte, error) {
+		return modifyWorkItemThreadList(initialBytes, func(threadList WorkItemThreadList) {
+			delete(threadList, threadLink.PostID)
+		})
+	})
+}
+
+func modifyWorkItemThreadList(initialBytes []byte, modify func(threadList WorkItemThreadList)) ([]byte, error) {
+	threadList := WorkItemThreadList{}
+	if len(initialBytes) != 0 {
+		if err := json.Unmarshal(initialBytes, &threadList); err != nil {
+			return nil, err
+		}
+	}
+
+	modify(threadList)
+	return json.Marshal(threadList)
+}
@@ -0,0 +1,135 @@
+package store
+
+import (
+	"reflect"
+	"testing"
+
+	"bou.ke/monkey"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/testutils"
+)
+
+func TestModifyWorkItemThreadList(t *testing.T) {
+	for _, testCase := range []struct {
+		description    string
+		initialBytes   []byte
+		expectedResult string
+		expectedError  bool
+	}{
+		{
+			description:    "ModifyWorkItemThreadList: thread is added to an empty list",
+			expectedResult: `{"mockRootID":"mockChannelID"}`,
+		},
+		{
+			description:    "ModifyWorkItemThreadList: thread is added to the existing threads",
+			initialBytes:   []byte(`{"mockOtherRootID":"mockOtherChannelID"}`),
+			expectedResult: `{"mockOtherRootID":"mockOtherChannelID","mockRootID":"mockChannelID"}`,
+		},
+		{
+			description:   "ModifyWorkItemThreadList: unmarshaling gives error",
+			initialBytes:  []byte("mockInvalidJSON"),
+			expectedError: true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			resp, err := modifyWorkItemThreadList(testCase.initialBytes, func(threadList WorkItemThreadList) {
+				threadList["mockRootID"] = testutils.MockChannelID
+			})
+
+			if testCase.expectedError {
+				assert.NotNil(t, err)
+				assert.Nil(t, resp)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.JSONEq(t, testCase.expectedResult, string(resp))
+		})
+	}
+}
+
+func TestStoreThreadLink(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description       string
+		storeError        error
+		atomicModifyError error
+	}{
+		{
+			description: "StoreThreadLink: thread link is stored successfully",
+		},
+		{
+			description: "StoreThreadLink: 'StoreJSON' gives error",
+			storeError:  errors.New("mockError"),
+		},
+		{
+			description:       "StoreThreadLink: 'AtomicModify' gives error",
+			atomicModifyError: errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "StoreJSON", func(_ *Store, key string, _ interface{}) error {
+				assert.Equal(t, GetThreadLinkKey("mockRootID"), key)
+				return testCase.storeError
+			})
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "AtomicModify", func(_ *Store, key string, _ func([]byte) ([]byte, error)) error {
+				assert.Equal(t, GetWorkItemThreadsKey(testutils.MockOrganization, "1"), key)
+				return testCase.atomicModifyError
+			})
+
+			err := s.StoreThreadLink(&serializers.ThreadLink{PostID: "mockRootID", OrganizationName: testutils.MockOrganization, WorkItemID: "1"})
+
+			if testCase.storeError != nil || testCase.atomicModifyError != nil {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestGetThreadLinksForWorkItem(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	for _, testCase := range []struct {
+		description string
+		err         error
+	}{
+		{
+			description: "GetThreadLinksForWorkItem: thread links are fetched successfully",
+		},
+		{
+			description: "GetThreadLinksForWorkItem: 'Load' gives error",
+			err:         errors.New("mockError"),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			monkey.PatchInstanceMethod(reflect.TypeOf(&s), "Load", func(_ *Store, key string) ([]byte, error) {
+				switch key {
+				case GetWorkItemThreadsKey(testutils.MockOrganization, "1"):
+					return []byte(`{"mockRootID":"mockChannelID","mockDeletedRootID":"mockChannelID"}`), testCase.err
+				case GetThreadLinkKey("mockRootID"):
+					return []byte(`{"postID":"mockRootID","channelID":"mockChannelID"}`), nil
+				}
+				return nil, nil
+			})
+
+			threadLinks, err := s.GetThreadLinksForWorkItem(testutils.MockOrganization, "1")
+
+			if testCase.err != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, threadLinks)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Len(t, threadLinks, 1)
+			assert.Equal(t, "mockRootID", threadLinks[0].PostID)
+		})
+	}
+}
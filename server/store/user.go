@@ -6,6 +6,7 @@ type UserStore interface {
 	StoreAzureDevopsUserDetailsWithMattermostUserID(user *serializers.User) error
 	LoadAzureDevopsUserIDFromMattermostUser(mattermostUserID string) (string, error)
 	LoadAzureDevopsUserDetails(userID string) (*serializers.User, error)
+	LoadAzureDevopsUserIDFromEmail(email string) (string, error)
 	DeleteUser(mattermostUserID string) (bool, error)
 }
 
@@ -18,6 +19,13 @@ func (s *Store) StoreAzureDevopsUserDetailsWithMattermostUserID(user *serializer
 		return err
 	}
 
+	// The email of the connected user is used to match the Azure DevOps identities which only have a unique name
+	if user.Email != "" {
+		if err := s.Store(GetAzureDevopsUserEmailKey(user.Email), []byte(user.ID)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -38,6 +46,17 @@ func (s *Store) LoadAzureDevopsUserDetails(userID string) (*serializers.User, er
 	return &user, nil
 }
 
+// LoadAzureDevopsUserIDFromEmail returns the ID of the connected Azure DevOps user with an email, or an empty string if there is none.
+// The user details of the ID are deleted when the user disconnects, so the ID of a disconnected user does not resolve to a Mattermost user.
+func (s *Store) LoadAzureDevopsUserIDFromEmail(email string) (string, error) {
+	azureDevopsUserID, err := s.Load(GetAzureDevopsUserEmailKey(email))
+	if err != nil {
+		return "", err
+	}
+
+	return string(azureDevopsUserID), nil
+}
+
 func (s *Store) DeleteUser(mattermostUserID string) (bool, error) {
 	azureDevopsUserID, err := s.LoadAzureDevopsUserIDFromMattermostUser(mattermostUserID)
 	if err != nil {
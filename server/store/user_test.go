@@ -53,6 +53,20 @@ func TestStoreUser(t *testing.T) {
 	}
 }
 
+func TestLoadAzureDevopsUserIDFromEmail(t *testing.T) {
+	defer monkey.UnpatchAll()
+	s := Store{}
+	monkey.PatchInstanceMethod(reflect.TypeOf(&s), "Load", func(_ *Store, key string) ([]byte, error) {
+		assert.Equal(t, GetAzureDevopsUserEmailKey("john@example.com"), key)
+		return []byte(testutils.MockAzureDevopsUserID), nil
+	})
+
+	azureDevopsUserID, err := s.LoadAzureDevopsUserIDFromEmail("John@Example.com")
+
+	assert.Nil(t, err)
+	assert.Equal(t, testutils.MockAzureDevopsUserID, azureDevopsUserID)
+}
+
 func TestLoadUser(t *testing.T) {
 	defer monkey.UnpatchAll()
 	s := Store{}
@@ -140,6 +140,63 @@ func GetSubscriptionListMapKey() string {
 	return constants.SubscriptionPrefix
 }
 
+func GetPresetListMapKey(channelID string) string {
+	return fmt.Sprintf(constants.PresetPrefix, channelID)
+}
+
+func GetThreadLinkKey(postID string) string {
+	return fmt.Sprintf(constants.ThreadLinkPrefix, postID)
+}
+
+// GetWorkItemThreadsKey returns the key of the threads linked to a work item, work item IDs are unique within an organization
+func GetWorkItemThreadsKey(organization, workItemID string) string {
+	return fmt.Sprintf(constants.WorkItemThreadsPrefix, GetKeyMD5Hash(fmt.Sprintf(constants.WorkItemKey, strings.ToLower(organization), workItemID)))
+}
+
+// GetPullRequestThreadKey returns the key of the posts of a pull request thread, pull request IDs are unique within an organization
+func GetPullRequestThreadKey(organization string, pullRequestID, threadID int) string {
+	return fmt.Sprintf(constants.PullRequestThreadPrefix, GetKeyMD5Hash(fmt.Sprintf(constants.PullRequestThreadKey, strings.ToLower(organization), pullRequestID, threadID)))
+}
+
+// GetMirroredPullRequestCommentKey returns the key marking a comment of a pull request thread as mirrored from a reply in Mattermost
+func GetMirroredPullRequestCommentKey(organization string, pullRequestID, threadID, commentID int) string {
+	return fmt.Sprintf(constants.MirroredPullRequestCommentPrefix, GetKeyMD5Hash(fmt.Sprintf(constants.MirroredPullRequestCommentKey, strings.ToLower(organization), pullRequestID, threadID, commentID)))
+}
+
+func GetPersonalNotificationSettingsKey(mattermostUserID string) string {
+	return fmt.Sprintf(constants.PersonalNotificationSettingsPrefix, mattermostUserID)
+}
+
+// GetPersonalNotificationSentKey returns the key marking a personal notification of an event as sent to a user
+func GetPersonalNotificationSentKey(eventID, kind, mattermostUserID string) string {
+	return fmt.Sprintf(constants.PersonalNotificationSentPrefix, GetKeyMD5Hash(fmt.Sprintf(constants.PersonalNotificationSentKey, eventID, kind, mattermostUserID)))
+}
+
+// GetPullRequestReviewersKey returns the key of the tracked reviewers of a pull request, repository IDs are globally unique
+func GetPullRequestReviewersKey(repositoryID string, pullRequestID int) string {
+	return fmt.Sprintf(constants.PullRequestReviewersPrefix, GetKeyMD5Hash(fmt.Sprintf(constants.PullRequestReviewersKey, strings.ToLower(repositoryID), pullRequestID)))
+}
+
+// GetAzureDevopsUserEmailKey returns the key of the connected Azure DevOps user with an email, emails are case insensitive
+func GetAzureDevopsUserEmailKey(email string) string {
+	return fmt.Sprintf(constants.AzureDevOpsUserEmailPrefix, GetKeyMD5Hash(strings.ToLower(email)))
+}
+
+// GetReviewReminderSentKey returns the key marking an occurrence of a review reminder as sent, the occurrences are the dates of the reminder e.g. "2022-01-10"
+func GetReviewReminderSentKey(reminderID, occurrence string) string {
+	return fmt.Sprintf(constants.ReviewReminderSentPrefix, GetKeyMD5Hash(fmt.Sprintf(constants.ReviewReminderSentKey, reminderID, occurrence)))
+}
+
+// GetApprovalReminderSentKey returns the key marking a reminder of an approval as sent, the reminders are numbered from 1
+func GetApprovalReminderSentKey(approvalID string, reminder int) string {
+	return fmt.Sprintf(constants.ApprovalReminderSentPrefix, GetKeyMD5Hash(fmt.Sprintf(constants.ApprovalReminderSentKey, approvalID, reminder)))
+}
+
+// GetApprovalPostsKey returns the key of the posts of a release or run approval, the organization names are case insensitive
+func GetApprovalPostsKey(requestName, organization, approvalID string) string {
+	return fmt.Sprintf(constants.ApprovalPostsPrefix, GetKeyMD5Hash(fmt.Sprintf(constants.ApprovalPostsKey, requestName, strings.ToLower(organization), approvalID)))
+}
+
 // GetKeyMD5Hash can be used to create a md5 hash from a string
 func GetKeyMD5Hash(key string) string {
 	// #nosec : The hash generated by the code below does not consist of any sensitive data
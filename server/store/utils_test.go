@@ -6,9 +6,11 @@ import (
 	"testing"
 
 	"bou.ke/monkey"
+	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
 	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
 )
 
@@ -137,3 +139,29 @@ func TestStoreJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMirroredPullRequestCommentKey(t *testing.T) {
+	key := GetMirroredPullRequestCommentKey("mockOrganizationWithALongName", 123456, 654321, 42)
+
+	assert.LessOrEqual(t, len(key), model.KEY_VALUE_KEY_MAX_RUNES)
+	assert.Equal(t, key, GetMirroredPullRequestCommentKey("MockOrganizationWithALongName", 123456, 654321, 42))
+}
+
+func TestGetPersonalNotificationSentKey(t *testing.T) {
+	key := GetPersonalNotificationSentKey("9b5e1c3a-6f0d-4d4e-8a52-2c1f3b7e9d10", constants.PersonalNotificationAssignments, model.NewId())
+
+	assert.LessOrEqual(t, len(key), model.KEY_VALUE_KEY_MAX_RUNES)
+}
+
+func TestGetReviewReminderSentKey(t *testing.T) {
+	key := GetReviewReminderSentKey(model.NewId(), "2022-01-10")
+
+	assert.LessOrEqual(t, len(key), model.KEY_VALUE_KEY_MAX_RUNES)
+}
+
+func TestGetApprovalReminderSentKey(t *testing.T) {
+	key := GetApprovalReminderSentKey("9b5e1c3a-6f0d-4d4e-8a52-2c1f3b7e9d10", 12)
+
+	assert.LessOrEqual(t, len(key), model.KEY_VALUE_KEY_MAX_RUNES)
+	assert.NotEqual(t, key, GetApprovalReminderSentKey("9b5e1c3a-6f0d-4d4e-8a52-2c1f3b7e9d10", 13))
+}
@@ -1,11 +1,21 @@
 package testutils
 
 import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost-server/v5/model"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	"github.com/mattermost/mattermost-plugin-azure-devops/server/constants"
 	"github.com/mattermost/mattermost-plugin-azure-devops/server/serializers"
 )
 
+var MockApprovalCreatedAt = time.Date(2022, time.January, 10, 4, 5, 0, 0, time.UTC)
+
 const (
 	MockOrganization      = "mockOrganization"
 	MockProjectName       = "mockProjectName"
@@ -63,3 +73,247 @@ func GenerateStringOfSize(sizeInByte int) string {
 
 	return string(bytes)
 }
+
+func GetMockApprovalAttachment(requestName string, approvalID interface{}) *model.SlackAttachment {
+	return &model.SlackAttachment{
+		Title: "mockTitle",
+		Actions: []*model.PostAction{
+			{
+				Name: "Approve",
+				Integration: &model.PostActionIntegration{
+					Context: map[string]interface{}{
+						constants.PipelineRequestContextRequestName:  requestName,
+						constants.PipelineRequestContextOrganization: MockOrganization,
+						constants.PipelineRequestContextApprovalID:   approvalID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func GetMockBuildTimeline() *serializers.BuildTimeline {
+	return &serializers.BuildTimeline{
+		Records: []*serializers.TimelineRecord{
+			{ID: "mockStageID", Type: constants.TimelineRecordTypeStage, Name: "Build", Result: constants.BuildResultFailed},
+			{ID: "mockJobID", ParentID: "mockStageID", Type: constants.TimelineRecordTypeJob, Name: "Linux", Result: constants.BuildResultFailed},
+			{
+				ID:       "mockTaskID",
+				ParentID: "mockJobID",
+				Type:     constants.TimelineRecordTypeTask,
+				Name:     "Run tests",
+				Result:   constants.BuildResultFailed,
+				Log:      &serializers.TimelineRecordLog{ID: 7},
+				Issues: []*serializers.TimelineIssue{
+					{Type: "warning", Message: "mockWarning"},
+					{Type: constants.TimelineIssueTypeError, Message: "Process exited with `code` 1\nmockDetails"},
+				},
+			},
+		},
+	}
+}
+
+func GetMockFailedBuildNotification(eventType, result string) *serializers.SubscriptionNotification {
+	body := GetMockSubscriptionNotification(eventType)
+	body.ID = "mockEventID"
+	body.Message = serializers.DetailedMessage{Markdown: "Build [20240101.1](mockBuildURL) failed"}
+	body.Resource.Result = result
+	body.Resource.RequestedFor = serializers.RequestedFor{ID: "mockAzureDevopsUserID", Name: "mockDisplayName", UniqueName: "mock@example.com"}
+	body.Resource.Run.Result = result
+	body.Resource.Run.Links.Web.Href = "https://dev.azure.com/mockOrganization/mockProjectName/_build/results?buildId=1"
+	return body
+}
+
+func GetMockCodePushNotification(oldObjectID, newObjectID string, commits ...serializers.Commit) *serializers.SubscriptionNotification {
+	body := GetMockSubscriptionNotification(constants.SubscriptionEventCodePushed)
+	body.Resource.Repository = serializers.Repository{ID: "mockRepositoryID", Name: "mockRepo", RemoteURL: "https://dev.azure.com/mockOrganization/mockProjectName/_git/mockRepo"}
+	body.Resource.RefUpdates = []serializers.RefUpdates{{Name: "refs/heads/feature/mock", OldObjectID: oldObjectID, NewObjectID: newObjectID}}
+	body.Resource.Commits = commits
+
+	return body
+}
+
+func GetMockCommit(commitID, comment, authorName string) serializers.Commit {
+	return serializers.Commit{
+		CommitID: commitID,
+		Comment:  comment,
+		Author:   &serializers.CommitAuthor{Name: authorName, Email: "mock@example.com"},
+	}
+}
+
+func GetMockSubscriptionNotification(eventType string) *serializers.SubscriptionNotification {
+	return &serializers.SubscriptionNotification{
+		SubscriptionID: MockSubscriptionID,
+		EventType:      eventType,
+	}
+}
+
+func ParseMockSubscriptionNotification(t *testing.T, body string) *serializers.SubscriptionNotification {
+	notification := &serializers.SubscriptionNotification{}
+	require.Nil(t, json.Unmarshal([]byte(body), notification))
+	return notification
+}
+
+func GetMockPipelineApproval() *serializers.PipelineApproval {
+	return &serializers.PipelineApproval{
+		ApprovalID:       "mockApprovalID",
+		OrganizationName: MockOrganization,
+		ProjectID:        MockProjectID,
+		ChannelID:        MockChannelID,
+		PostID:           "mockPostID",
+		MattermostUserID: MockMattermostUserID,
+		PipelineName:     "mockPipeline",
+		PipelineURL:      "mockPipelineURL",
+		StageName:        "mockStage",
+		StageURL:         "mockStageURL",
+		CreatedAt:        MockApprovalCreatedAt,
+		ExpiresAt:        MockApprovalCreatedAt.Add(72 * time.Hour),
+	}
+}
+
+func GetMockPipelineApprovalPost() *model.Post {
+	post := &model.Post{Id: "mockPostID", ChannelId: MockChannelID}
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{
+		{
+			Fields: []*model.SlackAttachmentField{
+				{Title: "Run pipeline", Value: "[mockPipeline](mockPipelineURL)", Short: true},
+				{Title: "Stage", Value: "[mockStage](mockStageURL)", Short: true},
+				{Title: "Approver(s)", Value: "mockApprover\nmockOtherApprover\n"},
+				{Title: constants.ApprovalExpiresFieldTitle, Value: "in 2 day(s)", Short: true},
+			},
+			Actions: []*model.PostAction{{Id: constants.PipelineRequestIDApproved, Name: "Approve"}},
+		},
+	})
+
+	return post
+}
+
+func GetMockBuild(status, result string) *serializers.BuildDetails {
+	build := &serializers.BuildDetails{
+		ID:          1,
+		BuildNumber: "20240101.1",
+		Status:      status,
+		Result:      result,
+	}
+	build.Link.Web.Href = "mockBuildURL"
+	return build
+}
+
+func GetMockActivePullRequest(pullRequestID int, repository string, isDraft bool, reviewers []serializers.Reviewer) *serializers.PullRequest {
+	pullRequest := GetMockPullRequest(constants.PullRequestStatusActive)
+	pullRequest.PullRequestID = pullRequestID
+	pullRequest.Repository.Name = repository
+	pullRequest.IsDraft = isDraft
+	pullRequest.Reviewers = reviewers
+	return pullRequest
+}
+
+func GetMockPullRequest(status string) *serializers.PullRequest {
+	return &serializers.PullRequest{
+		PullRequestID:         1,
+		Title:                 "mockTitle",
+		Status:                status,
+		LastMergeSourceCommit: &serializers.Commit{CommitID: "mockCommitID"},
+		Repository: serializers.Repository{
+			ID:      "mockRepositoryID",
+			Name:    "mockRepository",
+			Project: serializers.Project{ID: MockProjectID, Name: MockProjectName},
+		},
+	}
+}
+
+func GetMockPullRequestThread() *serializers.PullRequestThreadReference {
+	return &serializers.PullRequestThreadReference{
+		Organization:  MockOrganization,
+		ProjectID:     MockProjectID,
+		RepositoryID:  "mockRepositoryID",
+		PullRequestID: 1,
+		ThreadID:      5,
+		CommentID:     1,
+	}
+}
+
+func GetMockReviewReminder() *serializers.ReviewReminder {
+	return &serializers.ReviewReminder{
+		ID:               "mockReminderID",
+		ChannelID:        MockChannelID,
+		OrganizationName: MockOrganization,
+		ProjectName:      MockProjectName,
+		Days:             []string{"mon", "tue", "wed", "thu", "fri"},
+		Time:             "09:30",
+		Timezone:         "Asia/Kolkata",
+		MinAge:           "24h",
+		CreatedBy:        MockMattermostUserID,
+	}
+}
+
+func GetMockIteration() *serializers.Iteration {
+	// Sprint of two weeks from Monday, 2 January 2023 to Friday, 13 January 2023
+	startDate := time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC)
+	finishDate := time.Date(2023, time.January, 13, 0, 0, 0, 0, time.UTC)
+	return &serializers.Iteration{
+		ID:   "mockIterationID",
+		Name: "Sprint 1",
+		Path: `mockProjectName\Sprint 1`,
+		Attributes: serializers.IterationAttributes{
+			StartDate:  &startDate,
+			FinishDate: &finishDate,
+		},
+	}
+}
+
+func GetMockSprintWorkItem(id int, state, assignedToID string, remainingWork float64, updatedAt time.Time) *serializers.TaskValue {
+	return &serializers.TaskValue{
+		ID: id,
+		Fields: serializers.TaskFieldValue{
+			Title:         "mockTitle",
+			Type:          "Task",
+			State:         state,
+			AssignedTo:    serializers.TaskUserDetails{ID: assignedToID, DisplayName: assignedToID},
+			UpdatedAt:     updatedAt,
+			RemainingWork: remainingWork,
+		},
+	}
+}
+
+func GetMockGitRepositoryList(names ...string) *serializers.GitRepositoryList {
+	repositoryList := &serializers.GitRepositoryList{Count: len(names)}
+	for _, name := range names {
+		repositoryList.Value = append(repositoryList.Value, &serializers.GitRepository{
+			ID:            fmt.Sprintf("%sID", name),
+			Name:          name,
+			DefaultBranch: "refs/heads/main",
+			WebURL:        fmt.Sprintf("https://dev.azure.com/mockOrganization/mockProjectName/_git/%s", name),
+			Project:       serializers.Project{ID: MockProjectID},
+		})
+	}
+
+	return repositoryList
+}
+
+func GetMockParentTask() *serializers.TaskValue {
+	parent := &serializers.TaskValue{
+		ID:  42,
+		URL: "https://dev.azure.com/mockOrganization/mockProjectID/_apis/wit/workItems/42",
+		Fields: serializers.TaskFieldValue{
+			Title:     "mockParentTitle",
+			Type:      "User Story",
+			AreaPath:  `mockProjectName\Web`,
+			Iteration: `mockProjectName\Sprint 1`,
+		},
+	}
+	parent.Link.HTML.Href = "mockParentLink"
+	return parent
+}
+
+func GetMockWorkItemUpdatedNotification(workItemType, tags string, priority float64, stateChange map[string]interface{}) *serializers.SubscriptionNotification {
+	body := GetMockSubscriptionNotification(constants.SubscriptionEventWorkItemUpdated)
+	body.Resource.Revision.Fields.WorkItemType = workItemType
+	body.Resource.Revision.Fields.Tags = tags
+	body.Resource.Revision.Fields.Priority = priority
+	if stateChange != nil {
+		body.Resource.Fields.State = stateChange
+	}
+
+	return body
+}
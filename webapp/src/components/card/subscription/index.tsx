@@ -18,8 +18,8 @@ type SubscriptionCardProps = {
     subscriptionDetails: SubscriptionDetails
 }
 
-const SubscriptionCard = ({handleDeleteSubscrption, subscriptionDetails: {channelType, eventType, serviceType, channelName, createdBy, targetBranch, repositoryName, pullRequestCreatedByName, pullRequestReviewersContainsName, pushedByName, mergeResultName, notificationTypeName, areaPath, releasePipelineName, buildPipeline, buildStatusName, approvalStatusName, approvalTypeName, releaseStatusName, stageNameValue, runPipelineName, runEnvironment, runStage, runStageId, runResultId, runStageResultId, runStageStateIdName, runStateIdName}, subscriptionDetails}: SubscriptionCardProps) => {
-    const showFilter = areaPath || repositoryName || targetBranch || pullRequestCreatedByName || pullRequestReviewersContainsName || pushedByName || mergeResultName || notificationTypeName || releasePipelineName || buildPipeline || buildStatusName || approvalStatusName || approvalTypeName || releaseStatusName || stageNameValue || runPipelineName || runEnvironment || runStage || runStageId || runResultId || runStageResultId || runStageStateIdName || runStateIdName;
+const SubscriptionCard = ({handleDeleteSubscrption, subscriptionDetails: {channelType, eventType, serviceType, channelName, createdBy, targetBranch, repositoryName, pullRequestCreatedByName, pullRequestReviewersContainsName, pushedByName, mergeResultName, notificationTypeName, areaPath, releasePipelineName, buildPipeline, buildStatusName, approvalStatusName, approvalTypeName, releaseStatusName, stageNameValue, runPipelineName, runEnvironment, runStage, runStageId, runResultId, runStageResultId, runStageStateIdName, runStateIdName, workItemFilters}, subscriptionDetails}: SubscriptionCardProps) => {
+    const showFilter = areaPath || repositoryName || targetBranch || pullRequestCreatedByName || pullRequestReviewersContainsName || pushedByName || mergeResultName || notificationTypeName || releasePipelineName || buildPipeline || buildStatusName || approvalStatusName || approvalTypeName || releaseStatusName || stageNameValue || runPipelineName || runEnvironment || runStage || runStageId || runResultId || runStageResultId || runStageStateIdName || runStateIdName || workItemFilters;
 
     return (
         <BaseCard>
@@ -104,6 +104,11 @@ const SubscriptionCard = ({handleDeleteSubscrption, subscriptionDetails: {channe
                                     {runStageResultId && <Chip text={`Stage result is: ${runStageResultId}`}/>}
                                     {runStateIdName && <Chip text={`State is: ${runStateIdName}`}/>}
                                     {runResultId && <Chip text={`Result is: ${runResultId}`}/>}
+                                    {Boolean(workItemFilters?.workItemTypes?.length) && <Chip text={`Work item type is one of: ${workItemFilters?.workItemTypes?.join(', ')}`}/>}
+                                    {workItemFilters?.tag && <Chip text={`Tags contain: ${workItemFilters.tag}`}/>}
+                                    {workItemFilters?.stateFrom && <Chip text={`State changed from: ${workItemFilters.stateFrom}`}/>}
+                                    {workItemFilters?.stateTo && <Chip text={`State changed to: ${workItemFilters.stateTo}`}/>}
+                                    {Boolean(workItemFilters?.maxPriority) && <Chip text={`Priority is at most: ${workItemFilters?.maxPriority}`}/>}
                                 </div>
                             </div>
                         )
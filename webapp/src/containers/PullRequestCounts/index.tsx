@@ -0,0 +1,45 @@
+import React, {useEffect} from 'react';
+
+import usePluginApi from 'hooks/usePluginApi';
+
+import {getWebsocketEventState} from 'selectors';
+
+import pluginConstants from 'pluginConstants';
+
+// Shows the number of pull requests waiting for the review of the user at the bottom of the team sidebar
+const PullRequestCounts = (): JSX.Element | null => {
+    const {state, makeApiRequest, getApiState} = usePluginApi();
+    const {isConnected} = getWebsocketEventState(state);
+    const {data} = getApiState(pluginConstants.pluginApiServiceConfigs.getPullRequestCounts.apiServiceName);
+    const counts = data as PullRequestCounts | undefined;
+
+    // The counts are refetched at an interval as the reviews happen in Azure DevOps
+    useEffect(() => {
+        if (!isConnected) {
+            return () => null;
+        }
+
+        const fetchCounts = () => makeApiRequest(pluginConstants.pluginApiServiceConfigs.getPullRequestCounts.apiServiceName);
+        fetchCounts();
+        const interval = setInterval(fetchCounts, pluginConstants.common.pullRequestCountsRefetchInterval);
+        return () => clearInterval(interval);
+    }, [isConnected]);
+
+    if (!isConnected || !counts) {
+        return null;
+    }
+
+    return (
+        <div
+            className='azure-devops-pull-request-counts'
+            title={pluginConstants.common.pullRequestCountsTooltip}
+        >
+            <span className='azure-devops-pull-request-counts__label'>{'PRs waiting for my review'}</span>
+            <span className={`azure-devops-pull-request-counts__badge ${counts.waitingForReview ? 'azure-devops-pull-request-counts__badge--active' : ''}`}>
+                {counts.waitingForReview}
+            </span>
+        </div>
+    );
+};
+
+export default PullRequestCounts;
@@ -5,6 +5,7 @@ import Modal from 'components/modal';
 import EmptyState from 'components/emptyState';
 import Form from 'components/form';
 import Dropdown from 'components/dropdown';
+import Input from 'components/inputField';
 
 import usePluginApi from 'hooks/usePluginApi';
 import useForm from 'hooks/useForm';
@@ -19,6 +20,30 @@ import {boardEventTypeOptions, subscriptionFiltersForBoards, subscriptionFilters
 
 import Utils, {formLabelValuePairs} from 'utils';
 
+// The fields which are shown by the modal itself
+const coreFieldReferenceNames = ['System.Title', 'System.Description', 'System.AreaPath'];
+
+const booleanFieldOptions: LabelValuePair[] = [
+    {value: 'true', label: 'Yes'},
+    {value: 'false', label: 'No'},
+];
+
+const isRequiredField = (field: WorkItemFieldDefinition) => field.alwaysRequired && field.defaultValue === null;
+
+// Only the required fields and the custom fields of the process are shown, the other fields keep their default values
+const isAdditionalField = (field: WorkItemFieldDefinition) => !coreFieldReferenceNames.includes(field.referenceName) && !field.readOnly && (isRequiredField(field) || field.referenceName.startsWith('Custom.'));
+
+const getAdditionalFieldPlaceholder = (field: WorkItemFieldDefinition) => {
+    switch (field.type) {
+    case 'identity':
+        return `${field.name} (@username or email)`;
+    case 'dateTime':
+        return `${field.name} (YYYY-MM-DD)`;
+    default:
+        return field.name;
+    }
+};
+
 const TaskModal = () => {
     const {createTaskModal: createTaskModalFields} = pluginConstants.form;
 
@@ -37,11 +62,15 @@ const TaskModal = () => {
     // State variables
     const {visibility, commandArgs} = getCreateTaskModalState(state);
     const [selectedProjectId, setSelectedProjectId] = useState<string>('');
+    const [additionalFields, setAdditionalFields] = useState<Record<string, string>>({});
+    const [additionalFieldErrors, setAdditionalFieldErrors] = useState<Record<string, string>>({});
 
     // Function to hide the modal and reset all the states
     const resetModalState = () => {
         dispatch(toggleShowTaskModal({isVisible: false, commandArgs: []}));
         resetFormFields();
+        setAdditionalFields({});
+        setAdditionalFieldErrors({});
     };
 
     // Opens link project modal
@@ -90,6 +119,86 @@ const TaskModal = () => {
 
     const getAreaPathOptions = useCallback(() => (isAreaPathSuccess ? ([...formLabelValuePairs('displayValue', 'value', areaPathList[subscriptionFiltersNameForBoards.areaPath], ['[Any]'])]) : []), [areaPathList]);
 
+    // Work item types of the selected project
+    const getWorkItemTypesRequest = useMemo<GetWorkItemTypesRequest>(() => ({
+        organization: formFields.organization as string,
+        project: formFields.project as string,
+    }), [formFields.organization, formFields.project]);
+
+    useEffect(() => {
+        if (visibility && getWorkItemTypesRequest.organization && getWorkItemTypesRequest.project) {
+            makeApiRequestWithCompletionStatus(
+                pluginConstants.pluginApiServiceConfigs.getWorkItemTypes.apiServiceName,
+                getWorkItemTypesRequest,
+            );
+        }
+    }, [visibility, getWorkItemTypesRequest]);
+
+    const {data: workItemTypesData, isLoading: isWorkItemTypesLoading, isSuccess: isWorkItemTypesSuccess} = getApiState(
+        pluginConstants.pluginApiServiceConfigs.getWorkItemTypes.apiServiceName,
+        getWorkItemTypesRequest as APIRequestPayload,
+    );
+
+    const workItemTypeOptions: LabelValuePair[] = isWorkItemTypesSuccess ? (workItemTypesData as WorkItemType[]).map((workItemType) => ({value: workItemType.name, label: workItemType.name})) : [];
+
+    // Pre-select the type passed in the command once the types of the project are fetched
+    useEffect(() => {
+        if (!isWorkItemTypesSuccess || !commandArgs.type || formFields.type) {
+            return;
+        }
+
+        const commandType = workItemTypeOptions.find((option) => option.value.toLowerCase() === commandArgs.type.toLowerCase());
+        if (commandType) {
+            setSpecificFieldValue({
+                ...formFields,
+                type: commandType.value,
+            });
+        }
+    }, [isWorkItemTypesSuccess, workItemTypesData]);
+
+    // Field definitions of the selected work item type
+    const getWorkItemTypeFieldsRequest = useMemo<GetWorkItemTypeFieldsRequest>(() => ({
+        organization: formFields.organization as string,
+        project: formFields.project as string,
+        type: formFields.type as string,
+    }), [formFields.organization, formFields.project, formFields.type]);
+
+    useEffect(() => {
+        setAdditionalFields({});
+        setAdditionalFieldErrors({});
+        if (visibility && getWorkItemTypeFieldsRequest.organization && getWorkItemTypeFieldsRequest.project && getWorkItemTypeFieldsRequest.type) {
+            makeApiRequestWithCompletionStatus(
+                pluginConstants.pluginApiServiceConfigs.getWorkItemTypeFields.apiServiceName,
+                getWorkItemTypeFieldsRequest,
+            );
+        }
+    }, [visibility, getWorkItemTypeFieldsRequest]);
+
+    const {data: workItemTypeFieldsData, isLoading: isWorkItemTypeFieldsLoading, isSuccess: isWorkItemTypeFieldsSuccess} = getApiState(
+        pluginConstants.pluginApiServiceConfigs.getWorkItemTypeFields.apiServiceName,
+        getWorkItemTypeFieldsRequest as APIRequestPayload,
+    );
+
+    const additionalFieldDefinitions = isWorkItemTypeFieldsSuccess && formFields.type ? (workItemTypeFieldsData as WorkItemFieldDefinition[]).filter(isAdditionalField) : [];
+
+    const handleSetAdditionalField = (referenceName: string, newValue: string) => {
+        setAdditionalFields({...additionalFields, [referenceName]: newValue});
+        setAdditionalFieldErrors({...additionalFieldErrors, [referenceName]: ''});
+    };
+
+    // Checks if the required fields of the work item type are filled, the values are validated by the server
+    const isErrorInAdditionalFields = () => {
+        const errors: Record<string, string> = {};
+        additionalFieldDefinitions.forEach((field) => {
+            if (isRequiredField(field) && !additionalFields[field.referenceName]?.trim()) {
+                errors[field.referenceName] = `${field.name} is required`;
+            }
+        });
+
+        setAdditionalFieldErrors(errors);
+        return Boolean(Object.keys(errors).length);
+    };
+
     const handleSetAreaPathField = (newValue: string) =>
         setSpecificFieldValue({
             ...formFields,
@@ -100,6 +209,13 @@ const TaskModal = () => {
         onChangeFormField(field as CreateTaskModalFields, newValue);
         if (field === 'project' && selectedOption) {
             setSelectedProjectId((selectedOption as ProjectListLabelValuePair).projectID);
+
+            // The work item types differ between the processes of the projects
+            setSpecificFieldValue({
+                ...formFields,
+                project: newValue,
+                type: '',
+            });
         }
     };
 
@@ -119,7 +235,7 @@ const TaskModal = () => {
         case 'project':
             return projectList.filter((project) => project.metaData === formFields.organization);
         case 'type':
-            return createTaskModalFields.type.optionsList;
+            return workItemTypeOptions;
         default:
             return [];
         }
@@ -135,6 +251,7 @@ const TaskModal = () => {
                 title: formFields.title ?? '',
                 description: formFields.description ?? '',
                 areaPath: formFields.areaPath ?? '',
+                additionalFields: Object.fromEntries(Object.entries(additionalFields).filter(([, value]) => value.trim())),
             },
             timestamp: formFields.timestamp ?? '',
         };
@@ -144,7 +261,8 @@ const TaskModal = () => {
 
     // Handles creating a new task on confirmation
     const onConfirm = () => {
-        if (!isErrorInFormValidation()) {
+        const isAdditionalFieldsError = isErrorInAdditionalFields();
+        if (!isErrorInFormValidation() && !isAdditionalFieldsError) {
             // Make POST api request
             makeApiRequestWithCompletionStatus(
                 pluginConstants.pluginApiServiceConfigs.createTask.apiServiceName,
@@ -206,7 +324,7 @@ const TaskModal = () => {
 
     const {isLoading: isCreateTaskLoading, isError, error} = getApiState(pluginConstants.pluginApiServiceConfigs.createTask.apiServiceName, getApiPayload());
     const isAnyProjectLinked = Boolean(organizationList.length && projectList.length);
-    const isLoading = isOrganizationAndProjectListLoading || isCreateTaskLoading || isAreaPathLoading;
+    const isLoading = isOrganizationAndProjectListLoading || isCreateTaskLoading || isAreaPathLoading || isWorkItemTypesLoading || isWorkItemTypeFieldsLoading;
 
     return (
         <Modal
@@ -245,6 +363,32 @@ const TaskModal = () => {
                                 loadingOptions={isAreaPathLoading}
                                 disabled={!formFields.project || isLoading}
                             />
+                            {
+                                additionalFieldDefinitions.map((field) => (
+                                    field.allowedValues?.length || field.type === 'boolean' ? (
+                                        <Dropdown
+                                            key={field.referenceName}
+                                            placeholder={field.name}
+                                            value={additionalFields[field.referenceName] ?? ''}
+                                            onChange={(newValue) => handleSetAdditionalField(field.referenceName, newValue)}
+                                            options={field.allowedValues?.length ? field.allowedValues.map((allowedValue) => ({value: String(allowedValue), label: String(allowedValue)})) : booleanFieldOptions}
+                                            required={isRequiredField(field)}
+                                            error={additionalFieldErrors[field.referenceName]}
+                                            disabled={isLoading}
+                                        />
+                                    ) : (
+                                        <Input
+                                            key={field.referenceName}
+                                            placeholder={getAdditionalFieldPlaceholder(field)}
+                                            value={additionalFields[field.referenceName] ?? ''}
+                                            onChange={(e: React.ChangeEvent<HTMLInputElement>) => handleSetAdditionalField(field.referenceName, e.target.value)}
+                                            required={isRequiredField(field)}
+                                            error={additionalFieldErrors[field.referenceName]}
+                                            disabled={isLoading}
+                                        />
+                                    )
+                                ))
+                            }
                         </>
                     ) : !isLoading && (
                         <EmptyState
@@ -14,7 +14,7 @@ export default class Hooks {
         }
 
         if (commandTrimmed && commandTrimmed.startsWith('/azuredevops link')) {
-            const commandArgs = getCommandArgs(commandTrimmed);
+            const commandArgs = getCommandArgs(commandTrimmed) ?? [];
             this.store.dispatch(setGlobalModalState({modalId: 'linkProject', commandArgs}));
             return Promise.resolve({
                 message,
@@ -22,8 +22,10 @@ export default class Hooks {
             });
         }
 
-        if (commandTrimmed && commandTrimmed.startsWith('/azuredevops boards workitem create')) {
-            const commandArgs = getCommandArgs(commandTrimmed);
+        // Work items created using a preset are handled by the server
+        const createTaskCommandArgs = commandTrimmed && commandTrimmed.startsWith('/azuredevops boards workitem create') ? getCommandArgs(commandTrimmed) : null;
+        if (createTaskCommandArgs && !createTaskCommandArgs.includes('--preset')) {
+            const commandArgs = createTaskCommandArgs;
             this.store.dispatch(setGlobalModalState({modalId: 'createBoardTask', commandArgs}));
             return Promise.resolve({
                 message,
@@ -32,7 +34,7 @@ export default class Hooks {
         }
 
         if (commandTrimmed && commandTrimmed.startsWith('/azuredevops boards subscription add')) {
-            const commandArgs = getCommandArgs(commandTrimmed);
+            const commandArgs = getCommandArgs(commandTrimmed) ?? [];
             this.store.dispatch(setGlobalModalState({modalId: 'subscribeProject', commandArgs: [...commandArgs, boards]}));
             return {
                 message,
@@ -41,7 +43,7 @@ export default class Hooks {
         }
 
         if (commandTrimmed && commandTrimmed.startsWith('/azuredevops repos subscription add')) {
-            const commandArgs = getCommandArgs(commandTrimmed);
+            const commandArgs = getCommandArgs(commandTrimmed) ?? [];
             this.store.dispatch(setGlobalModalState({modalId: 'subscribeProject', commandArgs: [...commandArgs, repos]}));
             return {
                 message,
@@ -50,7 +52,7 @@ export default class Hooks {
         }
 
         if (commandTrimmed && commandTrimmed.startsWith('/azuredevops pipelines subscription add')) {
-            const commandArgs = getCommandArgs(commandTrimmed);
+            const commandArgs = getCommandArgs(commandTrimmed) ?? [];
             this.store.dispatch(setGlobalModalState({modalId: 'subscribeProject', commandArgs: [...commandArgs, pipelines]}));
             return {
                 message,
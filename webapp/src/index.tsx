@@ -2,6 +2,8 @@ import React from 'react';
 import {Store, Action} from 'redux';
 
 import {GlobalState} from 'mattermost-redux/types/store';
+import {openInteractiveDialog} from 'mattermost-redux/actions/integrations';
+import {getPost} from 'mattermost-redux/selectors/entities/posts';
 
 import reducer from 'reducers';
 
@@ -17,6 +19,9 @@ import Rhs from 'containers/Rhs';
 import LinkModal from 'containers/modals/LinkModal';
 import TaskModal from 'containers/modals/TaskModal';
 import SubscribeModal from 'containers/modals/SubscribeModal';
+import PullRequestCounts from 'containers/PullRequestCounts';
+
+import Utils from 'utils';
 
 import App from './app';
 
@@ -32,6 +37,7 @@ export default class Plugin {
         registry.registerRootComponent(TaskModal);
         registry.registerRootComponent(LinkModal);
         registry.registerRootComponent(SubscribeModal);
+        registry.registerBottomTeamSidebarComponent(PullRequestCounts);
 
         registry.registerWebSocketEventHandler(`custom_${Constants.common.pluginId}_connect`, handleConnect(store));
 
@@ -45,6 +51,54 @@ export default class Plugin {
         registry.registerSlashCommandWillBePostedHook(hooks.slashCommandWillBePostedHook);
 
         registry.registerChannelHeaderButtonAction(<ChannelHeaderButton/>, () => store.dispatch(showRHSPlugin), null, Constants.common.AzureDevops);
+
+        // The files are attached from the server on submitting the dialog, the ID of the post is sent as the callback ID
+        registry.registerPostDropdownMenuAction(
+            Constants.common.AttachFilesToWorkItem,
+            (postId: string) => store.dispatch(openInteractiveDialog({
+                url: `${Utils.getBaseUrls().pluginApiBaseUrl}/attachments/dialog`,
+                dialog: {
+                    callback_id: postId,
+                    title: Constants.common.AttachFilesToWorkItem,
+                    elements: [{
+                        display_name: 'Work item link',
+                        name: 'workItemLink',
+                        type: 'text',
+                        placeholder: 'https://dev.azure.com/organization/project/_workitems/edit/1',
+                    }],
+                    submit_label: 'Attach',
+                },
+            })),
+            (postId: string) => Boolean(getPost(store.getState(), postId)?.file_ids?.length),
+        );
+
+        // The thread of the post is linked from the server on submitting the dialog, the ID of the post is sent as the callback ID
+        registry.registerPostDropdownMenuAction(
+            Constants.common.LinkThreadToWorkItem,
+            (postId: string) => store.dispatch(openInteractiveDialog({
+                url: `${Utils.getBaseUrls().pluginApiBaseUrl}/thread/link/dialog`,
+                dialog: {
+                    callback_id: postId,
+                    title: Constants.common.LinkThreadToWorkItem,
+                    elements: [
+                        {
+                            display_name: 'Work item link',
+                            name: 'workItemLink',
+                            type: 'text',
+                            placeholder: 'https://dev.azure.com/organization/project/_workitems/edit/1',
+                        },
+                        {
+                            display_name: 'Mirror replies',
+                            name: 'mirrorReplies',
+                            type: 'bool',
+                            placeholder: 'Add the replies in the thread as comments on the work item',
+                            optional: true,
+                        },
+                    ],
+                    submit_label: 'Link',
+                },
+            })),
+        );
     }
 }
 
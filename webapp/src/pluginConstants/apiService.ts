@@ -45,4 +45,19 @@ export const pluginApiServiceConfigs: Record<PluginApiServiceName, PluginApiServ
         method: 'POST',
         apiServiceName: 'getSubscriptionFilters',
     },
+    getWorkItemTypes: {
+        path: '/workitem-types',
+        method: 'GET',
+        apiServiceName: 'getWorkItemTypes',
+    },
+    getWorkItemTypeFields: {
+        path: '/workitem-types',
+        method: 'GET',
+        apiServiceName: 'getWorkItemTypeFields',
+    },
+    getPullRequestCounts: {
+        path: '/pull-requests/counts',
+        method: 'GET',
+        apiServiceName: 'getPullRequestCounts',
+    },
 };
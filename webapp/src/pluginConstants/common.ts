@@ -4,6 +4,11 @@ export const pluginId = 'mattermost-plugin-azure-devops';
 
 export const AzureDevops = 'Azure DevOps';
 export const RightSidebarHeader = 'Azure DevOps';
+export const AttachFilesToWorkItem = 'Attach files to Azure DevOps work item';
+export const LinkThreadToWorkItem = 'Link thread to Azure DevOps work item';
+export const createTaskTypeArgument = 'type=';
+export const pullRequestCountsTooltip = 'Active pull requests of your linked projects which you have not voted on yet. Run "/azuredevops repos prs [project] review" to list them.';
+export const pullRequestCountsRefetchInterval = 5 * 60 * 1000;
 
 export const MMCSRF = 'MMCSRF';
 export const MMAUTHTOKEN = 'MMAUTHTOKEN';
@@ -514,21 +514,6 @@ export const subscriptionModal: Record<SubscriptionModalFields, ModalFormFieldCo
 };
 
 // Create task modal
-const taskTypeOptions = [
-    {
-        value: 'Task',
-        label: 'Task',
-    },
-    {
-        value: 'Epic',
-        label: 'Epic',
-    },
-    {
-        value: 'Issue',
-        label: 'Issue',
-    },
-];
-
 export const createTaskModal: Record<CreateTaskModalFields, ModalFormFieldConfig> = {
     organization: {
         label: 'Organization name',
@@ -550,7 +535,6 @@ export const createTaskModal: Record<CreateTaskModalFields, ModalFormFieldConfig
         label: 'Work item type',
         value: '',
         type: 'dropdown',
-        optionsList: taskTypeOptions,
         validations: {
             isRequired: true,
         },
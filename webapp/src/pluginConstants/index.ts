@@ -9,6 +9,11 @@ import {
     MMUSERID,
     pluginId,
     RightSidebarHeader,
+    AttachFilesToWorkItem,
+    LinkThreadToWorkItem,
+    createTaskTypeArgument,
+    pullRequestCountsTooltip,
+    pullRequestCountsRefetchInterval,
     eventTypeMap,
     serviceTypeIcon,
     defaultPage,
@@ -66,6 +71,11 @@ export default {
         AzureDevops,
         deleteAllSubscriptionsMessage,
         RightSidebarHeader,
+        AttachFilesToWorkItem,
+    LinkThreadToWorkItem,
+        createTaskTypeArgument,
+        pullRequestCountsTooltip,
+        pullRequestCountsRefetchInterval,
         eventTypeMap,
         serviceTypeIcon,
         defaultPage,
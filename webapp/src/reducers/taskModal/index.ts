@@ -7,6 +7,7 @@ const initialState: CreateTaskModalState = {
     commandArgs: {
         title: '',
         description: '',
+        type: '',
     },
 };
 
@@ -18,11 +19,13 @@ export const createTaskModalSlice = createSlice({
             state.visibility = action.payload.isVisible;
             state.commandArgs.title = '';
             state.commandArgs.description = '';
+            state.commandArgs.type = '';
 
             if (action.payload.commandArgs.length > 1) {
-                const {title, description} = getCreateTaskModalCommandArgs(action.payload.commandArgs) as CreateTaskCommandArgs;
+                const {title, description, type} = getCreateTaskModalCommandArgs(action.payload.commandArgs) as CreateTaskCommandArgs;
                 state.commandArgs.title = title;
                 state.commandArgs.description = description;
+                state.commandArgs.type = type;
             }
         },
     },
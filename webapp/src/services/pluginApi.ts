@@ -79,5 +79,23 @@ export const azureDevOpsPluginApi = createApi({
                 body: payload,
             }),
         }),
+        [Constants.pluginApiServiceConfigs.getWorkItemTypes.apiServiceName]: builder.query<WorkItemType[], GetWorkItemTypesRequest>({
+            query: (params) => ({
+                url: `${Constants.pluginApiServiceConfigs.getWorkItemTypes.path}/${params.organization}/${encodeURIComponent(params.project)}`,
+                method: Constants.pluginApiServiceConfigs.getWorkItemTypes.method,
+            }),
+        }),
+        [Constants.pluginApiServiceConfigs.getWorkItemTypeFields.apiServiceName]: builder.query<WorkItemFieldDefinition[], GetWorkItemTypeFieldsRequest>({
+            query: (params) => ({
+                url: `${Constants.pluginApiServiceConfigs.getWorkItemTypeFields.path}/${params.organization}/${encodeURIComponent(params.project)}/${encodeURIComponent(params.type)}/fields`,
+                method: Constants.pluginApiServiceConfigs.getWorkItemTypeFields.method,
+            }),
+        }),
+        [Constants.pluginApiServiceConfigs.getPullRequestCounts.apiServiceName]: builder.query<PullRequestCounts, void>({
+            query: () => ({
+                url: Constants.pluginApiServiceConfigs.getPullRequestCounts.path,
+                method: Constants.pluginApiServiceConfigs.getPullRequestCounts.method,
+            }),
+        }),
     }),
 });
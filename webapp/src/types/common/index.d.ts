@@ -32,6 +32,29 @@ type CreateTaskFields = {
     title: string,
     description: string,
     areaPath: string,
+    additionalFields?: Record<string, string>,
+}
+
+type WorkItemType = {
+    name: string,
+    referenceName: string,
+}
+
+type WorkItemFieldDefinition = {
+    name: string,
+    referenceName: string,
+    type: string,
+    alwaysRequired: boolean,
+    readOnly: boolean,
+    defaultValue: string | number | boolean | null,
+    allowedValues: Array<string | number> | null,
+    helpText: string,
+}
+
+type PullRequestCounts = {
+    waitingForReview: number,
+    review: number,
+    mine: number,
 }
 
 type ProjectDetails = {
@@ -122,6 +145,15 @@ type SubscriptionDetails = {
     runStateId: string
     runStateIdName: string
     runResultId: string
+    workItemFilters?: WorkItemFilters
+}
+
+type WorkItemFilters = {
+    workItemTypes?: string[]
+    tag?: string
+    stateFrom?: string
+    stateTo?: string
+    maxPriority?: number
 }
 
 type WebsocketEventParams = {
@@ -79,3 +79,12 @@ type GetSubscriptionFiltersRequest = {
     releasePipelineId?: string
     runPipeline?: string
 }
+
+type GetWorkItemTypesRequest = {
+    organization: string,
+    project: string,
+}
+
+type GetWorkItemTypeFieldsRequest = GetWorkItemTypesRequest & {
+    type: string,
+}
@@ -9,7 +9,10 @@ type PluginApiServiceName =
     'createSubscription' |
     'getSubscriptionList' |
     'deleteSubscription' |
-    'getSubscriptionFilters'
+    'getSubscriptionFilters' |
+    'getWorkItemTypes' |
+    'getWorkItemTypeFields' |
+    'getPullRequestCounts'
 
 type PluginApiService = {
     path: string,
@@ -34,4 +37,6 @@ type APIRequestPayload =
     FetchSubscriptionList |
     GetSubscriptionFiltersRequest |
     GetSubscriptionFiltersResponse |
+    GetWorkItemTypesRequest |
+    GetWorkItemTypeFieldsRequest |
     void;
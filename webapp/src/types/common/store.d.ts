@@ -38,6 +38,7 @@ type SubscribeModalState = {
 type CreateTaskCommandArgs = {
     title: string;
     description: string;
+    type: string;
 }
 
 type CreateTaskModalState = {
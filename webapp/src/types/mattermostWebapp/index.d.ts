@@ -10,6 +10,8 @@ export interface PluginRegistry {
     registerRootComponent(component: ReactDOM);
     registerChannelIntroButtonAction(icon: JSX.Element, action: () => void, tooltipText?: string | null);
     registerChannelHeaderMenuAction(text: string, action: () => void);
+    registerPostDropdownMenuAction(text: string, action: (postId: string) => void, filter?: (postId: string) => boolean);
+    registerBottomTeamSidebarComponent(component: React.ElementType);
     registerRightHandSidebarComponent(component: () => JSX.Element, title: string | JSX.Element);
     registerChannelHeaderButtonAction(icon: JSX.Element, action: () => void, dropdownText: string | null, tooltipText: string | null);
     registerWebSocketEventHandler(event: string, handler: (msg: WebsocketEventParams) => void)
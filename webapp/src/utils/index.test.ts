@@ -0,0 +1,32 @@
+import {getCommandArgs, parseCommandArgs} from './index';
+
+describe('parseCommandArgs', () => {
+    test.each([
+        ['arguments separated by whitespace', '/azuredevops  boards\tsubscription   list ', ['/azuredevops', 'boards', 'subscription', 'list']],
+        ['double quoted arguments', '/azuredevops boards workitem create "Login fails" "Steps to repro..."', ['/azuredevops', 'boards', 'workitem', 'create', 'Login fails', 'Steps to repro...']],
+        ['single quoted arguments', '/azuredevops boards workitem create \'Login "fails"\'', ['/azuredevops', 'boards', 'workitem', 'create', 'Login "fails"']],
+        ['empty quoted argument', '/azuredevops boards subscription delete ""', ['/azuredevops', 'boards', 'subscription', 'delete', '']],
+        ['quotes in the middle of an argument', '/azuredevops boards preset add triage area="Web App"\\\\Checkout', ['/azuredevops', 'boards', 'preset', 'add', 'triage', 'area=Web App\\Checkout']],
+        ['escaped quotes and whitespace', '/azuredevops boards workitem create "Login \\"fails\\"" Steps\\ to\\ repro', ['/azuredevops', 'boards', 'workitem', 'create', 'Login "fails"', 'Steps to repro']],
+        ['backslash before other characters is kept', '/azuredevops boards preset add bug-triage area=Web\\Checkout', ['/azuredevops', 'boards', 'preset', 'add', 'bug-triage', 'area=Web\\Checkout']],
+        ['backslash is not an escape character in single quotes', '/azuredevops boards workitem create \'C:\\\\temp\'', ['/azuredevops', 'boards', 'workitem', 'create', 'C:\\\\temp']],
+        ['flag with quoted value', '/azuredevops boards workitem create --preset="bug triage" "Login fails"', ['/azuredevops', 'boards', 'workitem', 'create', '--preset', 'bug triage', 'Login fails']],
+        ['quoted flag is not split', '/azuredevops boards workitem create "--preset=bug-triage"', ['/azuredevops', 'boards', 'workitem', 'create', '--preset=bug-triage']],
+    ])('%s', (_, command, expectedArgs) => {
+        expect(parseCommandArgs(command)).toEqual(expectedArgs);
+    });
+
+    test('missing closing quote', () => {
+        expect(parseCommandArgs('/azuredevops boards workitem create \'Login fails')).toBeNull();
+    });
+});
+
+describe('getCommandArgs', () => {
+    test('returns the arguments after the command and its first argument', () => {
+        expect(getCommandArgs('/azuredevops boards workitem create \'Login fails\' type="User Story"')).toEqual(['workitem', 'create', 'Login fails', 'type=User Story']);
+    });
+
+    test('returns null if the command can not be parsed', () => {
+        expect(getCommandArgs('/azuredevops boards workitem create "Login fails')).toBeNull();
+    });
+});
@@ -16,17 +16,102 @@ const getBaseUrls = (): { pluginApiBaseUrl: string; mattermostApiBaseUrl: string
     return {pluginApiBaseUrl, mattermostApiBaseUrl};
 };
 
-export const getCommandArgs = (command: string) => {
-    const myRegexp = /[^\s"]+|"([^"]*)"/gi;
-    const myArray = [];
-    let match;
-    do {
-        match = myRegexp.exec(command);
-        if (match != null) {
-            myArray.push(match[1] ? match[1] : match[0]);
+const isWhitespace = (char: string): boolean => (/\s/).test(char);
+
+// Splits a slash command into arguments in the same way as the server, so that the modals get the same arguments as the server.
+// Arguments are separated by whitespace unless the whitespace is quoted with single or double quotes or escaped with a backslash.
+// A backslash escapes a following quote, backslash or whitespace and is kept as it is before any other character.
+// Options of the form "--flag=value" are split into the separate arguments "--flag" and "value".
+// Null is returned if a quote is not closed.
+export const parseCommandArgs = (command: string): string[] | null => {
+    const args: string[] = [];
+    let current = '';
+    let inArgument = false;
+    let quote = '';
+    let escaped = false;
+
+    // Set if the argument starts with an unquoted "--"
+    let isFlag = false;
+
+    const addArgument = () => {
+        if (!inArgument) {
+            return;
         }
-    } while (match != null);
-    return myArray.length > 2 ? myArray.slice(2) : [];
+
+        const separatorIndex = current.indexOf('=');
+        if (isFlag && separatorIndex >= 0) {
+            args.push(current.slice(0, separatorIndex), current.slice(separatorIndex + 1));
+        } else {
+            args.push(current);
+        }
+
+        current = '';
+        inArgument = false;
+        isFlag = false;
+    };
+
+    const chars = Array.from(command);
+    chars.forEach((char, index) => {
+        if (escaped) {
+            current += char;
+            escaped = false;
+            return;
+        }
+
+        if (char === '\\' && quote !== '\'') {
+            const next = chars[index + 1] ?? '';
+            if (next === '\\' || next === '"' || next === '\'' || (!quote && isWhitespace(next))) {
+                escaped = true;
+            } else {
+                current += char;
+            }
+            inArgument = true;
+            return;
+        }
+
+        if (quote) {
+            if (char === quote) {
+                quote = '';
+            } else {
+                current += char;
+            }
+            return;
+        }
+
+        if (char === '"' || char === '\'') {
+            quote = char;
+            inArgument = true;
+            return;
+        }
+
+        if (isWhitespace(char)) {
+            addArgument();
+            return;
+        }
+
+        if (!inArgument && char === '-' && chars[index + 1] === '-') {
+            isFlag = true;
+        }
+        current += char;
+        inArgument = true;
+    });
+
+    if (quote) {
+        return null;
+    }
+
+    addArgument();
+    return args;
+};
+
+// Returns the arguments of a slash command after the command and its first argument, null is returned if the command can not be parsed
+export const getCommandArgs = (command: string): string[] | null => {
+    const args = parseCommandArgs(command);
+    if (!args) {
+        return null;
+    }
+
+    return args.length > 2 ? args.slice(2) : [];
 };
 
 export const getProjectLinkModalArgs = (str: string): LinkPayload => {
@@ -44,10 +129,27 @@ export const getProjectLinkModalArgs = (str: string): LinkPayload => {
     };
 };
 
-export const getCreateTaskModalCommandArgs = (arr: Array<string>): CreateTaskCommandArgs => ({
-    title: arr[2] ?? '',
-    description: arr[3] ?? '',
-});
+// The type of the work item is passed as "type=Bug" or with a quoted type e.g. type="User Story", whose quotes are removed while parsing the command
+export const getCreateTaskModalCommandArgs = (arr: Array<string>): CreateTaskCommandArgs => {
+    const args: string[] = [];
+    let type = '';
+    for (let i = 0; i < arr.length; i++) {
+        if (arr[i] === Constants.common.createTaskTypeArgument && i + 1 < arr.length) {
+            type = arr[i + 1];
+            i++;
+        } else if (arr[i].startsWith(Constants.common.createTaskTypeArgument)) {
+            type = arr[i].slice(Constants.common.createTaskTypeArgument.length);
+        } else {
+            args.push(arr[i]);
+        }
+    }
+
+    return {
+        title: args[2] ?? '',
+        description: args[3] ?? '',
+        type,
+    };
+};
 
 export const onPressingEnterKey = (event: React.KeyboardEvent<HTMLSpanElement> | React.KeyboardEvent<SVGSVGElement>, func: () => void) => {
     if (event.key !== 'Enter' && event.key !== ' ') {